@@ -5,21 +5,32 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"strconv"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/gin-gonic/gin"
 )
 
 // FacadeHandler interface defines methods that can be used from `elrondFacade` context variable
 type FacadeHandler interface {
-	GetBalance(address string) (*big.Int, error)
+	GetBalance(address string, options state.QueryOptions) (*big.Int, error)
 	GetValueForKey(address string, key string) (string, error)
-	GetAccount(address string) (state.UserAccountHandler, error)
+	GetAccount(address string, options state.QueryOptions) (state.UserAccountHandler, error)
+	GetTransactionsForAddress(address string, from uint32, size uint32) (*transaction.ApiAccountHistory, error)
+	GetProof(address string, options state.QueryOptions) (*state.ApiProof, error)
+	GetProofDataTrie(address string, key string, options state.QueryOptions) (*state.ApiProof, *state.ApiProof, error)
 	IsInterfaceNil() bool
 }
 
+const (
+	defaultTransactionsPageSize = 20
+	maxTransactionsPageSize     = 100
+)
+
 type accountResponse struct {
 	Address  string `json:"address"`
 	Nonce    uint64 `json:"nonce"`
@@ -34,10 +45,13 @@ func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, "/:address", GetAccount)
 	router.RegisterHandler(http.MethodGet, "/:address/balance", GetBalance)
 	router.RegisterHandler(http.MethodGet, "/:address/key/:key", GetValueForKey)
+	router.RegisterHandler(http.MethodGet, "/:address/transactions", GetTransactions)
+	router.RegisterHandler(http.MethodGet, "/:address/proof", GetProof)
+	router.RegisterHandler(http.MethodGet, "/:address/key/:key/proof", GetProofDataTrie)
 }
 
-// GetAccount returns an accountResponse containing information
-//  about the account correlated with provided address
+// GetAccount returns an accountResponse containing information about the account correlated with provided address.
+// The optional blockNonce or rootHash query parameters select a past state
 func GetAccount(c *gin.Context) {
 	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
 	if !ok {
@@ -46,15 +60,22 @@ func GetAccount(c *gin.Context) {
 	}
 
 	addr := c.Param("address")
-	acc, err := ef.GetAccount(addr)
+	options, err := shared.GetStateQueryOptions(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrCouldNotGetAccount.Error(), err.Error())})
+		return
+	}
+
+	acc, err := ef.GetAccount(addr, options)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrCouldNotGetAccount.Error(), err.Error())})
+		c.JSON(shared.GetStatusCodeForStateError(err), gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrCouldNotGetAccount.Error(), err.Error())})
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{"account": accountResponseFromBaseAccount(addr, acc)})
 }
 
-// GetBalance returns the balance for the address parameter
+// GetBalance returns the balance for the address parameter. The optional blockNonce or rootHash
+// query parameters select a past state
 func GetBalance(c *gin.Context) {
 	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
 	if !ok {
@@ -68,9 +89,15 @@ func GetBalance(c *gin.Context) {
 		return
 	}
 
-	balance, err := ef.GetBalance(addr)
+	options, err := shared.GetStateQueryOptions(c)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetBalance.Error(), err.Error())})
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetBalance.Error(), err.Error())})
+		return
+	}
+
+	balance, err := ef.GetBalance(addr, options)
+	if err != nil {
+		c.JSON(shared.GetStatusCodeForStateError(err), gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetBalance.Error(), err.Error())})
 		return
 	}
 
@@ -106,6 +133,128 @@ func GetValueForKey(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"value": value})
 }
 
+// GetTransactions returns the transactions which touched the address parameter, newest first, and whether older
+// ones were pruned from the history. The optional from and size query parameters are used for pagination
+func GetTransactions(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetTransactionsForAddress.Error(), errors.ErrEmptyAddress.Error())})
+		return
+	}
+
+	from, err := getUint32QueryParameter(c, "from", 0)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetTransactionsForAddress.Error(), err.Error())})
+		return
+	}
+
+	size, err := getUint32QueryParameter(c, "size", defaultTransactionsPageSize)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetTransactionsForAddress.Error(), err.Error())})
+		return
+	}
+	if size > maxTransactionsPageSize {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s: size should be at most %d",
+			errors.ErrGetTransactionsForAddress.Error(), errors.ErrInvalidQueryParameter.Error(), maxTransactionsPageSize)})
+		return
+	}
+
+	history, err := ef.GetTransactionsForAddress(addr, from, size)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetTransactionsForAddress.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"transactions": history.Transactions, "truncated": history.Truncated})
+}
+
+// GetProof returns the Merkle inclusion proof of the account correlated with the address parameter. The proof is
+// built against the state of the current block or, if the optional blockNonce or rootHash query parameters are
+// provided, of a past state
+func GetProof(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), errors.ErrEmptyAddress.Error())})
+		return
+	}
+
+	options, err := shared.GetStateQueryOptions(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error())})
+		return
+	}
+
+	proof, err := ef.GetProof(addr, options)
+	if err != nil {
+		c.JSON(shared.GetStatusCodeForStateError(err), gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"proof": proof})
+}
+
+// GetProofDataTrie returns the Merkle inclusion proof of the account correlated with the address parameter together
+// with the Merkle inclusion proof of the key parameter in the account's data trie
+func GetProofDataTrie(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), errors.ErrEmptyAddress.Error())})
+		return
+	}
+
+	key := c.Param("key")
+	if key == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), errors.ErrEmptyKey.Error())})
+		return
+	}
+
+	options, err := shared.GetStateQueryOptions(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error())})
+		return
+	}
+
+	accountProof, dataTrieProof, err := ef.GetProofDataTrie(addr, key, options)
+	if err != nil {
+		c.JSON(shared.GetStatusCodeForStateError(err), gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"accountProof": accountProof, "dataTrieProof": dataTrieProof})
+}
+
+func getUint32QueryParameter(c *gin.Context, name string, defaultValue uint32) (uint32, error) {
+	param := c.Query(name)
+	if param == "" {
+		return defaultValue, nil
+	}
+
+	value, err := strconv.ParseUint(param, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", errors.ErrInvalidQueryParameter, name)
+	}
+
+	return uint32(value), nil
+}
+
 func accountResponseFromBaseAccount(address string, account state.UserAccountHandler) accountResponse {
 	return accountResponse{
 		Address:  address,
@@ -18,9 +18,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // General response structure
@@ -40,6 +42,12 @@ type addressResponse struct {
 	Balance string `json:"balance"`
 }
 
+type transactionsResponse struct {
+	GeneralResponse
+	Transactions []*transaction.ApiAccountHistoryEntry `json:"transactions"`
+	Truncated    bool                                  `json:"truncated"`
+}
+
 func NewAddressResponse() *addressResponse {
 	return &addressResponse{
 		Balance: "0",
@@ -78,7 +86,7 @@ func TestGetBalance_WithCorrectAddressShouldNotReturnError(t *testing.T) {
 	amount := big.NewInt(10)
 	addr := "testAddress"
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ state.QueryOptions) (i *big.Int, e error) {
 			return amount, nil
 		},
 	}
@@ -103,7 +111,7 @@ func TestGetBalance_WithWrongAddressShouldReturnZero(t *testing.T) {
 	t.Parallel()
 	otherAddress := "otherAddress"
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ state.QueryOptions) (i *big.Int, e error) {
 			return big.NewInt(0), nil
 		},
 	}
@@ -129,7 +137,7 @@ func TestGetBalance_NodeGetBalanceReturnsError(t *testing.T) {
 	addr := "addr"
 	balanceError := errors.New("error")
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ state.QueryOptions) (i *big.Int, e error) {
 			return nil, balanceError
 		},
 	}
@@ -149,7 +157,7 @@ func TestGetBalance_NodeGetBalanceReturnsError(t *testing.T) {
 func TestGetBalance_WithEmptyAddressShouldReturnZeroAndError(t *testing.T) {
 	t.Parallel()
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ state.QueryOptions) (i *big.Int, e error) {
 			return big.NewInt(0), errors.New("address was empty")
 		},
 	}
@@ -230,7 +238,7 @@ func TestGetAccount_FailWhenFacadeGetAccountFails(t *testing.T) {
 	t.Parallel()
 	returnedError := "i am an error"
 	facade := mock.Facade{
-		GetAccountHandler: func(address string) (state.UserAccountHandler, error) {
+		GetAccountHandler: func(address string, _ state.QueryOptions) (state.UserAccountHandler, error) {
 			return nil, errors.New(returnedError)
 		},
 	}
@@ -251,7 +259,7 @@ func TestGetAccount_FailWhenFacadeGetAccountFails(t *testing.T) {
 func TestGetAccount_ReturnsSuccessfully(t *testing.T) {
 	t.Parallel()
 	facade := mock.Facade{
-		GetAccountHandler: func(address string) (state.UserAccountHandler, error) {
+		GetAccountHandler: func(address string, _ state.QueryOptions) (state.UserAccountHandler, error) {
 			acc, _ := state.NewUserAccount([]byte("1234"))
 			_ = acc.AddToBalance(big.NewInt(100))
 			acc.IncreaseNonce(1)
@@ -275,6 +283,318 @@ func TestGetAccount_ReturnsSuccessfully(t *testing.T) {
 	assert.Empty(t, accountResponse.Error)
 }
 
+func TestGetAccount_WithBlockNonceShouldForwardQueryOptions(t *testing.T) {
+	t.Parallel()
+
+	var receivedOptions state.QueryOptions
+	facade := mock.Facade{
+		GetAccountHandler: func(address string, options state.QueryOptions) (state.UserAccountHandler, error) {
+			receivedOptions = options
+			acc, _ := state.NewUserAccount([]byte("1234"))
+
+			return acc, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test?blockNonce=37", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.NotNil(t, receivedOptions.BlockNonce)
+	assert.Equal(t, uint64(37), *receivedOptions.BlockNonce)
+	assert.Nil(t, receivedOptions.RootHash)
+}
+
+func TestGetAccount_InvalidQueryOptionsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetAccountHandler: func(address string, options state.QueryOptions) (state.UserAccountHandler, error) {
+			assert.Fail(t, "should have not called the facade")
+			return nil, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	urls := []string{
+		"/address/test?blockNonce=abc",
+		"/address/test?rootHash=zz",
+		"/address/test?blockNonce=1&rootHash=aa",
+	}
+	for _, url := range urls {
+		req, _ := http.NewRequest("GET", url, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		accountResponse := AccountResponse{}
+		loadResponse(resp.Body, &accountResponse)
+		assert.Equal(t, http.StatusBadRequest, resp.Code, url)
+		assert.True(t, strings.Contains(accountResponse.Error, errors2.ErrInvalidQueryParameter.Error()), url)
+	}
+}
+
+func TestGetBalance_WithRootHashShouldForwardQueryOptions(t *testing.T) {
+	t.Parallel()
+
+	var receivedOptions state.QueryOptions
+	facade := mock.Facade{
+		BalanceHandler: func(s string, options state.QueryOptions) (*big.Int, error) {
+			receivedOptions = options
+			return big.NewInt(5), nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test/balance?rootHash=aabb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	addressResponse := NewAddressResponse()
+	loadResponse(resp.Body, &addressResponse)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "5", addressResponse.Balance)
+	assert.Nil(t, receivedOptions.BlockNonce)
+	assert.Equal(t, []byte{0xaa, 0xbb}, receivedOptions.RootHash)
+}
+
+func TestGetBalance_StateNotAvailableShouldReturnNotFound(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		BalanceHandler: func(s string, options state.QueryOptions) (*big.Int, error) {
+			return nil, fmt.Errorf("%w for block nonce 2", state.ErrStateNotAvailable)
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test/balance?blockNonce=2", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	addressResponse := NewAddressResponse()
+	loadResponse(resp.Body, &addressResponse)
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	assert.True(t, strings.Contains(addressResponse.Error, state.ErrStateNotAvailable.Error()))
+}
+
+func TestGetTransactions_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	var calledFrom, calledSize uint32
+	facade := mock.Facade{
+		GetTransactionsForAddressCalled: func(address string, from uint32, size uint32) (*transaction.ApiAccountHistory, error) {
+			calledFrom, calledSize = from, size
+			return &transaction.ApiAccountHistory{
+				Transactions: []*transaction.ApiAccountHistoryEntry{
+					{Hash: "aa", BlockHash: "bb", BlockNonce: 3, Round: 4, Epoch: 1},
+				},
+				Truncated: true,
+			}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test/transactions?from=5&size=2", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := transactionsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, response.Error)
+	assert.Equal(t, uint32(5), calledFrom)
+	assert.Equal(t, uint32(2), calledSize)
+	require.Equal(t, 1, len(response.Transactions))
+	assert.Equal(t, "aa", response.Transactions[0].Hash)
+	assert.Equal(t, uint64(3), response.Transactions[0].BlockNonce)
+	assert.True(t, response.Truncated)
+}
+
+func TestGetTransactions_DefaultPaginationValues(t *testing.T) {
+	t.Parallel()
+
+	calledFrom, calledSize := uint32(100), uint32(100)
+	facade := mock.Facade{
+		GetTransactionsForAddressCalled: func(address string, from uint32, size uint32) (*transaction.ApiAccountHistory, error) {
+			calledFrom, calledSize = from, size
+			return &transaction.ApiAccountHistory{Transactions: make([]*transaction.ApiAccountHistoryEntry, 0)}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test/transactions", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, uint32(0), calledFrom)
+	assert.Equal(t, uint32(20), calledSize)
+}
+
+func TestGetTransactions_InvalidQueryParametersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{}
+	ws := startNodeServer(&facade)
+
+	for _, query := range []string{"from=-1", "size=abc", "size=101"} {
+		req, _ := http.NewRequest("GET", "/address/test/transactions?"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := transactionsResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code, query)
+		assert.True(t, strings.Contains(response.Error, errors2.ErrInvalidQueryParameter.Error()), query)
+	}
+}
+
+func TestGetTransactions_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetTransactionsForAddressCalled: func(address string, from uint32, size uint32) (*transaction.ApiAccountHistory, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test/transactions", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := transactionsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+type proofResponse struct {
+	GeneralResponse
+	Proof *state.ApiProof `json:"proof"`
+}
+
+type proofDataTrieResponse struct {
+	GeneralResponse
+	AccountProof  *state.ApiProof `json:"accountProof"`
+	DataTrieProof *state.ApiProof `json:"dataTrieProof"`
+}
+
+func TestGetProof_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedProof := &state.ApiProof{
+		RootHash: "aa",
+		Key:      "bb",
+		Proof:    []string{"cc", "dd"},
+	}
+	facade := mock.Facade{
+		GetProofCalled: func(address string, options state.QueryOptions) (*state.ApiProof, error) {
+			assert.Equal(t, "test", address)
+			assert.True(t, options.IsCurrentState())
+			return expectedProof, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test/proof", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := proofResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, response.Error)
+	assert.Equal(t, expectedProof, response.Proof)
+}
+
+func TestGetProof_AccountNotFoundShouldReturnNotFound(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetProofCalled: func(address string, options state.QueryOptions) (*state.ApiProof, error) {
+			require.NotNil(t, options.BlockNonce)
+			return nil, state.ErrAccNotFound
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test/proof?blockNonce=3", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := proofResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors2.ErrGetProof.Error()))
+	assert.Nil(t, response.Proof)
+}
+
+func TestGetProof_InvalidQueryOptionsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test/proof?rootHash=not-hex", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := proofResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors2.ErrInvalidQueryParameter.Error()))
+}
+
+func TestGetProofDataTrie_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedAccountProof := &state.ApiProof{RootHash: "aa", Key: "bb", Proof: []string{"cc"}}
+	expectedDataTrieProof := &state.ApiProof{RootHash: "dd", Key: "ee", Value: "ff", Proof: []string{"11"}}
+	facade := mock.Facade{
+		GetProofDataTrieCalled: func(address string, key string, options state.QueryOptions) (*state.ApiProof, *state.ApiProof, error) {
+			assert.Equal(t, "test", address)
+			assert.Equal(t, "ee", key)
+			return expectedAccountProof, expectedDataTrieProof, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test/key/ee/proof", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := proofDataTrieResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, response.Error)
+	assert.Equal(t, expectedAccountProof, response.AccountProof)
+	assert.Equal(t, expectedDataTrieProof, response.DataTrieProof)
+}
+
+func TestGetProofDataTrie_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetProofDataTrieCalled: func(address string, key string, options state.QueryOptions) (*state.ApiProof, *state.ApiProof, error) {
+			return nil, nil, errors.New("trie error")
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test/key/ee/proof", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := proofDataTrieResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, "trie error"))
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
@@ -319,6 +639,9 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:address", Open: true},
 					{Name: "/:address/balance", Open: true},
 					{Name: "/:address/key/:key", Open: true},
+					{Name: "/:address/transactions", Open: true},
+					{Name: "/:address/proof", Open: true},
+					{Name: "/:address/key/:key/proof", Open: true},
 				},
 			},
 		},
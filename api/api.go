@@ -6,11 +6,14 @@ import (
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/api/address"
+	"github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/api/events"
 	"github.com/ElrondNetwork/elrond-go/api/hardfork"
 	"github.com/ElrondNetwork/elrond-go/api/logs"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/network"
 	"github.com/ElrondNetwork/elrond-go/api/node"
+	"github.com/ElrondNetwork/elrond-go/api/rpc"
 	"github.com/ElrondNetwork/elrond-go/api/transaction"
 	valStats "github.com/ElrondNetwork/elrond-go/api/validator"
 	"github.com/ElrondNetwork/elrond-go/api/vmValues"
@@ -137,6 +140,13 @@ func registerRoutes(ws *gin.Engine, routesConfig config.ApiRoutesConfig, elrondF
 		valStats.Routes(wrappedValidatorsRouter)
 	}
 
+	blockRoutes := ws.Group("/block")
+	blockRoutes.Use(middleware.WithElrondFacade(elrondFacade))
+	wrappedBlockRouter, err := wrapper.NewRouterWrapper("block", blockRoutes, routesConfig)
+	if err == nil {
+		block.Routes(wrappedBlockRouter)
+	}
+
 	hardforkRoutes := ws.Group("/hardfork")
 	hardforkRoutes.Use(middleware.WithElrondFacade(elrondFacade))
 	wrappedHardforkRouter, err := wrapper.NewRouterWrapper("hardfork", hardforkRoutes, routesConfig)
@@ -144,30 +154,48 @@ func registerRoutes(ws *gin.Engine, routesConfig config.ApiRoutesConfig, elrondF
 		hardfork.Routes(wrappedHardforkRouter)
 	}
 
+	eventsRoutes := ws.Group("/events")
+	eventsRoutes.Use(middleware.WithElrondFacade(elrondFacade))
+	wrappedEventsRouter, err := wrapper.NewRouterWrapper("events", eventsRoutes, routesConfig)
+	if err == nil {
+		events.Routes(wrappedEventsRouter)
+	}
+
 	apiHandler, ok := elrondFacade.(MainApiHandler)
 	if ok && apiHandler.PprofEnabled() {
 		pprof.Register(ws)
 	}
 
-	if isLogRouteEnabled(routesConfig) {
+	logRoute, ok := getEnabledRoute(routesConfig, "log", "/log")
+	if ok {
 		marshalizerForLogs := &marshal.GogoProtoMarshalizer{}
-		registerLoggerWsRoute(ws, marshalizerForLogs)
+		registerLoggerWsRoute(ws, marshalizerForLogs, middleware.WithPermissionGroups(logRoute.Groups))
+	}
+
+	metricsRoute, ok := getEnabledRoute(routesConfig, "metrics", "/metrics")
+	if ok {
+		ws.GET("/metrics", middleware.WithPermissionGroups(metricsRoute.Groups), middleware.WithElrondFacade(elrondFacade), node.PrometheusMetrics)
+	}
+
+	rpcRoute, ok := getEnabledRoute(routesConfig, "rpc", "/rpc")
+	if ok {
+		ws.POST("/rpc", middleware.WithPermissionGroups(rpcRoute.Groups), middleware.WithElrondFacade(elrondFacade), rpc.NewHandler(routesConfig))
 	}
 }
 
-func isLogRouteEnabled(routesConfig config.ApiRoutesConfig) bool {
-	logConfig, ok := routesConfig.APIPackages["log"]
+func getEnabledRoute(routesConfig config.ApiRoutesConfig, packageName string, route string) (config.RouteConfig, bool) {
+	packageConfig, ok := routesConfig.APIPackages[packageName]
 	if !ok {
-		return false
+		return config.RouteConfig{}, false
 	}
 
-	for _, cfg := range logConfig.Routes {
-		if cfg.Name == "/log" && cfg.Open {
-			return true
+	for _, cfg := range packageConfig.Routes {
+		if cfg.Name == route && cfg.Open {
+			return cfg, true
 		}
 	}
 
-	return false
+	return config.RouteConfig{}, false
 }
 
 func registerValidators() error {
@@ -185,10 +213,10 @@ func registerValidators() error {
 	return nil
 }
 
-func registerLoggerWsRoute(ws *gin.Engine, marshalizer marshal.Marshalizer) {
+func registerLoggerWsRoute(ws *gin.Engine, marshalizer marshal.Marshalizer, permissionHandler gin.HandlerFunc) {
 	upgrader := websocket.Upgrader{}
 
-	ws.GET("/log", func(c *gin.Context) {
+	ws.GET("/log", permissionHandler, func(c *gin.Context) {
 		upgrader.CheckOrigin = func(r *http.Request) bool {
 			return true
 		}
@@ -0,0 +1,115 @@
+package block
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/gin-gonic/gin"
+)
+
+// BlockService interface defines methods that can be used from `elrondFacade` context variable
+type BlockService interface {
+	GetBlockByNonce(nonce uint64, withTxs bool) (*block.ApiBlock, error)
+	GetBlockByHash(hash string, withTxs bool) (*block.ApiBlock, error)
+	IsInterfaceNil() bool
+}
+
+// Routes defines block related routes
+func Routes(router *wrapper.RouterWrapper) {
+	router.RegisterHandler(http.MethodGet, "/by-nonce/:nonce", GetBlockByNonce)
+	router.RegisterHandler(http.MethodGet, "/by-hash/:hash", GetBlockByHash)
+}
+
+// GetBlockByNonce returns the block with the nonce parameter. The optional withTxs query parameter
+// controls whether the transactions of each miniblock are included
+func GetBlockByNonce(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(BlockService)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": apiErrors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	nonce, err := strconv.ParseUint(c.Param("nonce"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", apiErrors.ErrGetBlock.Error(), apiErrors.ErrInvalidBlockNonce.Error())})
+		return
+	}
+
+	withTxs, err := getWithTxsQueryParameter(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", apiErrors.ErrGetBlock.Error(), err.Error())})
+		return
+	}
+
+	apiBlock, err := ef.GetBlockByNonce(nonce, withTxs)
+	if err != nil {
+		c.JSON(getStatusCodeForBlockError(err), gin.H{"error": fmt.Sprintf("%s: %s", apiErrors.ErrGetBlock.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"block": apiBlock})
+}
+
+// GetBlockByHash returns the block with the hash parameter. The optional withTxs query parameter
+// controls whether the transactions of each miniblock are included
+func GetBlockByHash(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(BlockService)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": apiErrors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	hash := c.Param("hash")
+	if hash == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", apiErrors.ErrGetBlock.Error(), apiErrors.ErrEmptyBlockHash.Error())})
+		return
+	}
+	_, err := hex.DecodeString(hash)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", apiErrors.ErrGetBlock.Error(), apiErrors.ErrInvalidBlockHash.Error())})
+		return
+	}
+
+	withTxs, err := getWithTxsQueryParameter(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", apiErrors.ErrGetBlock.Error(), err.Error())})
+		return
+	}
+
+	apiBlock, err := ef.GetBlockByHash(hash, withTxs)
+	if err != nil {
+		c.JSON(getStatusCodeForBlockError(err), gin.H{"error": fmt.Sprintf("%s: %s", apiErrors.ErrGetBlock.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"block": apiBlock})
+}
+
+func getWithTxsQueryParameter(c *gin.Context) (bool, error) {
+	withTxsStr := c.Query("withTxs")
+	if withTxsStr == "" {
+		return false, nil
+	}
+
+	withTxs, err := strconv.ParseBool(withTxsStr)
+	if err != nil {
+		return false, fmt.Errorf("%w: withTxs", apiErrors.ErrInvalidQueryParameter)
+	}
+
+	return withTxs, nil
+}
+
+func getStatusCodeForBlockError(err error) int {
+	if errors.Is(err, data.ErrBlockNotFound) {
+		return http.StatusNotFound
+	}
+
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,278 @@
+package block_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/block"
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data"
+	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type blockResponse struct {
+	Block dataBlock.ApiBlock `json:"block"`
+	Error string             `json:"error"`
+}
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func TestGetBlockByNonce_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	var calledNonce uint64
+	var calledWithTxs bool
+	facade := mock.Facade{
+		GetBlockByNonceCalled: func(nonce uint64, withTxs bool) (*dataBlock.ApiBlock, error) {
+			calledNonce, calledWithTxs = nonce, withTxs
+			return &dataBlock.ApiBlock{Nonce: nonce, Hash: "aabb"}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/block/by-nonce/37?withTxs=true", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blockResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, response.Error)
+	assert.Equal(t, uint64(37), calledNonce)
+	assert.True(t, calledWithTxs)
+	assert.Equal(t, uint64(37), response.Block.Nonce)
+	assert.Equal(t, "aabb", response.Block.Hash)
+}
+
+func TestGetBlockByNonce_InvalidNonceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/block/by-nonce/abc", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blockResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidBlockNonce.Error()))
+}
+
+func TestGetBlockByNonce_InvalidWithTxsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/block/by-nonce/1?withTxs=maybe", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blockResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+}
+
+func TestGetBlockByNonce_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetBlockByNonceCalled: func(nonce uint64, withTxs bool) (*dataBlock.ApiBlock, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/block/by-nonce/1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blockResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetBlockByNonce_BlockNotFoundShouldReturnNotFound(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetBlockByNonceCalled: func(nonce uint64, withTxs bool) (*dataBlock.ApiBlock, error) {
+			return nil, fmt.Errorf("%w for nonce %d", data.ErrBlockNotFound, nonce)
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/block/by-nonce/1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blockResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	assert.True(t, strings.Contains(response.Error, data.ErrBlockNotFound.Error()))
+}
+
+func TestGetBlockByHash_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	var calledHash string
+	var calledWithTxs bool
+	facade := mock.Facade{
+		GetBlockByHashCalled: func(hash string, withTxs bool) (*dataBlock.ApiBlock, error) {
+			calledHash, calledWithTxs = hash, withTxs
+			return &dataBlock.ApiBlock{Nonce: 5, Hash: hash}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/block/by-hash/aabb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blockResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "aabb", calledHash)
+	assert.False(t, calledWithTxs)
+	assert.Equal(t, "aabb", response.Block.Hash)
+}
+
+func TestGetBlockByHash_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetBlockByHashCalled: func(hash string, withTxs bool) (*dataBlock.ApiBlock, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/block/by-hash/aabb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blockResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetBlockByHash_InvalidHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetBlockByHashCalled: func(hash string, withTxs bool) (*dataBlock.ApiBlock, error) {
+			assert.Fail(t, "should have not called the facade")
+			return nil, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/block/by-hash/not-hex", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blockResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidBlockHash.Error()))
+}
+
+func TestGetBlockByHash_BlockNotFoundShouldReturnNotFound(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetBlockByHashCalled: func(hash string, withTxs bool) (*dataBlock.ApiBlock, error) {
+			return nil, fmt.Errorf("%w for hash %s", data.ErrBlockNotFound, hash)
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/block/by-hash/aabb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blockResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	assert.True(t, strings.Contains(response.Error, data.ErrBlockNotFound.Error()))
+}
+
+func TestGetBlock_FailsWithWrongFacadeTypeConversion(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+	for _, path := range []string{"/block/by-nonce/1", "/block/by-hash/aabb"} {
+		req, _ := http.NewRequest("GET", path, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := blockResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, apiErrors.ErrInvalidAppContext.Error(), response.Error)
+	}
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	_ = jsonParser.Decode(destination)
+}
+
+func startNodeServer(handler block.BlockService) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	blockRoutes := ws.Group("/block")
+	if handler != nil {
+		blockRoutes.Use(middleware.WithElrondFacade(handler))
+	}
+	blockRouteWrapper, _ := wrapper.NewRouterWrapper("block", blockRoutes, getRoutesConfig())
+	block.Routes(blockRouteWrapper)
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("elrondFacade", mock.WrongFacade{})
+	})
+	blockRoutes := ws.Group("/block")
+	blockRouteWrapper, _ := wrapper.NewRouterWrapper("block", blockRoutes, getRoutesConfig())
+	block.Routes(blockRouteWrapper)
+	return ws
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"block": {
+				Routes: []config.RouteConfig{
+					{Name: "/by-nonce/:nonce", Open: true},
+					{Name: "/by-hash/:hash", Open: true},
+				},
+			},
+		},
+	}
+}
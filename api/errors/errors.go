@@ -45,3 +45,27 @@ var ErrQueryError = errors.New("query error")
 
 // ErrGetPidInfo signals that an error occurred while getting peer ID info
 var ErrGetPidInfo = errors.New("error getting peer id info")
+
+// ErrGetTransactionsForAddress signals that an error occurred while getting the transactions of an account
+var ErrGetTransactionsForAddress = errors.New("get transactions for address error")
+
+// ErrInvalidQueryParameter signals that an invalid query parameter was provided
+var ErrInvalidQueryParameter = errors.New("invalid query parameter")
+
+// ErrGetBlock signals that an error occurred while getting a block
+var ErrGetBlock = errors.New("get block error")
+
+// ErrInvalidBlockNonce signals that an invalid block nonce was provided
+var ErrInvalidBlockNonce = errors.New("invalid block nonce")
+
+// ErrEmptyBlockHash signals that an empty block hash was provided
+var ErrEmptyBlockHash = errors.New("block hash is empty")
+
+// ErrInvalidBlockHash signals that a block hash which is not hex encoded was provided
+var ErrInvalidBlockHash = errors.New("invalid block hash")
+
+// ErrGetProof signals that an error occurred while getting a Merkle proof
+var ErrGetProof = errors.New("get proof error")
+
+// ErrGetTransactionsPool signals that an error occurred while inspecting the transactions pool
+var ErrGetTransactionsPool = errors.New("get transactions pool error")
@@ -0,0 +1,9 @@
+package events
+
+import "errors"
+
+// ErrNilEventsService signals that a nil events service has been provided
+var ErrNilEventsService = errors.New("nil events service")
+
+// ErrNilWsConn signals that a nil web socket connection has been provided
+var ErrNilWsConn = errors.New("nil web socket connection")
@@ -0,0 +1,146 @@
+package events
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/process/events"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	filterTimeout = 30 * time.Second
+	writeTimeout  = 10 * time.Second
+	// maxCloseReasonLength is the room left for the reason in a close frame, whose payload is limited to 125 bytes
+	maxCloseReasonLength = 123
+)
+
+// subscribedMessage is sent to the client once its filter was accepted
+var subscribedMessage = []byte(`{"type":"subscribed"}`)
+
+type eventsSender struct {
+	facade EventsService
+	conn   wsConn
+}
+
+// NewEventsSender returns a new component that streams, over the web socket connection, the events selected
+// by the filter the client sends first
+func NewEventsSender(facade EventsService, conn wsConn) (*eventsSender, error) {
+	if check.IfNil(facade) {
+		return nil, ErrNilEventsService
+	}
+	if conn == nil {
+		return nil, ErrNilWsConn
+	}
+
+	return &eventsSender{
+		facade: facade,
+		conn:   conn,
+	}, nil
+}
+
+// StartSendingBlocking waits for the filter, subscribes and sends the events until the connection or the
+// subscription ends. The close frame sent to the client holds the reason a subscription was refused or ended
+func (es *eventsSender) StartSendingBlocking() {
+	defer func() {
+		_ = es.conn.Close()
+	}()
+
+	subscription, err := es.subscribe()
+	if err != nil {
+		log.Debug("events web socket subscription refused", "error", err.Error())
+		es.sendClose(websocket.ClosePolicyViolation, err.Error())
+		return
+	}
+	defer subscription.Close()
+
+	go es.monitorConnection(subscription)
+
+	err = es.sendMessage(subscribedMessage)
+	if err != nil {
+		return
+	}
+
+	for event := range subscription.Events() {
+		buff, errMarshal := json.Marshal(event)
+		if errMarshal != nil {
+			log.Warn("events web socket", "error", errMarshal.Error())
+			continue
+		}
+
+		err = es.sendMessage(buff)
+		if err != nil {
+			return
+		}
+	}
+
+	err = subscription.Err()
+	if err != nil {
+		es.sendClose(websocket.CloseTryAgainLater, err.Error())
+		return
+	}
+
+	es.sendClose(websocket.CloseNormalClosure, "")
+}
+
+func (es *eventsSender) subscribe() (events.Subscription, error) {
+	err := es.conn.SetReadDeadline(time.Now().Add(filterTimeout))
+	if err != nil {
+		return nil, err
+	}
+
+	_, message, err := es.conn.ReadMessage()
+	if err != nil {
+		return nil, err
+	}
+
+	filter := events.Filter{}
+	err = json.Unmarshal(message, &filter)
+	if err != nil {
+		return nil, err
+	}
+
+	err = es.conn.SetReadDeadline(time.Time{})
+	if err != nil {
+		return nil, err
+	}
+
+	return es.facade.SubscribeEvents(filter)
+}
+
+// monitorConnection reads, and discards, the client messages so that the close frames are processed. The
+// subscription ends as soon as the connection is closed by the client
+func (es *eventsSender) monitorConnection(subscription events.Subscription) {
+	defer subscription.Close()
+
+	for {
+		_, _, err := es.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (es *eventsSender) sendMessage(buff []byte) error {
+	err := es.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	if err != nil {
+		return err
+	}
+
+	err = es.conn.WriteMessage(websocket.TextMessage, buff)
+	if err != nil {
+		log.Debug("events web socket write", "error", err.Error())
+	}
+
+	return err
+}
+
+func (es *eventsSender) sendClose(code int, reason string) {
+	if len(reason) > maxCloseReasonLength {
+		reason = reason[:maxCloseReasonLength]
+	}
+
+	_ = es.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	_ = es.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason))
+}
@@ -0,0 +1,14 @@
+package events
+
+import (
+	"io"
+	"time"
+)
+
+type wsConn interface {
+	io.Closer
+	ReadMessage() (messageType int, p []byte, err error)
+	WriteMessage(messageType int, data []byte) error
+	SetReadDeadline(t time.Time) error
+	SetWriteDeadline(t time.Time) error
+}
@@ -0,0 +1,57 @@
+package events
+
+import (
+	"net/http"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/process/events"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+var log = logger.GetOrCreate("api/events")
+
+// EventsService interface defines methods that can be used from `elrondFacade` context variable
+type EventsService interface {
+	SubscribeEvents(filter events.Filter) (events.Subscription, error)
+	IsInterfaceNil() bool
+}
+
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool {
+		return true
+	},
+}
+
+// Routes defines events related routes
+func Routes(router *wrapper.RouterWrapper) {
+	router.RegisterHandler(http.MethodGet, "/ws", StreamEvents)
+}
+
+// StreamEvents upgrades the request to a web socket connection. The first message sent by the client is the JSON
+// encoded filter; afterwards the node streams, as JSON text messages, the events selected by the filter until
+// either side closes the connection
+func StreamEvents(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(EventsService)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.Debug("events web socket upgrade", "error", err.Error())
+		return
+	}
+
+	es, err := NewEventsSender(ef, conn)
+	if err != nil {
+		log.Error("events web socket", "error", err.Error())
+		_ = conn.Close()
+		return
+	}
+
+	es.StartSendingBlocking()
+}
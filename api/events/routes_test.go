@@ -0,0 +1,270 @@
+package events_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/events"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	processEvents "github.com/ElrondNetwork/elrond-go/process/events"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const readTimeout = time.Second
+
+type subscriptionStub struct {
+	chEvent  chan *processEvents.Event
+	mutState sync.Mutex
+	err      error
+	closed   bool
+}
+
+func newSubscriptionStub() *subscriptionStub {
+	return &subscriptionStub{
+		chEvent: make(chan *processEvents.Event, 10),
+	}
+}
+
+func (ss *subscriptionStub) Events() <-chan *processEvents.Event {
+	return ss.chEvent
+}
+
+func (ss *subscriptionStub) Err() error {
+	ss.mutState.Lock()
+	defer ss.mutState.Unlock()
+
+	return ss.err
+}
+
+func (ss *subscriptionStub) Close() {
+	ss.closeWithError(nil)
+}
+
+func (ss *subscriptionStub) isClosed() bool {
+	ss.mutState.Lock()
+	defer ss.mutState.Unlock()
+
+	return ss.closed
+}
+
+func (ss *subscriptionStub) closeWithError(err error) {
+	ss.mutState.Lock()
+	defer ss.mutState.Unlock()
+
+	if ss.closed {
+		return
+	}
+	ss.closed = true
+	ss.err = err
+	close(ss.chEvent)
+}
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func TestStreamEvents_ShouldSendSelectedEvents(t *testing.T) {
+	t.Parallel()
+
+	sub := newSubscriptionStub()
+	var receivedFilter processEvents.Filter
+	facade := mock.Facade{
+		SubscribeEventsCalled: func(filter processEvents.Filter) (processEvents.Subscription, error) {
+			receivedFilter = filter
+			return sub, nil
+		},
+	}
+	server := httptest.NewServer(startNodeServer(&facade))
+	defer server.Close()
+
+	conn := dial(t, server)
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	filter := processEvents.Filter{Blocks: true, Addresses: []string{"erd1"}}
+	require.Nil(t, conn.WriteJSON(filter))
+	assert.Equal(t, `{"type":"subscribed"}`, string(readMessage(t, conn)))
+	assert.Equal(t, filter, receivedFilter)
+
+	sub.chEvent <- &processEvents.Event{
+		Type:  processEvents.BlockEventType,
+		Block: &processEvents.BlockEvent{Nonce: 7, Hash: "aabb"},
+	}
+	event := &processEvents.Event{}
+	require.Nil(t, json.Unmarshal(readMessage(t, conn), event))
+	assert.Equal(t, processEvents.BlockEventType, event.Type)
+	assert.Equal(t, uint64(7), event.Block.Nonce)
+
+	sub.closeWithError(errors.New("subscriber too slow"))
+	_ = conn.SetReadDeadline(time.Now().Add(readTimeout))
+	_, _, err := conn.ReadMessage()
+	closeErr, ok := err.(*websocket.CloseError)
+	require.True(t, ok)
+	assert.Equal(t, websocket.CloseTryAgainLater, closeErr.Code)
+	assert.Equal(t, "subscriber too slow", closeErr.Text)
+}
+
+func TestStreamEvents_SubscribeErrorShouldClose(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		SubscribeEventsCalled: func(filter processEvents.Filter) (processEvents.Subscription, error) {
+			return nil, errors.New("too many subscribers")
+		},
+	}
+	server := httptest.NewServer(startNodeServer(&facade))
+	defer server.Close()
+
+	conn := dial(t, server)
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	require.Nil(t, conn.WriteJSON(processEvents.Filter{Blocks: true}))
+	_ = conn.SetReadDeadline(time.Now().Add(readTimeout))
+	_, _, err := conn.ReadMessage()
+	closeErr, ok := err.(*websocket.CloseError)
+	require.True(t, ok)
+	assert.Equal(t, websocket.ClosePolicyViolation, closeErr.Code)
+	assert.Equal(t, "too many subscribers", closeErr.Text)
+}
+
+func TestStreamEvents_InvalidFilterShouldClose(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		SubscribeEventsCalled: func(filter processEvents.Filter) (processEvents.Subscription, error) {
+			require.Fail(t, "should not have subscribed")
+			return nil, nil
+		},
+	}
+	server := httptest.NewServer(startNodeServer(&facade))
+	defer server.Close()
+
+	conn := dial(t, server)
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	require.Nil(t, conn.WriteMessage(websocket.TextMessage, []byte("not a filter")))
+	_ = conn.SetReadDeadline(time.Now().Add(readTimeout))
+	_, _, err := conn.ReadMessage()
+	closeErr, ok := err.(*websocket.CloseError)
+	require.True(t, ok)
+	assert.Equal(t, websocket.ClosePolicyViolation, closeErr.Code)
+}
+
+func TestStreamEvents_ClientDisconnectShouldCloseSubscription(t *testing.T) {
+	t.Parallel()
+
+	sub := newSubscriptionStub()
+	facade := mock.Facade{
+		SubscribeEventsCalled: func(filter processEvents.Filter) (processEvents.Subscription, error) {
+			return sub, nil
+		},
+	}
+	server := httptest.NewServer(startNodeServer(&facade))
+	defer server.Close()
+
+	conn := dial(t, server)
+	require.Nil(t, conn.WriteJSON(processEvents.Filter{Blocks: true}))
+	_ = readMessage(t, conn)
+	_ = conn.Close()
+
+	assert.Eventually(t, sub.isClosed, readTimeout, time.Millisecond*10)
+}
+
+func TestStreamEvents_WrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+	req, _ := http.NewRequest("GET", "/events/ws", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Error string `json:"error"`
+	}{}
+	_ = json.NewDecoder(resp.Body).Decode(&response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, apiErrors.ErrInvalidAppContext.Error(), response.Error)
+}
+
+func TestNewEventsSender_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	es, err := events.NewEventsSender(nil, &websocket.Conn{})
+	assert.Nil(t, es)
+	assert.Equal(t, events.ErrNilEventsService, err)
+
+	es, err = events.NewEventsSender(&mock.Facade{}, nil)
+	assert.Nil(t, es)
+	assert.Equal(t, events.ErrNilWsConn, err)
+}
+
+func dial(t *testing.T, server *httptest.Server) *websocket.Conn {
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/events/ws"
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.Nil(t, err)
+
+	return conn
+}
+
+func readMessage(t *testing.T, conn *websocket.Conn) []byte {
+	_ = conn.SetReadDeadline(time.Now().Add(readTimeout))
+	_, message, err := conn.ReadMessage()
+	require.Nil(t, err)
+
+	return message
+}
+
+func startNodeServer(handler events.EventsService) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	eventsRoutes := ws.Group("/events")
+	if handler != nil {
+		eventsRoutes.Use(middleware.WithElrondFacade(handler))
+	}
+	eventsRouteWrapper, _ := wrapper.NewRouterWrapper("events", eventsRoutes, getRoutesConfig())
+	events.Routes(eventsRouteWrapper)
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("elrondFacade", mock.WrongFacade{})
+	})
+	eventsRoutes := ws.Group("/events")
+	eventsRouteWrapper, _ := wrapper.NewRouterWrapper("events", eventsRoutes, getRoutesConfig())
+	events.Routes(eventsRouteWrapper)
+	return ws
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"events": {
+				Routes: []config.RouteConfig{
+					{Name: "/ws", Open: true},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,255 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-gonic/gin"
+)
+
+// auditLog records the denied requests. It has its own name so that its level can be set independently
+var auditLog = logger.GetOrCreate("api/audit")
+
+const apiCallerContextKey = "apiCaller"
+
+const anonymousCallerName = "anonymous"
+
+type apiCaller struct {
+	name   string
+	groups []string
+}
+
+func (caller *apiCaller) belongsToAnyGroup(groups []string) bool {
+	for _, group := range groups {
+		for _, callerGroup := range caller.groups {
+			if group == callerGroup {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// requestsWindow counts the requests made in a fixed time window
+type requestsWindow struct {
+	start       time.Time
+	numRequests uint32
+}
+
+// hasRoom starts a new window if the current one has ended, then tells whether one more request fits in the window
+func (rw *requestsWindow) hasRoom(now time.Time, length time.Duration, maxRequests uint32) bool {
+	if now.Sub(rw.start) >= length {
+		rw.start = now
+		rw.numRequests = 0
+	}
+
+	return rw.numRequests < maxRequests
+}
+
+type apiKey struct {
+	caller               *apiCaller
+	maxRequestsPerWindow uint32
+	window               time.Duration
+	maxRequestsPerQuota  uint32
+	quotaPeriod          time.Duration
+
+	mutRequests sync.Mutex
+	rateWindow  requestsWindow
+	quotaWindow requestsWindow
+}
+
+func (key *apiKey) tryAddRequest(now time.Time) error {
+	key.mutRequests.Lock()
+	defer key.mutRequests.Unlock()
+
+	isQuotaEnabled := key.maxRequestsPerQuota > 0
+	if isQuotaEnabled && !key.quotaWindow.hasRoom(now, key.quotaPeriod, key.maxRequestsPerQuota) {
+		return ErrQuotaExceeded
+	}
+	if !key.rateWindow.hasRoom(now, key.window, key.maxRequestsPerWindow) {
+		return ErrRateLimitExceeded
+	}
+
+	key.rateWindow.numRequests++
+	key.quotaWindow.numRequests++
+
+	return nil
+}
+
+// apiKeyAuthenticator is a middleware which identifies the callers by their API key and enforces the rate limit and
+// the quota of each key. The permission groups of the caller are checked by the routes, see WithPermissionGroups
+type apiKeyAuthenticator struct {
+	headerName     string
+	allowAnonymous bool
+	keys           map[string]*apiKey
+}
+
+// NewApiKeyAuthenticator creates a new instance of an apiKeyAuthenticator
+func NewApiKeyAuthenticator(authConfig config.ApiAuthenticationConfig) (*apiKeyAuthenticator, error) {
+	if len(authConfig.HeaderName) == 0 {
+		return nil, ErrEmptyApiKeyHeaderName
+	}
+
+	keys := make(map[string]*apiKey, len(authConfig.Keys))
+	names := make(map[string]struct{}, len(authConfig.Keys))
+	for _, keyConfig := range authConfig.Keys {
+		key, err := newApiKey(keyConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		_, isNameDuplicated := names[keyConfig.Name]
+		_, isKeyDuplicated := keys[keyConfig.Key]
+		if isNameDuplicated || isKeyDuplicated {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicatedApiKey, keyConfig.Name)
+		}
+
+		names[keyConfig.Name] = struct{}{}
+		keys[keyConfig.Key] = key
+	}
+
+	return &apiKeyAuthenticator{
+		headerName:     authConfig.HeaderName,
+		allowAnonymous: authConfig.AllowAnonymous,
+		keys:           keys,
+	}, nil
+}
+
+func newApiKey(keyConfig config.ApiKeyConfig) (*apiKey, error) {
+	if len(keyConfig.Name) == 0 || len(keyConfig.Key) == 0 {
+		return nil, ErrEmptyApiKey
+	}
+	if keyConfig.MaxRequestsPerWindow == 0 || keyConfig.WindowInSec == 0 {
+		return nil, fmt.Errorf("%w for API key %s", ErrInvalidRateLimit, keyConfig.Name)
+	}
+	if keyConfig.MaxRequestsPerQuota > 0 && keyConfig.QuotaPeriodInSec == 0 {
+		return nil, fmt.Errorf("%w: no quota period for API key %s", ErrInvalidRateLimit, keyConfig.Name)
+	}
+
+	return &apiKey{
+		caller: &apiCaller{
+			name:   keyConfig.Name,
+			groups: keyConfig.Groups,
+		},
+		maxRequestsPerWindow: keyConfig.MaxRequestsPerWindow,
+		window:               time.Duration(keyConfig.WindowInSec) * time.Second,
+		maxRequestsPerQuota:  keyConfig.MaxRequestsPerQuota,
+		quotaPeriod:          time.Duration(keyConfig.QuotaPeriodInSec) * time.Second,
+	}, nil
+}
+
+// MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests
+func (aka *apiKeyAuthenticator) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		keyValue := c.GetHeader(aka.headerName)
+		if len(keyValue) == 0 {
+			if !aka.allowAnonymous {
+				denyRequest(c, http.StatusUnauthorized, anonymousCallerName, ErrMissingApiKey)
+				return
+			}
+
+			c.Set(apiCallerContextKey, &apiCaller{name: anonymousCallerName})
+			c.Next()
+			return
+		}
+
+		key, ok := aka.keys[keyValue]
+		if !ok {
+			denyRequest(c, http.StatusUnauthorized, anonymousCallerName, ErrUnknownApiKey)
+			return
+		}
+
+		err := key.tryAddRequest(time.Now())
+		if err != nil {
+			denyRequest(c, http.StatusTooManyRequests, key.caller.name, err)
+			return
+		}
+
+		addRequestCharger(c, func() error {
+			errCharge := key.tryAddRequest(time.Now())
+			if errCharge != nil {
+				auditDeniedRequest(c, key.caller.name, errCharge)
+			}
+
+			return errCharge
+		})
+
+		c.Set(apiCallerContextKey, key.caller)
+		c.Next()
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (aka *apiKeyAuthenticator) IsInterfaceNil() bool {
+	return aka == nil
+}
+
+// WithPermissionGroups returns a handler which lets through only the callers belonging to one of the given permission
+// groups. It lets through all the requests when the API keys authentication is disabled
+func WithPermissionGroups(groups []string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !CheckPermissionGroups(c, groups) {
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// CheckPermissionGroups tells whether the caller belongs to one of the given permission groups, aborting the request
+// when it does not. It always returns true when no group is given or when the API keys authentication is disabled
+func CheckPermissionGroups(c *gin.Context, groups []string) bool {
+	err := AuthorizePermissionGroups(c, groups)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return false
+	}
+
+	return true
+}
+
+// AuthorizePermissionGroups returns ErrForbiddenRoute, logging the denial, when the caller does not belong to one of the
+// given permission groups. Unlike CheckPermissionGroups, it does not abort the request, so that a handler can deny
+// only a part of it
+func AuthorizePermissionGroups(c *gin.Context, groups []string) error {
+	if len(groups) == 0 {
+		return nil
+	}
+
+	value, ok := c.Get(apiCallerContextKey)
+	if !ok {
+		return nil
+	}
+
+	caller, ok := value.(*apiCaller)
+	if !ok {
+		auditDeniedRequest(c, anonymousCallerName, ErrForbiddenRoute)
+		return ErrForbiddenRoute
+	}
+	if !caller.belongsToAnyGroup(groups) {
+		auditDeniedRequest(c, caller.name, ErrForbiddenRoute)
+		return ErrForbiddenRoute
+	}
+
+	return nil
+}
+
+func denyRequest(c *gin.Context, status int, callerName string, reason error) {
+	auditDeniedRequest(c, callerName, reason)
+	c.AbortWithStatusJSON(status, gin.H{"error": reason.Error()})
+}
+
+func auditDeniedRequest(c *gin.Context, callerName string, reason error) {
+	auditLog.Warn("api request denied",
+		"caller", callerName,
+		"source", c.ClientIP(),
+		"method", c.Request.Method,
+		"path", c.Request.URL.Path,
+		"reason", reason.Error(),
+	)
+}
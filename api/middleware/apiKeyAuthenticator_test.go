@@ -0,0 +1,218 @@
+package middleware_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const apiKeyHeader = "X-Api-Key"
+
+func createAuthenticationConfig() config.ApiAuthenticationConfig {
+	return config.ApiAuthenticationConfig{
+		Enabled:        true,
+		HeaderName:     apiKeyHeader,
+		AllowAnonymous: true,
+		Keys: []config.ApiKeyConfig{
+			{Name: "admin", Key: "admin-key", Groups: []string{"admin"}, MaxRequestsPerWindow: 100, WindowInSec: 60},
+			{Name: "partner", Key: "partner-key", MaxRequestsPerWindow: 100, WindowInSec: 60},
+		},
+	}
+}
+
+func startNodeServerApiKeyAuthenticator(authConfig config.ApiAuthenticationConfig) *gin.Engine {
+	ws := gin.New()
+	if authConfig.Enabled {
+		authenticator, _ := middleware.NewApiKeyAuthenticator(authConfig)
+		ws.Use(authenticator.MiddlewareHandlerFunc())
+	}
+
+	routesConfig := config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"test": {
+				Routes: []config.RouteConfig{
+					{Name: "/open", Open: true},
+					{Name: "/admin", Open: true, Groups: []string{"admin"}},
+				},
+			},
+		},
+	}
+	handler := func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"message": "ok"})
+	}
+
+	testRoutes, _ := wrapper.NewRouterWrapper("test", ws.Group("/test"), routesConfig)
+	testRoutes.RegisterHandler(http.MethodGet, "/open", handler)
+	testRoutes.RegisterHandler(http.MethodPost, "/admin", handler)
+
+	return ws
+}
+
+func doRequestWithApiKey(ws *gin.Engine, method string, path string, key string) int {
+	req, _ := http.NewRequest(method, path, nil)
+	if len(key) > 0 {
+		req.Header.Set(apiKeyHeader, key)
+	}
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp.Code
+}
+
+func TestNewApiKeyAuthenticator_EmptyHeaderNameShouldErr(t *testing.T) {
+	t.Parallel()
+
+	authConfig := createAuthenticationConfig()
+	authConfig.HeaderName = ""
+	aka, err := middleware.NewApiKeyAuthenticator(authConfig)
+
+	assert.True(t, check.IfNil(aka))
+	assert.Equal(t, middleware.ErrEmptyApiKeyHeaderName, err)
+}
+
+func TestNewApiKeyAuthenticator_EmptyKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	authConfig := createAuthenticationConfig()
+	authConfig.Keys[1].Key = ""
+	aka, err := middleware.NewApiKeyAuthenticator(authConfig)
+
+	assert.True(t, check.IfNil(aka))
+	assert.Equal(t, middleware.ErrEmptyApiKey, err)
+}
+
+func TestNewApiKeyAuthenticator_InvalidRateLimitShouldErr(t *testing.T) {
+	t.Parallel()
+
+	authConfig := createAuthenticationConfig()
+	authConfig.Keys[1].WindowInSec = 0
+	aka, err := middleware.NewApiKeyAuthenticator(authConfig)
+
+	assert.True(t, check.IfNil(aka))
+	assert.True(t, errors.Is(err, middleware.ErrInvalidRateLimit))
+
+	authConfig = createAuthenticationConfig()
+	authConfig.Keys[1].MaxRequestsPerQuota = 10
+	aka, err = middleware.NewApiKeyAuthenticator(authConfig)
+
+	assert.True(t, check.IfNil(aka))
+	assert.True(t, errors.Is(err, middleware.ErrInvalidRateLimit))
+}
+
+func TestNewApiKeyAuthenticator_DuplicatedKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	authConfig := createAuthenticationConfig()
+	authConfig.Keys[1].Key = authConfig.Keys[0].Key
+	aka, err := middleware.NewApiKeyAuthenticator(authConfig)
+
+	assert.True(t, check.IfNil(aka))
+	assert.True(t, errors.Is(err, middleware.ErrDuplicatedApiKey))
+
+	authConfig = createAuthenticationConfig()
+	authConfig.Keys[1].Name = authConfig.Keys[0].Name
+	aka, err = middleware.NewApiKeyAuthenticator(authConfig)
+
+	assert.True(t, check.IfNil(aka))
+	assert.True(t, errors.Is(err, middleware.ErrDuplicatedApiKey))
+}
+
+func TestNewApiKeyAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	aka, err := middleware.NewApiKeyAuthenticator(createAuthenticationConfig())
+
+	assert.False(t, check.IfNil(aka))
+	assert.Nil(t, err)
+}
+
+func TestApiKeyAuthenticator_AnonymousRequests(t *testing.T) {
+	t.Parallel()
+
+	authConfig := createAuthenticationConfig()
+	ws := startNodeServerApiKeyAuthenticator(authConfig)
+	assert.Equal(t, http.StatusOK, doRequestWithApiKey(ws, http.MethodGet, "/test/open", ""))
+	assert.Equal(t, http.StatusForbidden, doRequestWithApiKey(ws, http.MethodPost, "/test/admin", ""))
+
+	authConfig.AllowAnonymous = false
+	ws = startNodeServerApiKeyAuthenticator(authConfig)
+	assert.Equal(t, http.StatusUnauthorized, doRequestWithApiKey(ws, http.MethodGet, "/test/open", ""))
+}
+
+func TestApiKeyAuthenticator_UnknownKeyShouldBeDenied(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerApiKeyAuthenticator(createAuthenticationConfig())
+
+	assert.Equal(t, http.StatusUnauthorized, doRequestWithApiKey(ws, http.MethodGet, "/test/open", "unknown-key"))
+}
+
+func TestApiKeyAuthenticator_PermissionGroups(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerApiKeyAuthenticator(createAuthenticationConfig())
+
+	assert.Equal(t, http.StatusOK, doRequestWithApiKey(ws, http.MethodGet, "/test/open", "admin-key"))
+	assert.Equal(t, http.StatusOK, doRequestWithApiKey(ws, http.MethodPost, "/test/admin", "admin-key"))
+	assert.Equal(t, http.StatusOK, doRequestWithApiKey(ws, http.MethodGet, "/test/open", "partner-key"))
+	assert.Equal(t, http.StatusForbidden, doRequestWithApiKey(ws, http.MethodPost, "/test/admin", "partner-key"))
+}
+
+func TestApiKeyAuthenticator_PermissionGroupsNotEnforcedWhenDisabled(t *testing.T) {
+	t.Parallel()
+
+	authConfig := createAuthenticationConfig()
+	authConfig.Enabled = false
+	ws := startNodeServerApiKeyAuthenticator(authConfig)
+
+	assert.Equal(t, http.StatusOK, doRequestWithApiKey(ws, http.MethodPost, "/test/admin", ""))
+}
+
+func TestApiKeyAuthenticator_RateLimitShouldWork(t *testing.T) {
+	t.Parallel()
+
+	authConfig := createAuthenticationConfig()
+	authConfig.Keys[1].MaxRequestsPerWindow = 2
+	authConfig.Keys[1].WindowInSec = 1
+	ws := startNodeServerApiKeyAuthenticator(authConfig)
+
+	assert.Equal(t, http.StatusOK, doRequestWithApiKey(ws, http.MethodGet, "/test/open", "partner-key"))
+	assert.Equal(t, http.StatusOK, doRequestWithApiKey(ws, http.MethodGet, "/test/open", "partner-key"))
+	assert.Equal(t, http.StatusTooManyRequests, doRequestWithApiKey(ws, http.MethodGet, "/test/open", "partner-key"))
+	// the other keys are not affected
+	assert.Equal(t, http.StatusOK, doRequestWithApiKey(ws, http.MethodGet, "/test/open", "admin-key"))
+
+	time.Sleep(time.Second + 100*time.Millisecond)
+	assert.Equal(t, http.StatusOK, doRequestWithApiKey(ws, http.MethodGet, "/test/open", "partner-key"))
+}
+
+func TestApiKeyAuthenticator_QuotaShouldWork(t *testing.T) {
+	t.Parallel()
+
+	authConfig := createAuthenticationConfig()
+	authConfig.Keys[1].MaxRequestsPerQuota = 3
+	authConfig.Keys[1].QuotaPeriodInSec = 3600
+	ws := startNodeServerApiKeyAuthenticator(authConfig)
+
+	for i := 0; i < 3; i++ {
+		require.Equal(t, http.StatusOK, doRequestWithApiKey(ws, http.MethodGet, "/test/open", "partner-key"))
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, "/test/open", nil)
+	req.Header.Set(apiKeyHeader, "partner-key")
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	assert.Contains(t, resp.Body.String(), middleware.ErrQuotaExceeded.Error())
+}
@@ -4,3 +4,33 @@ import "errors"
 
 // ErrInvalidMaxNumRequests signals that a provided number of requests is invalid
 var ErrInvalidMaxNumRequests = errors.New("max number of requests value is invalid")
+
+// ErrEmptyApiKeyHeaderName signals that the name of the header holding the API key was not provided
+var ErrEmptyApiKeyHeaderName = errors.New("empty API key header name")
+
+// ErrEmptyApiKey signals that an API key was defined without a name or without a value
+var ErrEmptyApiKey = errors.New("empty API key")
+
+// ErrDuplicatedApiKey signals that the same API key, or the same name, was defined more than once
+var ErrDuplicatedApiKey = errors.New("duplicated API key")
+
+// ErrInvalidRateLimit signals that the rate limit or the quota of an API key is invalid
+var ErrInvalidRateLimit = errors.New("invalid rate limit")
+
+// ErrMissingApiKey signals that a request without an API key was received while anonymous requests are not allowed
+var ErrMissingApiKey = errors.New("missing API key")
+
+// ErrUnknownApiKey signals that a request was made with an API key which is not defined
+var ErrUnknownApiKey = errors.New("unknown API key")
+
+// ErrRateLimitExceeded signals that an API key made too many requests in the current rate window
+var ErrRateLimitExceeded = errors.New("rate limit exceeded")
+
+// ErrQuotaExceeded signals that an API key used all its requests for the current quota period
+var ErrQuotaExceeded = errors.New("quota exceeded")
+
+// ErrForbiddenRoute signals that the caller does not belong to any of the permission groups allowed on the route
+var ErrForbiddenRoute = errors.New("route not allowed for the caller")
+
+// ErrTooManyRequests signals that the node is serving too many requests, either in total or from the same source
+var ErrTooManyRequests = errors.New("too many requests")
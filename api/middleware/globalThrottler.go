@@ -25,15 +25,34 @@ func NewGlobalThrottler(maxConnections uint32) (*globalThrottler, error) {
 // MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests
 func (gt *globalThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		select {
-		case gt.queue <- struct{}{}:
-		default:
+		if !gt.tryAcquire() {
 			c.AbortWithStatus(http.StatusTooManyRequests)
 			return
 		}
 
+		numAcquired := uint32(1)
+		addRequestCharger(c, func() error {
+			if !gt.tryAcquire() {
+				return ErrTooManyRequests
+			}
+
+			numAcquired++
+			return nil
+		})
+
 		c.Next()
-		<-gt.queue
+		for i := uint32(0); i < numAcquired; i++ {
+			<-gt.queue
+		}
+	}
+}
+
+func (gt *globalThrottler) tryAcquire() bool {
+	select {
+	case gt.queue <- struct{}{}:
+		return true
+	default:
+		return false
 	}
 }
 
@@ -0,0 +1,43 @@
+package middleware
+
+import "github.com/gin-gonic/gin"
+
+const requestChargersContextKey = "requestChargers"
+
+// requestCharger charges one more request to a limiter
+type requestCharger func() error
+
+// addRequestCharger registers the limiter of a middleware, so that it can be charged with the additional requests
+// carried by the current HTTP request
+func addRequestCharger(c *gin.Context, charger requestCharger) {
+	chargers := getRequestChargers(c)
+	c.Set(requestChargersContextKey, append(chargers, charger))
+}
+
+func getRequestChargers(c *gin.Context) []requestCharger {
+	value, ok := c.Get(requestChargersContextKey)
+	if !ok {
+		return nil
+	}
+
+	chargers, ok := value.([]requestCharger)
+	if !ok {
+		return nil
+	}
+
+	return chargers
+}
+
+// ChargeAdditionalRequest charges one more request to all the limiters the current HTTP request went through: the
+// global and the source throttlers, the rate limit and the quota of the API key. It is used by the handlers serving
+// more than one request at once, such as the JSON-RPC batches, the first request being charged by the middlewares
+func ChargeAdditionalRequest(c *gin.Context) error {
+	for _, charger := range getRequestChargers(c) {
+		err := charger()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -37,21 +37,34 @@ func (st *sourceThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
 			return
 		}
 
-		st.mutRequests.Lock()
-		requests := st.sourceRequests[remoteAddr]
-		isQuotaReached := requests >= st.maxNumRequests
-		st.sourceRequests[remoteAddr]++
-		st.mutRequests.Unlock()
-
-		if isQuotaReached {
+		if !st.tryAddRequest(remoteAddr) {
 			c.AbortWithStatus(http.StatusTooManyRequests)
 			return
 		}
 
+		addRequestCharger(c, func() error {
+			if !st.tryAddRequest(remoteAddr) {
+				return ErrTooManyRequests
+			}
+
+			return nil
+		})
+
 		c.Next()
 	}
 }
 
+func (st *sourceThrottler) tryAddRequest(remoteAddr string) bool {
+	st.mutRequests.Lock()
+	defer st.mutRequests.Unlock()
+
+	requests := st.sourceRequests[remoteAddr]
+	isQuotaReached := requests >= st.maxNumRequests
+	st.sourceRequests[remoteAddr]++
+
+	return !isQuotaReached
+}
+
 // Reset resets all accumulated counters
 func (st *sourceThrottler) Reset() {
 	st.mutRequests.Lock()
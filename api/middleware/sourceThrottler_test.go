@@ -13,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -58,7 +59,7 @@ func TestSourceThrottler_LimitBadRequestShouldErr(t *testing.T) {
 	t.Parallel()
 	addr := "testAddress"
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ state.QueryOptions) (i *big.Int, e error) {
 			return big.NewInt(10), nil
 		},
 	}
@@ -78,7 +79,7 @@ func TestSourceThrottler_LimitUnderShouldProcessRequest(t *testing.T) {
 	t.Parallel()
 	addr := "testAddress"
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ state.QueryOptions) (i *big.Int, e error) {
 			return big.NewInt(10), nil
 		},
 	}
@@ -98,7 +99,7 @@ func TestSourceThrottler_LimitOverShouldErr(t *testing.T) {
 	t.Parallel()
 
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ state.QueryOptions) (i *big.Int, e error) {
 			return big.NewInt(10), nil
 		},
 	}
@@ -123,7 +124,7 @@ func TestSourceThrottler_LimitResetShouldWork(t *testing.T) {
 	t.Parallel()
 
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ state.QueryOptions) (i *big.Int, e error) {
 			return big.NewInt(10), nil
 		},
 	}
@@ -160,3 +161,32 @@ func makeRequestSourceThrottler(ws *gin.Engine, mutResponses *sync.Mutex, respon
 	responses[resp.Code]++
 	mutResponses.Unlock()
 }
+
+func TestSourceThrottler_ChargeAdditionalRequestShouldCountTowardsTheLimit(t *testing.T) {
+	t.Parallel()
+
+	sourceThrottler, _ := middleware.NewSourceThrottler(3)
+	ws := gin.New()
+	ws.Use(sourceThrottler.MiddlewareHandlerFunc())
+	ws.GET("/batch", func(c *gin.Context) {
+		numCharged := 0
+		for i := 0; i < 3; i++ {
+			if middleware.ChargeAdditionalRequest(c) == nil {
+				numCharged++
+			}
+		}
+		c.JSON(http.StatusOK, gin.H{"charged": numCharged})
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "/batch", nil)
+	req.RemoteAddr = "127.0.0.1:8080"
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Contains(t, resp.Body.String(), `"charged":2`)
+
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+}
@@ -6,37 +6,87 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/events"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
 // Facade is the mock implementation of a node router handler
 type Facade struct {
-	ShouldErrorStart                  bool
-	ShouldErrorStop                   bool
-	TpsBenchmarkHandler               func() *statistics.TpsBenchmark
-	GetHeartbeatsHandler              func() ([]data.PubKeyHeartbeat, error)
-	BalanceHandler                    func(string) (*big.Int, error)
-	GetAccountHandler                 func(address string) (state.UserAccountHandler, error)
-	GenerateTransactionHandler        func(sender string, receiver string, value *big.Int, code string) (*transaction.Transaction, error)
-	GetTransactionHandler             func(hash string) (*transaction.ApiTransactionResult, error)
-	CreateTransactionHandler          func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64, gasLimit uint64, data string, signatureHex string) (*transaction.Transaction, []byte, error)
-	ValidateTransactionHandler        func(tx *transaction.Transaction) error
-	SendBulkTransactionsHandler       func(txs []*transaction.Transaction) (uint64, error)
-	ExecuteSCQueryHandler             func(query *process.SCQuery) (*vmcommon.VMOutput, error)
-	StatusMetricsHandler              func() external.StatusMetricsHandler
-	ValidatorStatisticsHandler        func() (map[string]*state.ValidatorApiResponse, error)
-	ComputeTransactionGasLimitHandler func(tx *transaction.Transaction) (uint64, error)
-	NodeConfigCalled                  func() map[string]interface{}
-	GetQueryHandlerCalled             func(name string) (debug.QueryHandler, error)
-	GetTransactionStatusCalled        func(hash string) (string, error)
-	GetValueForKeyCalled              func(address string, key string) (string, error)
-	GetPeerInfoCalled                 func(pid string) ([]core.QueryP2PPeerInfo, error)
+	ShouldErrorStart                   bool
+	ShouldErrorStop                    bool
+	TpsBenchmarkHandler                func() *statistics.TpsBenchmark
+	GetHeartbeatsHandler               func() ([]data.PubKeyHeartbeat, error)
+	BalanceHandler                     func(address string, options state.QueryOptions) (*big.Int, error)
+	GetAccountHandler                  func(address string, options state.QueryOptions) (state.UserAccountHandler, error)
+	GetStateRootHashCalled             func(options state.QueryOptions) ([]byte, error)
+	GetProofCalled                     func(address string, options state.QueryOptions) (*state.ApiProof, error)
+	GetProofDataTrieCalled             func(address string, key string, options state.QueryOptions) (*state.ApiProof, *state.ApiProof, error)
+	GenerateTransactionHandler         func(sender string, receiver string, value *big.Int, code string) (*transaction.Transaction, error)
+	GetTransactionHandler              func(hash string) (*transaction.ApiTransactionResult, error)
+	CreateTransactionHandler           func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64, gasLimit uint64, data string, signatureHex string) (*transaction.Transaction, []byte, error)
+	ValidateTransactionHandler         func(tx *transaction.Transaction) error
+	SendBulkTransactionsHandler        func(txs []*transaction.Transaction) (uint64, error)
+	ExecuteSCQueryHandler              func(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	StatusMetricsHandler               func() external.StatusMetricsHandler
+	ValidatorStatisticsHandler         func() (map[string]*state.ValidatorApiResponse, error)
+	ComputeTransactionGasLimitHandler  func(tx *transaction.Transaction) (uint64, error)
+	SimulateTransactionHandler         func(tx *transaction.Transaction) (*transaction.SimulationResults, error)
+	PrometheusMetricsHandler           func() string
+	NodeConfigCalled                   func() map[string]interface{}
+	GetQueryHandlerCalled              func(name string) (debug.QueryHandler, error)
+	GetTransactionStatusCalled         func(hash string) (string, error)
+	GetValueForKeyCalled               func(address string, key string) (string, error)
+	GetPeerInfoCalled                  func(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetTransactionsForAddressCalled    func(address string, from uint32, size uint32) (*transaction.ApiAccountHistory, error)
+	SubscribeEventsCalled              func(filter events.Filter) (events.Subscription, error)
+	GetBlockByNonceCalled              func(nonce uint64, withTxs bool) (*block.ApiBlock, error)
+	GetBlockByHashCalled               func(hash string, withTxs bool) (*block.ApiBlock, error)
+	GetTransactionsPoolCalled          func() (*transaction.ApiTransactionsPool, error)
+	GetTransactionsPoolForSenderCalled func(address string) (*transaction.ApiTransactionsPoolForSender, error)
+	GetTransactionFromPoolCalled       func(hash string) (*transaction.ApiTransactionInPool, error)
+	GetQueryGasLimitCalled             func() uint64
+}
+
+// GetTransactionsPool -
+func (f *Facade) GetTransactionsPool() (*transaction.ApiTransactionsPool, error) {
+	return f.GetTransactionsPoolCalled()
+}
+
+// GetTransactionsPoolForSender -
+func (f *Facade) GetTransactionsPoolForSender(address string) (*transaction.ApiTransactionsPoolForSender, error) {
+	return f.GetTransactionsPoolForSenderCalled(address)
+}
+
+// GetTransactionFromPool -
+func (f *Facade) GetTransactionFromPool(hash string) (*transaction.ApiTransactionInPool, error) {
+	return f.GetTransactionFromPoolCalled(hash)
+}
+
+// GetBlockByNonce -
+func (f *Facade) GetBlockByNonce(nonce uint64, withTxs bool) (*block.ApiBlock, error) {
+	return f.GetBlockByNonceCalled(nonce, withTxs)
+}
+
+// GetBlockByHash -
+func (f *Facade) GetBlockByHash(hash string, withTxs bool) (*block.ApiBlock, error) {
+	return f.GetBlockByHashCalled(hash, withTxs)
+}
+
+// GetTransactionsForAddress -
+func (f *Facade) GetTransactionsForAddress(address string, from uint32, size uint32) (*transaction.ApiAccountHistory, error) {
+	return f.GetTransactionsForAddressCalled(address, from, size)
+}
+
+// SubscribeEvents -
+func (f *Facade) SubscribeEvents(filter events.Filter) (events.Subscription, error) {
+	return f.SubscribeEventsCalled(filter)
 }
 
 // GetTransactionStatus -
@@ -73,8 +123,8 @@ func (f *Facade) GetHeartbeats() ([]data.PubKeyHeartbeat, error) {
 }
 
 // GetBalance is the mock implementation of a handler's GetBalance method
-func (f *Facade) GetBalance(address string) (*big.Int, error) {
-	return f.BalanceHandler(address)
+func (f *Facade) GetBalance(address string, options state.QueryOptions) (*big.Int, error) {
+	return f.BalanceHandler(address, options)
 }
 
 // GetValueForKey is the mock implementation of a handler's GetValueForKey method
@@ -87,8 +137,27 @@ func (f *Facade) GetValueForKey(address string, key string) (string, error) {
 }
 
 // GetAccount is the mock implementation of a handler's GetAccount method
-func (f *Facade) GetAccount(address string) (state.UserAccountHandler, error) {
-	return f.GetAccountHandler(address)
+func (f *Facade) GetAccount(address string, options state.QueryOptions) (state.UserAccountHandler, error) {
+	return f.GetAccountHandler(address, options)
+}
+
+// GetStateRootHash is the mock implementation of a handler's GetStateRootHash method
+func (f *Facade) GetStateRootHash(options state.QueryOptions) ([]byte, error) {
+	if f.GetStateRootHashCalled != nil {
+		return f.GetStateRootHashCalled(options)
+	}
+
+	return options.RootHash, nil
+}
+
+// GetProof is the mock implementation of a handler's GetProof method
+func (f *Facade) GetProof(address string, options state.QueryOptions) (*state.ApiProof, error) {
+	return f.GetProofCalled(address, options)
+}
+
+// GetProofDataTrie is the mock implementation of a handler's GetProofDataTrie method
+func (f *Facade) GetProofDataTrie(address string, key string, options state.QueryOptions) (*state.ApiProof, *state.ApiProof, error) {
+	return f.GetProofDataTrieCalled(address, key, options)
 }
 
 // CreateTransaction is  mock implementation of a handler's CreateTransaction method
@@ -115,7 +184,7 @@ func (f *Facade) SendBulkTransactions(txs []*transaction.Transaction) (uint64, e
 	return f.SendBulkTransactionsHandler(txs)
 }
 
-//ValidateTransaction --
+// ValidateTransaction --
 func (f *Facade) ValidateTransaction(tx *transaction.Transaction) error {
 	return f.ValidateTransactionHandler(tx)
 }
@@ -140,6 +209,16 @@ func (f *Facade) ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64
 	return f.ComputeTransactionGasLimitHandler(tx)
 }
 
+// SimulateTransaction --
+func (f *Facade) SimulateTransaction(tx *transaction.Transaction) (*transaction.SimulationResults, error) {
+	return f.SimulateTransactionHandler(tx)
+}
+
+// PrometheusMetrics --
+func (f *Facade) PrometheusMetrics() string {
+	return f.PrometheusMetricsHandler()
+}
+
 // NodeConfig -
 func (f *Facade) NodeConfig() map[string]interface{} {
 	return f.NodeConfigCalled()
@@ -155,6 +234,15 @@ func (f *Facade) DecodeAddressPubkey(pk string) ([]byte, error) {
 	return hex.DecodeString(pk)
 }
 
+// GetQueryGasLimit -
+func (f *Facade) GetQueryGasLimit() uint64 {
+	if f.GetQueryGasLimitCalled != nil {
+		return f.GetQueryGasLimitCalled()
+	}
+
+	return 0
+}
+
 // GetQueryHandler -
 func (f *Facade) GetQueryHandler(name string) (debug.QueryHandler, error) {
 	return f.GetQueryHandlerCalled(name)
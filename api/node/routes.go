@@ -15,13 +15,18 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-const pidQueryParam = "pid"
+const (
+	pidQueryParam = "pid"
+	// prometheusContentType is the content type of the Prometheus text exposition format
+	prometheusContentType = "text/plain; version=0.0.4; charset=utf-8"
+)
 
 // FacadeHandler interface defines methods that can be used from `elrondFacade` context variable
 type FacadeHandler interface {
 	GetHeartbeats() ([]data.PubKeyHeartbeat, error)
 	TpsBenchmark() *statistics.TpsBenchmark
 	StatusMetrics() external.StatusMetricsHandler
+	PrometheusMetrics() string
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
 	IsInterfaceNil() bool
@@ -127,6 +132,17 @@ func P2pStatusMetrics(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"details": details})
 }
 
+// PrometheusMetrics returns all the node metrics in the Prometheus text format, ready to be scraped
+func PrometheusMetrics(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	c.Data(http.StatusOK, prometheusContentType, []byte(ef.PrometheusMetrics()))
+}
+
 func statsFromTpsBenchmark(tpsBenchmark *statistics.TpsBenchmark) statisticsResponse {
 	sr := statisticsResponse{}
 	sr.LiveTPS = tpsBenchmark.LiveTPS()
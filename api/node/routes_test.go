@@ -267,6 +267,38 @@ func TestP2PStatusMetrics_ShouldDisplayNonP2pMetrics(t *testing.T) {
 	assert.False(t, strings.Contains(respStr, key))
 }
 
+func TestPrometheusMetrics_WrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startMetricsServer(mock.WrongFacade{})
+	req, _ := http.NewRequest("GET", "/metrics", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(resp.Body.String(), errors.ErrInvalidAppContext.Error()))
+}
+
+func TestPrometheusMetrics_ShouldReturnTextFormat(t *testing.T) {
+	t.Parallel()
+
+	metrics := "# TYPE erd_nonce gauge\nerd_nonce 37\n"
+	facade := &mock.Facade{
+		PrometheusMetricsHandler: func() string {
+			return metrics
+		},
+	}
+
+	ws := startMetricsServer(facade)
+	req, _ := http.NewRequest("GET", "/metrics", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.True(t, strings.HasPrefix(resp.Header().Get("Content-Type"), "text/plain"))
+	assert.Equal(t, metrics, resp.Body.String())
+}
+
 func TestQueryDebug_GetQueryErrorsShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -444,6 +476,15 @@ func startNodeServerWithFacade(facade interface{}) *gin.Engine {
 	return ws
 }
 
+func startMetricsServer(facade interface{}) *gin.Engine {
+	ws := gin.New()
+	ws.Use(func(c *gin.Context) {
+		c.Set("elrondFacade", facade)
+	})
+	ws.GET("/metrics", node.PrometheusMetrics)
+	return ws
+}
+
 func getRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
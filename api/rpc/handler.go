@@ -0,0 +1,207 @@
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/process"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/gin-gonic/gin"
+)
+
+// maxBatchSize is the maximum number of requests accepted in a batch
+const maxBatchSize = 100
+
+// defaultMaxBodySizeInBytes is the maximum size of a request body used when none is configured
+const defaultMaxBodySizeInBytes = 1 << 20
+
+// FacadeHandler interface defines methods that can be used from `elrondFacade` context variable
+type FacadeHandler interface {
+	GetAccount(address string, options state.QueryOptions) (state.UserAccountHandler, error)
+	GetBalance(address string, options state.QueryOptions) (*big.Int, error)
+	GetValueForKey(address string, key string) (string, error)
+	CreateTransaction(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64,
+		gasLimit uint64, data string, signatureHex string) (*transaction.Transaction, []byte, error)
+	ValidateTransaction(tx *transaction.Transaction) error
+	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
+	SimulateTransaction(tx *transaction.Transaction) (*transaction.SimulationResults, error)
+	GetTransaction(hash string) (*transaction.ApiTransactionResult, error)
+	GetTransactionStatus(hash string) (string, error)
+	ExecuteSCQuery(*process.SCQuery) (*vmcommon.VMOutput, error)
+	DecodeAddressPubkey(pk string) ([]byte, error)
+	EncodeAddressPubkey(pk []byte) (string, error)
+	GetQueryGasLimit() uint64
+	GetStateRootHash(options state.QueryOptions) ([]byte, error)
+	StatusMetrics() external.StatusMetricsHandler
+	GetHeartbeats() ([]data.PubKeyHeartbeat, error)
+	ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error)
+	IsInterfaceNil() bool
+}
+
+type enabledMethod struct {
+	handler methodHandler
+	groups  []string
+}
+
+type gateway struct {
+	methods            map[string]enabledMethod
+	maxBodySizeInBytes int64
+}
+
+// NewHandler returns the handler of the JSON-RPC 2.0 endpoint. It accepts a single request or a batch of requests and
+// enables only the methods whose REST route is open in the routes config. Each call of a batch is charged separately to
+// the limiters of the API middlewares
+func NewHandler(routesConfig config.ApiRoutesConfig) gin.HandlerFunc {
+	gw := &gateway{
+		methods:            make(map[string]enabledMethod),
+		maxBodySizeInBytes: routesConfig.Rpc.MaxBodySizeInBytes,
+	}
+	if gw.maxBodySizeInBytes <= 0 {
+		gw.maxBodySizeInBytes = defaultMaxBodySizeInBytes
+	}
+	for name, m := range methods {
+		routeConfig, ok := getOpenRoute(routesConfig, m.packageName, m.route)
+		if !ok {
+			continue
+		}
+
+		gw.methods[name] = enabledMethod{
+			handler: m.handler,
+			groups:  routeConfig.Groups,
+		}
+	}
+
+	return gw.handle
+}
+
+func getOpenRoute(routesConfig config.ApiRoutesConfig, packageName string, route string) (config.RouteConfig, bool) {
+	packageConfig, ok := routesConfig.APIPackages[packageName]
+	if !ok {
+		return config.RouteConfig{}, false
+	}
+
+	for _, routeConfig := range packageConfig.Routes {
+		if routeConfig.Name == route && routeConfig.Open {
+			return routeConfig, true
+		}
+	}
+
+	return config.RouteConfig{}, false
+}
+
+func (gw *gateway) handle(c *gin.Context) {
+	facade, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, gw.maxBodySizeInBytes))
+	if err != nil {
+		c.JSON(http.StatusOK, newErrorResponse(nil, &Error{Code: ErrorCodeParseError, Message: err.Error()}))
+		return
+	}
+
+	body = bytes.TrimSpace(body)
+	isBatch := len(body) > 0 && body[0] == '['
+	if !isBatch {
+		response := gw.processRequest(c, facade, body, true)
+		if response == nil {
+			c.Status(http.StatusNoContent)
+			return
+		}
+
+		c.JSON(http.StatusOK, response)
+		return
+	}
+
+	batch := make([]json.RawMessage, 0)
+	err = json.Unmarshal(body, &batch)
+	if err != nil {
+		c.JSON(http.StatusOK, newErrorResponse(nil, &Error{Code: ErrorCodeParseError, Message: err.Error()}))
+		return
+	}
+	if len(batch) == 0 || len(batch) > maxBatchSize {
+		message := fmt.Sprintf("the batch should hold between 1 and %d requests", maxBatchSize)
+		c.JSON(http.StatusOK, newErrorResponse(nil, &Error{Code: ErrorCodeInvalidRequest, Message: message}))
+		return
+	}
+
+	responses := make([]*Response, 0, len(batch))
+	for index, rawRequest := range batch {
+		// the first call is charged by the middlewares, along with the HTTP request
+		isCharged := index == 0
+		response := gw.processRequest(c, facade, rawRequest, isCharged)
+		if response != nil {
+			responses = append(responses, response)
+		}
+	}
+
+	if len(responses) == 0 {
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
+// processRequest executes a single request, returning nil for the notifications. A request which was not charged yet
+// is charged to the limiters of the API middlewares before being executed
+func (gw *gateway) processRequest(c *gin.Context, facade FacadeHandler, rawRequest json.RawMessage, isCharged bool) *Response {
+	if !json.Valid(rawRequest) {
+		return newErrorResponse(nil, &Error{Code: ErrorCodeParseError, Message: "invalid json"})
+	}
+
+	request := &Request{}
+	err := json.Unmarshal(rawRequest, request)
+	if err != nil {
+		return newErrorResponse(nil, &Error{Code: ErrorCodeInvalidRequest, Message: err.Error()})
+	}
+	if !isCharged {
+		err = middleware.ChargeAdditionalRequest(c)
+		if err != nil && request.isNotification() {
+			return nil
+		}
+		if err != nil {
+			return newErrorResponse(request.ID, &Error{Code: ErrorCodeLimitExceeded, Message: err.Error()})
+		}
+	}
+	if request.JSONRPC != Version || request.Method == "" {
+		return newErrorResponse(request.ID, &Error{Code: ErrorCodeInvalidRequest, Message: "invalid request"})
+	}
+
+	result, rpcErr := gw.call(c, facade, request)
+	if request.isNotification() {
+		return nil
+	}
+	if rpcErr != nil {
+		return newErrorResponse(request.ID, rpcErr)
+	}
+
+	return newResultResponse(request.ID, result)
+}
+
+func (gw *gateway) call(c *gin.Context, facade FacadeHandler, request *Request) (interface{}, *Error) {
+	m, ok := gw.methods[request.Method]
+	if !ok {
+		return nil, &Error{Code: ErrorCodeMethodNotFound, Message: fmt.Sprintf("method not found: %s", request.Method)}
+	}
+
+	err := middleware.AuthorizePermissionGroups(c, m.groups)
+	if err != nil {
+		return nil, newError(err, fmt.Errorf("method %s", request.Method))
+	}
+
+	return m.handler(facade, request.Params)
+}
@@ -0,0 +1,338 @@
+package rpc_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/rpc"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"address": {
+				Routes: []config.RouteConfig{
+					{Name: "/:address", Open: true},
+					{Name: "/:address/balance", Open: true},
+					{Name: "/:address/key/:key", Open: false},
+				},
+			},
+			"transaction": {
+				Routes: []config.RouteConfig{
+					{Name: "/send", Open: true, Groups: []string{"admin"}},
+					{Name: "/:txhash/status", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func startNodeServer(handler interface{}, authConfig *config.ApiAuthenticationConfig) *gin.Engine {
+	ws := gin.New()
+	if authConfig != nil {
+		authenticator, _ := middleware.NewApiKeyAuthenticator(*authConfig)
+		ws.Use(authenticator.MiddlewareHandlerFunc())
+	}
+	ws.POST("/rpc", middleware.WithElrondFacade(handler), rpc.NewHandler(getRoutesConfig()))
+
+	return ws
+}
+
+func doRequest(ws *gin.Engine, body string, apiKey string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(http.MethodPost, "/rpc", strings.NewReader(body))
+	if len(apiKey) > 0 {
+		req.Header.Set("X-Api-Key", apiKey)
+	}
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func loadResponse(t *testing.T, resp *httptest.ResponseRecorder) rpc.Response {
+	response := rpc.Response{}
+	err := json.Unmarshal(resp.Body.Bytes(), &response)
+	require.Nil(t, err)
+
+	return response
+}
+
+func TestRpc_FailsWithWrongFacadeTypeConversion(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(mock.WrongFacade{}, nil)
+	resp := doRequest(ws, `{"jsonrpc":"2.0","method":"address_getBalance","params":{"address":"erd1"},"id":1}`, "")
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+}
+
+func TestRpc_SingleRequestShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		BalanceHandler: func(address string, options state.QueryOptions) (*big.Int, error) {
+			assert.Equal(t, "erd1", address)
+			require.NotNil(t, options.BlockNonce)
+			assert.Equal(t, uint64(7), *options.BlockNonce)
+			return big.NewInt(37), nil
+		},
+	}
+
+	ws := startNodeServer(facade, nil)
+	resp := doRequest(ws, `{"jsonrpc":"2.0","method":"address_getBalance","params":{"address":"erd1","blockNonce":7},"id":"a"}`, "")
+	response := loadResponse(t, resp)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Nil(t, response.Error)
+	assert.Equal(t, "37", response.Result)
+	assert.Equal(t, `"a"`, string(response.ID))
+}
+
+func TestRpc_BatchShouldAnswerEachRequestAndSkipNotifications(t *testing.T) {
+	t.Parallel()
+
+	numSentTxs := 0
+	facade := &mock.Facade{
+		BalanceHandler: func(_ string, _ state.QueryOptions) (*big.Int, error) {
+			return big.NewInt(37), nil
+		},
+		GetTransactionStatusCalled: func(_ string) (string, error) {
+			return "pending", nil
+		},
+		CreateTransactionHandler: func(_ uint64, _ string, _ string, _ string, _ uint64, _ uint64, _ string, _ string) (*transaction.Transaction, []byte, error) {
+			return &transaction.Transaction{}, []byte("hash"), nil
+		},
+		ValidateTransactionHandler: func(_ *transaction.Transaction) error {
+			return nil
+		},
+		SendBulkTransactionsHandler: func(txs []*transaction.Transaction) (uint64, error) {
+			numSentTxs += len(txs)
+			return uint64(len(txs)), nil
+		},
+	}
+
+	ws := startNodeServer(facade, nil)
+	resp := doRequest(ws, `[
+		{"jsonrpc":"2.0","method":"address_getBalance","params":{"address":"erd1"},"id":1},
+		{"jsonrpc":"2.0","method":"transaction_send","params":{"sender":"erd1","receiver":"erd2","value":"1"}},
+		{"jsonrpc":"2.0","method":"transaction_getStatus","params":{"txHash":"aa"},"id":2},
+		{"jsonrpc":"2.0","method":"address_getValueForKey","params":{"address":"erd1","key":"aa"},"id":3},
+		{"jsonrpc":"1.0","method":"address_getBalance","id":4},
+		1
+	]`, "")
+
+	responses := make([]rpc.Response, 0)
+	err := json.Unmarshal(resp.Body.Bytes(), &responses)
+	require.Nil(t, err)
+	require.Len(t, responses, 5)
+
+	assert.Equal(t, "37", responses[0].Result)
+	assert.Equal(t, 1, numSentTxs)
+	assert.Equal(t, "pending", responses[1].Result)
+	assert.Equal(t, rpc.ErrorCodeMethodNotFound, responses[2].Error.Code)
+	assert.Equal(t, "3", string(responses[2].ID))
+	assert.Equal(t, rpc.ErrorCodeInvalidRequest, responses[3].Error.Code)
+	assert.Equal(t, rpc.ErrorCodeInvalidRequest, responses[4].Error.Code)
+}
+
+func TestRpc_OnlyNotificationsShouldNotAnswer(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		BalanceHandler: func(_ string, _ state.QueryOptions) (*big.Int, error) {
+			return big.NewInt(37), nil
+		},
+	}
+
+	ws := startNodeServer(facade, nil)
+	resp := doRequest(ws, `{"jsonrpc":"2.0","method":"address_getBalance","params":{"address":"erd1"}}`, "")
+
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+	assert.Equal(t, 0, resp.Body.Len())
+}
+
+func TestRpc_InvalidBodiesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{}, nil)
+
+	response := loadResponse(t, doRequest(ws, `{"jsonrpc":"2.0",`, ""))
+	assert.Equal(t, rpc.ErrorCodeParseError, response.Error.Code)
+	assert.Equal(t, "null", string(response.ID))
+
+	response = loadResponse(t, doRequest(ws, `[`, ""))
+	assert.Equal(t, rpc.ErrorCodeParseError, response.Error.Code)
+
+	response = loadResponse(t, doRequest(ws, `[]`, ""))
+	assert.Equal(t, rpc.ErrorCodeInvalidRequest, response.Error.Code)
+}
+
+func TestRpc_InvalidParamsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{}, nil)
+
+	requests := []string{
+		`{"jsonrpc":"2.0","method":"address_getBalance","id":1}`,
+		`{"jsonrpc":"2.0","method":"address_getBalance","params":["erd1"],"id":1}`,
+		`{"jsonrpc":"2.0","method":"address_getBalance","params":{"address":"erd1","unknown":1},"id":1}`,
+		`{"jsonrpc":"2.0","method":"address_getBalance","params":{"address":""},"id":1}`,
+		`{"jsonrpc":"2.0","method":"address_getBalance","params":{"address":"erd1","blockNonce":1,"rootHash":"aa"},"id":1}`,
+		`{"jsonrpc":"2.0","method":"transaction_getStatus","params":{"txHash":""},"id":1}`,
+	}
+	for _, request := range requests {
+		response := loadResponse(t, doRequest(ws, request, ""))
+		require.NotNil(t, response.Error, request)
+		assert.Equal(t, rpc.ErrorCodeInvalidParams, response.Error.Code, request)
+	}
+}
+
+func TestRpc_FacadeErrorsShouldBeMapped(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetAccountHandler: func(_ string, _ state.QueryOptions) (state.UserAccountHandler, error) {
+			return nil, state.ErrAccNotFound
+		},
+		BalanceHandler: func(_ string, _ state.QueryOptions) (*big.Int, error) {
+			return nil, errors.New("expected error")
+		},
+		CreateTransactionHandler: func(_ uint64, _ string, _ string, _ string, _ uint64, _ uint64, _ string, _ string) (*transaction.Transaction, []byte, error) {
+			return nil, nil, errors.New("invalid signature")
+		},
+	}
+
+	ws := startNodeServer(facade, nil)
+
+	response := loadResponse(t, doRequest(ws, `{"jsonrpc":"2.0","method":"address_getAccount","params":{"address":"erd1"},"id":1}`, ""))
+	assert.Equal(t, rpc.ErrorCodeNotFound, response.Error.Code)
+
+	response = loadResponse(t, doRequest(ws, `{"jsonrpc":"2.0","method":"address_getBalance","params":{"address":"erd1"},"id":1}`, ""))
+	assert.Equal(t, rpc.ErrorCodeServerError, response.Error.Code)
+	assert.Contains(t, response.Error.Message, "expected error")
+
+	response = loadResponse(t, doRequest(ws, `{"jsonrpc":"2.0","method":"transaction_send","params":{"sender":"erd1"},"id":1}`, ""))
+	assert.Equal(t, rpc.ErrorCodeTxRejected, response.Error.Code)
+}
+
+func TestRpc_PermissionGroupsShouldBeEnforcedPerMethod(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		CreateTransactionHandler: func(_ uint64, _ string, _ string, _ string, _ uint64, _ uint64, _ string, _ string) (*transaction.Transaction, []byte, error) {
+			return &transaction.Transaction{}, []byte("hash"), nil
+		},
+		ValidateTransactionHandler: func(_ *transaction.Transaction) error {
+			return nil
+		},
+		SendBulkTransactionsHandler: func(txs []*transaction.Transaction) (uint64, error) {
+			return uint64(len(txs)), nil
+		},
+		GetTransactionStatusCalled: func(_ string) (string, error) {
+			return "pending", nil
+		},
+	}
+	authConfig := &config.ApiAuthenticationConfig{
+		Enabled:        true,
+		HeaderName:     "X-Api-Key",
+		AllowAnonymous: true,
+		Keys: []config.ApiKeyConfig{
+			{Name: "admin", Key: "admin-key", Groups: []string{"admin"}, MaxRequestsPerWindow: 100, WindowInSec: 60},
+		},
+	}
+
+	ws := startNodeServer(facade, authConfig)
+	body := `[
+		{"jsonrpc":"2.0","method":"transaction_send","params":{"sender":"erd1"},"id":1},
+		{"jsonrpc":"2.0","method":"transaction_getStatus","params":{"txHash":"aa"},"id":2}
+	]`
+
+	responses := make([]rpc.Response, 0)
+	err := json.Unmarshal(doRequest(ws, body, "").Body.Bytes(), &responses)
+	require.Nil(t, err)
+	require.Len(t, responses, 2)
+	assert.Equal(t, rpc.ErrorCodeForbidden, responses[0].Error.Code)
+	assert.Equal(t, "pending", responses[1].Result)
+
+	responses = make([]rpc.Response, 0)
+	err = json.Unmarshal(doRequest(ws, body, "admin-key").Body.Bytes(), &responses)
+	require.Nil(t, err)
+	require.Len(t, responses, 2)
+	assert.Equal(t, hex.EncodeToString([]byte("hash")), responses[0].Result)
+	assert.Equal(t, "pending", responses[1].Result)
+}
+
+func TestRpc_BatchCallsShouldBeChargedSeparately(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetTransactionStatusCalled: func(_ string) (string, error) {
+			return "pending", nil
+		},
+	}
+	authConfig := &config.ApiAuthenticationConfig{
+		Enabled:        true,
+		HeaderName:     "X-Api-Key",
+		AllowAnonymous: true,
+		Keys: []config.ApiKeyConfig{
+			{Name: "partner", Key: "partner-key", MaxRequestsPerWindow: 100, WindowInSec: 60, MaxRequestsPerQuota: 2, QuotaPeriodInSec: 3600},
+		},
+	}
+
+	ws := startNodeServer(facade, authConfig)
+	body := `[
+		{"jsonrpc":"2.0","method":"transaction_getStatus","params":{"txHash":"aa"},"id":1},
+		{"jsonrpc":"2.0","method":"transaction_getStatus","params":{"txHash":"bb"},"id":2},
+		{"jsonrpc":"2.0","method":"transaction_getStatus","params":{"txHash":"cc"},"id":3}
+	]`
+
+	responses := make([]rpc.Response, 0)
+	err := json.Unmarshal(doRequest(ws, body, "partner-key").Body.Bytes(), &responses)
+	require.Nil(t, err)
+	require.Len(t, responses, 3)
+	assert.Equal(t, "pending", responses[0].Result)
+	assert.Equal(t, "pending", responses[1].Result)
+	assert.Equal(t, rpc.ErrorCodeLimitExceeded, responses[2].Error.Code)
+	assert.Equal(t, "3", string(responses[2].ID))
+
+	resp := doRequest(ws, `{"jsonrpc":"2.0","method":"transaction_getStatus","params":{"txHash":"aa"},"id":1}`, "partner-key")
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+}
+
+func TestRpc_TooLargeBodyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetTransactionStatusCalled: func(_ string) (string, error) {
+			return "pending", nil
+		},
+	}
+	routesConfig := getRoutesConfig()
+	routesConfig.Rpc.MaxBodySizeInBytes = 100
+
+	ws := gin.New()
+	ws.POST("/rpc", middleware.WithElrondFacade(facade), rpc.NewHandler(routesConfig))
+
+	body := `{"jsonrpc":"2.0","method":"transaction_getStatus","params":{"txHash":"aa"},"id":1}`
+	response := loadResponse(t, doRequest(ws, body, ""))
+	assert.Equal(t, "pending", response.Result)
+
+	body = `{"jsonrpc":"2.0","method":"transaction_getStatus","params":{"txHash":"` + strings.Repeat("a", 100) + `"},"id":1}`
+	response = loadResponse(t, doRequest(ws, body, ""))
+	require.NotNil(t, response.Error)
+	assert.Equal(t, rpc.ErrorCodeParseError, response.Error.Code)
+}
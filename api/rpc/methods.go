@@ -0,0 +1,308 @@
+package rpc
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	apiTransaction "github.com/ElrondNetwork/elrond-go/api/transaction"
+	"github.com/ElrondNetwork/elrond-go/api/vmValues"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+var errMissingParams = errors.New("missing params")
+
+type methodHandler func(facade FacadeHandler, params json.RawMessage) (interface{}, *Error)
+
+// method is a JSON-RPC method backed by the same facade operation as a REST route. The method is enabled only when the
+// route is open in api.toml and it can be called only by the callers belonging to the permission groups of the route
+type method struct {
+	packageName string
+	route       string
+	handler     methodHandler
+}
+
+var methods = map[string]method{
+	"address_getAccount":      {packageName: "address", route: "/:address", handler: getAccount},
+	"address_getBalance":      {packageName: "address", route: "/:address/balance", handler: getBalance},
+	"address_getValueForKey":  {packageName: "address", route: "/:address/key/:key", handler: getValueForKey},
+	"transaction_send":        {packageName: "transaction", route: "/send", handler: sendTransaction},
+	"transaction_simulate":    {packageName: "transaction", route: "/simulate", handler: simulateTransaction},
+	"transaction_get":         {packageName: "transaction", route: "/:txhash", handler: getTransaction},
+	"transaction_getStatus":   {packageName: "transaction", route: "/:txhash/status", handler: getTransactionStatus},
+	"vm_query":                {packageName: "vm-values", route: "/query", handler: executeQuery},
+	"network_getConfig":       {packageName: "network", route: "/config", handler: getNetworkConfig},
+	"network_getStatus":       {packageName: "network", route: "/status", handler: getNetworkStatus},
+	"node_getHeartbeatStatus": {packageName: "node", route: "/heartbeatstatus", handler: getHeartbeatStatus},
+	"validator_getStatistics": {packageName: "validator", route: "/statistics", handler: getValidatorStatistics},
+}
+
+type accountParams struct {
+	Address    string  `json:"address"`
+	Key        string  `json:"key"`
+	BlockNonce *uint64 `json:"blockNonce"`
+	RootHash   string  `json:"rootHash"`
+}
+
+type txHashParams struct {
+	TxHash string `json:"txHash"`
+}
+
+type vmQueryParams struct {
+	vmValues.VMValueRequest
+	BlockNonce *uint64 `json:"blockNonce"`
+	RootHash   string  `json:"rootHash"`
+}
+
+type accountResult struct {
+	Address  string `json:"address"`
+	Nonce    uint64 `json:"nonce"`
+	Balance  string `json:"balance"`
+	Code     string `json:"code"`
+	CodeHash []byte `json:"codeHash"`
+	RootHash []byte `json:"rootHash"`
+}
+
+// decodeParams decodes the params object of a request, rejecting the unknown fields and the positional params
+func decodeParams(params json.RawMessage, value interface{}) error {
+	if len(params) == 0 {
+		return errMissingParams
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(params))
+	decoder.DisallowUnknownFields()
+
+	return decoder.Decode(value)
+}
+
+func decodeAccountParams(params json.RawMessage, scope error) (*accountParams, state.QueryOptions, *Error) {
+	accParams := &accountParams{}
+	err := decodeParams(params, accParams)
+	if err != nil {
+		return nil, state.QueryOptions{}, newError(apiErrors.ErrValidation, err)
+	}
+	if accParams.Address == "" {
+		return nil, state.QueryOptions{}, newError(scope, apiErrors.ErrEmptyAddress)
+	}
+
+	options, err := shared.NewStateQueryOptions(accParams.BlockNonce, accParams.RootHash)
+	if err != nil {
+		return nil, state.QueryOptions{}, newError(apiErrors.ErrValidation, err)
+	}
+
+	return accParams, options, nil
+}
+
+func getAccount(facade FacadeHandler, params json.RawMessage) (interface{}, *Error) {
+	accParams, options, rpcErr := decodeAccountParams(params, apiErrors.ErrCouldNotGetAccount)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	account, err := facade.GetAccount(accParams.Address, options)
+	if err != nil {
+		return nil, newError(apiErrors.ErrCouldNotGetAccount, err)
+	}
+
+	return &accountResult{
+		Address:  accParams.Address,
+		Nonce:    account.GetNonce(),
+		Balance:  account.GetBalance().String(),
+		Code:     hex.EncodeToString(account.GetCode()),
+		CodeHash: account.GetCodeHash(),
+		RootHash: account.GetRootHash(),
+	}, nil
+}
+
+func getBalance(facade FacadeHandler, params json.RawMessage) (interface{}, *Error) {
+	accParams, options, rpcErr := decodeAccountParams(params, apiErrors.ErrGetBalance)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	balance, err := facade.GetBalance(accParams.Address, options)
+	if err != nil {
+		return nil, newError(apiErrors.ErrGetBalance, err)
+	}
+
+	return balance.String(), nil
+}
+
+func getValueForKey(facade FacadeHandler, params json.RawMessage) (interface{}, *Error) {
+	accParams, options, rpcErr := decodeAccountParams(params, apiErrors.ErrGetValueForKey)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	if accParams.Key == "" {
+		return nil, newError(apiErrors.ErrGetValueForKey, apiErrors.ErrEmptyKey)
+	}
+	if !options.IsCurrentState() {
+		return nil, newError(apiErrors.ErrValidation, apiErrors.ErrInvalidQueryParameter)
+	}
+
+	value, err := facade.GetValueForKey(accParams.Address, accParams.Key)
+	if err != nil {
+		return nil, newError(apiErrors.ErrGetValueForKey, err)
+	}
+
+	return value, nil
+}
+
+func createTransaction(facade FacadeHandler, params json.RawMessage, scope error) (*transaction.Transaction, []byte, *Error) {
+	txRequest := apiTransaction.SendTxRequest{}
+	err := decodeParams(params, &txRequest)
+	if err != nil {
+		return nil, nil, newError(apiErrors.ErrValidation, err)
+	}
+
+	tx, txHash, err := facade.CreateTransaction(
+		txRequest.Nonce,
+		txRequest.Value,
+		txRequest.Receiver,
+		txRequest.Sender,
+		txRequest.GasPrice,
+		txRequest.GasLimit,
+		txRequest.Data,
+		txRequest.Signature,
+	)
+	if err != nil {
+		return nil, nil, newError(scope, err)
+	}
+
+	return tx, txHash, nil
+}
+
+func sendTransaction(facade FacadeHandler, params json.RawMessage) (interface{}, *Error) {
+	tx, txHash, rpcErr := createTransaction(facade, params, apiErrors.ErrTxGenerationFailed)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	err := facade.ValidateTransaction(tx)
+	if err != nil {
+		return nil, newError(apiErrors.ErrTxGenerationFailed, err)
+	}
+
+	_, err = facade.SendBulkTransactions([]*transaction.Transaction{tx})
+	if err != nil {
+		return nil, &Error{Code: ErrorCodeServerError, Message: err.Error()}
+	}
+
+	return hex.EncodeToString(txHash), nil
+}
+
+func simulateTransaction(facade FacadeHandler, params json.RawMessage) (interface{}, *Error) {
+	tx, _, rpcErr := createTransaction(facade, params, apiErrors.ErrValidation)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	results, err := facade.SimulateTransaction(tx)
+	if err != nil {
+		return nil, &Error{Code: ErrorCodeServerError, Message: err.Error()}
+	}
+
+	return results, nil
+}
+
+func decodeTxHashParams(params json.RawMessage) (string, *Error) {
+	hashParams := txHashParams{}
+	err := decodeParams(params, &hashParams)
+	if err != nil {
+		return "", newError(apiErrors.ErrValidation, err)
+	}
+	if hashParams.TxHash == "" {
+		return "", newError(apiErrors.ErrValidation, apiErrors.ErrValidationEmptyTxHash)
+	}
+
+	return hashParams.TxHash, nil
+}
+
+func getTransaction(facade FacadeHandler, params json.RawMessage) (interface{}, *Error) {
+	txHash, rpcErr := decodeTxHashParams(params)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	tx, err := facade.GetTransaction(txHash)
+	if err != nil {
+		return nil, newError(apiErrors.ErrTxNotFound, err)
+	}
+
+	return tx, nil
+}
+
+func getTransactionStatus(facade FacadeHandler, params json.RawMessage) (interface{}, *Error) {
+	txHash, rpcErr := decodeTxHashParams(params)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	status, err := facade.GetTransactionStatus(txHash)
+	if err != nil {
+		return nil, newError(apiErrors.ErrGetTransaction, err)
+	}
+
+	return status, nil
+}
+
+func executeQuery(facade FacadeHandler, params json.RawMessage) (interface{}, *Error) {
+	queryParams := vmQueryParams{}
+	err := decodeParams(params, &queryParams)
+	if err != nil {
+		return nil, newError(apiErrors.ErrValidation, err)
+	}
+
+	options, err := shared.NewStateQueryOptions(queryParams.BlockNonce, queryParams.RootHash)
+	if err != nil {
+		return nil, newError(apiErrors.ErrValidation, err)
+	}
+
+	query, err := vmValues.CreateSCQuery(facade, &queryParams.VMValueRequest)
+	if err != nil {
+		return nil, newError(apiErrors.ErrValidation, err)
+	}
+	if !options.IsCurrentState() {
+		query.RootHash, err = facade.GetStateRootHash(options)
+		if err != nil {
+			return nil, newError(apiErrors.ErrQueryError, err)
+		}
+	}
+
+	vmOutput, err := facade.ExecuteSCQuery(query)
+	if err != nil {
+		return nil, newError(apiErrors.ErrQueryError, err)
+	}
+
+	return vmOutput, nil
+}
+
+func getNetworkConfig(facade FacadeHandler, _ json.RawMessage) (interface{}, *Error) {
+	return facade.StatusMetrics().ConfigMetrics(), nil
+}
+
+func getNetworkStatus(facade FacadeHandler, _ json.RawMessage) (interface{}, *Error) {
+	return facade.StatusMetrics().NetworkMetrics(), nil
+}
+
+func getHeartbeatStatus(facade FacadeHandler, _ json.RawMessage) (interface{}, *Error) {
+	heartbeats, err := facade.GetHeartbeats()
+	if err != nil {
+		return nil, &Error{Code: ErrorCodeServerError, Message: err.Error()}
+	}
+
+	return heartbeats, nil
+}
+
+func getValidatorStatistics(facade FacadeHandler, _ json.RawMessage) (interface{}, *Error) {
+	statistics, err := facade.ValidatorStatisticsApi()
+	if err != nil {
+		return nil, &Error{Code: ErrorCodeServerError, Message: err.Error()}
+	}
+
+	return statistics, nil
+}
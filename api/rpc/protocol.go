@@ -0,0 +1,144 @@
+package rpc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+)
+
+// Version is the only JSON-RPC version accepted by the gateway
+const Version = "2.0"
+
+// Error codes defined by the JSON-RPC 2.0 specification
+const (
+	// ErrorCodeParseError signals that the request body is not a valid JSON
+	ErrorCodeParseError = -32700
+	// ErrorCodeInvalidRequest signals that the JSON is not a valid request object
+	ErrorCodeInvalidRequest = -32600
+	// ErrorCodeMethodNotFound signals that the method does not exist or that its route is not enabled
+	ErrorCodeMethodNotFound = -32601
+	// ErrorCodeInvalidParams signals invalid method parameters
+	ErrorCodeInvalidParams = -32602
+	// ErrorCodeInternalError signals an internal error of the gateway
+	ErrorCodeInternalError = -32603
+)
+
+// Server error codes, mapped from the errors returned by the REST routes
+const (
+	// ErrorCodeServerError signals that the node could not complete the call
+	ErrorCodeServerError = -32000
+	// ErrorCodeNotFound signals that the requested account, key, transaction or state was not found
+	ErrorCodeNotFound = -32001
+	// ErrorCodeForbidden signals that the caller does not belong to the permission groups of the method
+	ErrorCodeForbidden = -32002
+	// ErrorCodeLimitExceeded signals that a call of a batch exceeded the rate limit, the quota or the throttling limits
+	ErrorCodeLimitExceeded = -32005
+	// ErrorCodeTxRejected signals that the transaction could not be created or did not pass the validation
+	ErrorCodeTxRejected = -32010
+	// ErrorCodeQueryFailed signals that the smart contract query could not be executed
+	ErrorCodeQueryFailed = -32020
+)
+
+// errorCodes maps the errors of the api/errors package to the JSON-RPC error codes. The errors which are not found in
+// here are reported as ErrorCodeServerError
+var errorCodes = map[error]int{
+	apiErrors.ErrInvalidAppContext:     ErrorCodeInternalError,
+	apiErrors.ErrInvalidJSONRequest:    ErrorCodeInvalidParams,
+	apiErrors.ErrValidation:            ErrorCodeInvalidParams,
+	apiErrors.ErrValidationEmptyTxHash: ErrorCodeInvalidParams,
+	apiErrors.ErrEmptyAddress:          ErrorCodeInvalidParams,
+	apiErrors.ErrEmptyKey:              ErrorCodeInvalidParams,
+	apiErrors.ErrInvalidQueryParameter: ErrorCodeInvalidParams,
+	apiErrors.ErrTxNotFound:            ErrorCodeNotFound,
+	apiErrors.ErrTxGenerationFailed:    ErrorCodeTxRejected,
+	apiErrors.ErrQueryError:            ErrorCodeQueryFailed,
+	middleware.ErrForbiddenRoute:       ErrorCodeForbidden,
+}
+
+// Request is a JSON-RPC 2.0 request object. A request without an id is a notification, which gets no response
+type Request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	ID      json.RawMessage `json:"id,omitempty"`
+}
+
+func (req *Request) isNotification() bool {
+	return req.ID == nil
+}
+
+// Response is a JSON-RPC 2.0 response object, holding either a result or an error
+type Response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *Error          `json:"error,omitempty"`
+	ID      json.RawMessage `json:"id"`
+}
+
+// Error is a JSON-RPC 2.0 error object
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Error returns the message of the error
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// newError creates the error of a failed call, prefixing the message with the scope of the error, as the REST routes
+// do. The code is taken from the error itself when it is a known one, otherwise from its scope
+func newError(scope error, err error) *Error {
+	return &Error{
+		Code:    errorCodeFor(scope, err),
+		Message: fmt.Sprintf("%s: %s", scope.Error(), err.Error()),
+	}
+}
+
+func errorCodeFor(scope error, err error) int {
+	if shared.GetStatusCodeForStateError(err) == http.StatusNotFound {
+		return ErrorCodeNotFound
+	}
+
+	code, ok := lookupErrorCode(err)
+	if ok {
+		return code
+	}
+	code, ok = lookupErrorCode(scope)
+	if ok {
+		return code
+	}
+
+	return ErrorCodeServerError
+}
+
+func lookupErrorCode(err error) (int, bool) {
+	for knownErr, code := range errorCodes {
+		if errors.Is(err, knownErr) {
+			return code, true
+		}
+	}
+
+	return 0, false
+}
+
+func newResultResponse(id json.RawMessage, result interface{}) *Response {
+	return &Response{
+		JSONRPC: Version,
+		Result:  result,
+		ID:      id,
+	}
+}
+
+func newErrorResponse(id json.RawMessage, err *Error) *Response {
+	return &Response{
+		JSONRPC: Version,
+		Error:   err,
+		ID:      id,
+	}
+}
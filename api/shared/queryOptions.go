@@ -0,0 +1,69 @@
+package shared
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// UrlParameterBlockNonce is the name of the query parameter which selects the state at the end of a past block
+	UrlParameterBlockNonce = "blockNonce"
+	// UrlParameterRootHash is the name of the query parameter which selects the state with a given hex encoded root hash
+	UrlParameterRootHash = "rootHash"
+)
+
+// GetStateQueryOptions parses the optional blockNonce and rootHash query parameters of the request. At most one of
+// them can be provided; when none is provided the returned options select the current state
+func GetStateQueryOptions(c *gin.Context) (state.QueryOptions, error) {
+	var blockNonce *uint64
+	blockNonceParam := c.Query(UrlParameterBlockNonce)
+	if blockNonceParam != "" {
+		nonce, err := strconv.ParseUint(blockNonceParam, 10, 64)
+		if err != nil {
+			return state.QueryOptions{}, fmt.Errorf("%w: %s", apiErrors.ErrInvalidQueryParameter, UrlParameterBlockNonce)
+		}
+		blockNonce = &nonce
+	}
+
+	return NewStateQueryOptions(blockNonce, c.Query(UrlParameterRootHash))
+}
+
+// NewStateQueryOptions creates the options selecting a past state from an optional block nonce and an optional hex
+// encoded root hash. At most one of them can be provided; when none is provided the options select the current state
+func NewStateQueryOptions(blockNonce *uint64, rootHashHex string) (state.QueryOptions, error) {
+	options := state.QueryOptions{
+		BlockNonce: blockNonce,
+	}
+
+	if rootHashHex != "" {
+		rootHash, err := hex.DecodeString(rootHashHex)
+		if err != nil {
+			return state.QueryOptions{}, fmt.Errorf("%w: %s", apiErrors.ErrInvalidQueryParameter, UrlParameterRootHash)
+		}
+		options.RootHash = rootHash
+	}
+
+	err := options.Check()
+	if err != nil {
+		return state.QueryOptions{}, fmt.Errorf("%w: only one of %s and %s can be provided",
+			apiErrors.ErrInvalidQueryParameter, UrlParameterBlockNonce, UrlParameterRootHash)
+	}
+
+	return options, nil
+}
+
+// GetStatusCodeForStateError returns the HTTP status code matching an error encountered while reading the state
+func GetStatusCodeForStateError(err error) int {
+	if errors.Is(err, state.ErrStateNotAvailable) || errors.Is(err, state.ErrAccNotFound) || errors.Is(err, state.ErrKeyNotFound) {
+		return http.StatusNotFound
+	}
+
+	return http.StatusInternalServerError
+}
@@ -20,11 +20,24 @@ type TxService interface {
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
 	GetTransaction(hash string) (*transaction.ApiTransactionResult, error)
 	GetTransactionStatus(hash string) (string, error)
+	GetTransactionsPool() (*transaction.ApiTransactionsPool, error)
+	GetTransactionsPoolForSender(address string) (*transaction.ApiTransactionsPoolForSender, error)
+	GetTransactionFromPool(hash string) (*transaction.ApiTransactionInPool, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64, error)
+	SimulateTransaction(tx *transaction.Transaction) (*transaction.SimulationResults, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
 	IsInterfaceNil() bool
 }
 
+const (
+	txHashParam         = "txhash"
+	subPathParam        = "subpath"
+	addressParam        = "address"
+	poolPathSegment     = "pool"
+	statusPathSegment   = "status"
+	bySenderPathSegment = "by-sender"
+)
+
 // TxRequest represents the structure on which user input for generating a new transaction will validate against
 type TxRequest struct {
 	Sender   string   `form:"sender" json:"sender"`
@@ -66,9 +79,38 @@ type TxResponse struct {
 func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodPost, "/send", SendTransaction)
 	router.RegisterHandler(http.MethodPost, "/cost", ComputeTransactionGasLimit)
+	router.RegisterHandler(http.MethodPost, "/simulate", SimulateTransaction)
 	router.RegisterHandler(http.MethodPost, "/send-multiple", SendMultipleTransactions)
-	router.RegisterHandler(http.MethodGet, "/:txhash", GetTransaction)
-	router.RegisterHandler(http.MethodGet, "/:txhash/status", GetTransactionStatus)
+
+	// the router can not hold the static pool path segment next to the transaction hash wildcard, so the transactions
+	// pool endpoints share the router paths of the transaction endpoints
+	router.RegisterSharedPathHandler(http.MethodGet, "/:txhash",
+		wrapper.SharedPathEndpoint{Name: "/pool", Matches: isPoolRequest, Handler: GetTransactionsPool},
+		wrapper.SharedPathEndpoint{Name: "/:txhash", Matches: isTransactionRequest, Handler: GetTransaction},
+	)
+	router.RegisterSharedPathHandler(http.MethodGet, "/:txhash/:subpath",
+		wrapper.SharedPathEndpoint{Name: "/pool/:txhash", Matches: isPoolRequest, Handler: GetTransactionFromPool},
+		wrapper.SharedPathEndpoint{Name: "/:txhash/status", Matches: isStatusRequest, Handler: GetTransactionStatus},
+	)
+	router.RegisterSharedPathHandler(http.MethodGet, "/:txhash/:subpath/:address",
+		wrapper.SharedPathEndpoint{Name: "/pool/by-sender/:address", Matches: isPoolBySenderRequest, Handler: GetTransactionsPoolForSender},
+	)
+}
+
+func isPoolRequest(c *gin.Context) bool {
+	return c.Param(txHashParam) == poolPathSegment
+}
+
+func isTransactionRequest(c *gin.Context) bool {
+	return c.Param(txHashParam) != poolPathSegment
+}
+
+func isStatusRequest(c *gin.Context) bool {
+	return isTransactionRequest(c) && c.Param(subPathParam) == statusPathSegment
+}
+
+func isPoolBySenderRequest(c *gin.Context) bool {
+	return isPoolRequest(c) && c.Param(subPathParam) == bySenderPathSegment
 }
 
 // SendTransaction will receive a transaction from the client and propagate it for processing
@@ -184,7 +226,7 @@ func GetTransaction(c *gin.Context) {
 		return
 	}
 
-	txhash := c.Param("txhash")
+	txhash := c.Param(txHashParam)
 	if txhash == "" {
 		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyTxHash.Error())})
 		return
@@ -207,7 +249,7 @@ func GetTransactionStatus(c *gin.Context) {
 		return
 	}
 
-	txhash := c.Param("txhash")
+	txhash := c.Param(txHashParam)
 	if txhash == "" {
 		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyTxHash.Error())})
 		return
@@ -222,6 +264,71 @@ func GetTransactionStatus(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"status": status})
 }
 
+// GetTransactionsPool returns the number of transactions and bytes held in each cache of the transactions pool
+func GetTransactionsPool(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(TxService)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	pool, err := ef.GetTransactionsPool()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetTransactionsPool.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"pool": pool})
+}
+
+// GetTransactionsPoolForSender returns the transactions of a sender held in the transactions pool, along with the
+// nonce gaps and the score tracked for the sender
+func GetTransactionsPoolForSender(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(TxService)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	address := c.Param(addressParam)
+	if address == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrEmptyAddress.Error())})
+		return
+	}
+
+	senderTxs, err := ef.GetTransactionsPoolForSender(address)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetTransactionsPool.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"sender": senderTxs})
+}
+
+// GetTransactionFromPool returns a transaction held in the transactions pool, along with the cache holding it
+func GetTransactionFromPool(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(TxService)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	// the transaction hash is the path segment following the pool one
+	txhash := c.Param(subPathParam)
+	if txhash == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyTxHash.Error())})
+		return
+	}
+
+	tx, err := ef.GetTransactionFromPool(txhash)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrGetTransactionsPool.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"transaction": tx})
+}
+
 // ComputeTransactionGasLimit returns how many gas units a transaction wil consume
 func ComputeTransactionGasLimit(c *gin.Context) {
 	ef, ok := c.MustGet("elrondFacade").(TxService)
@@ -259,3 +366,42 @@ func ComputeTransactionGasLimit(c *gin.Context) {
 
 	c.JSON(http.StatusOK, gin.H{"txGasUnits": cost})
 }
+
+// SimulateTransaction executes a transaction on a copy of the current state and returns its outcome, without
+// propagating it
+func SimulateTransaction(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(TxService)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+	var gtx SendTxRequest
+	err := c.ShouldBindJSON(&gtx)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error())})
+		return
+	}
+
+	tx, _, err := ef.CreateTransaction(
+		gtx.Nonce,
+		gtx.Value,
+		gtx.Receiver,
+		gtx.Sender,
+		gtx.GasPrice,
+		gtx.GasLimit,
+		gtx.Data,
+		gtx.Signature,
+	)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error())})
+		return
+	}
+
+	results, err := ef.SimulateTransaction(tx)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"result": results})
+}
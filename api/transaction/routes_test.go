@@ -49,6 +49,26 @@ type TransactionCostResponse struct {
 	Cost uint64 `json:"txGasUnits"`
 }
 
+type TransactionSimulationResponse struct {
+	GeneralResponse
+	Result tr.SimulationResults `json:"result"`
+}
+
+type TransactionsPoolResponse struct {
+	GeneralResponse
+	Pool *tr.ApiTransactionsPool `json:"pool"`
+}
+
+type TransactionsPoolForSenderResponse struct {
+	GeneralResponse
+	Sender *tr.ApiTransactionsPoolForSender `json:"sender"`
+}
+
+type TransactionInPoolResponse struct {
+	GeneralResponse
+	Transaction *tr.ApiTransactionInPool `json:"transaction"`
+}
+
 func init() {
 	gin.SetMode(gin.TestMode)
 }
@@ -168,6 +188,111 @@ func TestGetTransaction_FailsWithWrongFacadeTypeConversion(t *testing.T) {
 	assert.Equal(t, transactionResponse.Error, errors2.ErrInvalidAppContext.Error())
 }
 
+func TestGetTransactionsPool(t *testing.T) {
+	pool := &tr.ApiTransactionsPool{
+		NumTxs: 2,
+		Caches: []*tr.ApiTransactionsPoolCache{{Name: "0", NumTxs: 2, NumSenders: 1}},
+	}
+	facade := mock.Facade{
+		GetTransactionsPoolCalled: func() (*tr.ApiTransactionsPool, error) {
+			return pool, nil
+		},
+		GetTransactionHandler: func(hash string) (*tr.ApiTransactionResult, error) {
+			assert.Fail(t, "should have not called GetTransaction")
+			return nil, nil
+		},
+	}
+
+	req, _ := http.NewRequest("GET", "/transaction/pool", nil)
+	ws := startNodeServer(&facade)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := TransactionsPoolResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, pool, response.Pool)
+}
+
+func TestGetTransactionsPool_FacadeErrorsShouldErr(t *testing.T) {
+	facade := mock.Facade{
+		GetTransactionsPoolCalled: func() (*tr.ApiTransactionsPool, error) {
+			return nil, errors.New("not supported")
+		},
+	}
+
+	req, _ := http.NewRequest("GET", "/transaction/pool", nil)
+	ws := startNodeServer(&facade)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := TransactionsPoolResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, response.Error, errors2.ErrGetTransactionsPool.Error())
+}
+
+func TestGetTransactionsPoolForSender(t *testing.T) {
+	senderTxs := &tr.ApiTransactionsPoolForSender{
+		Sender:       "alice",
+		NonceGaps:    []*tr.ApiNonceGap{{From: 2, To: 3}},
+		Transactions: []*tr.ApiTransactionInPool{{Hash: "aa", Nonce: 4}},
+	}
+	facade := mock.Facade{
+		GetTransactionsPoolForSenderCalled: func(address string) (*tr.ApiTransactionsPoolForSender, error) {
+			assert.Equal(t, "alice", address)
+			return senderTxs, nil
+		},
+	}
+
+	req, _ := http.NewRequest("GET", "/transaction/pool/by-sender/alice", nil)
+	ws := startNodeServer(&facade)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := TransactionsPoolForSenderResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, senderTxs, response.Sender)
+}
+
+func TestGetTransactionFromPool(t *testing.T) {
+	tx := &tr.ApiTransactionInPool{Hash: "aa", Cache: "1_0", Nonce: 4}
+	facade := mock.Facade{
+		GetTransactionFromPoolCalled: func(hash string) (*tr.ApiTransactionInPool, error) {
+			assert.Equal(t, "aa", hash)
+			return tx, nil
+		},
+	}
+
+	req, _ := http.NewRequest("GET", "/transaction/pool/aa", nil)
+	ws := startNodeServer(&facade)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := TransactionInPoolResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, tx, response.Transaction)
+}
+
+func TestGetTransactionFromPool_UnknownSubPathShouldNotBeFound(t *testing.T) {
+	facade := mock.Facade{}
+
+	ws := startNodeServer(&facade)
+	for _, path := range []string{"/transaction/hash/unknown", "/transaction/hash/by-sender/alice"} {
+		req, _ := http.NewRequest("GET", path, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+	}
+}
+
 func TestSendTransaction_ErrorWithWrongFacade(t *testing.T) {
 	t.Parallel()
 
@@ -429,6 +554,66 @@ func TestComputeTransactionGasLimit(t *testing.T) {
 	assert.Equal(t, expectedGasLimit, transactionCostResponse.Cost)
 }
 
+func TestSimulateTransaction_ErrorWhenSimulatingShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		CreateTransactionHandler: func(_ uint64, _ string, _ string, _ string, _ uint64, _ uint64, _ string, _ string) (*tr.Transaction, []byte, error) {
+			return &tr.Transaction{}, nil, nil
+		},
+		SimulateTransactionHandler: func(tx *tr.Transaction) (*tr.SimulationResults, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	jsonBytes, _ := json.Marshal(transaction.SendTxRequest{Sender: "sender1", Receiver: "receiver1", Value: "100"})
+	req, _ := http.NewRequest("POST", "/transaction/simulate", bytes.NewBuffer(jsonBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulationResponse := TransactionSimulationResponse{}
+	loadResponse(resp.Body, &simulationResponse)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, expectedErr.Error(), simulationResponse.Error)
+}
+
+func TestSimulateTransaction(t *testing.T) {
+	t.Parallel()
+
+	expectedResults := tr.SimulationResults{
+		Status:  "success",
+		Hash:    "aaaa",
+		GasUsed: 50000,
+		Fee:     "50000000000000",
+		AccountDeltas: []*tr.ApiAccountDelta{
+			{Address: "sender1", BalanceBefore: "150", BalanceAfter: "50", NonceBefore: 1, NonceAfter: 2},
+		},
+	}
+	facade := mock.Facade{
+		CreateTransactionHandler: func(_ uint64, _ string, _ string, _ string, _ uint64, _ uint64, _ string, _ string) (*tr.Transaction, []byte, error) {
+			return &tr.Transaction{}, nil, nil
+		},
+		SimulateTransactionHandler: func(tx *tr.Transaction) (*tr.SimulationResults, error) {
+			return &expectedResults, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	jsonBytes, _ := json.Marshal(transaction.SendTxRequest{Sender: "sender1", Receiver: "receiver1", Value: "100"})
+	req, _ := http.NewRequest("POST", "/transaction/simulate", bytes.NewBuffer(jsonBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulationResponse := TransactionSimulationResponse{}
+	loadResponse(resp.Body, &simulationResponse)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedResults, simulationResponse.Result)
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
@@ -475,8 +660,12 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/send", Open: true},
 					{Name: "/send-multiple", Open: true},
 					{Name: "/cost", Open: true},
+					{Name: "/simulate", Open: true},
 					{Name: "/:txhash", Open: true},
 					{Name: "/:txhash/status", Open: true},
+					{Name: "/pool", Open: true},
+					{Name: "/pool/by-sender/:address", Open: true},
+					{Name: "/pool/:txhash", Open: true},
 				},
 			},
 		},
@@ -3,10 +3,14 @@ package vmValues
 import (
 	"encoding/hex"
 	"fmt"
+	"math/big"
 	"net/http"
+	"sort"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/process"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/gin-gonic/gin"
@@ -16,6 +20,9 @@ import (
 type FacadeHandler interface {
 	ExecuteSCQuery(*process.SCQuery) (*vmcommon.VMOutput, error)
 	DecodeAddressPubkey(pk string) ([]byte, error)
+	EncodeAddressPubkey(pk []byte) (string, error)
+	GetStateRootHash(options state.QueryOptions) ([]byte, error)
+	GetQueryGasLimit() uint64
 	IsInterfaceNil() bool
 }
 
@@ -26,12 +33,50 @@ type VMValueRequest struct {
 	Args      []string `form:"args"  json:"args"`
 }
 
+// VMQueryRequest represents the structure of a typed smart contract query. The arguments are given either hex encoded
+// in Args or typed in TypedArgs, and the return data is decoded according to ReturnTypes, the items without a type
+// being returned hex encoded. Caller and Value optionally set the caller and the value seen by the smart contract
+type VMQueryRequest struct {
+	ScAddress   string       `json:"scAddress"`
+	FuncName    string       `json:"funcName"`
+	Caller      string       `json:"caller"`
+	Value       string       `json:"value"`
+	Args        []string     `json:"args"`
+	TypedArgs   []TypedValue `json:"typedArgs"`
+	ReturnTypes []string     `json:"returnTypes"`
+}
+
+// VMQueryResponse holds the decoded output of a smart contract query
+type VMQueryResponse struct {
+	ReturnData     []interface{}            `json:"returnData"`
+	ReturnCode     string                   `json:"returnCode"`
+	ReturnMessage  string                   `json:"returnMessage"`
+	GasRemaining   uint64                   `json:"gasRemaining"`
+	GasUsed        uint64                   `json:"gasUsed"`
+	OutputAccounts []*OutputAccountResponse `json:"outputAccounts"`
+}
+
+// OutputAccountResponse holds the changes of an account touched by a smart contract query
+type OutputAccountResponse struct {
+	Address        string                   `json:"address"`
+	Nonce          uint64                   `json:"nonce"`
+	BalanceDelta   string                   `json:"balanceDelta"`
+	StorageUpdates []*StorageUpdateResponse `json:"storageUpdates"`
+}
+
+// StorageUpdateResponse holds a hex encoded storage key of an account along with its new value
+type StorageUpdateResponse struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
 // Routes defines address related routes
 func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodPost, "/hex", getHex)
 	router.RegisterHandler(http.MethodPost, "/string", getString)
 	router.RegisterHandler(http.MethodPost, "/int", getInt)
 	router.RegisterHandler(http.MethodPost, "/query", executeQuery)
+	router.RegisterHandler(http.MethodPost, "/typed-query", executeTypedQuery)
 }
 
 // getHex returns the data as bytes, hex-encoded
@@ -77,6 +122,42 @@ func executeQuery(context *gin.Context) {
 	returnOkResponse(context, vmOutput)
 }
 
+// executeTypedQuery runs a typed query and returns its decoded output
+func executeTypedQuery(context *gin.Context) {
+	facade, ok := context.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		returnBadRequest(context, "executeTypedQuery", errors.ErrInvalidAppContext)
+		return
+	}
+
+	request := VMQueryRequest{}
+	err := context.ShouldBindJSON(&request)
+	if err != nil {
+		returnBadRequest(context, "executeTypedQuery", errors.ErrInvalidJSONRequest)
+		return
+	}
+
+	command, err := createTypedSCQuery(facade, &request)
+	if err != nil {
+		returnBadRequest(context, "executeTypedQuery", err)
+		return
+	}
+
+	vmOutput, err := runQuery(context, facade, command)
+	if err != nil {
+		returnBadRequest(context, "executeTypedQuery", err)
+		return
+	}
+
+	response, err := createVMQueryResponse(facade, command, vmOutput, request.ReturnTypes)
+	if err != nil {
+		returnBadRequest(context, "executeTypedQuery", err)
+		return
+	}
+
+	returnOkResponse(context, response)
+}
+
 func doExecuteQuery(context *gin.Context) (*vmcommon.VMOutput, error) {
 	facade, ok := context.MustGet("elrondFacade").(FacadeHandler)
 	if !ok {
@@ -89,20 +170,32 @@ func doExecuteQuery(context *gin.Context) (*vmcommon.VMOutput, error) {
 		return nil, errors.ErrInvalidJSONRequest
 	}
 
-	command, err := createSCQuery(facade, &request)
+	command, err := CreateSCQuery(facade, &request)
 	if err != nil {
 		return nil, err
 	}
 
-	vmOutput, err := facade.ExecuteSCQuery(command)
+	return runQuery(context, facade, command)
+}
+
+// runQuery executes the query against the state selected by the optional blockNonce or rootHash query parameters
+func runQuery(context *gin.Context, facade FacadeHandler, command *process.SCQuery) (*vmcommon.VMOutput, error) {
+	options, err := shared.GetStateQueryOptions(context)
 	if err != nil {
 		return nil, err
 	}
+	if !options.IsCurrentState() {
+		command.RootHash, err = facade.GetStateRootHash(options)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	return vmOutput, nil
+	return facade.ExecuteSCQuery(command)
 }
 
-func createSCQuery(fh FacadeHandler, request *VMValueRequest) (*process.SCQuery, error) {
+// CreateSCQuery decodes the address and the hex encoded arguments of the request into a smart contract query
+func CreateSCQuery(fh FacadeHandler, request *VMValueRequest) (*process.SCQuery, error) {
 	decodedAddress, err := fh.DecodeAddressPubkey(request.ScAddress)
 	if err != nil {
 		return nil, fmt.Errorf("'%s' is not a valid address: %s", request.ScAddress, err.Error())
@@ -126,6 +219,129 @@ func createSCQuery(fh FacadeHandler, request *VMValueRequest) (*process.SCQuery,
 	}, nil
 }
 
+func createTypedSCQuery(fh FacadeHandler, request *VMQueryRequest) (*process.SCQuery, error) {
+	if len(request.Args) > 0 && len(request.TypedArgs) > 0 {
+		return nil, fmt.Errorf("%w: only one of args and typedArgs can be provided", errors.ErrInvalidQueryParameter)
+	}
+
+	command, err := CreateSCQuery(fh, &VMValueRequest{
+		ScAddress: request.ScAddress,
+		FuncName:  request.FuncName,
+		Args:      request.Args,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, typedArg := range request.TypedArgs {
+		arg, errEncode := encodeTypedValue(fh, typedArg)
+		if errEncode != nil {
+			return nil, fmt.Errorf("argument %d is not a valid %s: %s", i, typedArg.Type, errEncode.Error())
+		}
+
+		command.Arguments = append(command.Arguments, arg)
+	}
+
+	if len(request.Caller) > 0 {
+		command.CallerAddr, err = fh.DecodeAddressPubkey(request.Caller)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid caller address: %s", request.Caller, err.Error())
+		}
+	}
+	if len(request.Value) > 0 {
+		value, ok := big.NewInt(0).SetString(request.Value, 10)
+		if !ok || value.Sign() < 0 {
+			return nil, fmt.Errorf("'%s' is not a valid value", request.Value)
+		}
+		command.CallValue = value
+	}
+
+	// the gas limit is set explicitly, so that the gas used can be computed from the output
+	command.GasLimit = fh.GetQueryGasLimit()
+	// a failed call is reported through the return code and message of the response
+	command.KeepFailedOutput = true
+
+	return command, nil
+}
+
+func createVMQueryResponse(
+	fh FacadeHandler,
+	command *process.SCQuery,
+	vmOutput *vmcommon.VMOutput,
+	returnTypes []string,
+) (*VMQueryResponse, error) {
+	returnData := make([]interface{}, len(vmOutput.ReturnData))
+	for i, data := range vmOutput.ReturnData {
+		returnType := TypeBytes
+		if i < len(returnTypes) {
+			returnType = returnTypes[i]
+		}
+
+		value, err := decodeTypedValue(fh, returnType, data)
+		if err != nil {
+			return nil, fmt.Errorf("return data %d is not a valid %s: %s", i, returnType, err.Error())
+		}
+		returnData[i] = value
+	}
+
+	outputAccounts := make([]*OutputAccountResponse, 0, len(vmOutput.OutputAccounts))
+	for _, outputAccount := range vmOutput.OutputAccounts {
+		accountResponse, err := createOutputAccountResponse(fh, outputAccount)
+		if err != nil {
+			return nil, err
+		}
+
+		outputAccounts = append(outputAccounts, accountResponse)
+	}
+	sort.Slice(outputAccounts, func(i, j int) bool {
+		return outputAccounts[i].Address < outputAccounts[j].Address
+	})
+
+	gasUsed := uint64(0)
+	if command.GasLimit > vmOutput.GasRemaining {
+		gasUsed = command.GasLimit - vmOutput.GasRemaining
+	}
+
+	return &VMQueryResponse{
+		ReturnData:     returnData,
+		ReturnCode:     vmOutput.ReturnCode.String(),
+		ReturnMessage:  vmOutput.ReturnMessage,
+		GasRemaining:   vmOutput.GasRemaining,
+		GasUsed:        gasUsed,
+		OutputAccounts: outputAccounts,
+	}, nil
+}
+
+func createOutputAccountResponse(fh FacadeHandler, outputAccount *vmcommon.OutputAccount) (*OutputAccountResponse, error) {
+	address, err := fh.EncodeAddressPubkey(outputAccount.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	balanceDelta := big.NewInt(0)
+	if outputAccount.BalanceDelta != nil {
+		balanceDelta = outputAccount.BalanceDelta
+	}
+
+	storageUpdates := make([]*StorageUpdateResponse, 0, len(outputAccount.StorageUpdates))
+	for _, storageUpdate := range outputAccount.StorageUpdates {
+		storageUpdates = append(storageUpdates, &StorageUpdateResponse{
+			Key:   hex.EncodeToString(storageUpdate.Offset),
+			Value: hex.EncodeToString(storageUpdate.Data),
+		})
+	}
+	sort.Slice(storageUpdates, func(i, j int) bool {
+		return storageUpdates[i].Key < storageUpdates[j].Key
+	})
+
+	return &OutputAccountResponse{
+		Address:        address,
+		Nonce:          outputAccount.Nonce,
+		BalanceDelta:   balanceDelta.String(),
+		StorageUpdates: storageUpdates,
+	}, nil
+}
+
 func returnBadRequest(context *gin.Context, errScope string, err error) {
 	message := fmt.Sprintf("%s: %s", errScope, err)
 	context.JSON(http.StatusBadRequest, gin.H{"error": message})
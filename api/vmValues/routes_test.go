@@ -16,6 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/process"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/gin-contrib/cors"
@@ -33,6 +34,11 @@ type vmOutputResponse struct {
 	Error string             `json:"error"`
 }
 
+type vmQueryResponse struct {
+	Data  *VMQueryResponse `json:"data"`
+	Error string           `json:"error"`
+}
+
 func init() {
 	gin.SetMode(gin.TestMode)
 }
@@ -148,6 +154,185 @@ func TestQuery_ShouldWork(t *testing.T) {
 	require.Equal(t, int64(42), big.NewInt(0).SetBytes(response.Data.ReturnData[0]).Int64())
 }
 
+func TestQuery_TypedShouldWork(t *testing.T) {
+	t.Parallel()
+
+	callerAddress := "0000000000000000000000000000000000000000000000000000000000000001"
+	outputAddress := "0000000000000000000000000000000000000000000000000000000000000002"
+	outputAddressBytes, _ := hex.DecodeString(outputAddress)
+	facade := mock.Facade{
+		GetQueryGasLimitCalled: func() uint64 {
+			return 1000
+		},
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vmcommon.VMOutput, e error) {
+			require.Equal(t, callerAddress, hex.EncodeToString(query.CallerAddr))
+			require.Equal(t, big.NewInt(1000), query.CallValue)
+			require.Equal(t, [][]byte{{0xff, 0x7f}, {0x2a}, {0x01}, []byte("abc")}, query.Arguments)
+			require.Equal(t, uint64(1000), query.GasLimit)
+
+			return &vmcommon.VMOutput{
+				ReturnData:    [][]byte{{0xff}, {0xff}, {0x01}, []byte("abc"), {0x01, 0x02}},
+				ReturnCode:    vmcommon.Ok,
+				ReturnMessage: "message",
+				GasRemaining:  400,
+				OutputAccounts: map[string]*vmcommon.OutputAccount{
+					string(outputAddressBytes): {
+						Address:      outputAddressBytes,
+						BalanceDelta: big.NewInt(-5),
+						StorageUpdates: map[string]*vmcommon.StorageUpdate{
+							"key": {Offset: []byte("key"), Data: []byte("value")},
+						},
+					},
+				},
+			}, nil
+		},
+	}
+
+	request := VMQueryRequest{
+		ScAddress: DummyScAddress,
+		FuncName:  "function",
+		Caller:    callerAddress,
+		Value:     "1000",
+		TypedArgs: []TypedValue{
+			{Type: TypeBigInt, Value: json.RawMessage(`"-129"`)},
+			{Type: TypeU64, Value: json.RawMessage(`42`)},
+			{Type: TypeBool, Value: json.RawMessage(`true`)},
+			{Type: TypeString, Value: json.RawMessage(`"abc"`)},
+		},
+		ReturnTypes: []string{TypeBigInt, TypeBigUint, TypeBool, TypeString},
+	}
+
+	response := vmQueryResponse{}
+	statusCode := doPost(&facade, "/vm-values/typed-query", request, &response)
+
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, "", response.Error)
+	require.Equal(t, []interface{}{"-1", "255", true, "abc", "0102"}, response.Data.ReturnData)
+	require.Equal(t, "message", response.Data.ReturnMessage)
+	require.Equal(t, uint64(400), response.Data.GasRemaining)
+	require.Equal(t, uint64(600), response.Data.GasUsed)
+	require.Len(t, response.Data.OutputAccounts, 1)
+	require.Equal(t, outputAddress, response.Data.OutputAccounts[0].Address)
+	require.Equal(t, "-5", response.Data.OutputAccounts[0].BalanceDelta)
+	require.Equal(t, []*StorageUpdateResponse{{Key: hex.EncodeToString([]byte("key")), Value: hex.EncodeToString([]byte("value"))}},
+		response.Data.OutputAccounts[0].StorageUpdates)
+}
+
+func TestQuery_TypedFailedCallShouldReturnCodeAndMessage(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetQueryGasLimitCalled: func() uint64 {
+			return 1000
+		},
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vmcommon.VMOutput, e error) {
+			if !query.KeepFailedOutput {
+				return nil, errors.New("error running vm func")
+			}
+
+			return &vmcommon.VMOutput{
+				ReturnCode:    vmcommon.UserError,
+				ReturnMessage: "not allowed",
+				GasRemaining:  900,
+			}, nil
+		},
+	}
+
+	request := VMQueryRequest{
+		ScAddress: DummyScAddress,
+		FuncName:  "function",
+	}
+
+	response := vmQueryResponse{}
+	statusCode := doPost(&facade, "/vm-values/typed-query", request, &response)
+
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, "", response.Error)
+	require.Equal(t, vmcommon.UserError.String(), response.Data.ReturnCode)
+	require.Equal(t, "not allowed", response.Data.ReturnMessage)
+	require.Empty(t, response.Data.ReturnData)
+	require.Equal(t, uint64(100), response.Data.GasUsed)
+}
+
+func TestQuery_InvalidTypedQueryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vmcommon.VMOutput, e error) {
+			return &vmcommon.VMOutput{
+				ReturnData: [][]byte{{0x01, 0x02}},
+			}, nil
+		},
+	}
+
+	requests := map[string]VMQueryRequest{
+		"only one of args and typedArgs": {
+			ScAddress: DummyScAddress,
+			FuncName:  "function",
+			Args:      []string{"aa"},
+			TypedArgs: []TypedValue{{Type: TypeU64, Value: json.RawMessage(`1`)}},
+		},
+		"argument 0 is not a valid u64": {
+			ScAddress: DummyScAddress,
+			FuncName:  "function",
+			TypedArgs: []TypedValue{{Type: TypeU64, Value: json.RawMessage(`-1`)}},
+		},
+		"unknown type": {
+			ScAddress: DummyScAddress,
+			FuncName:  "function",
+			TypedArgs: []TypedValue{{Type: "float", Value: json.RawMessage(`1`)}},
+		},
+		"not a valid value": {
+			ScAddress: DummyScAddress,
+			FuncName:  "function",
+			Value:     "-1",
+		},
+		"return data 0 is not a valid bool": {
+			ScAddress:   DummyScAddress,
+			FuncName:    "function",
+			ReturnTypes: []string{TypeBool},
+		},
+	}
+
+	for expectedError, request := range requests {
+		response := vmQueryResponse{}
+		statusCode := doPost(&facade, "/vm-values/typed-query", request, &response)
+
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, expectedError)
+	}
+}
+
+func TestQuery_WithBlockNonceShouldSetRootHash(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	var receivedQuery *process.SCQuery
+	facade := mock.Facade{
+		GetStateRootHashCalled: func(options state.QueryOptions) ([]byte, error) {
+			require.NotNil(t, options.BlockNonce)
+			require.Equal(t, uint64(7), *options.BlockNonce)
+			return rootHash, nil
+		},
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vmcommon.VMOutput, e error) {
+			receivedQuery = query
+			return &vmcommon.VMOutput{}, nil
+		},
+	}
+
+	request := VMValueRequest{
+		ScAddress: DummyScAddress,
+		FuncName:  "function",
+		Args:      []string{},
+	}
+
+	response := simpleResponse{}
+	statusCode := doPost(&facade, "/vm-values/query?blockNonce=7", request, &response)
+
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, rootHash, receivedQuery.RootHash)
+}
+
 func TestCreateSCQuery_ArgumentIsNotHexShouldErr(t *testing.T) {
 	request := VMValueRequest{
 		ScAddress: DummyScAddress,
@@ -155,7 +340,7 @@ func TestCreateSCQuery_ArgumentIsNotHexShouldErr(t *testing.T) {
 		Args:      []string{"bad arg"},
 	}
 
-	_, err := createSCQuery(&mock.Facade{}, &request)
+	_, err := CreateSCQuery(&mock.Facade{}, &request)
 	require.NotNil(t, err)
 	require.Contains(t, err.Error(), "'bad arg' is not a valid hex string")
 }
@@ -336,6 +521,7 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/string", Open: true},
 					{Name: "/int", Open: true},
 					{Name: "/query", Open: true},
+					{Name: "/typed-query", Open: true},
 				},
 			},
 		},
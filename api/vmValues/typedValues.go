@@ -0,0 +1,165 @@
+package vmValues
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+)
+
+// The types of the smart contract arguments and return data, encoded as the smart contracts expect them
+const (
+	// TypeAddress is an address, given and returned in the format used by the node's API (bech32)
+	TypeAddress = "address"
+	// TypeBigInt is a signed big integer, given and returned as a decimal string
+	TypeBigInt = "bigInt"
+	// TypeBigUint is an unsigned big integer, given and returned as a decimal string
+	TypeBigUint = "bigUint"
+	// TypeU64 is an unsigned 64 bits integer
+	TypeU64 = "u64"
+	// TypeBool is a boolean
+	TypeBool = "bool"
+	// TypeBytes is a byte array, given and returned hex encoded
+	TypeBytes = "bytes"
+	// TypeString is an UTF-8 string
+	TypeString = "string"
+)
+
+var errUnknownType = errors.New("unknown type")
+
+// AddressConverter converts the addresses between their API format and their bytes
+type AddressConverter interface {
+	DecodeAddressPubkey(pk string) ([]byte, error)
+	EncodeAddressPubkey(pk []byte) (string, error)
+}
+
+// TypedValue is a smart contract argument along with its type. The value can be given either as a JSON string or as
+// a JSON literal (e.g. 42 for u64, true for bool)
+type TypedValue struct {
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+func (tv *TypedValue) valueAsString() (string, error) {
+	if len(tv.Value) > 0 && tv.Value[0] == '"' {
+		var value string
+		err := json.Unmarshal(tv.Value, &value)
+		return value, err
+	}
+
+	return string(tv.Value), nil
+}
+
+// encodeTypedValue encodes a typed argument in the format expected by the smart contracts
+func encodeTypedValue(converter AddressConverter, typedValue TypedValue) ([]byte, error) {
+	value, err := typedValue.valueAsString()
+	if err != nil {
+		return nil, err
+	}
+
+	switch typedValue.Type {
+	case TypeAddress:
+		return converter.DecodeAddressPubkey(value)
+	case TypeBigInt:
+		number, ok := big.NewInt(0).SetString(value, 10)
+		if !ok {
+			return nil, fmt.Errorf("'%s' is not a valid %s", value, TypeBigInt)
+		}
+		return bigIntToTwosComplement(number), nil
+	case TypeBigUint:
+		number, ok := big.NewInt(0).SetString(value, 10)
+		if !ok || number.Sign() < 0 {
+			return nil, fmt.Errorf("'%s' is not a valid %s", value, TypeBigUint)
+		}
+		return number.Bytes(), nil
+	case TypeU64:
+		number, errParse := strconv.ParseUint(value, 10, 64)
+		if errParse != nil {
+			return nil, fmt.Errorf("'%s' is not a valid %s", value, TypeU64)
+		}
+		return big.NewInt(0).SetUint64(number).Bytes(), nil
+	case TypeBool:
+		flag, errParse := strconv.ParseBool(value)
+		if errParse != nil {
+			return nil, fmt.Errorf("'%s' is not a valid %s", value, TypeBool)
+		}
+		if flag {
+			return []byte{1}, nil
+		}
+		return make([]byte, 0), nil
+	case TypeBytes:
+		return hex.DecodeString(value)
+	case TypeString:
+		return []byte(value), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownType, typedValue.Type)
+	}
+}
+
+// decodeTypedValue decodes an item of the return data according to the given type
+func decodeTypedValue(converter AddressConverter, valueType string, data []byte) (interface{}, error) {
+	switch valueType {
+	case TypeAddress:
+		return converter.EncodeAddressPubkey(data)
+	case TypeBigInt:
+		return twosComplementToBigInt(data).String(), nil
+	case TypeBigUint:
+		return big.NewInt(0).SetBytes(data).String(), nil
+	case TypeU64:
+		if len(data) > 8 {
+			return nil, fmt.Errorf("%d bytes are too many for a %s", len(data), TypeU64)
+		}
+		return big.NewInt(0).SetBytes(data).Uint64(), nil
+	case TypeBool:
+		if len(data) == 0 {
+			return false, nil
+		}
+		if len(data) == 1 && data[0] == 1 {
+			return true, nil
+		}
+		return nil, fmt.Errorf("'%s' is not a valid %s", hex.EncodeToString(data), TypeBool)
+	case TypeBytes:
+		return hex.EncodeToString(data), nil
+	case TypeString:
+		return string(data), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownType, valueType)
+	}
+}
+
+// bigIntToTwosComplement returns the shortest two's complement big endian representation of the number, zero
+// being represented by no bytes at all
+func bigIntToTwosComplement(number *big.Int) []byte {
+	if number.Sign() == 0 {
+		return make([]byte, 0)
+	}
+
+	magnitude := big.NewInt(0).Set(number)
+	if number.Sign() < 0 {
+		// a negative number fits in n bytes if its absolute value minus one fits in 8*n-1 bits
+		magnitude.Abs(magnitude).Sub(magnitude, big.NewInt(1))
+	}
+	numBytes := magnitude.BitLen()/8 + 1
+
+	result := big.NewInt(0).Set(number)
+	if number.Sign() < 0 {
+		result.Add(result, big.NewInt(0).Lsh(big.NewInt(1), uint(8*numBytes)))
+	}
+
+	buff := make([]byte, numBytes)
+	resultBytes := result.Bytes()
+	copy(buff[numBytes-len(resultBytes):], resultBytes)
+
+	return buff
+}
+
+func twosComplementToBigInt(data []byte) *big.Int {
+	number := big.NewInt(0).SetBytes(data)
+	if len(data) > 0 && data[0]&0x80 != 0 {
+		number.Sub(number, big.NewInt(0).Lsh(big.NewInt(1), uint(8*len(data))))
+	}
+
+	return number
+}
@@ -0,0 +1,102 @@
+package vmValues
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBigIntToTwosComplement(t *testing.T) {
+	t.Parallel()
+
+	expected := map[int64]string{
+		0:    "",
+		1:    "01",
+		127:  "7f",
+		128:  "0080",
+		255:  "00ff",
+		256:  "0100",
+		-1:   "ff",
+		-128: "80",
+		-129: "ff7f",
+		-256: "ff00",
+		-257: "feff",
+	}
+
+	for number, encoded := range expected {
+		buff := bigIntToTwosComplement(big.NewInt(number))
+		require.Equal(t, encoded, hex.EncodeToString(buff), number)
+		require.Equal(t, number, twosComplementToBigInt(buff).Int64(), number)
+	}
+}
+
+func TestEncodeTypedValue_ShouldRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{}
+	values := []struct {
+		valueType string
+		value     string
+		decoded   interface{}
+	}{
+		{TypeAddress, `"00000000000000000500fabd9501b7e5353de57a4e319857c2fb99089770720a"`, "00000000000000000500fabd9501b7e5353de57a4e319857c2fb99089770720a"},
+		{TypeBigInt, `"-1000000000000000000000"`, "-1000000000000000000000"},
+		{TypeBigUint, `"1000000000000000000000"`, "1000000000000000000000"},
+		{TypeU64, `18446744073709551615`, uint64(18446744073709551615)},
+		{TypeU64, `"0"`, uint64(0)},
+		{TypeBool, `false`, false},
+		{TypeBool, `"true"`, true},
+		{TypeBytes, `"deadbeef"`, "deadbeef"},
+		{TypeString, `"hello"`, "hello"},
+	}
+
+	for _, v := range values {
+		buff, err := encodeTypedValue(facade, TypedValue{Type: v.valueType, Value: json.RawMessage(v.value)})
+		require.Nil(t, err, v.value)
+
+		decoded, err := decodeTypedValue(facade, v.valueType, buff)
+		require.Nil(t, err, v.value)
+		require.Equal(t, v.decoded, decoded, v.value)
+	}
+}
+
+func TestEncodeTypedValue_InvalidValuesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{}
+	values := map[string]string{
+		TypeBigInt:  `"1.5"`,
+		TypeBigUint: `"-1"`,
+		TypeU64:     `18446744073709551616`,
+		TypeBool:    `"yes"`,
+		TypeBytes:   `"zz"`,
+	}
+
+	for valueType, value := range values {
+		_, err := encodeTypedValue(facade, TypedValue{Type: valueType, Value: json.RawMessage(value)})
+		require.NotNil(t, err, valueType)
+	}
+
+	_, err := encodeTypedValue(facade, TypedValue{Type: "float", Value: json.RawMessage(`1`)})
+	require.True(t, errors.Is(err, errUnknownType))
+}
+
+func TestDecodeTypedValue_InvalidDataShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{}
+
+	_, err := decodeTypedValue(facade, TypeU64, make([]byte, 9))
+	require.NotNil(t, err)
+
+	_, err = decodeTypedValue(facade, TypeBool, []byte{2})
+	require.NotNil(t, err)
+
+	_, err = decodeTypedValue(facade, "float", []byte{1})
+	require.True(t, errors.Is(err, errUnknownType))
+}
@@ -2,8 +2,10 @@ package wrapper
 
 import (
 	"errors"
+	"net/http"
 	"sync"
 
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/gin-gonic/gin"
 )
@@ -15,6 +17,14 @@ type RouterWrapper struct {
 	mutRoutesConfig sync.RWMutex
 }
 
+// SharedPathEndpoint is an endpoint served on a router path shared with other endpoints. Matches tells if a request
+// on the shared path is meant for this endpoint
+type SharedPathEndpoint struct {
+	Name    string
+	Matches func(c *gin.Context) bool
+	Handler gin.HandlerFunc
+}
+
 // NewRouterWrapper will return a new instance of RouterWrapper
 func NewRouterWrapper(packageName string, router *gin.RouterGroup, routesConfig config.ApiRoutesConfig) (*RouterWrapper, error) {
 	if router == nil {
@@ -32,22 +42,66 @@ func NewRouterWrapper(packageName string, router *gin.RouterGroup, routesConfig
 	}, nil
 }
 
-// RegisterHandler will register the handler for the given method and path
+// RegisterHandler will register the handler for the given method and path. When the route is restricted to some
+// permission groups, the handler is preceded by the check of the caller's groups
 func (rw *RouterWrapper) RegisterHandler(method string, path string, handlers ...gin.HandlerFunc) {
-	if rw.isEndpointActive(path) {
-		rw.router.Handle(method, path, handlers...)
+	endpoint, isActive := rw.getActiveEndpoint(path)
+	if !isActive {
+		return
 	}
+
+	if len(endpoint.Groups) > 0 {
+		handlers = append([]gin.HandlerFunc{middleware.WithPermissionGroups(endpoint.Groups)}, handlers...)
+	}
+
+	rw.router.Handle(method, path, handlers...)
 }
 
-func (rw *RouterWrapper) isEndpointActive(endpointToCheck string) bool {
+// RegisterSharedPathHandler will register, on the given path, the endpoints which can not be told apart by the router,
+// such as a static path segment next to a wildcard one. A request is served by the first active endpoint matching it
+func (rw *RouterWrapper) RegisterSharedPathHandler(method string, path string, endpoints ...SharedPathEndpoint) {
+	activeEndpoints := make([]SharedPathEndpoint, 0, len(endpoints))
+	activeEndpointsGroups := make([][]string, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		endpointConfig, isActive := rw.getActiveEndpoint(endpoint.Name)
+		if isActive {
+			activeEndpoints = append(activeEndpoints, endpoint)
+			activeEndpointsGroups = append(activeEndpointsGroups, endpointConfig.Groups)
+		}
+	}
+
+	if len(activeEndpoints) == 0 {
+		return
+	}
+
+	rw.router.Handle(method, path, func(c *gin.Context) {
+		for i, endpoint := range activeEndpoints {
+			if !endpoint.Matches(c) {
+				continue
+			}
+
+			if !middleware.CheckPermissionGroups(c, activeEndpointsGroups[i]) {
+				return
+			}
+
+			endpoint.Handler(c)
+			return
+		}
+
+		c.AbortWithStatus(http.StatusNotFound)
+	})
+}
+
+func (rw *RouterWrapper) getActiveEndpoint(endpointToCheck string) (config.RouteConfig, bool) {
 	rw.mutRoutesConfig.RLock()
 	routesConfig := rw.routesConfig
 	rw.mutRoutesConfig.RUnlock()
+
 	for _, endpoint := range routesConfig.Routes {
 		if endpoint.Name == endpointToCheck && endpoint.Open {
-			return true
+			return endpoint, true
 		}
 	}
 
-	return false
+	return config.RouteConfig{}, false
 }
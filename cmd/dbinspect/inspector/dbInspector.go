@@ -0,0 +1,289 @@
+package inspector
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+)
+
+var log = logger.GetOrCreate("dbinspect/inspector")
+
+const (
+	// TxTypeNormal selects the transactions storage
+	TxTypeNormal = "normal"
+	// TxTypeUnsigned selects the smart contract results storage
+	TxTypeUnsigned = "unsigned"
+	// TxTypeReward selects the reward transactions storage
+	TxTypeReward = "reward"
+)
+
+// ArgsDBInspector holds the arguments needed for creating a dbInspector
+type ArgsDBInspector struct {
+	GeneralConfig             config.Config
+	Marshalizer               marshal.Marshalizer
+	Hasher                    hashing.Hasher
+	LatestStorageDataProvider storage.LatestStorageDataProviderHandler
+	PathManager               storage.PathManagerHandler
+	DefaultEpochString        string
+}
+
+// EpochInfo holds the shards found in the directory of an epoch
+type EpochInfo struct {
+	Epoch  uint32
+	Shards []string
+}
+
+type dbInspector struct {
+	generalConfig             config.Config
+	marshalizer               marshal.Marshalizer
+	hasher                    hashing.Hasher
+	latestStorageDataProvider storage.LatestStorageDataProviderHandler
+	pathManager               storage.PathManagerHandler
+	defaultEpochString        string
+}
+
+// NewDBInspector creates a component able to read the databases of a stopped node
+func NewDBInspector(args ArgsDBInspector) (*dbInspector, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.LatestStorageDataProvider) {
+		return nil, ErrNilLatestDataProvider
+	}
+	if check.IfNil(args.PathManager) {
+		return nil, ErrNilPathManager
+	}
+
+	return &dbInspector{
+		generalConfig:             args.GeneralConfig,
+		marshalizer:               args.Marshalizer,
+		hasher:                    args.Hasher,
+		latestStorageDataProvider: args.LatestStorageDataProvider,
+		pathManager:               args.PathManager,
+		defaultEpochString:        args.DefaultEpochString,
+	}, nil
+}
+
+// GetLatestData returns the epoch, shard and round of the most recent bootstrap data found in storage
+func (di *dbInspector) GetLatestData() (storage.LatestDataFromStorage, error) {
+	return di.latestStorageDataProvider.Get()
+}
+
+// ListEpochs returns the epochs found in storage, newest first, together with the shards of each epoch
+func (di *dbInspector) ListEpochs() ([]*EpochInfo, error) {
+	parentDir, _, err := di.latestStorageDataProvider.GetParentDirAndLastEpoch()
+	if err != nil {
+		return nil, err
+	}
+
+	directoriesNames, err := storageFactory.NewDirectoryReader().ListDirectoriesAsString(parentDir)
+	if err != nil {
+		return nil, err
+	}
+
+	epochs := make([]*EpochInfo, 0, len(directoriesNames))
+	for _, dirName := range directoriesNames {
+		epoch, ok := di.parseEpochDirName(dirName)
+		if !ok {
+			continue
+		}
+
+		shards, errShards := di.latestStorageDataProvider.GetShardsFromDirectory(filepath.Join(parentDir, dirName))
+		if errShards != nil {
+			return nil, errShards
+		}
+		sort.Strings(shards)
+
+		epochs = append(epochs, &EpochInfo{
+			Epoch:  epoch,
+			Shards: shards,
+		})
+	}
+
+	sort.Slice(epochs, func(i, j int) bool {
+		return epochs[i].Epoch > epochs[j].Epoch
+	})
+
+	return epochs, nil
+}
+
+// GetHeaderByNonce returns the hash and the header of the self shard block with the given nonce. The epochs are
+// searched starting with the newest one
+func (di *dbInspector) GetHeaderByNonce(shardID string, nonce uint64) ([]byte, data.HeaderHandler, error) {
+	nonceHashConfig := di.generalConfig.ShardHdrNonceHashStorage
+	nonceHashIdentifierSuffix := shardID
+	headerConfig := di.generalConfig.BlockHeaderStorage
+	var header data.HeaderHandler = &block.Header{}
+	if shardID == core.GetShardIdString(core.MetachainShardId) {
+		nonceHashConfig = di.generalConfig.MetaHdrNonceHashStorage
+		nonceHashIdentifierSuffix = ""
+		headerConfig = di.generalConfig.MetaBlockStorage
+		header = &block.MetaBlock{}
+	}
+
+	nonceBytes := uint64ByteSlice.NewBigEndianConverter().ToByteSlice(nonce)
+	headerHash, err := di.searchEpochs(shardID, nonceHashConfig.DB, nonceHashIdentifierSuffix, nonceBytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: header with nonce %d", err, nonce)
+	}
+
+	err = di.getAndUnmarshal(shardID, headerConfig.DB, headerHash, header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return headerHash, header, nil
+}
+
+// GetMiniBlock returns the miniblock with the given hash
+func (di *dbInspector) GetMiniBlock(shardID string, hash []byte) (*block.MiniBlock, error) {
+	miniBlock := &block.MiniBlock{}
+	err := di.getAndUnmarshal(shardID, di.generalConfig.MiniBlocksStorage.DB, hash, miniBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	return miniBlock, nil
+}
+
+// GetTransaction returns the transaction of the given type with the given hash
+func (di *dbInspector) GetTransaction(shardID string, hash []byte, txType string) (data.TransactionHandler, error) {
+	var tx data.TransactionHandler
+	var dbConfig config.DBConfig
+	switch txType {
+	case TxTypeNormal:
+		tx = &transaction.Transaction{}
+		dbConfig = di.generalConfig.TxStorage.DB
+	case TxTypeUnsigned:
+		tx = &smartContractResult.SmartContractResult{}
+		dbConfig = di.generalConfig.UnsignedTransactionStorage.DB
+	case TxTypeReward:
+		tx = &rewardTx.RewardTx{}
+		dbConfig = di.generalConfig.RewardTxStorage.DB
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTransactionType, txType)
+	}
+
+	err := di.getAndUnmarshal(shardID, dbConfig, hash, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// WalkAccountsTrie walks the user accounts trie or, if peerAccounts is set, the validators trie, starting from the
+// given root hash. Each leaf is passed to the leaf handler and the returned report holds the missing nodes and
+// the nodes whose content does not match their hash
+func (di *dbInspector) WalkAccountsTrie(
+	shardID string,
+	rootHash []byte,
+	peerAccounts bool,
+	leafHandler func(key []byte, value []byte),
+) (*trie.IntegrityReport, error) {
+	storageConfig := di.generalConfig.AccountsTrieStorage
+	if peerAccounts {
+		storageConfig = di.generalConfig.PeerAccountsTrieStorage
+	}
+
+	persister, err := di.openPersister(storageConfig.DB, di.pathManager.PathForStatic(shardID, storageConfig.DB.FilePath))
+	if err != nil {
+		return nil, err
+	}
+	defer di.closePersister(persister)
+
+	return trie.WalkTrie(rootHash, persister, di.marshalizer, di.hasher, leafHandler)
+}
+
+func (di *dbInspector) getAndUnmarshal(shardID string, dbConfig config.DBConfig, key []byte, obj interface{}) error {
+	buff, err := di.searchEpochs(shardID, dbConfig, "", key)
+	if err != nil {
+		return fmt.Errorf("%w: %x in %s", err, key, dbConfig.FilePath)
+	}
+
+	return di.marshalizer.Unmarshal(obj, buff)
+}
+
+// searchEpochs looks for the key in the databases of the given pruning storage, from the newest epoch to the oldest
+func (di *dbInspector) searchEpochs(shardID string, dbConfig config.DBConfig, identifierSuffix string, key []byte) ([]byte, error) {
+	epochs, err := di.ListEpochs()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, epochInfo := range epochs {
+		path := di.pathManager.PathForEpoch(shardID, epochInfo.Epoch, dbConfig.FilePath) + identifierSuffix
+		value, errGet := di.getFromPersister(dbConfig, path, key)
+		if errGet == nil {
+			return value, nil
+		}
+
+		log.Trace("key not found", "path", path, "error", errGet)
+	}
+
+	return nil, ErrKeyNotFound
+}
+
+func (di *dbInspector) getFromPersister(dbConfig config.DBConfig, path string, key []byte) ([]byte, error) {
+	persister, err := di.openPersister(dbConfig, path)
+	if err != nil {
+		return nil, err
+	}
+	defer di.closePersister(persister)
+
+	return persister.Get(key)
+}
+
+func (di *dbInspector) openPersister(dbConfig config.DBConfig, path string) (storage.Persister, error) {
+	_, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrDatabaseNotFound, path)
+	}
+
+	return storageFactory.NewPersisterFactory(dbConfig).Create(path)
+}
+
+func (di *dbInspector) closePersister(persister storage.Persister) {
+	err := persister.Close()
+	if err != nil {
+		log.Warn("cannot close persister", "error", err)
+	}
+}
+
+func (di *dbInspector) parseEpochDirName(dirName string) (uint32, bool) {
+	prefix := di.defaultEpochString + "_"
+	if !strings.HasPrefix(dirName, prefix) {
+		return 0, false
+	}
+
+	epoch := uint32(0)
+	_, err := fmt.Sscanf(strings.TrimPrefix(dirName, prefix), "%d", &epoch)
+
+	return epoch, err == nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (di *dbInspector) IsInterfaceNil() bool {
+	return di == nil
+}
@@ -0,0 +1,148 @@
+package inspector_test
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/dbinspect/inspector"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/pathmanager"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const chainID = "test-chain"
+
+func createDBConfig(filePath string) config.DBConfig {
+	return config.DBConfig{
+		FilePath:          filePath,
+		Type:              string(storageUnit.LvlDBSerial),
+		BatchDelaySeconds: 1,
+		MaxBatchSize:      1,
+		MaxOpenFiles:      10,
+	}
+}
+
+func createArgs(t *testing.T, workingDir string) inspector.ArgsDBInspector {
+	generalConfig := config.Config{}
+	generalConfig.MiniBlocksStorage.DB = createDBConfig("MiniBlocks")
+	generalConfig.BootstrapStorage.DB = createDBConfig("BootstrapData")
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	bootstrapDataProvider, err := factory.NewBootstrapDataProvider(marshalizer)
+	require.Nil(t, err)
+
+	latestDataProvider, err := factory.NewLatestDataProvider(factory.ArgsLatestDataProvider{
+		GeneralConfig:         generalConfig,
+		Marshalizer:           marshalizer,
+		Hasher:                &blake2b.Blake2b{},
+		BootstrapDataProvider: bootstrapDataProvider,
+		DirectoryReader:       factory.NewDirectoryReader(),
+		WorkingDir:            workingDir,
+		ChainID:               chainID,
+		DefaultDBPath:         "db",
+		DefaultEpochString:    "Epoch",
+		DefaultShardString:    "Shard",
+	})
+	require.Nil(t, err)
+
+	pathManager, err := pathmanager.NewPathManager(
+		filepath.Join(workingDir, "db", chainID,
+			fmt.Sprintf("Epoch_%s", core.PathEpochPlaceholder),
+			fmt.Sprintf("Shard_%s", core.PathShardPlaceholder),
+			core.PathIdentifierPlaceholder),
+		filepath.Join(workingDir, "db", chainID, "Static",
+			fmt.Sprintf("Shard_%s", core.PathShardPlaceholder),
+			core.PathIdentifierPlaceholder),
+	)
+	require.Nil(t, err)
+
+	return inspector.ArgsDBInspector{
+		GeneralConfig:             generalConfig,
+		Marshalizer:               marshalizer,
+		Hasher:                    &blake2b.Blake2b{},
+		LatestStorageDataProvider: latestDataProvider,
+		PathManager:               pathManager,
+		DefaultEpochString:        "Epoch",
+	}
+}
+
+func TestNewDBInspector_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgs(t, "")
+	args.Marshalizer = nil
+	dbi, err := inspector.NewDBInspector(args)
+
+	assert.True(t, check.IfNil(dbi))
+	assert.Equal(t, inspector.ErrNilMarshalizer, err)
+}
+
+func TestNewDBInspector_NilPathManagerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgs(t, "")
+	args.PathManager = nil
+	dbi, err := inspector.NewDBInspector(args)
+
+	assert.True(t, check.IfNil(dbi))
+	assert.Equal(t, inspector.ErrNilPathManager, err)
+}
+
+func TestDbInspector_ListEpochsAndGetMiniBlock(t *testing.T) {
+	t.Parallel()
+
+	workingDir, err := ioutil.TempDir("", "dbinspect")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(workingDir)
+	}()
+
+	args := createArgs(t, workingDir)
+	dbi, err := inspector.NewDBInspector(args)
+	require.Nil(t, err)
+
+	miniBlock := &block.MiniBlock{
+		TxHashes:        [][]byte{[]byte("tx hash")},
+		ReceiverShardID: 1,
+		SenderShardID:   0,
+	}
+	miniBlockBytes, err := args.Marshalizer.Marshal(miniBlock)
+	require.Nil(t, err)
+	miniBlockHash := args.Hasher.Compute(string(miniBlockBytes))
+
+	dbConfig := args.GeneralConfig.MiniBlocksStorage.DB
+	persister, err := factory.NewPersisterFactory(dbConfig).Create(args.PathManager.PathForEpoch("0", 3, dbConfig.FilePath))
+	require.Nil(t, err)
+	require.Nil(t, persister.Put(miniBlockHash, miniBlockBytes))
+	require.Nil(t, persister.Close())
+	require.Nil(t, os.MkdirAll(filepath.Join(workingDir, "db", chainID, "Epoch_7", "Shard_0"), os.ModePerm))
+
+	epochs, err := dbi.ListEpochs()
+	require.Nil(t, err)
+	require.Equal(t, 2, len(epochs))
+	assert.Equal(t, uint32(7), epochs[0].Epoch)
+	assert.Equal(t, uint32(3), epochs[1].Epoch)
+	assert.Equal(t, []string{"0"}, epochs[1].Shards)
+
+	recovered, err := dbi.GetMiniBlock("0", miniBlockHash)
+	require.Nil(t, err)
+	assert.Equal(t, miniBlock, recovered)
+
+	_, err = dbi.GetMiniBlock("0", []byte("missing"))
+	assert.True(t, errors.Is(err, inspector.ErrKeyNotFound))
+
+	_, err = dbi.GetTransaction("0", miniBlockHash, "unknown")
+	assert.True(t, errors.Is(err, inspector.ErrUnknownTransactionType))
+}
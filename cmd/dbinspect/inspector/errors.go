@@ -0,0 +1,24 @@
+package inspector
+
+import "errors"
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilLatestDataProvider signals that a nil latest storage data provider has been provided
+var ErrNilLatestDataProvider = errors.New("nil latest storage data provider")
+
+// ErrNilPathManager signals that a nil path manager has been provided
+var ErrNilPathManager = errors.New("nil path manager")
+
+// ErrDatabaseNotFound signals that the requested database does not exist on disk
+var ErrDatabaseNotFound = errors.New("database not found")
+
+// ErrKeyNotFound signals that the requested key was not found in any of the searched databases
+var ErrKeyNotFound = errors.New("key not found")
+
+// ErrUnknownTransactionType signals that an unknown transaction type has been provided
+var ErrUnknownTransactionType = errors.New("unknown transaction type")
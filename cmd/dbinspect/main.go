@@ -0,0 +1,529 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/cmd/dbinspect/inspector"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	hasherFactory "github.com/ElrondNetwork/elrond-go/hashing/factory"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	marshalizerFactory "github.com/ElrondNetwork/elrond-go/marshal/factory"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/pathmanager"
+	"github.com/urfave/cli"
+)
+
+type cfg struct {
+	workingDir string
+	configFile string
+	chainID    string
+	shardID    string
+	nonce      uint64
+	hash       string
+	txType     string
+	rootHash   string
+	peer       bool
+}
+
+const (
+	defaultDBPath         = "db"
+	defaultEpochString    = "Epoch"
+	defaultStaticDbString = "Static"
+	defaultShardString    = "Shard"
+)
+
+var (
+	dbInspectHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}} command [command options]
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+COMMANDS:
+   {{range .Commands}}{{join .Names ", "}}{{ "\t" }}{{.Usage}}
+   {{end}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	// workingDirectory defines a flag for the directory in which the node keeps its db directory
+	workingDirectory = cli.StringFlag{
+		Name:        "working-directory",
+		Usage:       "The node's working directory, the one holding the db directory",
+		Value:       ".",
+		Destination: &argsConfig.workingDir,
+	}
+
+	// configurationFile defines a flag for the path to the node's main configuration file
+	configurationFile = cli.StringFlag{
+		Name:        "config",
+		Usage:       "The node's main configuration file, used for the storage, marshalizer and hasher settings",
+		Value:       "./config/config.toml",
+		Destination: &argsConfig.configFile,
+	}
+
+	// chainID defines a flag for the chain whose databases should be inspected
+	chainID = cli.StringFlag{
+		Name:        "chain-id",
+		Usage:       "The chain ID. If not provided, the only chain found in the db directory is used",
+		Value:       "",
+		Destination: &argsConfig.chainID,
+	}
+
+	// shardID defines a flag for the shard whose databases should be inspected
+	shardID = cli.StringFlag{
+		Name:        "shard",
+		Usage:       "The shard whose databases should be inspected. Example: 0, 1, metachain",
+		Value:       "0",
+		Destination: &argsConfig.shardID,
+	}
+
+	// nonce defines a flag for the nonce of the header to be fetched
+	nonce = cli.Uint64Flag{
+		Name:        "nonce",
+		Usage:       "The nonce of the header",
+		Destination: &argsConfig.nonce,
+	}
+
+	// hash defines a flag for the hex encoded hash of the miniblock or transaction to be fetched
+	hash = cli.StringFlag{
+		Name:        "hash",
+		Usage:       "The hex encoded hash",
+		Destination: &argsConfig.hash,
+	}
+
+	// txType defines a flag for the kind of transaction to be fetched
+	txType = cli.StringFlag{
+		Name:        "type",
+		Usage:       "The transaction type. Available options: normal, unsigned, reward",
+		Value:       inspector.TxTypeNormal,
+		Destination: &argsConfig.txType,
+	}
+
+	// rootHash defines a flag for the hex encoded root hash of the trie to be walked
+	rootHash = cli.StringFlag{
+		Name:        "root-hash",
+		Usage:       "The hex encoded root hash of the trie",
+		Destination: &argsConfig.rootHash,
+	}
+
+	// peerAccounts defines a flag for selecting the validators trie instead of the user accounts trie
+	peerAccounts = cli.BoolFlag{
+		Name:        "peer",
+		Usage:       "Use the validators (peer accounts) trie instead of the user accounts trie",
+		Destination: &argsConfig.peer,
+	}
+
+	argsConfig = &cfg{}
+
+	log = logger.GetOrCreate("dbinspect")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = dbInspectHelpTemplate
+	app.Name = "DB inspection Tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary reads the databases of a stopped node: headers, miniblocks, transactions and state tries"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		workingDirectory,
+		configurationFile,
+		chainID,
+		shardID,
+	}
+	app.Commands = []cli.Command{
+		{
+			Name:   "list",
+			Usage:  "lists the epochs and the shards found in storage and the latest bootstrap data",
+			Action: listStorage,
+		},
+		{
+			Name:   "header",
+			Usage:  "prints the header with the given nonce",
+			Flags:  []cli.Flag{nonce},
+			Action: printHeader,
+		},
+		{
+			Name:   "miniblock",
+			Usage:  "prints the miniblock with the given hash",
+			Flags:  []cli.Flag{hash},
+			Action: printMiniBlock,
+		},
+		{
+			Name:   "tx",
+			Usage:  "prints the transaction with the given hash",
+			Flags:  []cli.Flag{hash, txType},
+			Action: printTransaction,
+		},
+		{
+			Name:   "trie",
+			Usage:  "prints all the accounts of the trie with the given root hash",
+			Flags:  []cli.Flag{rootHash, peerAccounts},
+			Action: printTrie,
+		},
+		{
+			Name:   "verify-trie",
+			Usage:  "checks that all the nodes of the trie with the given root hash are present and not corrupted",
+			Flags:  []cli.Flag{rootHash, peerAccounts},
+			Action: verifyTrie,
+		},
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error("error inspecting storage", "error", err)
+
+		os.Exit(1)
+	}
+}
+
+type dbInspectorHandler interface {
+	GetLatestData() (storage.LatestDataFromStorage, error)
+	ListEpochs() ([]*inspector.EpochInfo, error)
+	GetHeaderByNonce(shardID string, nonce uint64) ([]byte, data.HeaderHandler, error)
+	GetMiniBlock(shardID string, hash []byte) (*block.MiniBlock, error)
+	GetTransaction(shardID string, hash []byte, txType string) (data.TransactionHandler, error)
+	WalkAccountsTrie(shardID string, rootHash []byte, peerAccounts bool, leafHandler func(key []byte, value []byte)) (*trie.IntegrityReport, error)
+}
+
+func createDBInspector() (dbInspectorHandler, marshal.Marshalizer, error) {
+	generalConfig := &config.Config{}
+	err := core.LoadTomlFile(generalConfig, argsConfig.configFile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	marshalizer, err := marshalizerFactory.NewMarshalizer(generalConfig.Marshalizer.Type)
+	if err != nil {
+		return nil, nil, err
+	}
+	hasher, err := hasherFactory.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	workingDir, err := filepath.Abs(argsConfig.workingDir)
+	if err != nil {
+		return nil, nil, err
+	}
+	chain, err := getChainID(workingDir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pathManager, err := createPathManager(workingDir, chain)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bootstrapDataProvider, err := storageFactory.NewBootstrapDataProvider(marshalizer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	latestDataProvider, err := storageFactory.NewLatestDataProvider(storageFactory.ArgsLatestDataProvider{
+		GeneralConfig:         *generalConfig,
+		Marshalizer:           marshalizer,
+		Hasher:                hasher,
+		BootstrapDataProvider: bootstrapDataProvider,
+		DirectoryReader:       storageFactory.NewDirectoryReader(),
+		WorkingDir:            workingDir,
+		ChainID:               chain,
+		DefaultDBPath:         defaultDBPath,
+		DefaultEpochString:    defaultEpochString,
+		DefaultShardString:    defaultShardString,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dbInspector, err := inspector.NewDBInspector(inspector.ArgsDBInspector{
+		GeneralConfig:             *generalConfig,
+		Marshalizer:               marshalizer,
+		Hasher:                    hasher,
+		LatestStorageDataProvider: latestDataProvider,
+		PathManager:               pathManager,
+		DefaultEpochString:        defaultEpochString,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return dbInspector, marshalizer, nil
+}
+
+func getChainID(workingDir string) (string, error) {
+	if len(argsConfig.chainID) > 0 {
+		return argsConfig.chainID, nil
+	}
+
+	chains, err := storageFactory.NewDirectoryReader().ListDirectoriesAsString(filepath.Join(workingDir, defaultDBPath))
+	if err != nil {
+		return "", err
+	}
+	if len(chains) != 1 {
+		return "", fmt.Errorf("found %d chains in the db directory, please provide the --%s flag", len(chains), chainID.Name)
+	}
+
+	return chains[0], nil
+}
+
+func createPathManager(workingDir string, chain string) (*pathmanager.PathManager, error) {
+	pathTemplateForPruningStorer := filepath.Join(
+		workingDir,
+		defaultDBPath,
+		chain,
+		fmt.Sprintf("%s_%s", defaultEpochString, core.PathEpochPlaceholder),
+		fmt.Sprintf("%s_%s", defaultShardString, core.PathShardPlaceholder),
+		core.PathIdentifierPlaceholder)
+
+	pathTemplateForStaticStorer := filepath.Join(
+		workingDir,
+		defaultDBPath,
+		chain,
+		defaultStaticDbString,
+		fmt.Sprintf("%s_%s", defaultShardString, core.PathShardPlaceholder),
+		core.PathIdentifierPlaceholder)
+
+	return pathmanager.NewPathManager(pathTemplateForPruningStorer, pathTemplateForStaticStorer)
+}
+
+func listStorage(_ *cli.Context) error {
+	dbInspector, _, err := createDBInspector()
+	if err != nil {
+		return err
+	}
+
+	epochs, err := dbInspector.ListEpochs()
+	if err != nil {
+		return err
+	}
+
+	latestData, err := dbInspector.GetLatestData()
+	if err != nil {
+		log.Warn("cannot read the latest bootstrap data", "error", err)
+	}
+
+	return printJSON(struct {
+		Epochs     []*inspector.EpochInfo
+		LatestData storage.LatestDataFromStorage
+	}{
+		Epochs:     epochs,
+		LatestData: latestData,
+	})
+}
+
+func printHeader(_ *cli.Context) error {
+	dbInspector, _, err := createDBInspector()
+	if err != nil {
+		return err
+	}
+
+	headerHash, header, err := dbInspector.GetHeaderByNonce(argsConfig.shardID, argsConfig.nonce)
+	if err != nil {
+		return err
+	}
+
+	return printJSON(struct {
+		Hash   string
+		Header data.HeaderHandler
+	}{
+		Hash:   hex.EncodeToString(headerHash),
+		Header: header,
+	})
+}
+
+func printMiniBlock(_ *cli.Context) error {
+	dbInspector, _, err := createDBInspector()
+	if err != nil {
+		return err
+	}
+
+	miniBlockHash, err := hex.DecodeString(argsConfig.hash)
+	if err != nil {
+		return err
+	}
+
+	miniBlock, err := dbInspector.GetMiniBlock(argsConfig.shardID, miniBlockHash)
+	if err != nil {
+		return err
+	}
+
+	txHashes := make([]string, 0, len(miniBlock.TxHashes))
+	for _, txHash := range miniBlock.TxHashes {
+		txHashes = append(txHashes, hex.EncodeToString(txHash))
+	}
+
+	return printJSON(struct {
+		Hash            string
+		Type            string
+		SenderShardID   uint32
+		ReceiverShardID uint32
+		TxHashes        []string
+	}{
+		Hash:            argsConfig.hash,
+		Type:            miniBlock.Type.String(),
+		SenderShardID:   miniBlock.SenderShardID,
+		ReceiverShardID: miniBlock.ReceiverShardID,
+		TxHashes:        txHashes,
+	})
+}
+
+func printTransaction(_ *cli.Context) error {
+	dbInspector, _, err := createDBInspector()
+	if err != nil {
+		return err
+	}
+
+	txHash, err := hex.DecodeString(argsConfig.hash)
+	if err != nil {
+		return err
+	}
+
+	tx, err := dbInspector.GetTransaction(argsConfig.shardID, txHash, argsConfig.txType)
+	if err != nil {
+		return err
+	}
+
+	return printJSON(tx)
+}
+
+func printTrie(_ *cli.Context) error {
+	dbInspector, marshalizer, err := createDBInspector()
+	if err != nil {
+		return err
+	}
+
+	trieRootHash, err := hex.DecodeString(argsConfig.rootHash)
+	if err != nil {
+		return err
+	}
+
+	leafHandler := func(key []byte, value []byte) {
+		account, errDecode := decodeAccount(marshalizer, value)
+		if errDecode != nil {
+			log.Warn("cannot decode account", "key", key, "error", errDecode)
+			return
+		}
+
+		errPrint := printJSON(struct {
+			Key     string
+			Account interface{}
+		}{
+			Key:     hex.EncodeToString(key),
+			Account: account,
+		})
+		if errPrint != nil {
+			log.Warn("cannot print account", "key", key, "error", errPrint)
+		}
+	}
+
+	report, err := dbInspector.WalkAccountsTrie(argsConfig.shardID, trieRootHash, argsConfig.peer, leafHandler)
+	if err != nil {
+		return err
+	}
+	if !report.IsValid() {
+		log.Warn("the trie is not complete, run verify-trie for details",
+			"missing nodes", len(report.MissingNodes),
+			"hash mismatches", len(report.HashMismatches))
+	}
+
+	return nil
+}
+
+func verifyTrie(_ *cli.Context) error {
+	dbInspector, _, err := createDBInspector()
+	if err != nil {
+		return err
+	}
+
+	trieRootHash, err := hex.DecodeString(argsConfig.rootHash)
+	if err != nil {
+		return err
+	}
+
+	report, err := dbInspector.WalkAccountsTrie(argsConfig.shardID, trieRootHash, argsConfig.peer, nil)
+	if err != nil {
+		return err
+	}
+
+	err = printJSON(struct {
+		Valid          bool
+		NumNodes       uint64
+		NumLeaves      uint64
+		MissingNodes   []string
+		HashMismatches []string
+	}{
+		Valid:          report.IsValid(),
+		NumNodes:       report.NumNodes,
+		NumLeaves:      report.NumLeaves,
+		MissingNodes:   encodeHashes(report.MissingNodes),
+		HashMismatches: encodeHashes(report.HashMismatches),
+	})
+	if err != nil {
+		return err
+	}
+
+	if !report.IsValid() {
+		return fmt.Errorf("trie with root hash %s is corrupted", argsConfig.rootHash)
+	}
+
+	return nil
+}
+
+func decodeAccount(marshalizer marshal.Marshalizer, value []byte) (interface{}, error) {
+	if argsConfig.peer {
+		peerAccount := &state.PeerAccountData{}
+		err := marshalizer.Unmarshal(peerAccount, value)
+
+		return peerAccount, err
+	}
+
+	userAccount := &state.UserAccountData{}
+	err := marshalizer.Unmarshal(userAccount, value)
+
+	return userAccount, err
+}
+
+func encodeHashes(hashes [][]byte) []string {
+	encoded := make([]string, 0, len(hashes))
+	for _, h := range hashes {
+		encoded = append(encoded, hex.EncodeToString(h))
+	}
+
+	return encoded
+}
+
+func printJSON(obj interface{}) error {
+	buff, err := json.MarshalIndent(obj, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(buff))
+
+	return nil
+}
@@ -0,0 +1,265 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	hasherFactory "github.com/ElrondNetwork/elrond-go/hashing/factory"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/urfave/cli"
+)
+
+type cfg struct {
+	workingDir   string
+	configFile   string
+	chainID      string
+	targetType   string
+	numSamples   int
+	keepBackup   bool
+	updateConfig bool
+}
+
+const (
+	defaultDBPath         = "db"
+	defaultEpochString    = "Epoch"
+	defaultStaticDbString = "Static"
+	defaultShardString    = "Shard"
+)
+
+var (
+	dbMigrateHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	// workingDirectory defines a flag for the directory in which the node keeps its db directory
+	workingDirectory = cli.StringFlag{
+		Name:        "working-directory",
+		Usage:       "The node's working directory, the one holding the db directory",
+		Value:       ".",
+		Destination: &argsConfig.workingDir,
+	}
+
+	// configurationFile defines a flag for the path to the node's main configuration file
+	configurationFile = cli.StringFlag{
+		Name:        "config",
+		Usage:       "The node's main configuration file, holding the persister type of each storer",
+		Value:       "./config/config.toml",
+		Destination: &argsConfig.configFile,
+	}
+
+	// chainID defines a flag for the chain whose databases should be migrated
+	chainID = cli.StringFlag{
+		Name:        "chain-id",
+		Usage:       "The chain ID. If not provided, the only chain found in the db directory is used",
+		Value:       "",
+		Destination: &argsConfig.chainID,
+	}
+
+	// targetType defines a flag for the persister type the databases are migrated to
+	targetType = cli.StringFlag{
+		Name:        "target-type",
+		Usage:       "The persister type the databases are migrated to. Available options: LvlDB, LvlDBSerial, BoltDB",
+		Value:       string(storageUnit.BoltDB),
+		Destination: &argsConfig.targetType,
+	}
+
+	// numSamples defines a flag for the number of values checked by hash in each migrated database
+	numSamples = cli.IntFlag{
+		Name:        "num-samples",
+		Usage:       "How many randomly chosen values of each database are compared by hash after the copy",
+		Value:       1000,
+		Destination: &argsConfig.numSamples,
+	}
+
+	// keepBackup defines a flag for keeping the source databases next to the migrated ones
+	keepBackup = cli.BoolFlag{
+		Name:        "keep-backup",
+		Usage:       "Keep each source database in a directory with the " + storageFactory.BackupSuffix + " suffix",
+		Destination: &argsConfig.keepBackup,
+	}
+
+	// updateConfig defines a flag for writing the target type in the configuration file after the migration
+	updateConfig = cli.BoolTFlag{
+		Name:        "update-config",
+		Usage:       "Set the target type on all the persistent storers of the configuration file after the migration",
+		Destination: &argsConfig.updateConfig,
+	}
+
+	argsConfig = &cfg{}
+
+	log = logger.GetOrCreate("dbmigrate")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = dbMigrateHelpTemplate
+	app.Name = "DB migration Tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary copies the databases of a stopped node to another persister type, so it restarts without resyncing"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		workingDirectory,
+		configurationFile,
+		chainID,
+		targetType,
+		numSamples,
+		keepBackup,
+		updateConfig,
+	}
+
+	app.Action = func(_ *cli.Context) error {
+		return migrate()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error("error migrating databases", "error", err)
+
+		os.Exit(1)
+	}
+}
+
+func migrate() error {
+	generalConfig := &config.Config{}
+	err := core.LoadTomlFile(generalConfig, argsConfig.configFile)
+	if err != nil {
+		return err
+	}
+
+	hasher, err := hasherFactory.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return err
+	}
+
+	workingDir, err := filepath.Abs(argsConfig.workingDir)
+	if err != nil {
+		return err
+	}
+	chain, err := getChainID(workingDir)
+	if err != nil {
+		return err
+	}
+
+	migrator, err := storageFactory.NewDBMigrator(storageFactory.ArgsDBMigrator{
+		GeneralConfig:         *generalConfig,
+		Hasher:                hasher,
+		DestinationDBType:     storageUnit.DBType(argsConfig.targetType),
+		WorkingDir:            workingDir,
+		ChainID:               chain,
+		DefaultDBPath:         defaultDBPath,
+		DefaultEpochString:    defaultEpochString,
+		DefaultStaticDbString: defaultStaticDbString,
+		DefaultShardString:    defaultShardString,
+		NumSamples:            argsConfig.numSamples,
+		KeepBackup:            argsConfig.keepBackup,
+	})
+	if err != nil {
+		return err
+	}
+
+	migrated, err := migrator.Migrate()
+	if err != nil {
+		log.Error("migration stopped, the databases not listed as migrated still use the source type",
+			"num migrated", len(migrated))
+		return err
+	}
+
+	numEntries := uint64(0)
+	for _, unit := range migrated {
+		numEntries += unit.NumEntries
+	}
+	log.Info("migration done", "databases", len(migrated), "entries", numEntries)
+
+	if !argsConfig.updateConfig {
+		log.Warn("the configuration file was not updated, set the DB type of the storers before starting the node",
+			"type", argsConfig.targetType)
+		return nil
+	}
+
+	return updateDBTypesInConfigFile(argsConfig.configFile, argsConfig.targetType)
+}
+
+func getChainID(workingDir string) (string, error) {
+	if len(argsConfig.chainID) > 0 {
+		return argsConfig.chainID, nil
+	}
+
+	chains, err := storageFactory.NewDirectoryReader().ListDirectoriesAsString(filepath.Join(workingDir, defaultDBPath))
+	if err != nil {
+		return "", err
+	}
+	if len(chains) != 1 {
+		return "", fmt.Errorf("found %d chains in the db directory, please provide the --%s flag", len(chains), chainID.Name)
+	}
+
+	return chains[0], nil
+}
+
+var sectionRegex = regexp.MustCompile(`^\s*\[+([^\]]+)\]+\s*$`)
+var dbTypeRegex = regexp.MustCompile(`^(\s*Type\s*=\s*)"(LvlDB|LvlDBSerial|BoltDB)"(.*)$`)
+
+// updateDBTypesInConfigFile rewrites, in place, the Type of every persistent database section of the configuration
+// file. The file is edited line by line so that its comments and layout are kept; the original is saved with
+// the .bak suffix
+func updateDBTypesInConfigFile(configFile string, dbType string) error {
+	buff, err := ioutil.ReadFile(configFile)
+	if err != nil {
+		return err
+	}
+
+	err = ioutil.WriteFile(configFile+".bak", buff, 0644)
+	if err != nil {
+		return err
+	}
+
+	lines := strings.Split(string(buff), "\n")
+	isDBSection := false
+	numUpdated := 0
+	for i, line := range lines {
+		section := sectionRegex.FindStringSubmatch(line)
+		if section != nil {
+			isDBSection = strings.HasSuffix(section[1], ".DB") || section[1] == "TrieSnapshotDB"
+			continue
+		}
+		if !isDBSection {
+			continue
+		}
+
+		typeLine := dbTypeRegex.FindStringSubmatch(line)
+		if typeLine == nil {
+			continue
+		}
+
+		lines[i] = fmt.Sprintf("%s\"%s\"%s", typeLine[1], dbType, typeLine[3])
+		numUpdated++
+	}
+
+	log.Info("configuration file updated", "file", configFile, "storers", numUpdated, "type", dbType)
+
+	return ioutil.WriteFile(configFile, []byte(strings.Join(lines, "\n")), 0644)
+}
@@ -7,6 +7,7 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/slashing"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/partitioning"
 	"github.com/ElrondNetwork/elrond-go/core/serviceContainer"
@@ -15,6 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data"
 	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	disabledState "github.com/ElrondNetwork/elrond-go/data/state/disabled"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/factory/containers"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/factory/resolverscontainer"
@@ -29,6 +31,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/accountHistory"
+	accountHistoryDisabled "github.com/ElrondNetwork/elrond-go/process/accountHistory/disabled"
 	"github.com/ElrondNetwork/elrond-go/process/block"
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
 	"github.com/ElrondNetwork/elrond-go/process/block/pendingMb"
@@ -36,7 +40,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/block/postprocess"
 	"github.com/ElrondNetwork/elrond-go/process/block/preprocess"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
+	"github.com/ElrondNetwork/elrond-go/process/dataValidators"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
+	"github.com/ElrondNetwork/elrond-go/process/events"
+	eventsDisabled "github.com/ElrondNetwork/elrond-go/process/events/disabled"
+	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/factory/interceptorscontainer"
 	"github.com/ElrondNetwork/elrond-go/process/factory/metachain"
 	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
@@ -52,10 +60,13 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/track"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/process/transactionLog"
+	"github.com/ElrondNetwork/elrond-go/process/txPoolSnapshot"
+	txPoolSnapshotDisabled "github.com/ElrondNetwork/elrond-go/process/txPoolSnapshot/disabled"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/sharding/networksharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/storage/timecache"
 	"github.com/ElrondNetwork/elrond-go/vm"
@@ -65,6 +76,12 @@ import (
 const (
 	// MaxTxsToRequest specifies the maximum number of txs to request
 	MaxTxsToRequest = 1000
+
+	// equivocationProofsPoolSize is the number of equivocation proofs kept in memory
+	equivocationProofsPoolSize = 1000
+
+	// maxEquivocationSlashingsInOneBlock is the maximum number of equivocation proofs a metachain block can include
+	maxEquivocationSlashingsInOneBlock = 10
 )
 
 //TODO remove this
@@ -103,6 +120,10 @@ type Process struct {
 	RequestHandler           process.RequestHandler
 	TxLogsProcessor          process.TransactionLogProcessorDatabase
 	HeaderValidator          epochStart.HeaderValidator
+	AccountHistory           process.AccountHistoryHandler
+	EventsNotifier           events.Notifier
+	TxPoolSnapshot           txPoolSnapshot.Snapshotter
+	EquivocationProofsPool   storage.Cacher
 }
 
 type processComponentsFactoryArgs struct {
@@ -324,6 +345,27 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 	}
 
 	args.txLogsProcessor = txLogsProcessor
+
+	accountHistory, err := createAccountHistory(args)
+	if err != nil {
+		return nil, err
+	}
+
+	eventsNotifier, err := createEventsNotifier(args)
+	if err != nil {
+		return nil, err
+	}
+
+	txPoolSnapshotter, err := createTxPoolSnapshot(args)
+	if err != nil {
+		return nil, err
+	}
+
+	equivocationProofsPool, err := lrucache.NewCache(equivocationProofsPoolSize)
+	if err != nil {
+		return nil, err
+	}
+
 	genesisBlocks, err := generateGenesisHeadersAndApplyInitialBalances(args)
 	if err != nil {
 		return nil, err
@@ -449,6 +491,9 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		blockTracker,
 		pendingMiniBlocksHandler,
 		txLogsProcessor,
+		accountHistory,
+		eventsNotifier,
+		equivocationProofsPool,
 	)
 	if err != nil {
 		return nil, err
@@ -487,9 +532,90 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		RequestHandler:           requestHandler,
 		TxLogsProcessor:          txLogsProcessor,
 		HeaderValidator:          headerValidator,
+		AccountHistory:           accountHistory,
+		EventsNotifier:           eventsNotifier,
+		TxPoolSnapshot:           txPoolSnapshotter,
+		EquivocationProofsPool:   equivocationProofsPool,
 	}, nil
 }
 
+func createAccountHistory(args *processComponentsFactoryArgs) (process.AccountHistoryHandler, error) {
+	if !args.mainConfig.AccountHistory.Enabled {
+		return accountHistoryDisabled.NewNilAccountHistory(), nil
+	}
+
+	numEpochsToKeep := uint32(args.mainConfig.StoragePruning.NumEpochsToKeep)
+	if args.mainConfig.StoragePruning.FullArchive || !args.mainConfig.StoragePruning.Enabled {
+		numEpochsToKeep = 0
+	}
+
+	return accountHistory.NewAccountHistory(accountHistory.ArgsAccountHistory{
+		Storer:             args.data.Store.GetStorer(dataRetriever.AccountHistoryUnit),
+		Marshalizer:        args.coreData.InternalMarshalizer,
+		ShardCoordinator:   args.shardCoordinator,
+		StartEpoch:         args.startEpochNum,
+		NumEpochsToKeep:    numEpochsToKeep,
+		MaxEntriesPerEpoch: args.mainConfig.AccountHistory.MaxEntriesPerEpoch,
+	})
+}
+
+func createEventsNotifier(args *processComponentsFactoryArgs) (events.Notifier, error) {
+	notifierConfig := args.mainConfig.EventsNotifier
+	if !notifierConfig.Enabled {
+		return eventsDisabled.NewDisabledEventsNotifier(), nil
+	}
+
+	eventsNotifier, err := events.NewEventsNotifier(events.ArgsEventsNotifier{
+		Marshalizer:         args.coreData.InternalMarshalizer,
+		PubkeyConverter:     args.state.AddressPubkeyConverter,
+		TxLogsStorer:        args.data.Store.GetStorer(dataRetriever.TxLogsUnit),
+		MaxSubscribers:      notifierConfig.MaxSubscribers,
+		SubscriberQueueSize: notifierConfig.SubscriberQueueSize,
+		MaxFilterEntries:    notifierConfig.MaxFilterEntries,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	args.epochStartNotifier.RegisterHandler(eventsNotifier)
+
+	return eventsNotifier, nil
+}
+
+func createTxPoolSnapshot(args *processComponentsFactoryArgs) (txPoolSnapshot.Snapshotter, error) {
+	snapshotConfig := args.mainConfig.TxPoolSnapshot
+	if !snapshotConfig.Enabled {
+		return txPoolSnapshotDisabled.NewDisabledTxPoolSnapshot(), nil
+	}
+
+	txPool, ok := args.data.Datapool.Transactions().(txPoolSnapshot.TxPool)
+	if !ok {
+		return nil, process.ErrWrongTypeAssertion
+	}
+
+	txValidator, err := dataValidators.NewTxValidator(
+		args.state.AccountsAdapter,
+		args.shardCoordinator,
+		args.whiteListHandler,
+		args.state.AddressPubkeyConverter,
+		core.MaxTxNonceDeltaAllowed,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return txPoolSnapshot.NewTxPoolSnapshot(txPoolSnapshot.ArgsTxPoolSnapshot{
+		TxPool:           txPool,
+		Storer:           args.data.Store.GetStorer(dataRetriever.TxPoolSnapshotUnit),
+		Marshalizer:      args.coreData.InternalMarshalizer,
+		Hasher:           args.coreData.Hasher,
+		ShardCoordinator: args.shardCoordinator,
+		TxValidator:      txValidator,
+		FeeHandler:       args.economicsData,
+		SnapshotInterval: time.Duration(snapshotConfig.SnapshotIntervalInSec) * time.Second,
+	})
+}
+
 func prepareGenesisBlock(args *processComponentsFactoryArgs, genesisBlocks map[uint32]data.HeaderHandler) error {
 	genesisBlock, ok := genesisBlocks[args.shardCoordinator.SelfId()]
 	if !ok {
@@ -1012,6 +1138,9 @@ func newBlockProcessor(
 	blockTracker process.BlockTracker,
 	pendingMiniBlocksHandler process.PendingMiniBlocksHandler,
 	txLogsProcessor process.TransactionLogProcessor,
+	accountHistory process.AccountHistoryHandler,
+	eventsNotifier process.BlockEventsNotifier,
+	equivocationProofsPool storage.Cacher,
 ) (process.BlockProcessor, error) {
 
 	shardCoordinator := processArgs.shardCoordinator
@@ -1038,6 +1167,8 @@ func newBlockProcessor(
 			processArgs.minSizeInBytes,
 			processArgs.maxSizeInBytes,
 			txLogsProcessor,
+			accountHistory,
+			eventsNotifier,
 			processArgs.version,
 		)
 	}
@@ -1067,6 +1198,9 @@ func newBlockProcessor(
 			processArgs.ratingsData,
 			processArgs.nodesConfig,
 			txLogsProcessor,
+			accountHistory,
+			eventsNotifier,
+			equivocationProofsPool,
 			processArgs.systemSCConfig,
 			processArgs.version,
 		)
@@ -1096,14 +1230,18 @@ func newShardBlockProcessor(
 	minSizeInBytes uint32,
 	maxSizeInBytes uint32,
 	txLogsProcessor process.TransactionLogProcessor,
+	accountHistory process.AccountHistoryHandler,
+	eventsNotifier process.BlockEventsNotifier,
 	version string,
 ) (process.BlockProcessor, error) {
 	argsParser := vmcommon.NewAtArgumentParser()
 
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasMap:          gasSchedule,
-		MapDNSAddresses: make(map[string]struct{}),
-		Marshalizer:     core.InternalMarshalizer,
+		GasMap:           gasSchedule,
+		MapDNSAddresses:  make(map[string]struct{}),
+		Marshalizer:      core.InternalMarshalizer,
+		Accounts:         stateComponents.AccountsAdapter,
+		ShardCoordinator: shardCoordinator,
 	}
 	builtInFuncs, err := builtInFunctions.CreateBuiltInFunctionContainer(argsBuiltIn)
 	if err != nil {
@@ -1323,6 +1461,8 @@ func newShardBlockProcessor(
 		BlockChain:             data.Blkc,
 		StateCheckpointModulus: stateCheckpointModulus,
 		BlockSizeThrottler:     blockSizeThrottler,
+		AccountHistory:         accountHistory,
+		EventsNotifier:         eventsNotifier,
 	}
 	arguments := block.ArgShardProcessor{
 		ArgBaseProcessor: argumentsBaseProcessor,
@@ -1366,6 +1506,9 @@ func newMetaBlockProcessor(
 	ratingsData process.RatingsInfoHandler,
 	nodesSetup sharding.GenesisNodesSetupHandler,
 	txLogsProcessor process.TransactionLogProcessor,
+	accountHistory process.AccountHistoryHandler,
+	eventsNotifier process.BlockEventsNotifier,
+	equivocationProofsPool storage.Cacher,
 	systemSCConfig *config.SystemSmartContractsConfig,
 	version string,
 ) (process.BlockProcessor, error) {
@@ -1541,7 +1684,13 @@ func newMetaBlockProcessor(
 		return nil, err
 	}
 
-	scDataGetter, err := smartContract.NewSCQueryService(vmContainer, economicsData)
+	argsQueryService := smartContract.ArgsNewSCQueryService{
+		VmContainer:       vmContainer,
+		EconomicsFee:      economicsData,
+		AccountsHolder:    vmFactory.BlockChainHookImpl(),
+		AccountsRecreator: disabledState.NewAccountsAdapterRecreator(),
+	}
+	scDataGetter, err := smartContract.NewSCQueryService(argsQueryService)
 	if err != nil {
 		return nil, err
 	}
@@ -1563,6 +1712,17 @@ func newMetaBlockProcessor(
 		return nil, err
 	}
 
+	equivocationSlasher, err := newEquivocationSlasher(
+		core,
+		stateComponents,
+		vmContainer,
+		messageSignVerifier,
+		equivocationProofsPool,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	argsEpochStartData := metachainEpochStart.ArgsNewEpochStartData{
 		Marshalizer:       core.InternalMarshalizer,
 		Hasher:            core.Hasher,
@@ -1649,7 +1809,18 @@ func newMetaBlockProcessor(
 		BlockChain:             data.Blkc,
 		StateCheckpointModulus: stateCheckpointModulus,
 		BlockSizeThrottler:     blockSizeThrottler,
+		AccountHistory:         accountHistory,
+		EventsNotifier:         eventsNotifier,
+	}
+	rewardsTxProcessor, err := rewardTransaction.NewRewardTxProcessor(
+		stateComponents.AccountsAdapter,
+		stateComponents.AddressPubkeyConverter,
+		shardCoordinator,
+	)
+	if err != nil {
+		return nil, err
 	}
+
 	arguments := block.ArgMetaProcessor{
 		ArgBaseProcessor:             argumentsBaseProcessor,
 		SCDataGetter:                 scDataGetter,
@@ -1660,6 +1831,8 @@ func newMetaBlockProcessor(
 		EpochRewardsCreator:          epochRewards,
 		EpochValidatorInfoCreator:    validatorInfoCreator,
 		ValidatorStatisticsProcessor: validatorStatisticsProcessor,
+		EquivocationSlasher:          equivocationSlasher,
+		RewardsTxProcessor:           rewardsTxProcessor,
 	}
 
 	metaProcessor, err := block.NewMetaProcessor(arguments)
@@ -1675,6 +1848,38 @@ func newMetaBlockProcessor(
 	return metaProcessor, nil
 }
 
+func newEquivocationSlasher(
+	core *mainFactory.CoreComponents,
+	stateComponents *mainFactory.StateComponents,
+	vmContainer process.VirtualMachinesContainer,
+	messageSignVerifier vm.MessageSignVerifier,
+	equivocationProofsPool storage.Cacher,
+) (process.EquivocationSlasher, error) {
+	systemVM, err := vmContainer.Get(factory.SystemVirtualMachine)
+	if err != nil {
+		return nil, err
+	}
+
+	proofVerifier, err := slashing.NewProofVerifier(slashing.ArgsProofVerifier{
+		Marshalizer:       core.InternalMarshalizer,
+		Hasher:            core.Hasher,
+		SignatureVerifier: messageSignVerifier,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	argsEquivocationSlasher := scToProtocol.ArgsEquivocationSlasher{
+		ProofsPool:             equivocationProofsPool,
+		ProofVerifier:          proofVerifier,
+		SystemVM:               systemVM,
+		Accounts:               stateComponents.AccountsAdapter,
+		MaxSlashingsInOneBlock: maxEquivocationSlashingsInOneBlock,
+	}
+
+	return scToProtocol.NewEquivocationSlasher(argsEquivocationSlasher)
+}
+
 func newValidatorStatisticsProcessor(
 	processComponents *processComponentsFactoryArgs,
 ) (process.ValidatorStatisticsProcessor, error) {
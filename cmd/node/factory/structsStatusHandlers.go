@@ -17,6 +17,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	factoryViews "github.com/ElrondNetwork/elrond-go/statusHandler/factory"
 	"github.com/ElrondNetwork/elrond-go/statusHandler/persister"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/prometheus"
 	"github.com/ElrondNetwork/elrond-go/statusHandler/view"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/urfave/cli"
@@ -40,6 +41,7 @@ type statusHandlersInfo struct {
 	StatusHandler            core.AppStatusHandler
 	StatusMetrics            external.StatusMetricsHandler
 	PersistentHandler        *persister.PersistentStatusHandler
+	PrometheusMetrics        external.PrometheusMetricsHandler
 	Uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
 }
 
@@ -136,6 +138,9 @@ func CreateStatusHandlers(arguments *ArgStatusHandlers) (*statusHandlersInfo, er
 	}
 	appStatusHandlers = append(appStatusHandlers, persistentHandler)
 
+	prometheusHandler := prometheus.NewPrometheusStatusHandler()
+	appStatusHandlers = append(appStatusHandlers, prometheusHandler)
+
 	if len(appStatusHandlers) > 0 {
 		handler, err = statusHandler.NewAppStatusFacadeWithHandlers(appStatusHandlers...)
 		if err != nil {
@@ -151,6 +156,7 @@ func CreateStatusHandlers(arguments *ArgStatusHandlers) (*statusHandlersInfo, er
 	statusHandlersInfoObject.UseTermUI = useTermui
 	statusHandlersInfoObject.StatusMetrics = statusMetrics
 	statusHandlersInfoObject.PersistentHandler = persistentHandler
+	statusHandlersInfoObject.PrometheusMetrics = prometheusHandler
 	return statusHandlersInfoObject, nil
 }
 
@@ -63,6 +63,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
+	"github.com/ElrondNetwork/elrond-go/process/txsimulator"
+	disabledTxSimulator "github.com/ElrondNetwork/elrond-go/process/txsimulator/disabled"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
@@ -446,7 +448,7 @@ func main() {
 
 func getSuite(config *config.Config) (crypto.Suite, error) {
 	switch config.Consensus.Type {
-	case consensus.BlsConsensusType:
+	case consensus.BlsConsensusType, consensus.RoundRobinConsensusType:
 		return mcl.NewSuiteBLS12(), nil
 	default:
 		return nil, errors.New("no consensus provided in config file")
@@ -525,6 +527,11 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 	}
 	log.Debug("config", "file", configurationSystemSCConfigFileName)
 
+	isEquivocationSlashingEnabled := systemSCConfig.StakingSystemSCConfig.EquivocationSlashPercentage > 0
+	if isEquivocationSlashingEnabled && !economicsConfig.ValidatorSettings.ActivateBLSPubKeyMessageVerification {
+		return errors.New("equivocation slashing can not be enabled while ActivateBLSPubKeyMessageVerification is false")
+	}
+
 	configurationRatingsFileName := ctx.GlobalString(configurationRatingsFile.Name)
 	ratingsConfig, err := loadRatingsConfig(configurationRatingsFileName)
 	if err != nil {
@@ -996,6 +1003,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		shardCoordinator.SelfId(),
 		chanStopNodeProcess,
 		bootstrapParameters,
+		generalConfig.Consensus.Type,
 	)
 	if err != nil {
 		return err
@@ -1079,12 +1087,16 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
-	if externalConfig.ElasticSearchConnector.Enabled {
-		log.Trace("creating elastic search components")
-		dbIndexer, err = createElasticIndexer(
+	indexerDriver, err := indexer.GetEnabledDriver(*externalConfig)
+	if err != nil {
+		return err
+	}
+	if len(indexerDriver) > 0 {
+		log.Trace("creating indexer components", "driver", indexerDriver)
+		dbIndexer, err = createIndexer(
 			ctx,
-			externalConfig.ElasticSearchConnector,
-			externalConfig.ElasticSearchConnector.URL,
+			indexerDriver,
+			*externalConfig,
 			coreComponents.InternalMarshalizer,
 			coreComponents.Hasher,
 			nodesCoordinator,
@@ -1092,6 +1104,8 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 			addressPubkeyConverter,
 			validatorPubkeyConverter,
 			shardCoordinator.SelfId(),
+			pathManager,
+			statusHandlersInfo.StatusHandler,
 		)
 		if err != nil {
 			return err
@@ -1249,6 +1263,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 	apiResolver, err := createApiResolver(
 		generalConfig,
 		stateComponents.AccountsAdapter,
+		stateComponents.AccountsRecreator,
 		stateComponents.PeerAccounts,
 		stateComponents.AddressPubkeyConverter,
 		dataComponents.Store,
@@ -1258,6 +1273,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		coreComponents.Uint64ByteSliceConverter,
 		shardCoordinator,
 		statusHandlersInfo.StatusMetrics,
+		statusHandlersInfo.PrometheusMetrics,
 		gasSchedule,
 		economicsData,
 		cryptoComponents.MessageSignVerifier,
@@ -1275,6 +1291,8 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		statusPollingInterval,
 		networkComponents,
 		processComponents,
+		dataComponents,
+		triesComponents,
 		shardCoordinator,
 	)
 	if err != nil {
@@ -1320,6 +1338,11 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
+	log.Debug("loading the transactions pool snapshot...")
+	err = processComponents.TxPoolSnapshot.LoadSnapshot()
+	log.LogIfError(err)
+	processComponents.TxPoolSnapshot.StartSnapshotting()
+
 	log.Info("application is now running")
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
@@ -1331,6 +1354,20 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		log.Info("terminating at internal stop signal", "reason", sig.Reason)
 	}
 
+	log.Debug("closing the events notifier...")
+	err = processComponents.EventsNotifier.Close()
+	log.LogIfError(err)
+
+	if !check.IfNil(dbIndexer) {
+		log.Debug("closing the indexer...")
+		err = dbIndexer.Close()
+		log.LogIfError(err)
+	}
+
+	log.Debug("saving the transactions pool snapshot...")
+	err = processComponents.TxPoolSnapshot.Close()
+	log.LogIfError(err)
+
 	log.Debug("closing all store units....")
 	err = dataComponents.Store.CloseAll()
 	log.LogIfError(err)
@@ -1691,6 +1728,7 @@ func createNodesCoordinator(
 	currentShardID uint32,
 	chanStopNodeProcess chan endProcess.ArgEndProcess,
 	bootstrapParameters bootstrap.Parameters,
+	consensusType string,
 ) (sharding.NodesCoordinator, error) {
 	shardIDAsObserver, err := processDestinationShardAsObserver(prefsConfig)
 	if err != nil {
@@ -1776,6 +1814,7 @@ func createNodesCoordinator(
 		ConsensusGroupCache:     consensusGroupCache,
 		ShuffledOutHandler:      shuffledOutHandler,
 		Epoch:                   currentEpoch,
+		RoundRobinConsensus:     consensusType == consensus.RoundRobinConsensusType,
 	}
 
 	baseNodesCoordinator, err := sharding.NewIndexHashedNodesCoordinator(argumentsNodesCoordinator)
@@ -1808,12 +1847,12 @@ func processDestinationShardAsObserver(prefsConfig config.PreferencesConfig) (ui
 	return uint32(val), err
 }
 
-// createElasticIndexer creates a new elasticIndexer where the server listens on the url,
-// authentication for the server is using the username and password
-func createElasticIndexer(
+// createIndexer creates a new indexer which persists the node's data through the given driver, configured
+// from the external config
+func createIndexer(
 	ctx *cli.Context,
-	elasticSearchConfig config.ElasticSearchConfig,
-	url string,
+	driver string,
+	externalConfig config.ExternalConfig,
 	marshalizer marshal.Marshalizer,
 	hasher hashing.Hasher,
 	nodesCoordinator sharding.NodesCoordinator,
@@ -1821,11 +1860,10 @@ func createElasticIndexer(
 	addressPubkeyConverter core.PubkeyConverter,
 	validatorPubkeyConverter core.PubkeyConverter,
 	shardId uint32,
+	pathManager storage.PathManagerHandler,
+	statusHandler core.AppStatusHandler,
 ) (indexer.Indexer, error) {
-	arguments := indexer.ElasticIndexerArgs{
-		Url:                      url,
-		UserName:                 elasticSearchConfig.Username,
-		Password:                 elasticSearchConfig.Password,
+	arguments := indexer.DataIndexerArgs{
 		Marshalizer:              marshalizer,
 		Hasher:                   hasher,
 		Options:                  &indexer.Options{TxIndexingEnabled: ctx.GlobalBoolT(enableTxIndexing.Name)},
@@ -1834,11 +1872,30 @@ func createElasticIndexer(
 		AddressPubkeyConverter:   addressPubkeyConverter,
 		ValidatorPubkeyConverter: validatorPubkeyConverter,
 		ShardId:                  shardId,
+		ExternalConfig:           externalConfig,
+		StatusHandler:            statusHandler,
 	}
 
 	var err error
-	dbIndexer, err = indexer.NewElasticIndexer(arguments)
+	retryQueueConfig := externalConfig.ElasticSearchConnector.RetryQueue
+	if driver == indexer.ElasticSearchDriver && retryQueueConfig.Enabled {
+		dbConfig := storageFactory.GetDBFromConfig(retryQueueConfig.Storage.DB)
+		dbConfig.FilePath = pathManager.PathForStatic(core.GetShardIdString(shardId), retryQueueConfig.Storage.DB.FilePath)
+		arguments.RetryQueueStorer, err = storageUnit.NewStorageUnitFromConf(
+			storageFactory.GetCacherFromConfig(retryQueueConfig.Storage.Cache),
+			dbConfig,
+			storageFactory.GetBloomFromConfig(retryQueueConfig.Storage.Bloom),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	dbIndexer, err = indexer.NewDataIndexer(driver, arguments)
 	if err != nil {
+		if !check.IfNil(arguments.RetryQueueStorer) {
+			_ = arguments.RetryQueueStorer.Close()
+		}
 		return nil, err
 	}
 
@@ -2018,6 +2075,7 @@ func createNode(
 		node.WithAddressPubkeyConverter(stateComponents.AddressPubkeyConverter),
 		node.WithValidatorPubkeyConverter(stateComponents.ValidatorPubkeyConverter),
 		node.WithAccountsAdapter(stateComponents.AccountsAdapter),
+		node.WithAccountsRecreator(stateComponents.AccountsRecreator),
 		node.WithBlockChain(data.Blkc),
 		node.WithDataStore(data.Store),
 		node.WithRoundDuration(nodesConfig.RoundDuration),
@@ -2066,6 +2124,9 @@ func createNode(
 		node.WithPublicKeySize(config.ValidatorPubkeyConverter.Length),
 		node.WithNodeStopChannel(chanStopNodeProcess),
 		node.WithApiTransactionByHashThrottler(apiTxsByHashThrottler),
+		node.WithAccountHistory(process.AccountHistory),
+		node.WithEventsSubscriber(process.EventsNotifier),
+		node.WithEquivocationProofsPool(process.EquivocationProofsPool),
 	)
 	if err != nil {
 		return nil, errors.New("error creating node: " + err.Error())
@@ -2104,6 +2165,16 @@ func createNode(
 		return nil, err
 	}
 
+	roundTracer, err := nodeDebugFactory.CreateConsensusDebugHandler(nd, config.Debug.Consensus)
+	if err != nil {
+		return nil, err
+	}
+
+	err = nd.ApplyOptions(node.WithRoundTracer(roundTracer))
+	if err != nil {
+		return nil, errors.New("error creating node: " + err.Error())
+	}
+
 	return nd, nil
 }
 
@@ -2188,6 +2259,7 @@ func startStatisticsMonitor(
 func createApiResolver(
 	config *config.Config,
 	accnts state.AccountsAdapter,
+	accountsRecreator state.AccountsAdapterRecreator,
 	validatorAccounts state.AccountsAdapter,
 	pubkeyConv core.PubkeyConverter,
 	storageService dataRetriever.StorageService,
@@ -2197,6 +2269,7 @@ func createApiResolver(
 	uint64Converter typeConverters.Uint64ByteSliceConverter,
 	shardCoordinator sharding.Coordinator,
 	statusMetrics external.StatusMetricsHandler,
+	prometheusMetrics external.PrometheusMetricsHandler,
 	gasSchedule map[string]map[string]uint64,
 	economics *economics.EconomicsData,
 	messageSigVerifier vm.MessageSignVerifier,
@@ -2207,9 +2280,11 @@ func createApiResolver(
 	var err error
 
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasMap:          gasSchedule,
-		MapDNSAddresses: make(map[string]struct{}),
-		Marshalizer:     marshalizer,
+		GasMap:           gasSchedule,
+		MapDNSAddresses:  make(map[string]struct{}),
+		Marshalizer:      marshalizer,
+		Accounts:         accnts,
+		ShardCoordinator: shardCoordinator,
 	}
 	builtInFuncs, err := builtInFunctions.CreateBuiltInFunctionContainer(argsBuiltIn)
 	if err != nil {
@@ -2258,7 +2333,13 @@ func createApiResolver(
 		return nil, err
 	}
 
-	scQueryService, err := smartContract.NewSCQueryService(vmContainer, economics)
+	argsQueryService := smartContract.ArgsNewSCQueryService{
+		VmContainer:       vmContainer,
+		EconomicsFee:      economics,
+		AccountsHolder:    vmFactory.BlockChainHookImpl(),
+		AccountsRecreator: accountsRecreator,
+	}
+	scQueryService, err := smartContract.NewSCQueryService(argsQueryService)
 	if err != nil {
 		return nil, err
 	}
@@ -2279,7 +2360,68 @@ func createApiResolver(
 		return nil, err
 	}
 
-	return external.NewNodeApiResolver(scQueryService, statusMetrics, txCostHandler)
+	txSimulator, err := createTxSimulator(
+		config,
+		argsHook,
+		accountsRecreator,
+		blockChain,
+		gasSchedule,
+		economics,
+		txTypeHandler,
+		hasher,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return external.NewNodeApiResolver(scQueryService, statusMetrics, txCostHandler, txSimulator, prometheusMetrics)
+}
+
+func createTxSimulator(
+	config *config.Config,
+	argsHook hooks.ArgBlockChainHook,
+	accountsRecreator state.AccountsAdapterRecreator,
+	blockChain data.ChainHandler,
+	gasSchedule map[string]map[string]uint64,
+	economics *economics.EconomicsData,
+	txTypeHandler process.TxTypeHandler,
+	hasher hashing.Hasher,
+) (external.TransactionSimulatorHandler, error) {
+	shardCoordinator := argsHook.ShardCoordinator
+	if shardCoordinator.SelfId() == core.MetachainShardId {
+		return disabledTxSimulator.NewTxSimulator(), nil
+	}
+
+	// the simulator has its own VMs as it points their blockchain hook to the simulated state
+	vmFactory, err := shard.NewVMContainerFactory(
+		config.VirtualMachineConfig,
+		economics.MaxGasLimitPerBlock(shardCoordinator.SelfId()),
+		gasSchedule,
+		argsHook)
+	if err != nil {
+		return nil, err
+	}
+
+	vmContainer, err := vmFactory.Create()
+	if err != nil {
+		return nil, err
+	}
+
+	argsTxSimulator := txsimulator.ArgsTxSimulator{
+		VmContainer:       vmContainer,
+		BlockChainHook:    vmFactory.BlockChainHookImpl(),
+		AccountsRecreator: accountsRecreator,
+		BlockChain:        blockChain,
+		ShardCoordinator:  shardCoordinator,
+		PubkeyConverter:   argsHook.PubkeyConv,
+		Hasher:            hasher,
+		Marshalizer:       argsHook.Marshalizer,
+		EconomicsFee:      economics,
+		TxTypeHandler:     txTypeHandler,
+		ArgsParser:        vmcommon.NewAtArgumentParser(),
+	}
+
+	return txsimulator.NewTxSimulator(argsTxSimulator)
 }
 
 func createWhiteListerVerifiedTxs(generalConfig *config.Config) (process.WhiteListHandler, error) {
@@ -10,6 +10,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/appStatusPolling"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/counting"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
 	mainFactory "github.com/ElrondNetwork/elrond-go/factory"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -17,6 +19,11 @@ import (
 
 const millisecondsInSecond = 1000
 
+// trieStatisticsHandler is implemented by the trie storage managers able to report their snapshot and pruning state
+type trieStatisticsHandler interface {
+	StorageStatistics() trie.StorageStatistics
+}
+
 // InitMetrics will init metrics for status handler
 func InitMetrics(
 	appStatusHandler core.AppStatusHandler,
@@ -133,6 +140,8 @@ func StartStatusPolling(
 	pollingInterval time.Duration,
 	networkComponents *mainFactory.NetworkComponents,
 	processComponents *factory.Process,
+	dataComponents *mainFactory.DataComponents,
+	triesComponents *mainFactory.TriesComponents,
 	shardCoordinator sharding.Coordinator,
 ) error {
 	if ash == nil {
@@ -144,6 +153,12 @@ func StartStatusPolling(
 	if processComponents == nil {
 		return errors.New("nil processComponents")
 	}
+	if dataComponents == nil {
+		return errors.New("nil dataComponents")
+	}
+	if triesComponents == nil {
+		return errors.New("nil triesComponents")
+	}
 	if check.IfNil(shardCoordinator) {
 		return errors.New("nil shard coordinator")
 	}
@@ -168,6 +183,16 @@ func StartStatusPolling(
 		return err
 	}
 
+	err = registerPoolsInformation(appStatusPollingHandler, dataComponents)
+	if err != nil {
+		return err
+	}
+
+	err = registerTrieStorageInformation(appStatusPollingHandler, triesComponents)
+	if err != nil {
+		return err
+	}
+
 	appStatusPollingHandler.Poll()
 
 	return nil
@@ -212,6 +237,63 @@ func registerShardsInformation(
 	return nil
 }
 
+func registerPoolsInformation(
+	appStatusPollingHandler *appStatusPolling.AppStatusPolling,
+	dataComponents *mainFactory.DataComponents,
+) error {
+
+	computePoolsInfo := func(appStatusHandler core.AppStatusHandler) {
+		dataPool := dataComponents.Datapool
+		if check.IfNil(dataPool) {
+			return
+		}
+
+		txPool, ok := dataPool.Transactions().(counting.Countable)
+		if ok {
+			appStatusHandler.SetInt64Value(core.MetricTxPoolSize, txPool.GetCounts().GetTotal())
+		}
+		if !check.IfNil(dataPool.MiniBlocks()) {
+			appStatusHandler.SetUInt64Value(core.MetricMiniBlocksPoolSize, uint64(dataPool.MiniBlocks().Len()))
+		}
+	}
+
+	err := appStatusPollingHandler.RegisterPollingFunc(computePoolsInfo)
+	if err != nil {
+		return fmt.Errorf("%w, cannot register handler func for pools information", err)
+	}
+
+	return nil
+}
+
+func registerTrieStorageInformation(
+	appStatusPollingHandler *appStatusPolling.AppStatusPolling,
+	triesComponents *mainFactory.TriesComponents,
+) error {
+
+	computeTrieStorageInfo := func(appStatusHandler core.AppStatusHandler) {
+		for identifier, storageManager := range triesComponents.TrieStorageManagers {
+			statisticsHandler, ok := storageManager.(trieStatisticsHandler)
+			if !ok {
+				continue
+			}
+
+			stats := statisticsHandler.StorageStatistics()
+			suffix := "_" + identifier
+			appStatusHandler.SetUInt64Value(core.MetricTrieNumSnapshots+suffix, uint64(stats.NumSnapshots))
+			appStatusHandler.SetUInt64Value(core.MetricTrieNumQueuedSnapshots+suffix, uint64(stats.NumQueuedSnapshots))
+			appStatusHandler.SetUInt64Value(core.MetricTrieNumSnapshotsInProgress+suffix, uint64(stats.NumSnapshotsInProgress))
+			appStatusHandler.SetUInt64Value(core.MetricTriePruningBufferLen+suffix, uint64(stats.PruningBufferLen))
+		}
+	}
+
+	err := appStatusPollingHandler.RegisterPollingFunc(computeTrieStorageInfo)
+	if err != nil {
+		return fmt.Errorf("%w, cannot register handler func for trie storage information", err)
+	}
+
+	return nil
+}
+
 func computeNumConnectedPeers(
 	appStatusHandler core.AppStatusHandler,
 	networkComponents *mainFactory.NetworkComponents,
@@ -2,12 +2,14 @@ package config
 
 // CacheConfig will map the json cache configuration
 type CacheConfig struct {
-	Type                 string
-	Capacity             uint32
-	SizePerSender        uint32
-	SizeInBytes          uint64
-	SizeInBytesPerSender uint32
-	Shards               uint32
+	Type                      string
+	Capacity                  uint32
+	SizePerSender             uint32
+	SizeInBytes               uint64
+	SizeInBytesPerSender      uint32
+	Shards                    uint32
+	MinGasPriceBumpPercentage uint32
+	SelectionPolicy           string
 }
 
 //HeadersPoolConfig will map the headers cache configuration
@@ -147,6 +149,9 @@ type Config struct {
 	Consensus           TypeConfig
 	StoragePruning      StoragePruningConfig
 	TxLogsStorage       StorageConfig
+	AccountHistory      AccountHistoryConfig
+	EventsNotifier      EventsNotifierConfig
+	TxPoolSnapshot      TxPoolSnapshotConfig
 
 	NTPConfig               NTPConfig
 	HeadersPoolConfig       HeadersPoolConfig
@@ -183,6 +188,28 @@ type HeartbeatConfig struct {
 	HeartbeatStorage                    StorageConfig
 }
 
+// AccountHistoryConfig will hold the settings for the local transactions-by-address index
+type AccountHistoryConfig struct {
+	Enabled               bool
+	MaxEntriesPerEpoch    uint32
+	AccountHistoryStorage StorageConfig
+}
+
+// EventsNotifierConfig will hold the settings for streaming blocks, transactions and smart contract logs to subscribers
+type EventsNotifierConfig struct {
+	Enabled             bool
+	MaxSubscribers      int
+	SubscriberQueueSize int
+	MaxFilterEntries    int
+}
+
+// TxPoolSnapshotConfig will hold the settings for persisting the transactions pool across restarts
+type TxPoolSnapshotConfig struct {
+	Enabled               bool
+	SnapshotIntervalInSec int
+	TxPoolSnapshotStorage StorageConfig
+}
+
 // ValidatorStatisticsConfig will hold validator statistics specific settings
 type ValidatorStatisticsConfig struct {
 	CacheRefreshIntervalInSec uint32
@@ -320,6 +347,7 @@ type HardforkConfig struct {
 type DebugConfig struct {
 	InterceptorResolver InterceptorResolverDebugConfig
 	Antiflood           AntifloodDebugConfig
+	Consensus           ConsensusDebugConfig
 }
 
 // InterceptorResolverDebugConfig will hold the interceptor-resolver debug configuration
@@ -340,9 +368,46 @@ type AntifloodDebugConfig struct {
 	IntervalAutoPrintInSeconds int
 }
 
+// ConsensusDebugConfig will hold the consensus round tracer debug configuration
+type ConsensusDebugConfig struct {
+	Enabled         bool
+	NumRoundsToKeep int
+}
+
 // ApiRoutesConfig holds the configuration related to Rest API routes
 type ApiRoutesConfig struct {
-	APIPackages map[string]APIPackageConfig
+	APIPackages    map[string]APIPackageConfig
+	Authentication ApiAuthenticationConfig
+	Rpc            ApiRpcConfig
+}
+
+// ApiRpcConfig holds the settings of the JSON-RPC endpoint
+type ApiRpcConfig struct {
+	// MaxBodySizeInBytes is the maximum size of a request body, single request or batch
+	MaxBodySizeInBytes int64
+}
+
+// ApiAuthenticationConfig holds the settings for authenticating the Rest API callers with API keys
+type ApiAuthenticationConfig struct {
+	Enabled bool
+	// HeaderName is the name of the request header holding the API key
+	HeaderName string
+	// AllowAnonymous allows the requests without an API key on the routes not restricted to permission groups
+	AllowAnonymous bool
+	Keys           []ApiKeyConfig
+}
+
+// ApiKeyConfig holds the settings of a single API key
+type ApiKeyConfig struct {
+	Name   string
+	Key    string
+	Groups []string
+	// MaxRequestsPerWindow and WindowInSec define the rate limit of the key
+	MaxRequestsPerWindow uint32
+	WindowInSec          uint32
+	// MaxRequestsPerQuota and QuotaPeriodInSec define the quota of the key, 0 meaning no quota
+	MaxRequestsPerQuota uint32
+	QuotaPeriodInSec    uint32
 }
 
 // APIPackageConfig holds the configuration for the routes of each package
@@ -354,4 +419,6 @@ type APIPackageConfig struct {
 type RouteConfig struct {
 	Name string
 	Open bool
+	// Groups restricts the route to the API keys belonging to one of these permission groups. Empty means unrestricted
+	Groups []string
 }
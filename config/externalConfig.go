@@ -3,12 +3,41 @@ package config
 // ExternalConfig will hold the configurations for external tools, such as Explorer or Elastic Search
 type ExternalConfig struct {
 	ElasticSearchConnector ElasticSearchConfig
+	FileIndexerConnector   FileIndexerConfig
+	SQLIndexerConnector    SQLIndexerConfig
 }
 
 // ElasticSearchConfig will hold the configuration for the elastic search
 type ElasticSearchConfig struct {
-	Enabled  bool
-	URL      string
-	Username string
-	Password string
+	Enabled    bool
+	URL        string
+	Username   string
+	Password   string
+	RetryQueue ElasticSearchRetryQueueConfig
+}
+
+// ElasticSearchRetryQueueConfig will hold the configuration for the disk backed queue of the elastic search
+// requests which could not be sent and are retried
+type ElasticSearchRetryQueueConfig struct {
+	Enabled            bool
+	InitialBackoffInMs uint32
+	MaxBackoffInMs     uint32
+	MaxQueueSize       uint64
+	Storage            StorageConfig
+}
+
+// FileIndexerConfig will hold the configuration for the indexer which writes JSON lines files
+type FileIndexerConfig struct {
+	Enabled         bool
+	Directory       string
+	MaxFileSizeInMB uint64
+	MaxNumFiles     uint32
+}
+
+// SQLIndexerConfig will hold the configuration for the indexer which writes in a SQL database
+type SQLIndexerConfig struct {
+	Enabled            bool
+	DriverName         string
+	DataSourceName     string
+	MaxOpenConnections int
 }
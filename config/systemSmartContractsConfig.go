@@ -2,7 +2,8 @@ package config
 
 // SystemSmartContractsConfig defines the system smart contract configs
 type SystemSmartContractsConfig struct {
-	ESDTSystemSCConfig ESDTSystemSCConfig
+	ESDTSystemSCConfig    ESDTSystemSCConfig
+	StakingSystemSCConfig StakingSystemSCConfig
 }
 
 // ESDTSystemSCConfig defines a set of constant to initialize the esdt system smart contract
@@ -10,3 +11,8 @@ type ESDTSystemSCConfig struct {
 	BaseIssuingCost string
 	OwnerAddress    string
 }
+
+// StakingSystemSCConfig defines a set of constants to initialize the staking system smart contract
+type StakingSystemSCConfig struct {
+	EquivocationSlashPercentage float64
+}
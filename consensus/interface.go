@@ -11,6 +11,9 @@ import (
 // BlsConsensusType specifies the signature scheme used in the consensus
 const BlsConsensusType = "bls"
 
+// RoundRobinConsensusType specifies the round-robin single leader consensus, which signs the blocks with BLS keys
+const RoundRobinConsensusType = "roundrobin"
+
 // Rounder defines the actions which should be handled by a round implementation
 type Rounder interface {
 	Index() int64
@@ -96,3 +99,15 @@ type HeadersPoolSubscriber interface {
 	RegisterHandler(handler func(headerHandler data.HeaderHandler, headerHash []byte))
 	IsInterfaceNil() bool
 }
+
+// RoundTracer records how each consensus round unfolded: the leader, the consensus group, the subrounds timings,
+// the received messages and the final outcome
+type RoundTracer interface {
+	StartRound(round int64, leader string, consensusGroup []string)
+	AddSubround(round int64, subround SubroundTrace)
+	AddReceivedMessage(round int64, msgType string, pubKey []byte, sinceRoundStart time.Duration)
+	SetMissingSigners(round int64, pubKeys []string)
+	EndRound(round int64, outcome string)
+	Query(search string) []string
+	IsInterfaceNil() bool
+}
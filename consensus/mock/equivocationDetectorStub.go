@@ -0,0 +1,31 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// EquivocationDetectorStub -
+type EquivocationDetectorStub struct {
+	CheckConsensusMessageCalled func(cnsMsg *consensus.Message, rawMessage []byte)
+	CheckHeaderCalled           func(header data.HeaderHandler, leaderPubKey []byte)
+}
+
+// CheckConsensusMessage -
+func (eds *EquivocationDetectorStub) CheckConsensusMessage(cnsMsg *consensus.Message, rawMessage []byte) {
+	if eds.CheckConsensusMessageCalled != nil {
+		eds.CheckConsensusMessageCalled(cnsMsg, rawMessage)
+	}
+}
+
+// CheckHeader -
+func (eds *EquivocationDetectorStub) CheckHeader(header data.HeaderHandler, leaderPubKey []byte) {
+	if eds.CheckHeaderCalled != nil {
+		eds.CheckHeaderCalled(header, leaderPubKey)
+	}
+}
+
+// IsInterfaceNil -
+func (eds *EquivocationDetectorStub) IsInterfaceNil() bool {
+	return eds == nil
+}
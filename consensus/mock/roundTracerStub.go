@@ -0,0 +1,66 @@
+package mock
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+// RoundTracerStub -
+type RoundTracerStub struct {
+	StartRoundCalled         func(round int64, leader string, consensusGroup []string)
+	AddSubroundCalled        func(round int64, subround consensus.SubroundTrace)
+	AddReceivedMessageCalled func(round int64, msgType string, pubKey []byte, sinceRoundStart time.Duration)
+	SetMissingSignersCalled  func(round int64, pubKeys []string)
+	EndRoundCalled           func(round int64, outcome string)
+	QueryCalled              func(search string) []string
+}
+
+// StartRound -
+func (rts *RoundTracerStub) StartRound(round int64, leader string, consensusGroup []string) {
+	if rts.StartRoundCalled != nil {
+		rts.StartRoundCalled(round, leader, consensusGroup)
+	}
+}
+
+// AddSubround -
+func (rts *RoundTracerStub) AddSubround(round int64, subround consensus.SubroundTrace) {
+	if rts.AddSubroundCalled != nil {
+		rts.AddSubroundCalled(round, subround)
+	}
+}
+
+// AddReceivedMessage -
+func (rts *RoundTracerStub) AddReceivedMessage(round int64, msgType string, pubKey []byte, sinceRoundStart time.Duration) {
+	if rts.AddReceivedMessageCalled != nil {
+		rts.AddReceivedMessageCalled(round, msgType, pubKey, sinceRoundStart)
+	}
+}
+
+// SetMissingSigners -
+func (rts *RoundTracerStub) SetMissingSigners(round int64, pubKeys []string) {
+	if rts.SetMissingSignersCalled != nil {
+		rts.SetMissingSignersCalled(round, pubKeys)
+	}
+}
+
+// EndRound -
+func (rts *RoundTracerStub) EndRound(round int64, outcome string) {
+	if rts.EndRoundCalled != nil {
+		rts.EndRoundCalled(round, outcome)
+	}
+}
+
+// Query -
+func (rts *RoundTracerStub) Query(search string) []string {
+	if rts.QueryCalled != nil {
+		return rts.QueryCalled(search)
+	}
+
+	return make([]string, 0)
+}
+
+// IsInterfaceNil -
+func (rts *RoundTracerStub) IsInterfaceNil() bool {
+	return rts == nil
+}
@@ -0,0 +1,20 @@
+package mock
+
+// SignatureVerifierStub -
+type SignatureVerifierStub struct {
+	VerifyCalled func(message []byte, signedMessage []byte, pubKey []byte) error
+}
+
+// Verify -
+func (svs *SignatureVerifierStub) Verify(message []byte, signedMessage []byte, pubKey []byte) error {
+	if svs.VerifyCalled != nil {
+		return svs.VerifyCalled(message, signedMessage, pubKey)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (svs *SignatureVerifierStub) IsInterfaceNil() bool {
+	return svs == nil
+}
@@ -25,6 +25,7 @@ type SposWorkerMock struct {
 	DisplayStatisticsCalled                func()
 	ReceivedHeaderCalled                   func(headerHandler data.HeaderHandler, headerHash []byte)
 	SetAppStatusHandlerCalled              func(ash core.AppStatusHandler) error
+	SetRoundTracerCalled                   func(roundTracer consensus.RoundTracer) error
 }
 
 // AddReceivedMessageCall -
@@ -98,6 +99,15 @@ func (sposWorkerMock *SposWorkerMock) SetAppStatusHandler(ash core.AppStatusHand
 	return nil
 }
 
+// SetRoundTracer -
+func (sposWorkerMock *SposWorkerMock) SetRoundTracer(roundTracer consensus.RoundTracer) error {
+	if sposWorkerMock.SetRoundTracerCalled != nil {
+		return sposWorkerMock.SetRoundTracerCalled(roundTracer)
+	}
+
+	return nil
+}
+
 // Close -
 func (sposWorkerMock *SposWorkerMock) Close() error {
 	return nil
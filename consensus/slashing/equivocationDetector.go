@@ -0,0 +1,191 @@
+package slashing
+
+import (
+	"bytes"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("consensus/slashing")
+
+// numRoundsToKeep is the number of past rounds for which the signed items are kept
+const numRoundsToKeep = 10
+
+type signedRecord struct {
+	item         SignedItem
+	proposedHash []byte
+	reported     bool
+}
+
+// ArgsEquivocationDetector holds the arguments needed to create an equivocation detector
+type ArgsEquivocationDetector struct {
+	Marshalizer       marshal.Marshalizer
+	Hasher            hashing.Hasher
+	SignatureVerifier SignatureVerifier
+	Messenger         MessageBroadcaster
+	ProofsPool        storage.Cacher
+}
+
+type equivocationDetector struct {
+	marshalizer marshal.Marshalizer
+	verifier    *proofVerifier
+	messenger   MessageBroadcaster
+	proofsPool  storage.Cacher
+
+	mutRecords sync.Mutex
+	records    map[uint64]map[string]*signedRecord
+	lastRound  uint64
+}
+
+// NewEquivocationDetector creates a component which detects the keys signing or proposing two different blocks in the
+// same round. Each detected equivocation is packed as a proof, added to the proofs pool and broadcast on the
+// equivocation proofs topic
+func NewEquivocationDetector(args ArgsEquivocationDetector) (*equivocationDetector, error) {
+	if check.IfNil(args.Messenger) {
+		return nil, ErrNilMessenger
+	}
+	if check.IfNil(args.ProofsPool) {
+		return nil, ErrNilProofsPool
+	}
+
+	verifier, err := NewProofVerifier(ArgsProofVerifier{
+		Marshalizer:       args.Marshalizer,
+		Hasher:            args.Hasher,
+		SignatureVerifier: args.SignatureVerifier,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &equivocationDetector{
+		marshalizer: args.Marshalizer,
+		verifier:    verifier,
+		messenger:   args.Messenger,
+		proofsPool:  args.ProofsPool,
+		records:     make(map[uint64]map[string]*signedRecord),
+	}, nil
+}
+
+// CheckConsensusMessage records the block hash signed by the sender of a consensus message. The message should have
+// already passed the signature verification, rawMessage being its marshalized form, as received from the network
+func (ed *equivocationDetector) CheckConsensusMessage(cnsMsg *consensus.Message, rawMessage []byte) {
+	if cnsMsg == nil || len(cnsMsg.BlockHeaderHash) == 0 || cnsMsg.RoundIndex < 0 {
+		return
+	}
+
+	item := SignedItem{
+		Kind: ConsensusMessageItem,
+		Data: rawMessage,
+	}
+	ed.record(cnsMsg.PubKey, uint64(cnsMsg.RoundIndex), cnsMsg.BlockHeaderHash, item)
+}
+
+// CheckHeader records the block hash proposed by the leader of a header, once its leader signature is verified
+func (ed *equivocationDetector) CheckHeader(header data.HeaderHandler, leaderPubKey []byte) {
+	if check.IfNil(header) || len(leaderPubKey) == 0 {
+		return
+	}
+
+	var kind byte
+	switch header.(type) {
+	case *block.Header:
+		kind = ShardHeaderItem
+	case *block.MetaBlock:
+		kind = MetaHeaderItem
+	default:
+		return
+	}
+
+	buff, err := ed.marshalizer.Marshal(header)
+	if err != nil {
+		log.Debug("equivocationDetector.CheckHeader: marshal header", "error", err.Error())
+		return
+	}
+
+	item := SignedItem{
+		Kind: kind,
+		Data: buff,
+	}
+	round, proposedHash, err := ed.verifier.verifyItem(leaderPubKey, item)
+	if err != nil {
+		log.Trace("equivocationDetector.CheckHeader: header not signed by the leader", "error", err.Error())
+		return
+	}
+
+	ed.record(leaderPubKey, round, proposedHash, item)
+}
+
+func (ed *equivocationDetector) record(pubKey []byte, round uint64, proposedHash []byte, item SignedItem) {
+	ed.mutRecords.Lock()
+	ed.removeOldRecords(round)
+
+	recordsInRound, ok := ed.records[round]
+	if !ok {
+		recordsInRound = make(map[string]*signedRecord)
+		ed.records[round] = recordsInRound
+	}
+
+	previous, ok := recordsInRound[string(pubKey)]
+	if !ok {
+		recordsInRound[string(pubKey)] = &signedRecord{
+			item:         item,
+			proposedHash: proposedHash,
+		}
+		ed.mutRecords.Unlock()
+		return
+	}
+
+	isEquivocation := !previous.reported && !bytes.Equal(previous.proposedHash, proposedHash)
+	if isEquivocation {
+		previous.reported = true
+	}
+	ed.mutRecords.Unlock()
+
+	if isEquivocation {
+		ed.report(pubKey, round, previous.item, item)
+	}
+}
+
+// removeOldRecords should be called under mutex protection
+func (ed *equivocationDetector) removeOldRecords(round uint64) {
+	if round <= ed.lastRound {
+		return
+	}
+
+	ed.lastRound = round
+	for recordedRound := range ed.records {
+		if recordedRound+numRoundsToKeep < round {
+			delete(ed.records, recordedRound)
+		}
+	}
+}
+
+func (ed *equivocationDetector) report(pubKey []byte, round uint64, first SignedItem, second SignedItem) {
+	proof, err := CreateProof(ed.marshalizer, pubKey, first, second)
+	if err != nil {
+		log.Debug("equivocationDetector.report: create proof", "error", err.Error())
+		return
+	}
+
+	log.Warn("equivocation detected",
+		"pk", pubKey,
+		"round", round,
+	)
+
+	ed.proofsPool.Put(proofKey(pubKey, round), proof, len(proof))
+	ed.messenger.Broadcast(core.EquivocationProofsTopic, proof)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ed *equivocationDetector) IsInterfaceNil() bool {
+	return ed == nil
+}
@@ -0,0 +1,119 @@
+package slashing_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/slashing"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createArgsEquivocationDetector(broadcastHandler func(topic string, buff []byte)) slashing.ArgsEquivocationDetector {
+	return slashing.ArgsEquivocationDetector{
+		Marshalizer:       testMarshalizer,
+		Hasher:            testHasher,
+		SignatureVerifier: createSignatureVerifier(),
+		Messenger:         &mock.MessengerStub{BroadcastCalled: broadcastHandler},
+		ProofsPool:        mock.NewCacherMock(),
+	}
+}
+
+func TestNewEquivocationDetector_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsEquivocationDetector(nil)
+	args.Messenger = nil
+	ed, err := slashing.NewEquivocationDetector(args)
+	assert.True(t, check.IfNil(ed))
+	assert.Equal(t, slashing.ErrNilMessenger, err)
+
+	args = createArgsEquivocationDetector(nil)
+	args.ProofsPool = nil
+	ed, err = slashing.NewEquivocationDetector(args)
+	assert.True(t, check.IfNil(ed))
+	assert.Equal(t, slashing.ErrNilProofsPool, err)
+
+	args = createArgsEquivocationDetector(nil)
+	args.SignatureVerifier = nil
+	ed, err = slashing.NewEquivocationDetector(args)
+	assert.True(t, check.IfNil(ed))
+	assert.Equal(t, slashing.ErrNilSignatureVerifier, err)
+}
+
+func TestEquivocationDetector_SameBlockShouldNotReport(t *testing.T) {
+	t.Parallel()
+
+	numBroadcasts := 0
+	ed, _ := slashing.NewEquivocationDetector(createArgsEquivocationDetector(func(_ string, _ []byte) {
+		numBroadcasts++
+	}))
+
+	header, proposedHash := createSignedHeader(offenderPubKey, 5, "root hash")
+	proposal, proposalBuff := createSignedMessage(offenderPubKey, 5, proposedHash)
+	ed.CheckConsensusMessage(proposal, proposalBuff)
+	ed.CheckConsensusMessage(proposal, proposalBuff)
+	ed.CheckHeader(header, offenderPubKey)
+
+	assert.Equal(t, 0, numBroadcasts)
+}
+
+func TestEquivocationDetector_TwoBlocksInTheSameRoundShouldReportOnce(t *testing.T) {
+	t.Parallel()
+
+	var broadcastTopic string
+	var broadcastProof []byte
+	numBroadcasts := 0
+	args := createArgsEquivocationDetector(func(topic string, buff []byte) {
+		broadcastTopic = topic
+		broadcastProof = buff
+		numBroadcasts++
+	})
+	ed, _ := slashing.NewEquivocationDetector(args)
+
+	first, firstBuff := createSignedMessage(offenderPubKey, 5, []byte("hash A"))
+	second, secondBuff := createSignedMessage(offenderPubKey, 5, []byte("hash B"))
+	third, thirdBuff := createSignedMessage(offenderPubKey, 5, []byte("hash C"))
+	otherRound, otherRoundBuff := createSignedMessage(offenderPubKey, 6, []byte("hash D"))
+	ed.CheckConsensusMessage(first, firstBuff)
+	ed.CheckConsensusMessage(otherRound, otherRoundBuff)
+	ed.CheckConsensusMessage(second, secondBuff)
+	ed.CheckConsensusMessage(third, thirdBuff)
+
+	require.Equal(t, 1, numBroadcasts)
+	assert.Equal(t, core.EquivocationProofsTopic, broadcastTopic)
+	assert.Equal(t, 1, args.ProofsPool.Len())
+
+	pv, _ := slashing.NewProofVerifier(createArgsProofVerifier())
+	pubKey, round, err := pv.VerifyProof(broadcastProof)
+	assert.Nil(t, err)
+	assert.Equal(t, offenderPubKey, pubKey)
+	assert.Equal(t, uint64(5), round)
+}
+
+func TestEquivocationDetector_HeaderConflictingWithProposalShouldReport(t *testing.T) {
+	t.Parallel()
+
+	var broadcastProof []byte
+	ed, _ := slashing.NewEquivocationDetector(createArgsEquivocationDetector(func(_ string, buff []byte) {
+		broadcastProof = buff
+	}))
+
+	proposal, proposalBuff := createSignedMessage(offenderPubKey, 5, []byte("proposed hash"))
+	ed.CheckConsensusMessage(proposal, proposalBuff)
+
+	notSigned, _ := createSignedHeader([]byte("other key"), 5, "root hash A")
+	ed.CheckHeader(notSigned, offenderPubKey)
+	assert.Nil(t, broadcastProof)
+
+	header, _ := createSignedHeader(offenderPubKey, 5, "root hash B")
+	ed.CheckHeader(header, offenderPubKey)
+	require.NotNil(t, broadcastProof)
+
+	pv, _ := slashing.NewProofVerifier(createArgsProofVerifier())
+	_, round, err := pv.VerifyProof(broadcastProof)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(5), round)
+}
@@ -0,0 +1,36 @@
+package slashing
+
+import "errors"
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilSignatureVerifier signals that a nil signature verifier has been provided
+var ErrNilSignatureVerifier = errors.New("nil signature verifier")
+
+// ErrNilProofVerifier signals that a nil proof verifier has been provided
+var ErrNilProofVerifier = errors.New("nil proof verifier")
+
+// ErrNilMessenger signals that a nil messenger has been provided
+var ErrNilMessenger = errors.New("nil messenger")
+
+// ErrNilProofsPool signals that a nil proofs pool has been provided
+var ErrNilProofsPool = errors.New("nil proofs pool")
+
+// ErrNilAntifloodHandler signals that a nil antiflood handler has been provided
+var ErrNilAntifloodHandler = errors.New("nil antiflood handler")
+
+// ErrNilMessage signals that a nil message has been received
+var ErrNilMessage = errors.New("nil message")
+
+// ErrInvalidProof signals that the equivocation proof is malformed or not correctly signed
+var ErrInvalidProof = errors.New("invalid equivocation proof")
+
+// ErrNoEquivocation signals that the items of the proof do not show two different blocks signed in the same round
+var ErrNoEquivocation = errors.New("the proof does not show an equivocation")
+
+// ErrProofAlreadyKnown signals that a proof for the same key and round is already in the proofs pool
+var ErrProofAlreadyKnown = errors.New("equivocation proof already known")
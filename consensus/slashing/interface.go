@@ -0,0 +1,19 @@
+package slashing
+
+// SignatureVerifier verifies the signature of a message against a public key given as bytes
+type SignatureVerifier interface {
+	Verify(message []byte, signedMessage []byte, pubKey []byte) error
+	IsInterfaceNil() bool
+}
+
+// ProofVerifier verifies an equivocation proof, returning the offending public key and the round of the equivocation
+type ProofVerifier interface {
+	VerifyProof(proof []byte) ([]byte, uint64, error)
+	IsInterfaceNil() bool
+}
+
+// MessageBroadcaster broadcasts a message on a topic
+type MessageBroadcaster interface {
+	Broadcast(topic string, buff []byte)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,212 @@
+package slashing
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/batch"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// The kinds of signed items an equivocation proof is made of
+const (
+	// ConsensusMessageItem is a consensus message carrying a block header hash, signed by its sender
+	ConsensusMessageItem byte = 1
+	// ShardHeaderItem is a shard header signed by its leader
+	ShardHeaderItem byte = 2
+	// MetaHeaderItem is a metachain header signed by its leader
+	MetaHeaderItem byte = 3
+)
+
+// a proof is a batch holding the offending public key followed by the kind and the data of each of the two items
+const numProofFields = 5
+
+// SignedItem is a marshalized consensus message or header, signed by the offending key
+type SignedItem struct {
+	Kind byte
+	Data []byte
+}
+
+// CreateProof packs two items signed by the same key in the same round for two different blocks as an equivocation proof
+func CreateProof(marshalizer marshal.Marshalizer, pubKey []byte, first SignedItem, second SignedItem) ([]byte, error) {
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+
+	proof := &batch.Batch{
+		Data: [][]byte{
+			pubKey,
+			{first.Kind},
+			first.Data,
+			{second.Kind},
+			second.Data,
+		},
+	}
+
+	return marshalizer.Marshal(proof)
+}
+
+// ArgsProofVerifier holds the arguments needed to create an equivocation proof verifier
+type ArgsProofVerifier struct {
+	Marshalizer       marshal.Marshalizer
+	Hasher            hashing.Hasher
+	SignatureVerifier SignatureVerifier
+}
+
+type proofVerifier struct {
+	marshalizer       marshal.Marshalizer
+	hasher            hashing.Hasher
+	signatureVerifier SignatureVerifier
+}
+
+// NewProofVerifier creates a verifier of equivocation proofs
+func NewProofVerifier(args ArgsProofVerifier) (*proofVerifier, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.SignatureVerifier) {
+		return nil, ErrNilSignatureVerifier
+	}
+
+	return &proofVerifier{
+		marshalizer:       args.Marshalizer,
+		hasher:            args.Hasher,
+		signatureVerifier: args.SignatureVerifier,
+	}, nil
+}
+
+// VerifyProof checks that both items of the proof are signed by the same key, in the same round, for two different
+// blocks. It returns the offending public key and the round of the equivocation
+func (pv *proofVerifier) VerifyProof(proof []byte) ([]byte, uint64, error) {
+	proofBatch := &batch.Batch{}
+	err := pv.marshalizer.Unmarshal(proofBatch, proof)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: %s", ErrInvalidProof, err.Error())
+	}
+	if len(proofBatch.Data) != numProofFields || len(proofBatch.Data[1]) != 1 || len(proofBatch.Data[3]) != 1 {
+		return nil, 0, fmt.Errorf("%w: wrong format", ErrInvalidProof)
+	}
+
+	pubKey := proofBatch.Data[0]
+	first := SignedItem{Kind: proofBatch.Data[1][0], Data: proofBatch.Data[2]}
+	second := SignedItem{Kind: proofBatch.Data[3][0], Data: proofBatch.Data[4]}
+
+	firstRound, firstHash, err := pv.verifyItem(pubKey, first)
+	if err != nil {
+		return nil, 0, err
+	}
+	secondRound, secondHash, err := pv.verifyItem(pubKey, second)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if firstRound != secondRound {
+		return nil, 0, fmt.Errorf("%w: the items belong to rounds %d and %d", ErrNoEquivocation, firstRound, secondRound)
+	}
+	if bytes.Equal(firstHash, secondHash) {
+		return nil, 0, fmt.Errorf("%w: the items are for the same block", ErrNoEquivocation)
+	}
+
+	return pubKey, firstRound, nil
+}
+
+// verifyItem checks the signature of an item, returning its round and the hash of the block it is for. The hash of a
+// header is computed without its signatures, which makes it equal to the hash carried by the consensus messages
+func (pv *proofVerifier) verifyItem(pubKey []byte, item SignedItem) (uint64, []byte, error) {
+	switch item.Kind {
+	case ConsensusMessageItem:
+		return pv.verifyConsensusMessage(pubKey, item.Data)
+	case ShardHeaderItem:
+		return pv.verifyHeader(pubKey, &block.Header{}, item.Data)
+	case MetaHeaderItem:
+		return pv.verifyHeader(pubKey, &block.MetaBlock{}, item.Data)
+	default:
+		return 0, nil, fmt.Errorf("%w: unknown item kind %d", ErrInvalidProof, item.Kind)
+	}
+}
+
+func (pv *proofVerifier) verifyConsensusMessage(pubKey []byte, buff []byte) (uint64, []byte, error) {
+	cnsMsg := &consensus.Message{}
+	err := pv.marshalizer.Unmarshal(cnsMsg, buff)
+	if err != nil {
+		return 0, nil, fmt.Errorf("%w: %s", ErrInvalidProof, err.Error())
+	}
+	if !bytes.Equal(cnsMsg.PubKey, pubKey) {
+		return 0, nil, fmt.Errorf("%w: consensus message sent by another key", ErrInvalidProof)
+	}
+	if len(cnsMsg.BlockHeaderHash) == 0 || cnsMsg.RoundIndex < 0 {
+		return 0, nil, fmt.Errorf("%w: consensus message without block header hash or round", ErrInvalidProof)
+	}
+
+	signature := cnsMsg.Signature
+	cnsMsg.Signature = nil
+	msgNoSig, err := pv.marshalizer.Marshal(cnsMsg)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	err = pv.signatureVerifier.Verify(msgNoSig, signature, pubKey)
+	if err != nil {
+		return 0, nil, fmt.Errorf("%w: consensus message signature: %s", ErrInvalidProof, err.Error())
+	}
+
+	return uint64(cnsMsg.RoundIndex), cnsMsg.BlockHeaderHash, nil
+}
+
+func (pv *proofVerifier) verifyHeader(pubKey []byte, header data.HeaderHandler, buff []byte) (uint64, []byte, error) {
+	err := pv.marshalizer.Unmarshal(header, buff)
+	if err != nil {
+		return 0, nil, fmt.Errorf("%w: %s", ErrInvalidProof, err.Error())
+	}
+
+	leaderSignature := header.GetLeaderSignature()
+	if len(leaderSignature) == 0 {
+		return 0, nil, fmt.Errorf("%w: header without leader signature", ErrInvalidProof)
+	}
+
+	// the leader signs the header without its own signature, as done in subroundEndRound
+	headerCopy := header.Clone()
+	headerCopy.SetLeaderSignature(nil)
+	headerNoLeaderSig, err := pv.marshalizer.Marshal(headerCopy)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	err = pv.signatureVerifier.Verify(headerNoLeaderSig, leaderSignature, pubKey)
+	if err != nil {
+		return 0, nil, fmt.Errorf("%w: header leader signature: %s", ErrInvalidProof, err.Error())
+	}
+
+	headerCopy.SetSignature(nil)
+	headerCopy.SetPubKeysBitmap(nil)
+	proposedHash, err := core.CalculateHash(pv.marshalizer, pv.hasher, headerCopy)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return header.GetRound(), proposedHash, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pv *proofVerifier) IsInterfaceNil() bool {
+	return pv == nil
+}
+
+// proofKey returns the key under which the proof of the equivocation of a public key in a round is pooled
+func proofKey(pubKey []byte, round uint64) []byte {
+	key := make([]byte, len(pubKey)+8)
+	copy(key, pubKey)
+	binary.BigEndian.PutUint64(key[len(pubKey):], round)
+
+	return key
+}
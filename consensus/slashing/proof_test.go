@@ -0,0 +1,195 @@
+package slashing_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/slashing"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testMarshalizer = &marshal.GogoProtoMarshalizer{}
+var testHasher = sha256.Sha256{}
+var offenderPubKey = []byte("offender public key")
+
+// the signatures of the tests are the hashes of the public key followed by the message
+func sign(pubKey []byte, message []byte) []byte {
+	return testHasher.Compute(string(pubKey) + string(message))
+}
+
+func createSignatureVerifier() *mock.SignatureVerifierStub {
+	return &mock.SignatureVerifierStub{
+		VerifyCalled: func(message []byte, signedMessage []byte, pubKey []byte) error {
+			if !bytes.Equal(sign(pubKey, message), signedMessage) {
+				return errors.New("invalid signature")
+			}
+			return nil
+		},
+	}
+}
+
+func createArgsProofVerifier() slashing.ArgsProofVerifier {
+	return slashing.ArgsProofVerifier{
+		Marshalizer:       testMarshalizer,
+		Hasher:            testHasher,
+		SignatureVerifier: createSignatureVerifier(),
+	}
+}
+
+func createSignedMessage(pubKey []byte, round int64, headerHash []byte) (*consensus.Message, []byte) {
+	cnsMsg := consensus.NewConsensusMessage(headerHash, []byte("share"), nil, nil, pubKey, nil, 2, round, []byte("chain"), nil, nil, nil)
+	msgNoSig, _ := testMarshalizer.Marshal(cnsMsg)
+	cnsMsg.Signature = sign(pubKey, msgNoSig)
+	buff, _ := testMarshalizer.Marshal(cnsMsg)
+
+	return cnsMsg, buff
+}
+
+// createSignedHeader returns a committed header, signed by its leader, along with the hash it had when proposed
+func createSignedHeader(pubKey []byte, round uint64, rootHash string) (*block.Header, []byte) {
+	header := &block.Header{
+		Round:    round,
+		Nonce:    round,
+		RootHash: []byte(rootHash),
+	}
+	proposedHash, _ := core.CalculateHash(testMarshalizer, testHasher, header)
+
+	header.PubKeysBitmap = []byte{7}
+	header.Signature = []byte("aggregated signature")
+	headerNoLeaderSig, _ := testMarshalizer.Marshal(header)
+	header.LeaderSignature = sign(pubKey, headerNoLeaderSig)
+
+	return header, proposedHash
+}
+
+func createMessageItem(buff []byte) slashing.SignedItem {
+	return slashing.SignedItem{Kind: slashing.ConsensusMessageItem, Data: buff}
+}
+
+func createHeaderItem(header *block.Header) slashing.SignedItem {
+	buff, _ := testMarshalizer.Marshal(header)
+	return slashing.SignedItem{Kind: slashing.ShardHeaderItem, Data: buff}
+}
+
+func TestNewProofVerifier_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsProofVerifier()
+	args.Marshalizer = nil
+	pv, err := slashing.NewProofVerifier(args)
+	assert.True(t, check.IfNil(pv))
+	assert.Equal(t, slashing.ErrNilMarshalizer, err)
+
+	args = createArgsProofVerifier()
+	args.Hasher = nil
+	pv, err = slashing.NewProofVerifier(args)
+	assert.True(t, check.IfNil(pv))
+	assert.Equal(t, slashing.ErrNilHasher, err)
+
+	args = createArgsProofVerifier()
+	args.SignatureVerifier = nil
+	pv, err = slashing.NewProofVerifier(args)
+	assert.True(t, check.IfNil(pv))
+	assert.Equal(t, slashing.ErrNilSignatureVerifier, err)
+}
+
+func TestProofVerifier_VerifyProofWithTwoMessagesShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pv, _ := slashing.NewProofVerifier(createArgsProofVerifier())
+	_, first := createSignedMessage(offenderPubKey, 5, []byte("hash A"))
+	_, second := createSignedMessage(offenderPubKey, 5, []byte("hash B"))
+	proof, err := slashing.CreateProof(testMarshalizer, offenderPubKey, createMessageItem(first), createMessageItem(second))
+	require.Nil(t, err)
+
+	pubKey, round, err := pv.VerifyProof(proof)
+
+	assert.Nil(t, err)
+	assert.Equal(t, offenderPubKey, pubKey)
+	assert.Equal(t, uint64(5), round)
+}
+
+func TestProofVerifier_VerifyProofWithHeaderAndMessage(t *testing.T) {
+	t.Parallel()
+
+	pv, _ := slashing.NewProofVerifier(createArgsProofVerifier())
+	header, proposedHash := createSignedHeader(offenderPubKey, 5, "root hash")
+
+	_, sameBlockMsg := createSignedMessage(offenderPubKey, 5, proposedHash)
+	proof, _ := slashing.CreateProof(testMarshalizer, offenderPubKey, createHeaderItem(header), createMessageItem(sameBlockMsg))
+	_, _, err := pv.VerifyProof(proof)
+	assert.True(t, errors.Is(err, slashing.ErrNoEquivocation))
+
+	_, otherBlockMsg := createSignedMessage(offenderPubKey, 5, []byte("other hash"))
+	proof, _ = slashing.CreateProof(testMarshalizer, offenderPubKey, createHeaderItem(header), createMessageItem(otherBlockMsg))
+	pubKey, round, err := pv.VerifyProof(proof)
+	assert.Nil(t, err)
+	assert.Equal(t, offenderPubKey, pubKey)
+	assert.Equal(t, uint64(5), round)
+}
+
+func TestProofVerifier_VerifyProofWithTwoHeadersShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pv, _ := slashing.NewProofVerifier(createArgsProofVerifier())
+	first, _ := createSignedHeader(offenderPubKey, 5, "root hash A")
+	second, _ := createSignedHeader(offenderPubKey, 5, "root hash B")
+	proof, _ := slashing.CreateProof(testMarshalizer, offenderPubKey, createHeaderItem(first), createHeaderItem(second))
+
+	_, round, err := pv.VerifyProof(proof)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(5), round)
+}
+
+func TestProofVerifier_VerifyProofDifferentRoundsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pv, _ := slashing.NewProofVerifier(createArgsProofVerifier())
+	_, first := createSignedMessage(offenderPubKey, 5, []byte("hash A"))
+	_, second := createSignedMessage(offenderPubKey, 6, []byte("hash B"))
+	proof, _ := slashing.CreateProof(testMarshalizer, offenderPubKey, createMessageItem(first), createMessageItem(second))
+
+	_, _, err := pv.VerifyProof(proof)
+
+	assert.True(t, errors.Is(err, slashing.ErrNoEquivocation))
+}
+
+func TestProofVerifier_VerifyProofInvalidItemsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pv, _ := slashing.NewProofVerifier(createArgsProofVerifier())
+	_, valid := createSignedMessage(offenderPubKey, 5, []byte("hash A"))
+	_, fromOtherKey := createSignedMessage([]byte("other key"), 5, []byte("hash B"))
+
+	forged, _ := createSignedMessage(offenderPubKey, 5, []byte("hash B"))
+	forged.Signature = []byte("forged signature")
+	forgedBuff, _ := testMarshalizer.Marshal(forged)
+
+	unsignedHeader, _ := createSignedHeader(offenderPubKey, 5, "root hash")
+	unsignedHeader.LeaderSignature = nil
+
+	invalidItems := []slashing.SignedItem{
+		createMessageItem(fromOtherKey),
+		createMessageItem(forgedBuff),
+		createHeaderItem(unsignedHeader),
+		{Kind: 37, Data: valid},
+	}
+	for _, item := range invalidItems {
+		proof, _ := slashing.CreateProof(testMarshalizer, offenderPubKey, createMessageItem(valid), item)
+		_, _, err := pv.VerifyProof(proof)
+		assert.True(t, errors.Is(err, slashing.ErrInvalidProof))
+	}
+
+	_, _, err := pv.VerifyProof([]byte("not a proof"))
+	assert.True(t, errors.Is(err, slashing.ErrInvalidProof))
+}
@@ -0,0 +1,82 @@
+package slashing
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// ArgsProofsProcessor holds the arguments needed to create a processor of the equivocation proofs received on the
+// network
+type ArgsProofsProcessor struct {
+	ProofVerifier    ProofVerifier
+	ProofsPool       storage.Cacher
+	AntifloodHandler consensus.P2PAntifloodHandler
+}
+
+type proofsProcessor struct {
+	proofVerifier    ProofVerifier
+	proofsPool       storage.Cacher
+	antifloodHandler consensus.P2PAntifloodHandler
+}
+
+// NewProofsProcessor creates the processor of the equivocation proofs topic. The valid proofs are added to the proofs
+// pool, from where the metachain includes them in its blocks
+func NewProofsProcessor(args ArgsProofsProcessor) (*proofsProcessor, error) {
+	if check.IfNil(args.ProofVerifier) {
+		return nil, ErrNilProofVerifier
+	}
+	if check.IfNil(args.ProofsPool) {
+		return nil, ErrNilProofsPool
+	}
+	if check.IfNil(args.AntifloodHandler) {
+		return nil, ErrNilAntifloodHandler
+	}
+
+	return &proofsProcessor{
+		proofVerifier:    args.ProofVerifier,
+		proofsPool:       args.ProofsPool,
+		antifloodHandler: args.AntifloodHandler,
+	}, nil
+}
+
+// ProcessReceivedMessage verifies the received equivocation proof and adds it to the proofs pool. Only the valid
+// proofs, not already known, are propagated further
+func (pp *proofsProcessor) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+	if check.IfNil(message) {
+		return ErrNilMessage
+	}
+
+	err := pp.antifloodHandler.CanProcessMessage(message, fromConnectedPeer)
+	if err != nil {
+		return err
+	}
+	err = pp.antifloodHandler.CanProcessMessagesOnTopic(fromConnectedPeer, core.EquivocationProofsTopic, 1, uint64(len(message.Data())), message.SeqNo())
+	if err != nil {
+		return err
+	}
+
+	pubKey, round, err := pp.proofVerifier.VerifyProof(message.Data())
+	if err != nil {
+		return err
+	}
+
+	has, _ := pp.proofsPool.HasOrAdd(proofKey(pubKey, round), message.Data(), len(message.Data()))
+	if has {
+		return ErrProofAlreadyKnown
+	}
+
+	log.Info("received equivocation proof",
+		"pk", pubKey,
+		"round", round,
+	)
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pp *proofsProcessor) IsInterfaceNil() bool {
+	return pp == nil
+}
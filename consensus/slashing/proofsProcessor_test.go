@@ -0,0 +1,93 @@
+package slashing_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/slashing"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func createArgsProofsProcessor() slashing.ArgsProofsProcessor {
+	pv, _ := slashing.NewProofVerifier(createArgsProofVerifier())
+
+	return slashing.ArgsProofsProcessor{
+		ProofVerifier:    pv,
+		ProofsPool:       mock.NewCacherMock(),
+		AntifloodHandler: &mock.P2PAntifloodHandlerStub{},
+	}
+}
+
+func createProof() []byte {
+	_, first := createSignedMessage(offenderPubKey, 5, []byte("hash A"))
+	_, second := createSignedMessage(offenderPubKey, 5, []byte("hash B"))
+	proof, _ := slashing.CreateProof(testMarshalizer, offenderPubKey, createMessageItem(first), createMessageItem(second))
+
+	return proof
+}
+
+func TestNewProofsProcessor_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsProofsProcessor()
+	args.ProofVerifier = nil
+	pp, err := slashing.NewProofsProcessor(args)
+	assert.True(t, check.IfNil(pp))
+	assert.Equal(t, slashing.ErrNilProofVerifier, err)
+
+	args = createArgsProofsProcessor()
+	args.ProofsPool = nil
+	pp, err = slashing.NewProofsProcessor(args)
+	assert.True(t, check.IfNil(pp))
+	assert.Equal(t, slashing.ErrNilProofsPool, err)
+
+	args = createArgsProofsProcessor()
+	args.AntifloodHandler = nil
+	pp, err = slashing.NewProofsProcessor(args)
+	assert.True(t, check.IfNil(pp))
+	assert.Equal(t, slashing.ErrNilAntifloodHandler, err)
+}
+
+func TestProofsProcessor_ProcessReceivedMessageShouldPoolTheValidProofsOnce(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsProofsProcessor()
+	pp, _ := slashing.NewProofsProcessor(args)
+
+	err := pp.ProcessReceivedMessage(nil, "peer")
+	assert.Equal(t, slashing.ErrNilMessage, err)
+
+	err = pp.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: []byte("invalid proof")}, "peer")
+	assert.True(t, errors.Is(err, slashing.ErrInvalidProof))
+	assert.Equal(t, 0, args.ProofsPool.Len())
+
+	err = pp.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: createProof()}, "peer")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, args.ProofsPool.Len())
+
+	err = pp.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: createProof()}, "peer")
+	assert.Equal(t, slashing.ErrProofAlreadyKnown, err)
+	assert.Equal(t, 1, args.ProofsPool.Len())
+}
+
+func TestProofsProcessor_ProcessReceivedMessageFloodedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createArgsProofsProcessor()
+	args.AntifloodHandler = &mock.P2PAntifloodHandlerStub{
+		CanProcessMessagesOnTopicCalled: func(_ core.PeerID, topic string, _ uint32, _ uint64, _ []byte) error {
+			assert.Equal(t, core.EquivocationProofsTopic, topic)
+			return expectedErr
+		},
+	}
+	pp, _ := slashing.NewProofsProcessor(args)
+
+	err := pp.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: createProof()}, "peer")
+
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 0, args.ProofsPool.Len())
+}
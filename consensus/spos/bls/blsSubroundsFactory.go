@@ -3,10 +3,12 @@ package bls
 import (
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/debug/consensusTrace"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 )
 
@@ -19,6 +21,7 @@ type factory struct {
 
 	appStatusHandler core.AppStatusHandler
 	indexer          indexer.Indexer
+	roundTracer      consensus.RoundTracer
 	chainID          []byte
 }
 
@@ -44,6 +47,7 @@ func NewSubroundsFactory(
 		consensusState:   consensusState,
 		worker:           worker,
 		appStatusHandler: statusHandler.NewNilStatusHandler(),
+		roundTracer:      consensusTrace.NewDisabledRoundTracer(),
 		chainID:          chainID,
 	}
 
@@ -88,6 +92,16 @@ func (fct *factory) SetIndexer(indexer indexer.Indexer) {
 	fct.indexer = indexer
 }
 
+// SetRoundTracer method will update the value of the factory's roundTracer
+func (fct *factory) SetRoundTracer(roundTracer consensus.RoundTracer) error {
+	if check.IfNil(roundTracer) {
+		return spos.ErrNilRoundTracer
+	}
+	fct.roundTracer = roundTracer
+
+	return fct.worker.SetRoundTracer(roundTracer)
+}
+
 // GenerateSubrounds will generate the subrounds used in BLS Cns
 func (fct *factory) GenerateSubrounds() error {
 	fct.initConsensusThreshold()
@@ -144,6 +158,11 @@ func (fct *factory) generateStartRoundSubround() error {
 		return err
 	}
 
+	err = subround.SetRoundTracer(fct.roundTracer)
+	if err != nil {
+		return err
+	}
+
 	subroundStartRound, err := NewSubroundStartRound(
 		subround,
 		fct.worker.Extend,
@@ -184,6 +203,11 @@ func (fct *factory) generateBlockSubround() error {
 		return err
 	}
 
+	err = subround.SetRoundTracer(fct.roundTracer)
+	if err != nil {
+		return err
+	}
+
 	subroundBlock, err := NewSubroundBlock(
 		subround,
 		fct.worker.Extend,
@@ -232,6 +256,11 @@ func (fct *factory) generateSignatureSubround() error {
 		return err
 	}
 
+	err = subroundSignatureObject.SetRoundTracer(fct.roundTracer)
+	if err != nil {
+		return err
+	}
+
 	fct.worker.AddReceivedMessageCall(MtSignature, subroundSignatureObject.receivedSignature)
 	fct.consensusCore.Chronology().AddSubround(subroundSignatureObject)
 
@@ -271,6 +300,11 @@ func (fct *factory) generateEndRoundSubround() error {
 		return err
 	}
 
+	err = subroundEndRoundObject.SetRoundTracer(fct.roundTracer)
+	if err != nil {
+		return err
+	}
+
 	fct.worker.AddReceivedMessageCall(MtBlockHeaderFinalInfo, subroundEndRoundObject.receivedBlockHeaderFinalInfo)
 	fct.worker.AddReceivedHeaderHandler(subroundEndRoundObject.receivedHeader)
 	fct.consensusCore.Chronology().AddSubround(subroundEndRoundObject)
@@ -524,6 +524,36 @@ func TestFactory_SetAppStatusHandlerOkStatusHandlerShouldWork(t *testing.T) {
 	assert.Equal(t, ash, fct.AppStatusHandler())
 }
 
+func TestFactory_SetRoundTracerNilRoundTracerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	fct := *initFactoryWithContainer(container)
+
+	err := fct.SetRoundTracer(nil)
+	assert.Equal(t, spos.ErrNilRoundTracer, err)
+}
+
+func TestFactory_SetRoundTracerShouldSetItOnTheWorker(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	worker := initWorker().(*mock.SposWorkerMock)
+	var workerRoundTracer consensus.RoundTracer
+	worker.SetRoundTracerCalled = func(roundTracer consensus.RoundTracer) error {
+		workerRoundTracer = roundTracer
+		return nil
+	}
+	fct, _ := bls.NewSubroundsFactory(container, initConsensusState(), worker, chainID)
+
+	roundTracer := &mock.RoundTracerStub{}
+	err := fct.SetRoundTracer(roundTracer)
+
+	assert.Nil(t, err)
+	assert.True(t, roundTracer == fct.RoundTracer())
+	assert.True(t, roundTracer == workerRoundTracer)
+}
+
 func TestFactory_SetIndexerShouldWork(t *testing.T) {
 	t.Parallel()
 
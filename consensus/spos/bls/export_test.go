@@ -123,6 +123,10 @@ func (fct *factory) Indexer() indexer.Indexer {
 	return fct.indexer
 }
 
+func (fct *factory) RoundTracer() consensus.RoundTracer {
+	return fct.roundTracer
+}
+
 // subroundStartRound
 
 // SubroundStartRound defines a type for the subroundStartRound structure
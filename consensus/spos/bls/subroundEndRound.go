@@ -132,6 +132,8 @@ func (sr *subroundEndRound) doEndRoundJob() bool {
 
 func (sr *subroundEndRound) doEndRoundJobByLeader() bool {
 	bitmap := sr.GenerateBitmap(SrSignature)
+	sr.RoundTracer().SetMissingSigners(sr.Rounder().Index(), sr.NodesWithoutJobDone(SrSignature))
+
 	err := sr.checkSignaturesValidity(bitmap)
 	if err != nil {
 		log.Debug("doEndRoundJob.checkSignaturesValidity", "error", err.Error())
@@ -325,6 +325,31 @@ func TestSubroundEndRound_DoEndRoundJobErrAggregatingSigShouldFail(t *testing.T)
 	assert.False(t, r)
 }
 
+func TestSubroundEndRound_DoEndRoundJobByLeaderShouldTraceMissingSigners(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	sr := *initSubroundEndRoundWithContainer(container)
+	sr.Header = &block.Header{}
+	sr.SetSelfPubKey("A")
+
+	consensusGroup := sr.ConsensusGroup()
+	for _, pubKey := range consensusGroup[1:] {
+		_ = sr.SetJobDone(pubKey, bls.SrSignature, true)
+	}
+
+	var missingSigners []string
+	_ = sr.SetRoundTracer(&mock.RoundTracerStub{
+		SetMissingSignersCalled: func(round int64, pubKeys []string) {
+			missingSigners = pubKeys
+		},
+	})
+
+	r := sr.DoEndRoundJob()
+	assert.True(t, r)
+	assert.Equal(t, []string{consensusGroup[0]}, missingSigners)
+}
+
 func TestSubroundEndRound_DoEndRoundJobErrCommitBlockShouldFail(t *testing.T) {
 	t.Parallel()
 
@@ -138,6 +138,7 @@ func (sr *subroundStartRound) initCurrentRound() bool {
 
 	pubKeys := sr.ConsensusGroup()
 
+	sr.RoundTracer().StartRound(sr.Rounder().Index(), leader, pubKeys)
 	sr.indexRoundIfNeeded(pubKeys)
 
 	selfIndex, err := sr.SelfConsensusGroupIndex()
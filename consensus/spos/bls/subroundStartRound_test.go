@@ -414,6 +414,36 @@ func TestSubroundStartRound_InitCurrentRoundShouldReturnTrue(t *testing.T) {
 	assert.True(t, r)
 }
 
+func TestSubroundStartRound_InitCurrentRoundShouldTraceLeaderAndConsensusGroup(t *testing.T) {
+	t.Parallel()
+
+	bootstrapperMock := &mock.BootstrapperMock{}
+	bootstrapperMock.GetNodeStateCalled = func() core.NodeState {
+		return core.NsSynchronized
+	}
+
+	container := mock.InitConsensusCore()
+	container.SetBootStrapper(bootstrapperMock)
+
+	srStartRound := *initSubroundStartRoundWithContainer(container)
+
+	tracedLeader := ""
+	var tracedConsensusGroup []string
+	_ = srStartRound.SetRoundTracer(&mock.RoundTracerStub{
+		StartRoundCalled: func(round int64, leader string, consensusGroup []string) {
+			tracedLeader = leader
+			tracedConsensusGroup = consensusGroup
+		},
+	})
+
+	r := srStartRound.InitCurrentRound()
+	assert.True(t, r)
+
+	leader, _ := srStartRound.GetLeader()
+	assert.Equal(t, leader, tracedLeader)
+	assert.Equal(t, srStartRound.ConsensusGroup(), tracedConsensusGroup)
+}
+
 func TestSubroundStartRound_GenerateNextConsensusGroupShouldReturnErr(t *testing.T) {
 	t.Parallel()
 
@@ -163,6 +163,9 @@ var ErrNilAntifloodHandler = errors.New("nil antiflood handler")
 // ErrNilPoolAdder signals that a nil pool adder has been provided
 var ErrNilPoolAdder = errors.New("nil pool adder")
 
+// ErrNilEquivocationDetector signals that a nil equivocation detector has been provided
+var ErrNilEquivocationDetector = errors.New("nil equivocation detector")
+
 // ErrNilHeaderSigVerifier signals that a nil header sig verifier has been provided
 var ErrNilHeaderSigVerifier = errors.New("nil header sig verifier")
 
@@ -201,3 +204,6 @@ var ErrInvalidPublicKeyBitmapSize = errors.New("invalid public key bitmap size")
 
 // ErrInvalidCacheSize signals an invalid size provided for cache
 var ErrInvalidCacheSize = errors.New("invalid cache size")
+
+// ErrNilRoundTracer signals that a nil round tracer has been provided
+var ErrNilRoundTracer = errors.New("nil round tracer")
@@ -156,6 +156,10 @@ func (wrk *Worker) AppStatusHandler() core.AppStatusHandler {
 	return wrk.appStatusHandler
 }
 
+func (wrk *Worker) RoundTracer() consensus.RoundTracer {
+	return wrk.roundTracer
+}
+
 func (wrk *Worker) CheckConsensusMessageValidity(cnsMsg *consensus.Message) error {
 	return wrk.checkConsensusMessageValidity(cnsMsg)
 }
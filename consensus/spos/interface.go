@@ -118,6 +118,8 @@ type WorkerHandler interface {
 	ReceivedHeader(headerHandler data.HeaderHandler, headerHash []byte)
 	//SetAppStatusHandler sets the status handler object used to collect useful metrics about consensus state machine
 	SetAppStatusHandler(ash core.AppStatusHandler) error
+	//SetRoundTracer sets the round tracer used to record the arrival of the consensus messages
+	SetRoundTracer(roundTracer consensus.RoundTracer) error
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 }
@@ -128,6 +130,13 @@ type PoolAdder interface {
 	IsInterfaceNil() bool
 }
 
+// EquivocationDetector detects the keys signing or proposing two different blocks in the same round
+type EquivocationDetector interface {
+	CheckConsensusMessage(cnsMsg *consensus.Message, rawMessage []byte)
+	CheckHeader(header data.HeaderHandler, leaderPubKey []byte)
+	IsInterfaceNil() bool
+}
+
 // RandSeedVerifier encapsulates methods that are check if header rand seed is correct
 type RandSeedVerifier interface {
 	VerifyRandSeed(header data.HeaderHandler) error
@@ -181,6 +181,26 @@ func (rcns *roundConsensus) ComputeSize(subroundId int) int {
 	return n
 }
 
+// NodesWithoutJobDone method returns the nodes belonging to the current jobDone group which have not done their job
+// in this subround
+func (rcns *roundConsensus) NodesWithoutJobDone(subroundId int) []string {
+	nodes := make([]string, 0)
+
+	for i := 0; i < len(rcns.consensusGroup); i++ {
+		isJobDone, err := rcns.JobDone(rcns.consensusGroup[i], subroundId)
+		if err != nil {
+			log.Debug("JobDone", "error", err.Error())
+			continue
+		}
+
+		if !isJobDone {
+			nodes = append(nodes, rcns.consensusGroup[i])
+		}
+	}
+
+	return nodes
+}
+
 // ResetRoundState method resets the state of each node from the current jobDone group, regarding to the
 // consensus validatorRoundStates
 func (rcns *roundConsensus) ResetRoundState() {
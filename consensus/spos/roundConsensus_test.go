@@ -250,6 +250,16 @@ func TestRoundConsensus_ComputeSize(t *testing.T) {
 	assert.Equal(t, 1, rcns.ComputeSize(bls.SrBlock))
 }
 
+func TestRoundConsensus_NodesWithoutJobDone(t *testing.T) {
+	t.Parallel()
+
+	rcns := *initRoundConsensus()
+
+	_ = rcns.SetJobDone("1", bls.SrSignature, true)
+	_ = rcns.SetJobDone("3", bls.SrBlock, true)
+	assert.Equal(t, []string{"2", "3"}, rcns.NodesWithoutJobDone(bls.SrSignature))
+}
+
 func TestRoundConsensus_ResetValidationMap(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,71 @@
+package roundRobin
+
+import (
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+var log = logger.GetOrCreate("consensus/spos/roundrobin")
+
+const (
+	// SrStartRound defines ID of Subround "Start round"
+	SrStartRound = iota
+	// SrBlock defines ID of Subround "block"
+	SrBlock
+)
+
+const (
+	// MtUnknown defines ID of a message that has unknown Data inside
+	MtUnknown consensus.MessageType = iota
+	// MtBlockBodyAndHeader defines ID of a message that has a block body and a sealed block header inside
+	MtBlockBodyAndHeader
+)
+
+// processingThresholdPercent specifies the max allocated time for processing the block as a percentage of the total time of the round
+const processingThresholdPercent = 85
+
+// srStartStartTime specifies the start time, from the total time of the round, of Subround Start
+const srStartStartTime = 0.0
+
+// srStartEndTime specifies the end time, from the total time of the round, of Subround Start
+const srStartEndTime = 0.05
+
+// srBlockStartTime specifies the start time, from the total time of the round, of Subround Block
+const srBlockStartTime = 0.05
+
+// srBlockEndTime specifies the end time, from the total time of the round, of Subround Block
+const srBlockEndTime = 0.85
+
+const (
+	// BlockBodyAndHeaderStringValue represents the string to be used to identify a block body and a block header
+	BlockBodyAndHeaderStringValue = "(BLOCK_BODY_AND_HEADER)"
+
+	// BlockUnknownStringValue represents the string to be used to identify an unknown block
+	BlockUnknownStringValue = "(UNKNOWN)"
+
+	// BlockDefaultStringValue represents the message to identify a message that is undefined
+	BlockDefaultStringValue = "Undefined message type"
+)
+
+func getStringValue(msgType consensus.MessageType) string {
+	switch msgType {
+	case MtBlockBodyAndHeader:
+		return BlockBodyAndHeaderStringValue
+	case MtUnknown:
+		return BlockUnknownStringValue
+	default:
+		return BlockDefaultStringValue
+	}
+}
+
+// getSubroundName returns the name of each Subround from a given Subround ID
+func getSubroundName(subroundId int) string {
+	switch subroundId {
+	case SrStartRound:
+		return "(START_ROUND)"
+	case SrBlock:
+		return "(BLOCK)"
+	default:
+		return "Undefined subround"
+	}
+}
@@ -0,0 +1,8 @@
+package roundRobin
+
+import (
+	"errors"
+)
+
+// ErrInvalidConsensusGroupSize signals that the consensus group is not made only of the leader of the round
+var ErrInvalidConsensusGroupSize = errors.New("invalid consensus group size, the round-robin consensus requires a group of one")
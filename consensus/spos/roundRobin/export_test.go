@@ -0,0 +1,25 @@
+package roundRobin
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+// GetStringValue -
+func GetStringValue(msgType consensus.MessageType) string {
+	return getStringValue(msgType)
+}
+
+// GetSubroundName -
+func GetSubroundName(subroundId int) string {
+	return getSubroundName(subroundId)
+}
+
+// DoBlockJob -
+func (sr *subroundBlock) DoBlockJob() bool {
+	return sr.doBlockJob()
+}
+
+// DoBlockConsensusCheck -
+func (sr *subroundBlock) DoBlockConsensusCheck() bool {
+	return sr.doBlockConsensusCheck()
+}
@@ -0,0 +1,230 @@
+package roundRobin
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/debug/consensusTrace"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
+)
+
+// factory defines the data needed by this factory to create all the subrounds and give them their specific
+// functionality
+type factory struct {
+	consensusCore  spos.ConsensusCoreHandler
+	consensusState *spos.ConsensusState
+	worker         spos.WorkerHandler
+
+	appStatusHandler core.AppStatusHandler
+	indexer          indexer.Indexer
+	roundTracer      consensus.RoundTracer
+	chainID          []byte
+}
+
+// NewSubroundsFactory creates a new factory object for the round-robin consensus. The consensus group should be made
+// only of the leader of the round, as there is no signature subround
+func NewSubroundsFactory(
+	consensusDataContainer spos.ConsensusCoreHandler,
+	consensusState *spos.ConsensusState,
+	worker spos.WorkerHandler,
+	chainID []byte,
+) (*factory, error) {
+	err := checkNewFactoryParams(
+		consensusDataContainer,
+		consensusState,
+		worker,
+		chainID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	fct := factory{
+		consensusCore:    consensusDataContainer,
+		consensusState:   consensusState,
+		worker:           worker,
+		appStatusHandler: statusHandler.NewNilStatusHandler(),
+		roundTracer:      consensusTrace.NewDisabledRoundTracer(),
+		chainID:          chainID,
+	}
+
+	return &fct, nil
+}
+
+func checkNewFactoryParams(
+	container spos.ConsensusCoreHandler,
+	state *spos.ConsensusState,
+	worker spos.WorkerHandler,
+	chainID []byte,
+) error {
+	err := spos.ValidateConsensusCore(container)
+	if err != nil {
+		return err
+	}
+	if state == nil {
+		return spos.ErrNilConsensusState
+	}
+	if check.IfNil(worker) {
+		return spos.ErrNilWorker
+	}
+	if len(chainID) == 0 {
+		return spos.ErrInvalidChainID
+	}
+	if state.ConsensusGroupSize() != 1 {
+		return ErrInvalidConsensusGroupSize
+	}
+
+	return nil
+}
+
+// SetAppStatusHandler method will update the value of the factory's appStatusHandler
+func (fct *factory) SetAppStatusHandler(ash core.AppStatusHandler) error {
+	if check.IfNil(ash) {
+		return spos.ErrNilAppStatusHandler
+	}
+	fct.appStatusHandler = ash
+
+	return fct.worker.SetAppStatusHandler(ash)
+}
+
+// SetIndexer method will update the value of the factory's indexer
+func (fct *factory) SetIndexer(indexer indexer.Indexer) {
+	fct.indexer = indexer
+}
+
+// SetRoundTracer method will update the value of the factory's roundTracer
+func (fct *factory) SetRoundTracer(roundTracer consensus.RoundTracer) error {
+	if check.IfNil(roundTracer) {
+		return spos.ErrNilRoundTracer
+	}
+	fct.roundTracer = roundTracer
+
+	return fct.worker.SetRoundTracer(roundTracer)
+}
+
+// GenerateSubrounds will generate the subrounds used in round-robin Cns
+func (fct *factory) GenerateSubrounds() error {
+	fct.initConsensusThreshold()
+	fct.consensusCore.Chronology().RemoveAllSubrounds()
+	fct.worker.RemoveAllReceivedMessagesCalls()
+
+	err := fct.generateStartRoundSubround()
+	if err != nil {
+		return err
+	}
+
+	err = fct.generateBlockSubround()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fct *factory) getTimeDuration() time.Duration {
+	return fct.consensusCore.Rounder().TimeDuration()
+}
+
+func (fct *factory) generateStartRoundSubround() error {
+	subround, err := spos.NewSubround(
+		-1,
+		SrStartRound,
+		SrBlock,
+		int64(float64(fct.getTimeDuration())*srStartStartTime),
+		int64(float64(fct.getTimeDuration())*srStartEndTime),
+		getSubroundName(SrStartRound),
+		fct.consensusState,
+		fct.worker.GetConsensusStateChangedChannel(),
+		fct.worker.ExecuteStoredMessages,
+		fct.consensusCore,
+		fct.chainID,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = subround.SetAppStatusHandler(fct.appStatusHandler)
+	if err != nil {
+		return err
+	}
+
+	err = subround.SetRoundTracer(fct.roundTracer)
+	if err != nil {
+		return err
+	}
+
+	// the start of the round is the same as the one of the BLS consensus: the consensus group is computed and the
+	// multi signer is prepared for it
+	subroundStartRound, err := bls.NewSubroundStartRound(
+		subround,
+		fct.worker.Extend,
+		processingThresholdPercent,
+		fct.worker.ExecuteStoredMessages,
+	)
+	if err != nil {
+		return err
+	}
+
+	subroundStartRound.SetIndexer(fct.indexer)
+
+	fct.consensusCore.Chronology().AddSubround(subroundStartRound)
+
+	return nil
+}
+
+func (fct *factory) generateBlockSubround() error {
+	subround, err := spos.NewSubround(
+		SrStartRound,
+		SrBlock,
+		-1,
+		int64(float64(fct.getTimeDuration())*srBlockStartTime),
+		int64(float64(fct.getTimeDuration())*srBlockEndTime),
+		getSubroundName(SrBlock),
+		fct.consensusState,
+		fct.worker.GetConsensusStateChangedChannel(),
+		fct.worker.ExecuteStoredMessages,
+		fct.consensusCore,
+		fct.chainID,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = subround.SetAppStatusHandler(fct.appStatusHandler)
+	if err != nil {
+		return err
+	}
+
+	err = subround.SetRoundTracer(fct.roundTracer)
+	if err != nil {
+		return err
+	}
+
+	subroundBlock, err := NewSubroundBlock(
+		subround,
+		fct.worker.Extend,
+		processingThresholdPercent,
+		fct.worker.DisplayStatistics,
+	)
+	if err != nil {
+		return err
+	}
+
+	fct.consensusCore.Chronology().AddSubround(subroundBlock)
+
+	return nil
+}
+
+func (fct *factory) initConsensusThreshold() {
+	fct.consensusState.SetThreshold(SrBlock, 1)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fct *factory) IsInterfaceNil() bool {
+	return fct == nil
+}
@@ -0,0 +1,145 @@
+package roundRobin_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/roundRobin"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+var chainID = []byte("chain ID")
+
+const roundTimeDuration = 100 * time.Millisecond
+
+func initWorker() spos.WorkerHandler {
+	sposWorker := &mock.SposWorkerMock{}
+	sposWorker.GetConsensusStateChangedChannelsCalled = func() chan bool {
+		return make(chan bool)
+	}
+	sposWorker.RemoveAllReceivedMessagesCallsCalled = func() {}
+
+	return sposWorker
+}
+
+func initRounderMock() *mock.RounderMock {
+	return &mock.RounderMock{
+		RoundIndex: 1,
+		TimeStampCalled: func() time.Time {
+			return time.Unix(0, 0)
+		},
+		TimeDurationCalled: func() time.Duration {
+			return roundTimeDuration
+		},
+	}
+}
+
+func TestFactory_NewFactoryNilContainerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	fct, err := roundRobin.NewSubroundsFactory(nil, initConsensusState("A", 1), initWorker(), chainID)
+
+	assert.True(t, check.IfNil(fct))
+	assert.Equal(t, spos.ErrNilConsensusCore, err)
+}
+
+func TestFactory_NewFactoryNilConsensusStateShouldFail(t *testing.T) {
+	t.Parallel()
+
+	fct, err := roundRobin.NewSubroundsFactory(mock.InitConsensusCore(), nil, initWorker(), chainID)
+
+	assert.True(t, check.IfNil(fct))
+	assert.Equal(t, spos.ErrNilConsensusState, err)
+}
+
+func TestFactory_NewFactoryNilWorkerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	fct, err := roundRobin.NewSubroundsFactory(mock.InitConsensusCore(), initConsensusState("A", 1), nil, chainID)
+
+	assert.True(t, check.IfNil(fct))
+	assert.Equal(t, spos.ErrNilWorker, err)
+}
+
+func TestFactory_NewFactoryEmptyChainIDShouldFail(t *testing.T) {
+	t.Parallel()
+
+	fct, err := roundRobin.NewSubroundsFactory(mock.InitConsensusCore(), initConsensusState("A", 1), initWorker(), nil)
+
+	assert.True(t, check.IfNil(fct))
+	assert.Equal(t, spos.ErrInvalidChainID, err)
+}
+
+func TestFactory_NewFactoryConsensusGroupBiggerThanOneShouldFail(t *testing.T) {
+	t.Parallel()
+
+	fct, err := roundRobin.NewSubroundsFactory(mock.InitConsensusCore(), initConsensusState("A", 2), initWorker(), chainID)
+
+	assert.True(t, check.IfNil(fct))
+	assert.Equal(t, roundRobin.ErrInvalidConsensusGroupSize, err)
+}
+
+func TestFactory_NewFactoryNilAppStatusHandlerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	fct, _ := roundRobin.NewSubroundsFactory(mock.InitConsensusCore(), initConsensusState("A", 1), initWorker(), chainID)
+	err := fct.SetAppStatusHandler(nil)
+
+	assert.Equal(t, spos.ErrNilAppStatusHandler, err)
+}
+
+func TestFactory_NewFactoryNilRoundTracerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	fct, _ := roundRobin.NewSubroundsFactory(mock.InitConsensusCore(), initConsensusState("A", 1), initWorker(), chainID)
+	err := fct.SetRoundTracer(nil)
+
+	assert.Equal(t, spos.ErrNilRoundTracer, err)
+}
+
+func TestFactory_SetRoundTracerShouldSetItOnTheWorker(t *testing.T) {
+	t.Parallel()
+
+	worker := initWorker().(*mock.SposWorkerMock)
+	var workerRoundTracer consensus.RoundTracer
+	worker.SetRoundTracerCalled = func(roundTracer consensus.RoundTracer) error {
+		workerRoundTracer = roundTracer
+		return nil
+	}
+	fct, _ := roundRobin.NewSubroundsFactory(mock.InitConsensusCore(), initConsensusState("A", 1), worker, chainID)
+
+	roundTracer := &mock.RoundTracerStub{}
+	err := fct.SetRoundTracer(roundTracer)
+
+	assert.Nil(t, err)
+	assert.True(t, roundTracer == workerRoundTracer)
+}
+
+func TestFactory_GenerateSubroundsShouldAddStartRoundAndBlock(t *testing.T) {
+	t.Parallel()
+
+	subrounds := make([]consensus.SubroundHandler, 0)
+	container := mock.InitConsensusCore()
+	container.SetRounder(initRounderMock())
+	container.SetChronology(&mock.ChronologyHandlerMock{
+		AddSubroundCalled: func(handler consensus.SubroundHandler) {
+			subrounds = append(subrounds, handler)
+		},
+	})
+
+	fct, err := roundRobin.NewSubroundsFactory(container, initConsensusState("A", 1), initWorker(), chainID)
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(fct))
+
+	err = fct.GenerateSubrounds()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(subrounds))
+	assert.Equal(t, roundRobin.SrStartRound, subrounds[0].Current())
+	assert.Equal(t, roundRobin.SrBlock, subrounds[0].Next())
+	assert.Equal(t, roundRobin.SrBlock, subrounds[1].Current())
+	assert.Equal(t, -1, subrounds[1].Next())
+}
@@ -0,0 +1,106 @@
+package roundRobin
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+)
+
+// peerMaxMessagesPerSec defines how many messages can be propagated by a pid in a round. A leader propagates only
+// one message per round (the sealed header together with its body), but because the leader might be selected again
+// in the next round, the message of that round can be received before the current one ends. One more message is
+// added for the forks that can appear on the system
+const peerMaxMessagesPerSec = uint32(3)
+
+// worker defines the data needed by spos to communicate between nodes which are in the validators group
+type worker struct {
+}
+
+// NewConsensusService creates a new worker object
+func NewConsensusService() (*worker, error) {
+	wrk := worker{}
+
+	return &wrk, nil
+}
+
+// InitReceivedMessages initializes the MessagesType map for all messages for the current ConsensusService
+func (wrk *worker) InitReceivedMessages() map[consensus.MessageType][]*consensus.Message {
+	receivedMessages := make(map[consensus.MessageType][]*consensus.Message)
+	receivedMessages[MtBlockBodyAndHeader] = make([]*consensus.Message, 0)
+
+	return receivedMessages
+}
+
+// GetMaxMessagesInARoundPerPeer returns the maximum number of messages a peer can send per round for round-robin
+func (wrk *worker) GetMaxMessagesInARoundPerPeer() uint32 {
+	return peerMaxMessagesPerSec
+}
+
+// GetStringValue gets the name of the messageType
+func (wrk *worker) GetStringValue(messageType consensus.MessageType) string {
+	return getStringValue(messageType)
+}
+
+// GetSubroundName gets the subround name for the subround id provided
+func (wrk *worker) GetSubroundName(subroundId int) string {
+	return getSubroundName(subroundId)
+}
+
+// IsMessageWithBlockBodyAndHeader returns if the current messageType is about block body and header
+func (wrk *worker) IsMessageWithBlockBodyAndHeader(msgType consensus.MessageType) bool {
+	return msgType == MtBlockBodyAndHeader
+}
+
+// IsMessageWithBlockBody returns false as the round-robin leader always sends the block body together with the header
+func (wrk *worker) IsMessageWithBlockBody(_ consensus.MessageType) bool {
+	return false
+}
+
+// IsMessageWithBlockHeader returns false as the round-robin leader always sends the header together with the block body
+func (wrk *worker) IsMessageWithBlockHeader(_ consensus.MessageType) bool {
+	return false
+}
+
+// IsMessageWithSignature returns false as the round-robin consensus has no signature messages
+func (wrk *worker) IsMessageWithSignature(_ consensus.MessageType) bool {
+	return false
+}
+
+// IsMessageWithFinalInfo returns false as the round-robin leader sends an already sealed header
+func (wrk *worker) IsMessageWithFinalInfo(_ consensus.MessageType) bool {
+	return false
+}
+
+// IsMessageTypeValid returns if the current messageType is valid
+func (wrk *worker) IsMessageTypeValid(msgType consensus.MessageType) bool {
+	return msgType == MtBlockBodyAndHeader
+}
+
+// IsSubroundSignature returns false as the round-robin consensus has no signature subround
+func (wrk *worker) IsSubroundSignature(_ int) bool {
+	return false
+}
+
+// IsSubroundStartRound returns if the current subround is about start round
+func (wrk *worker) IsSubroundStartRound(subroundId int) bool {
+	return subroundId == SrStartRound
+}
+
+// GetMessageRange provides the MessageType range used in checks by the consensus
+func (wrk *worker) GetMessageRange() []consensus.MessageType {
+	return []consensus.MessageType{MtBlockBodyAndHeader}
+}
+
+// CanProceed returns if the current messageType can proceed further if previous subrounds finished
+func (wrk *worker) CanProceed(consensusState *spos.ConsensusState, msgType consensus.MessageType) bool {
+	switch msgType {
+	case MtBlockBodyAndHeader:
+		return consensusState.Status(SrStartRound) == spos.SsFinished
+	}
+
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wrk *worker) IsInterfaceNil() bool {
+	return wrk == nil
+}
@@ -0,0 +1,99 @@
+package roundRobin_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/roundRobin"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func initConsensusState(selfPubKey string, consensusGroupSize int) *spos.ConsensusState {
+	eligibleList := []string{"A", "B", "C"}
+	eligibleNodesPubKeys := make(map[string]struct{})
+	for _, key := range eligibleList {
+		eligibleNodesPubKeys[key] = struct{}{}
+	}
+
+	rcns := spos.NewRoundConsensus(
+		eligibleNodesPubKeys,
+		consensusGroupSize,
+		selfPubKey)
+
+	rcns.SetConsensusGroup(eligibleList[:consensusGroupSize])
+	rcns.ResetRoundState()
+
+	rthr := spos.NewRoundThreshold()
+	rthr.SetThreshold(roundRobin.SrBlock, 1)
+
+	rstatus := spos.NewRoundStatus()
+	rstatus.ResetRoundStatus()
+
+	cns := spos.NewConsensusState(
+		rcns,
+		rthr,
+		rstatus,
+	)
+
+	cns.RoundIndex = 0
+	return cns
+}
+
+func TestWorker_NewConsensusServiceShouldWork(t *testing.T) {
+	t.Parallel()
+
+	service, err := roundRobin.NewConsensusService()
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(service))
+}
+
+func TestWorker_OnlyTheBlockBodyAndHeaderMessageShouldBeValid(t *testing.T) {
+	t.Parallel()
+
+	service, _ := roundRobin.NewConsensusService()
+
+	assert.True(t, service.IsMessageTypeValid(roundRobin.MtBlockBodyAndHeader))
+	assert.True(t, service.IsMessageWithBlockBodyAndHeader(roundRobin.MtBlockBodyAndHeader))
+	assert.False(t, service.IsMessageTypeValid(roundRobin.MtUnknown))
+	assert.False(t, service.IsMessageWithBlockBody(roundRobin.MtBlockBodyAndHeader))
+	assert.False(t, service.IsMessageWithBlockHeader(roundRobin.MtBlockBodyAndHeader))
+	assert.False(t, service.IsMessageWithSignature(roundRobin.MtBlockBodyAndHeader))
+	assert.False(t, service.IsMessageWithFinalInfo(roundRobin.MtBlockBodyAndHeader))
+	assert.Equal(t, []consensus.MessageType{roundRobin.MtBlockBodyAndHeader}, service.GetMessageRange())
+
+	receivedMessages := service.InitReceivedMessages()
+	assert.Equal(t, 1, len(receivedMessages))
+	assert.NotNil(t, receivedMessages[roundRobin.MtBlockBodyAndHeader])
+}
+
+func TestWorker_SubroundsShouldBeIdentified(t *testing.T) {
+	t.Parallel()
+
+	service, _ := roundRobin.NewConsensusService()
+
+	assert.True(t, service.IsSubroundStartRound(roundRobin.SrStartRound))
+	assert.False(t, service.IsSubroundStartRound(roundRobin.SrBlock))
+	assert.False(t, service.IsSubroundSignature(roundRobin.SrBlock))
+	assert.Equal(t, "(START_ROUND)", service.GetSubroundName(roundRobin.SrStartRound))
+	assert.Equal(t, "(BLOCK)", service.GetSubroundName(roundRobin.SrBlock))
+	assert.Equal(t, "Undefined subround", roundRobin.GetSubroundName(-1))
+	assert.Equal(t, "(BLOCK_BODY_AND_HEADER)", roundRobin.GetStringValue(roundRobin.MtBlockBodyAndHeader))
+	assert.Equal(t, "(UNKNOWN)", roundRobin.GetStringValue(roundRobin.MtUnknown))
+	assert.Equal(t, "Undefined message type", roundRobin.GetStringValue(consensus.MessageType(-1)))
+}
+
+func TestWorker_CanProceedWithBlockAfterTheStartRound(t *testing.T) {
+	t.Parallel()
+
+	service, _ := roundRobin.NewConsensusService()
+	consensusState := initConsensusState("A", 1)
+
+	consensusState.SetStatus(roundRobin.SrStartRound, spos.SsNotFinished)
+	assert.False(t, service.CanProceed(consensusState, roundRobin.MtBlockBodyAndHeader))
+
+	consensusState.SetStatus(roundRobin.SrStartRound, spos.SsFinished)
+	assert.True(t, service.CanProceed(consensusState, roundRobin.MtBlockBodyAndHeader))
+	assert.False(t, service.CanProceed(consensusState, roundRobin.MtUnknown))
+}
@@ -0,0 +1,413 @@
+package roundRobin
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/display"
+)
+
+// maxAllowedSizeInBytes defines how many bytes are allowed as payload in a message
+const maxAllowedSizeInBytes = uint32(core.MegabyteSize * 95 / 100)
+
+// subroundBlock defines the data needed by the subround Block. In this subround the leader of the round creates the
+// block, seals it alone, commits it and broadcasts it. The other nodes of the shard get the block through the worker
+// and the bootstrapper, as there is nothing to be agreed upon
+type subroundBlock struct {
+	*spos.Subround
+
+	processingThresholdPercentage int
+	displayStatistics             func()
+}
+
+// NewSubroundBlock creates a subroundBlock object
+func NewSubroundBlock(
+	baseSubround *spos.Subround,
+	extend func(subroundId int),
+	processingThresholdPercentage int,
+	displayStatistics func(),
+) (*subroundBlock, error) {
+	err := checkNewSubroundBlockParams(baseSubround)
+	if err != nil {
+		return nil, err
+	}
+
+	srBlock := subroundBlock{
+		Subround:                      baseSubround,
+		processingThresholdPercentage: processingThresholdPercentage,
+		displayStatistics:             displayStatistics,
+	}
+
+	srBlock.Job = srBlock.doBlockJob
+	srBlock.Check = srBlock.doBlockConsensusCheck
+	srBlock.Extend = extend
+
+	return &srBlock, nil
+}
+
+func checkNewSubroundBlockParams(
+	baseSubround *spos.Subround,
+) error {
+	if baseSubround == nil {
+		return spos.ErrNilSubround
+	}
+
+	if baseSubround.ConsensusState == nil {
+		return spos.ErrNilConsensusState
+	}
+
+	err := spos.ValidateConsensusCore(baseSubround.ConsensusCoreHandler)
+
+	return err
+}
+
+// doBlockJob method does the job of the subround Block
+func (sr *subroundBlock) doBlockJob() bool {
+	if !sr.IsSelfLeaderInCurrentRound() { // is NOT self leader in this round?
+		return false
+	}
+
+	if sr.Rounder().Index() <= sr.getRoundInLastCommittedBlock() {
+		return false
+	}
+
+	if sr.IsSelfJobDone(sr.Current()) {
+		return false
+	}
+
+	if sr.IsSubroundFinished(sr.Current()) {
+		return false
+	}
+
+	header, err := sr.createHeader()
+	if err != nil {
+		log.Debug("doBlockJob.createHeader", "error", err.Error())
+		return false
+	}
+
+	header, body, err := sr.createBlock(header)
+	if err != nil {
+		log.Debug("doBlockJob.createBlock", "error", err.Error())
+		return false
+	}
+
+	err = sr.sealBlockHeader(header)
+	if err != nil {
+		log.Debug("doBlockJob.sealBlockHeader", "error", err.Error())
+		return false
+	}
+
+	sr.Body = body
+	sr.Header = header
+
+	sr.sendBlockBodyAndHeader()
+
+	err = sr.BroadcastMessenger().BroadcastHeader(sr.Header)
+	if err != nil {
+		log.Debug("doBlockJob.BroadcastHeader", "error", err.Error())
+	}
+
+	startTime := time.Now()
+	err = sr.BlockProcessor().CommitBlock(sr.Header, sr.Body)
+	elapsedTime := time.Since(startTime)
+	if elapsedTime >= core.CommitMaxTime {
+		log.Warn("doBlockJob.CommitBlock", "elapsed time", elapsedTime)
+	} else {
+		log.Debug("elapsed time to commit block",
+			"time [s]", elapsedTime,
+		)
+	}
+	if err != nil {
+		log.Debug("doBlockJob.CommitBlock", "error", err)
+		return false
+	}
+
+	err = sr.SetSelfJobDone(sr.Current(), true)
+	if err != nil {
+		log.Debug("doBlockJob.SetSelfJobDone", "error", err.Error())
+		return false
+	}
+
+	sr.SetStatus(sr.Current(), spos.SsFinished)
+
+	sr.displayStatistics()
+
+	log.Debug("step 1: block has been sealed, committed and broadcast")
+
+	err = sr.broadcastMiniBlocksAndTransactions()
+	if err != nil {
+		log.Debug("doBlockJob.broadcastMiniBlocksAndTransactions", "error", err.Error())
+	}
+
+	msg := fmt.Sprintf("Added proposed block with nonce  %d  in blockchain", sr.Header.GetNonce())
+	log.Debug(display.Headline(msg, sr.SyncTimer().FormattedCurrentTime(), "+"))
+
+	sr.updateMetricsForLeader()
+
+	return true
+}
+
+func (sr *subroundBlock) createHeader() (data.HeaderHandler, error) {
+	var nonce uint64
+	var prevHash []byte
+	var prevRandSeed []byte
+
+	currentHeader := sr.Blockchain().GetCurrentBlockHeader()
+	if check.IfNil(currentHeader) {
+		nonce = 1
+		prevHash = sr.Blockchain().GetGenesisHeaderHash()
+		prevRandSeed = sr.Blockchain().GetGenesisHeader().GetRandSeed()
+	} else {
+		nonce = currentHeader.GetNonce() + 1
+		prevHash = sr.Blockchain().GetCurrentBlockHeaderHash()
+		prevRandSeed = currentHeader.GetRandSeed()
+	}
+
+	round := uint64(sr.Rounder().Index())
+	hdr := sr.BlockProcessor().CreateNewHeader(round, nonce)
+	hdr.SetPrevHash(prevHash)
+
+	randSeed, err := sr.SingleSigner().Sign(sr.PrivateKey(), prevRandSeed)
+	if err != nil {
+		return nil, err
+	}
+
+	hdr.SetShardID(sr.ShardCoordinator().SelfId())
+	hdr.SetTimeStamp(uint64(sr.Rounder().TimeStamp().Unix()))
+	hdr.SetPrevRandSeed(prevRandSeed)
+	hdr.SetRandSeed(randSeed)
+	hdr.SetChainID(sr.ChainID())
+
+	return hdr, nil
+}
+
+func (sr *subroundBlock) createBlock(header data.HeaderHandler) (data.HeaderHandler, data.BodyHandler, error) {
+	startTime := sr.RoundTimeStamp
+	maxTime := time.Duration(sr.EndTime())
+	haveTimeInCurrentSubround := func() bool {
+		return sr.Rounder().RemainingTime(startTime, maxTime) > 0
+	}
+
+	finalHeader, blockBody, err := sr.BlockProcessor().CreateBlock(
+		header,
+		haveTimeInCurrentSubround,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return finalHeader, blockBody, nil
+}
+
+// sealBlockHeader signs the header as the only member of the consensus group. The signature set on the header is
+// the multi-signature of a group of one, so the header is verified as any other header, while the leader signature
+// is added on top of it
+func (sr *subroundBlock) sealBlockHeader(header data.HeaderHandler) error {
+	headerHash, err := core.CalculateHash(sr.Marshalizer(), sr.Hasher(), header)
+	if err != nil {
+		return err
+	}
+
+	_, err = sr.MultiSigner().CreateSignatureShare(headerHash, nil)
+	if err != nil {
+		return err
+	}
+
+	// the leader is the only member of the consensus group, so only its bit is set
+	bitmap := []byte{1}
+	sig, err := sr.MultiSigner().AggregateSigs(bitmap)
+	if err != nil {
+		return err
+	}
+
+	header.SetPubKeysBitmap(bitmap)
+	header.SetSignature(sig)
+
+	marshalizedHdr, err := sr.Marshalizer().Marshal(header)
+	if err != nil {
+		return err
+	}
+
+	leaderSignature, err := sr.SingleSigner().Sign(sr.PrivateKey(), marshalizedHdr)
+	if err != nil {
+		return err
+	}
+
+	header.SetLeaderSignature(leaderSignature)
+	sr.Data = headerHash
+
+	return nil
+}
+
+// sendBlockBodyAndHeader sends the sealed header together with its body on the consensus topic, so that the other
+// nodes of the shard have the miniblocks in their pools when they sync the block
+func (sr *subroundBlock) sendBlockBodyAndHeader() {
+	marshalizedBody, err := sr.Marshalizer().Marshal(sr.Body)
+	if err != nil {
+		log.Debug("sendBlockBodyAndHeader.Marshal: body", "error", err.Error())
+		return
+	}
+
+	marshalizedHeader, err := sr.Marshalizer().Marshal(sr.Header)
+	if err != nil {
+		log.Debug("sendBlockBodyAndHeader.Marshal: header", "error", err.Error())
+		return
+	}
+
+	bodyAndHeaderSize := uint32(len(marshalizedBody) + len(marshalizedHeader))
+	if bodyAndHeaderSize > maxAllowedSizeInBytes {
+		log.Debug("sendBlockBodyAndHeader: block is too big to be sent on the consensus topic",
+			"body and header size", bodyAndHeaderSize,
+			"max allowed size in bytes", maxAllowedSizeInBytes)
+		return
+	}
+
+	headerHash := sr.Hasher().Compute(string(marshalizedHeader))
+
+	cnsMsg := consensus.NewConsensusMessage(
+		headerHash,
+		nil,
+		marshalizedBody,
+		marshalizedHeader,
+		[]byte(sr.SelfPubKey()),
+		nil,
+		int(MtBlockBodyAndHeader),
+		sr.Rounder().Index(),
+		sr.ChainID(),
+		nil,
+		nil,
+		nil,
+	)
+
+	err = sr.BroadcastMessenger().BroadcastConsensusMessage(cnsMsg)
+	if err != nil {
+		log.Debug("sendBlockBodyAndHeader.BroadcastConsensusMessage", "error", err.Error())
+		return
+	}
+
+	log.Debug("step 1: block body and sealed header have been sent",
+		"nonce", sr.Header.GetNonce(),
+		"hash", headerHash)
+}
+
+func (sr *subroundBlock) broadcastMiniBlocksAndTransactions() error {
+	miniBlocks, transactions, err := sr.BlockProcessor().MarshalizedDataToBroadcast(sr.Header, sr.Body)
+	if err != nil {
+		return err
+	}
+
+	if sr.ShardCoordinator().SelfId() != core.MetachainShardId {
+		var headerHash []byte
+		headerHash, err = core.CalculateHash(sr.Marshalizer(), sr.Hasher(), sr.Header)
+		if err != nil {
+			return err
+		}
+
+		metaMiniBlocks, metaTransactions := sr.extractMetaMiniBlocksAndTransactions(miniBlocks, transactions)
+
+		err = sr.BroadcastMessenger().SetDataForDelayBroadcast(headerHash, miniBlocks, transactions)
+		if err != nil {
+			return err
+		}
+
+		go sr.broadcast(metaMiniBlocks, metaTransactions, 0)
+		return nil
+	}
+
+	go sr.broadcast(miniBlocks, transactions, core.ExtraDelayForBroadcastBlockInfo)
+	return nil
+}
+
+func (sr *subroundBlock) extractMetaMiniBlocksAndTransactions(
+	miniBlocks map[uint32][]byte,
+	transactions map[string][][]byte,
+) (map[uint32][]byte, map[string][][]byte) {
+
+	metaMiniBlocks := make(map[uint32][]byte)
+	metaTransactions := make(map[string][][]byte)
+
+	for shardID, mbsMarshalized := range miniBlocks {
+		if shardID != core.MetachainShardId {
+			continue
+		}
+
+		metaMiniBlocks[shardID] = mbsMarshalized
+		delete(miniBlocks, shardID)
+	}
+
+	identifier := sr.ShardCoordinator().CommunicationIdentifier(core.MetachainShardId)
+
+	for broadcastTopic, txsMarshalized := range transactions {
+		if !strings.Contains(broadcastTopic, identifier) {
+			continue
+		}
+
+		metaTransactions[broadcastTopic] = txsMarshalized
+		delete(transactions, broadcastTopic)
+	}
+
+	return metaMiniBlocks, metaTransactions
+}
+
+func (sr *subroundBlock) broadcast(
+	miniBlocks map[uint32][]byte,
+	transactions map[string][][]byte,
+	extraDelayForBroadcast time.Duration,
+) {
+	time.Sleep(extraDelayForBroadcast)
+
+	if len(miniBlocks) > 0 {
+		err := sr.BroadcastMessenger().BroadcastMiniBlocks(miniBlocks)
+		if err != nil {
+			log.Warn("broadcast.BroadcastMiniBlocks", "error", err.Error())
+		}
+	}
+
+	if len(transactions) > 0 {
+		err := sr.BroadcastMessenger().BroadcastTransactions(transactions)
+		if err != nil {
+			log.Warn("broadcast.BroadcastTransactions", "error", err.Error())
+		}
+	}
+}
+
+func (sr *subroundBlock) updateMetricsForLeader() {
+	sr.AppStatusHandler().Increment(core.MetricCountAcceptedBlocks)
+	sr.AppStatusHandler().SetStringValue(core.MetricConsensusRoundState,
+		fmt.Sprintf("valid block produced in %f sec", time.Since(sr.Rounder().TimeStamp()).Seconds()))
+}
+
+func (sr *subroundBlock) getRoundInLastCommittedBlock() int64 {
+	roundInLastCommittedBlock := int64(0)
+	currentHeader := sr.Blockchain().GetCurrentBlockHeader()
+	if !check.IfNil(currentHeader) {
+		roundInLastCommittedBlock = int64(currentHeader.GetRound())
+	}
+
+	return roundInLastCommittedBlock
+}
+
+// doBlockConsensusCheck method checks if the consensus in the subround Block is achieved. The nodes which are not
+// the leader of the round have nothing to agree upon, so the subround is finished for them right away
+func (sr *subroundBlock) doBlockConsensusCheck() bool {
+	if sr.RoundCanceled {
+		return false
+	}
+
+	if sr.IsSubroundFinished(sr.Current()) {
+		return true
+	}
+
+	if !sr.IsSelfLeaderInCurrentRound() {
+		sr.SetStatus(sr.Current(), spos.SsFinished)
+		return true
+	}
+
+	return false
+}
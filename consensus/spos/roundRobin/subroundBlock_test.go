@@ -0,0 +1,149 @@
+package roundRobin_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/roundRobin"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/stretchr/testify/assert"
+)
+
+type roundRobinSubroundBlock interface {
+	DoBlockJob() bool
+	DoBlockConsensusCheck() bool
+}
+
+func displayStatistics() {
+}
+
+func extend(_ int) {
+}
+
+func executeStoredMessages() {
+}
+
+func initSubroundBlock(container *mock.ConsensusCoreMock, selfPubKey string) (roundRobinSubroundBlock, *spos.ConsensusState) {
+	container.SetRounder(initRounderMock())
+	container.SetBlockchain(&mock.BlockChainMock{
+		GetGenesisHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{RandSeed: []byte("genesis rand seed")}
+		},
+		GetGenesisHeaderHashCalled: func() []byte {
+			return []byte("genesis header hash")
+		},
+	})
+
+	consensusState := initConsensusState(selfPubKey, 1)
+	sr, _ := spos.NewSubround(
+		roundRobin.SrStartRound,
+		roundRobin.SrBlock,
+		-1,
+		int64(5*roundTimeDuration/100),
+		int64(85*roundTimeDuration/100),
+		"(BLOCK)",
+		consensusState,
+		make(chan bool, 1),
+		executeStoredMessages,
+		container,
+		chainID,
+	)
+
+	srBlock, _ := roundRobin.NewSubroundBlock(sr, extend, 85, displayStatistics)
+
+	return srBlock, consensusState
+}
+
+func TestSubroundBlock_NewSubroundBlockNilSubroundShouldFail(t *testing.T) {
+	t.Parallel()
+
+	srBlock, err := roundRobin.NewSubroundBlock(nil, extend, 85, displayStatistics)
+
+	assert.Nil(t, srBlock)
+	assert.Equal(t, spos.ErrNilSubround, err)
+}
+
+func TestSubroundBlock_DoBlockJobShouldSealCommitAndBroadcastTheBlock(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	var committedHeader data.HeaderHandler
+	blockProcessor := mock.InitBlockProcessorMock()
+	blockProcessor.CommitBlockCalled = func(header data.HeaderHandler, body data.BodyHandler) error {
+		committedHeader = header
+		return nil
+	}
+	container.SetBlockProcessor(blockProcessor)
+
+	var broadcastHeader data.HeaderHandler
+	var consensusMessage *consensus.Message
+	container.SetBroadcastMessenger(&mock.BroadcastMessengerMock{
+		BroadcastHeaderCalled: func(header data.HeaderHandler) error {
+			broadcastHeader = header
+			return nil
+		},
+		BroadcastConsensusMessageCalled: func(message *consensus.Message) error {
+			consensusMessage = message
+			return nil
+		},
+		SetDataForDelayBroadcastCalled: func(_ []byte, _ map[uint32][]byte, _ map[string][][]byte) error {
+			return nil
+		},
+	})
+
+	srBlock, consensusState := initSubroundBlock(container, "A")
+
+	assert.True(t, srBlock.DoBlockJob())
+	assert.True(t, consensusState.IsSubroundFinished(roundRobin.SrBlock))
+	assert.True(t, srBlock.DoBlockConsensusCheck())
+
+	assert.NotNil(t, committedHeader)
+	assert.Equal(t, committedHeader, broadcastHeader)
+	assert.Equal(t, []byte{1}, committedHeader.GetPubKeysBitmap())
+	assert.Equal(t, []byte("aggregatedSig"), committedHeader.GetSignature())
+	assert.NotNil(t, committedHeader.GetLeaderSignature())
+	assert.Equal(t, uint64(1), committedHeader.GetNonce())
+
+	assert.NotNil(t, consensusMessage)
+	assert.Equal(t, int64(roundRobin.MtBlockBodyAndHeader), consensusMessage.MsgType)
+	assert.Equal(t, []byte("A"), consensusMessage.PubKey)
+}
+
+func TestSubroundBlock_DoBlockJobCommitFailsShouldNotFinishTheSubround(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	blockProcessor := mock.InitBlockProcessorMock()
+	blockProcessor.CommitBlockCalled = func(header data.HeaderHandler, body data.BodyHandler) error {
+		return errors.New("commit error")
+	}
+	container.SetBlockProcessor(blockProcessor)
+
+	srBlock, consensusState := initSubroundBlock(container, "A")
+
+	assert.False(t, srBlock.DoBlockJob())
+	assert.False(t, consensusState.IsSubroundFinished(roundRobin.SrBlock))
+	assert.False(t, srBlock.DoBlockConsensusCheck())
+}
+
+func TestSubroundBlock_NotLeaderShouldFinishTheSubroundWithoutCreatingTheBlock(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	blockProcessor := mock.InitBlockProcessorMock()
+	blockProcessor.CreateBlockCalled = func(header data.HeaderHandler, haveTime func() bool) (data.HeaderHandler, data.BodyHandler, error) {
+		assert.Fail(t, "should have not created the block")
+		return nil, nil, nil
+	}
+	container.SetBlockProcessor(blockProcessor)
+
+	srBlock, consensusState := initSubroundBlock(container, "B")
+
+	assert.False(t, srBlock.DoBlockJob())
+	assert.True(t, srBlock.DoBlockConsensusCheck())
+	assert.True(t, consensusState.IsSubroundFinished(roundRobin.SrBlock))
+}
@@ -0,0 +1,114 @@
+package sposFactory
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/roundRobin"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+)
+
+// ArgsSubroundsFactory holds all dependencies required by a consensus engine in order to create its subrounds factory
+type ArgsSubroundsFactory struct {
+	ConsensusCore    spos.ConsensusCoreHandler
+	ConsensusState   *spos.ConsensusState
+	Worker           spos.WorkerHandler
+	AppStatusHandler core.AppStatusHandler
+	Indexer          indexer.Indexer
+	RoundTracer      consensus.RoundTracer
+	ChainID          []byte
+}
+
+// ConsensusEngine defines the components a consensus type is made of. The chronology, the broadcast messenger and the
+// worker are common to all the engines, while each engine brings its own message types and subrounds
+type ConsensusEngine interface {
+	ConsensusService() (spos.ConsensusService, error)
+	SubroundsFactory(args ArgsSubroundsFactory) (spos.SubroundsFactory, error)
+	IsInterfaceNil() bool
+}
+
+// GetConsensusEngine returns the consensus engine selected by the given consensus type
+func GetConsensusEngine(consensusType string) (ConsensusEngine, error) {
+	switch consensusType {
+	case blsConsensusType:
+		return &blsEngine{}, nil
+	case roundRobinConsensusType:
+		return &roundRobinEngine{}, nil
+	default:
+		return nil, ErrInvalidConsensusType
+	}
+}
+
+// blsEngine is the SPoS consensus with BLS aggregated signatures
+type blsEngine struct {
+}
+
+// ConsensusService returns the consensus service of the BLS consensus
+func (be *blsEngine) ConsensusService() (spos.ConsensusService, error) {
+	return bls.NewConsensusService()
+}
+
+// SubroundsFactory returns the factory of the start round, block, signature and end round subrounds
+func (be *blsEngine) SubroundsFactory(args ArgsSubroundsFactory) (spos.SubroundsFactory, error) {
+	subRoundFactoryBls, err := bls.NewSubroundsFactory(args.ConsensusCore, args.ConsensusState, args.Worker, args.ChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = subRoundFactoryBls.SetAppStatusHandler(args.AppStatusHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	subRoundFactoryBls.SetIndexer(args.Indexer)
+
+	err = subRoundFactoryBls.SetRoundTracer(args.RoundTracer)
+	if err != nil {
+		return nil, err
+	}
+
+	return subRoundFactoryBls, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (be *blsEngine) IsInterfaceNil() bool {
+	return be == nil
+}
+
+// roundRobinEngine is the single leader consensus in which the leader of each round seals the block alone. It is
+// meant for dev and test networks
+type roundRobinEngine struct {
+}
+
+// ConsensusService returns the consensus service of the round-robin consensus
+func (rre *roundRobinEngine) ConsensusService() (spos.ConsensusService, error) {
+	return roundRobin.NewConsensusService()
+}
+
+// SubroundsFactory returns the factory of the start round and block subrounds
+func (rre *roundRobinEngine) SubroundsFactory(args ArgsSubroundsFactory) (spos.SubroundsFactory, error) {
+	subRoundFactoryRoundRobin, err := roundRobin.NewSubroundsFactory(args.ConsensusCore, args.ConsensusState, args.Worker, args.ChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = subRoundFactoryRoundRobin.SetAppStatusHandler(args.AppStatusHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	subRoundFactoryRoundRobin.SetIndexer(args.Indexer)
+
+	err = subRoundFactoryRoundRobin.SetRoundTracer(args.RoundTracer)
+	if err != nil {
+		return nil, err
+	}
+
+	return subRoundFactoryRoundRobin, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rre *roundRobinEngine) IsInterfaceNil() bool {
+	return rre == nil
+}
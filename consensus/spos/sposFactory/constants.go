@@ -1,4 +1,5 @@
 package sposFactory
 
 const blsConsensusType = "bls"
+const roundRobinConsensusType = "roundrobin"
 const maxDelayCacheSize = 20
@@ -4,7 +4,6 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/broadcast"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
-	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/crypto"
@@ -20,36 +19,33 @@ func GetSubroundsFactory(
 	consensusType string,
 	appStatusHandler core.AppStatusHandler,
 	indexer indexer.Indexer,
+	roundTracer consensus.RoundTracer,
 	chainID []byte,
 ) (spos.SubroundsFactory, error) {
-	switch consensusType {
-	case blsConsensusType:
-		subRoundFactoryBls, err := bls.NewSubroundsFactory(consensusDataContainer, consensusState, worker, chainID)
-		if err != nil {
-			return nil, err
-		}
-
-		err = subRoundFactoryBls.SetAppStatusHandler(appStatusHandler)
-		if err != nil {
-			return nil, err
-		}
-
-		subRoundFactoryBls.SetIndexer(indexer)
-
-		return subRoundFactoryBls, nil
-	default:
-		return nil, ErrInvalidConsensusType
+	engine, err := GetConsensusEngine(consensusType)
+	if err != nil {
+		return nil, err
 	}
+
+	return engine.SubroundsFactory(ArgsSubroundsFactory{
+		ConsensusCore:    consensusDataContainer,
+		ConsensusState:   consensusState,
+		Worker:           worker,
+		AppStatusHandler: appStatusHandler,
+		Indexer:          indexer,
+		RoundTracer:      roundTracer,
+		ChainID:          chainID,
+	})
 }
 
 // GetConsensusCoreFactory returns a consensus service depending of the given parameter
 func GetConsensusCoreFactory(consensusType string) (spos.ConsensusService, error) {
-	switch consensusType {
-	case blsConsensusType:
-		return bls.NewConsensusService()
-	default:
-		return nil, ErrInvalidConsensusType
+	engine, err := GetConsensusEngine(consensusType)
+	if err != nil {
+		return nil, err
 	}
+
+	return engine.ConsensusService()
 }
 
 // GetBroadcastMessenger returns a consensus service depending of the given parameter
@@ -6,9 +6,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/roundRobin"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/debug/consensusTrace"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -30,6 +32,36 @@ func TestGetConsensusCoreFactory_BlsShouldWork(t *testing.T) {
 	assert.False(t, check.IfNil(csf))
 }
 
+func TestGetConsensusCoreFactory_RoundRobinShouldWork(t *testing.T) {
+	t.Parallel()
+
+	csf, err := sposFactory.GetConsensusCoreFactory(consensus.RoundRobinConsensusType)
+
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(csf))
+}
+
+func TestGetConsensusEngine_InvalidTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	engine, err := sposFactory.GetConsensusEngine("invalid")
+
+	assert.True(t, check.IfNil(engine))
+	assert.Equal(t, sposFactory.ErrInvalidConsensusType, err)
+}
+
+func TestGetConsensusEngine_KnownTypesShouldWork(t *testing.T) {
+	t.Parallel()
+
+	engine, err := sposFactory.GetConsensusEngine(consensus.BlsConsensusType)
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(engine))
+
+	engine, err = sposFactory.GetConsensusEngine(consensus.RoundRobinConsensusType)
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(engine))
+}
+
 func TestGetSubroundsFactory_BlsNilConsensusCoreShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -45,6 +77,7 @@ func TestGetSubroundsFactory_BlsNilConsensusCoreShouldErr(t *testing.T) {
 		consensusType,
 		statusHandler,
 		indexer,
+		consensusTrace.NewDisabledRoundTracer(),
 		chainID,
 	)
 
@@ -67,6 +100,7 @@ func TestGetSubroundsFactory_BlsNilStatusHandlerShouldErr(t *testing.T) {
 		consensusType,
 		nil,
 		indexer,
+		consensusTrace.NewDisabledRoundTracer(),
 		chainID,
 	)
 
@@ -74,6 +108,30 @@ func TestGetSubroundsFactory_BlsNilStatusHandlerShouldErr(t *testing.T) {
 	assert.Equal(t, spos.ErrNilAppStatusHandler, err)
 }
 
+func TestGetSubroundsFactory_BlsNilRoundTracerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	consensusCore := mock.InitConsensusCore()
+	worker := &mock.SposWorkerMock{}
+	consensusType := consensus.BlsConsensusType
+	statusHandler := &mock.AppStatusHandlerMock{}
+	chainID := []byte("chain-id")
+	indexer := &mock.IndexerMock{}
+	sf, err := sposFactory.GetSubroundsFactory(
+		consensusCore,
+		&spos.ConsensusState{},
+		worker,
+		consensusType,
+		statusHandler,
+		indexer,
+		nil,
+		chainID,
+	)
+
+	assert.Nil(t, sf)
+	assert.Equal(t, spos.ErrNilRoundTracer, err)
+}
+
 func TestGetSubroundsFactory_BlsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -90,12 +148,59 @@ func TestGetSubroundsFactory_BlsShouldWork(t *testing.T) {
 		consensusType,
 		statusHandler,
 		indexer,
+		consensusTrace.NewDisabledRoundTracer(),
 		chainID,
 	)
 	assert.Nil(t, err)
 	assert.False(t, check.IfNil(sf))
 }
 
+func TestGetSubroundsFactory_RoundRobinConsensusGroupBiggerThanOneShouldErr(t *testing.T) {
+	t.Parallel()
+
+	consensusState := spos.NewConsensusState(
+		spos.NewRoundConsensus(map[string]struct{}{"A": {}, "B": {}}, 2, "A"),
+		spos.NewRoundThreshold(),
+		spos.NewRoundStatus(),
+	)
+	sf, err := sposFactory.GetSubroundsFactory(
+		mock.InitConsensusCore(),
+		consensusState,
+		&mock.SposWorkerMock{},
+		consensus.RoundRobinConsensusType,
+		&mock.AppStatusHandlerMock{},
+		&mock.IndexerMock{},
+		consensusTrace.NewDisabledRoundTracer(),
+		[]byte("chain-id"),
+	)
+
+	assert.Nil(t, sf)
+	assert.Equal(t, roundRobin.ErrInvalidConsensusGroupSize, err)
+}
+
+func TestGetSubroundsFactory_RoundRobinShouldWork(t *testing.T) {
+	t.Parallel()
+
+	consensusState := spos.NewConsensusState(
+		spos.NewRoundConsensus(map[string]struct{}{"A": {}, "B": {}}, 1, "A"),
+		spos.NewRoundThreshold(),
+		spos.NewRoundStatus(),
+	)
+	sf, err := sposFactory.GetSubroundsFactory(
+		mock.InitConsensusCore(),
+		consensusState,
+		&mock.SposWorkerMock{},
+		consensus.RoundRobinConsensusType,
+		&mock.AppStatusHandlerMock{},
+		&mock.IndexerMock{},
+		consensusTrace.NewDisabledRoundTracer(),
+		[]byte("chain-id"),
+	)
+
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(sf))
+}
+
 func TestGetSubroundsFactory_InvalidConsensusTypeShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -108,6 +213,7 @@ func TestGetSubroundsFactory_InvalidConsensusTypeShouldErr(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 	)
 
 	assert.Nil(t, sf)
@@ -1,11 +1,13 @@
 package spos
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/debug/consensusTrace"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 )
 
@@ -30,6 +32,7 @@ type Subround struct {
 	consensusStateChangedChannel chan bool
 	executeStoredMessages        func()
 	appStatusHandler             core.AppStatusHandler
+	roundTracer                  consensus.RoundTracer
 
 	Job    func() bool          // method does the Subround Job and send the result to the peers
 	Check  func() bool          // method checks if the consensus of the Subround is done
@@ -77,6 +80,7 @@ func NewSubround(
 		Check:                        nil,
 		Extend:                       nil,
 		appStatusHandler:             statusHandler.NewNilStatusHandler(),
+		roundTracer:                  consensusTrace.NewDisabledRoundTracer(),
 	}
 
 	return &sr, nil
@@ -117,10 +121,37 @@ func (sr *Subround) DoWork(rounder consensus.Rounder) bool {
 		return false
 	}
 
+	roundIndex := rounder.Index()
+	startTime := rounder.TimeStamp()
+	started := time.Since(startTime)
+
+	isFinished := sr.doWork(rounder, startTime)
+
+	sr.roundTracer.AddSubround(roundIndex, consensus.SubroundTrace{
+		Name:            sr.name,
+		ConfiguredStart: time.Duration(sr.startTime),
+		ConfiguredEnd:   time.Duration(sr.endTime),
+		Started:         started,
+		Ended:           time.Since(startTime),
+		IsFinished:      isFinished,
+	})
+
+	if !isFinished {
+		sr.roundTracer.EndRound(roundIndex, fmt.Sprintf("canceled in subround %s", sr.name))
+		return false
+	}
+
+	if sr.next == -1 {
+		sr.roundTracer.EndRound(roundIndex, consensusTrace.OutcomeFinished)
+	}
+
+	return true
+}
+
+func (sr *Subround) doWork(rounder consensus.Rounder, startTime time.Time) bool {
 	// execute stored messages which were received in this new round but before this initialisation
 	go sr.executeStoredMessages()
 
-	startTime := rounder.TimeStamp()
 	maxTime := rounder.TimeDuration() * MaxThresholdPercent / 100
 
 	sr.Job()
@@ -195,6 +226,21 @@ func (sr *Subround) AppStatusHandler() core.AppStatusHandler {
 	return sr.appStatusHandler
 }
 
+// SetRoundTracer method sets the round tracer used to record the timings of the subround
+func (sr *Subround) SetRoundTracer(roundTracer consensus.RoundTracer) error {
+	if check.IfNil(roundTracer) {
+		return ErrNilRoundTracer
+	}
+	sr.roundTracer = roundTracer
+
+	return nil
+}
+
+// RoundTracer method returns the roundTracer instance
+func (sr *Subround) RoundTracer() consensus.RoundTracer {
+	return sr.roundTracer
+}
+
 // ConsensusChannel method returns the consensus channel
 func (sr *Subround) ConsensusChannel() chan bool {
 	return sr.consensusStateChangedChannel
@@ -5,9 +5,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/debug/consensusTrace"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -885,3 +887,123 @@ func TestSubround_AppStatusHandlerShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, ash == sr.AppStatusHandler())
 }
+
+func TestSubround_RoundTracerNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sr := &spos.Subround{}
+	err := sr.SetRoundTracer(nil)
+
+	assert.Equal(t, spos.ErrNilRoundTracer, err)
+}
+
+func TestSubround_RoundTracerShouldWork(t *testing.T) {
+	t.Parallel()
+
+	sr := &spos.Subround{}
+	rts := &mock.RoundTracerStub{}
+	err := sr.SetRoundTracer(rts)
+
+	assert.Nil(t, err)
+	assert.True(t, rts == sr.RoundTracer())
+}
+
+func createSubroundWithRoundTracer(next int, checkDone bool, rts *mock.RoundTracerStub) *spos.Subround {
+	sr, _ := spos.NewSubround(
+		bls.SrSignature,
+		bls.SrEndRound,
+		next,
+		int64(85*roundTimeDuration/100),
+		int64(95*roundTimeDuration/100),
+		"(END_ROUND)",
+		initConsensusState(),
+		make(chan bool, 1),
+		executeStoredMessages,
+		mock.InitConsensusCore(),
+		chainID,
+	)
+	sr.Job = func() bool {
+		return true
+	}
+	sr.Check = func() bool {
+		return checkDone
+	}
+	_ = sr.SetRoundTracer(rts)
+
+	return sr
+}
+
+func TestSubround_DoWorkLastSubroundShouldTraceTheFinishedRound(t *testing.T) {
+	t.Parallel()
+
+	var tracedSubround consensus.SubroundTrace
+	outcome := ""
+	rts := &mock.RoundTracerStub{
+		AddSubroundCalled: func(round int64, subround consensus.SubroundTrace) {
+			tracedSubround = subround
+		},
+		EndRoundCalled: func(round int64, roundOutcome string) {
+			outcome = roundOutcome
+		},
+	}
+	sr := createSubroundWithRoundTracer(-1, true, rts)
+
+	rounderMock := &mock.RounderMock{
+		TimeStampCalled: func() time.Time {
+			return time.Now()
+		},
+	}
+	r := sr.DoWork(rounderMock)
+
+	assert.True(t, r)
+	assert.Equal(t, "(END_ROUND)", tracedSubround.Name)
+	assert.Equal(t, time.Duration(85*roundTimeDuration/100), tracedSubround.ConfiguredStart)
+	assert.Equal(t, time.Duration(95*roundTimeDuration/100), tracedSubround.ConfiguredEnd)
+	assert.True(t, tracedSubround.IsFinished)
+	assert.Equal(t, consensusTrace.OutcomeFinished, outcome)
+}
+
+func TestSubround_DoWorkNotLastSubroundShouldNotEndTheRound(t *testing.T) {
+	t.Parallel()
+
+	endRoundCalled := false
+	rts := &mock.RoundTracerStub{
+		EndRoundCalled: func(round int64, roundOutcome string) {
+			endRoundCalled = true
+		},
+	}
+	sr := createSubroundWithRoundTracer(bls.SrEndRound+1, true, rts)
+
+	r := sr.DoWork(&mock.RounderMock{})
+
+	assert.True(t, r)
+	assert.False(t, endRoundCalled)
+}
+
+func TestSubround_DoWorkTimeOutShouldTraceTheCanceledRound(t *testing.T) {
+	t.Parallel()
+
+	var tracedSubround consensus.SubroundTrace
+	outcome := ""
+	rts := &mock.RoundTracerStub{
+		AddSubroundCalled: func(round int64, subround consensus.SubroundTrace) {
+			tracedSubround = subround
+		},
+		EndRoundCalled: func(round int64, roundOutcome string) {
+			outcome = roundOutcome
+		},
+	}
+	sr := createSubroundWithRoundTracer(-1, false, rts)
+
+	maxTime := time.Now().Add(10 * time.Millisecond)
+	rounderMock := &mock.RounderMock{
+		RemainingTimeCalled: func(time.Time, time.Duration) time.Duration {
+			return time.Until(maxTime)
+		},
+	}
+	r := sr.DoWork(rounderMock)
+
+	assert.False(t, r)
+	assert.False(t, tracedSubround.IsFinished)
+	assert.Equal(t, "canceled in subround (END_ROUND)", outcome)
+}
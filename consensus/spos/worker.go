@@ -14,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/debug/consensusTrace"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/ntp"
@@ -47,6 +48,7 @@ type Worker struct {
 	headerSigVerifier       RandSeedVerifier
 	headerIntegrityVerifier HeaderIntegrityVerifier
 	appStatusHandler        core.AppStatusHandler
+	roundTracer             consensus.RoundTracer
 	chainID                 []byte
 
 	networkShardingCollector consensus.NetworkShardingCollector
@@ -66,8 +68,9 @@ type Worker struct {
 	receivedHeadersHandlers   []func(headerHandler data.HeaderHandler)
 	mutReceivedHeadersHandler sync.RWMutex
 
-	antifloodHandler consensus.P2PAntifloodHandler
-	poolAdder        PoolAdder
+	antifloodHandler     consensus.P2PAntifloodHandler
+	poolAdder            PoolAdder
+	equivocationDetector EquivocationDetector
 
 	signatureSize       int
 	publicKeySize       int
@@ -97,6 +100,7 @@ type WorkerArgs struct {
 	NetworkShardingCollector consensus.NetworkShardingCollector
 	AntifloodHandler         consensus.P2PAntifloodHandler
 	PoolAdder                PoolAdder
+	EquivocationDetector     EquivocationDetector
 	SignatureSize            int
 	PublicKeySize            int
 }
@@ -127,9 +131,11 @@ func NewWorker(args *WorkerArgs) (*Worker, error) {
 		headerIntegrityVerifier:  args.HeaderIntegrityVerifier,
 		chainID:                  args.ChainID,
 		appStatusHandler:         statusHandler.NewNilStatusHandler(),
+		roundTracer:              consensusTrace.NewDisabledRoundTracer(),
 		networkShardingCollector: args.NetworkShardingCollector,
 		antifloodHandler:         args.AntifloodHandler,
 		poolAdder:                args.PoolAdder,
+		equivocationDetector:     args.EquivocationDetector,
 		signatureSize:            args.SignatureSize,
 		publicKeySize:            args.PublicKeySize,
 	}
@@ -223,6 +229,9 @@ func checkNewWorkerParams(args *WorkerArgs) error {
 	if check.IfNil(args.PoolAdder) {
 		return ErrNilPoolAdder
 	}
+	if check.IfNil(args.EquivocationDetector) {
+		return ErrNilEquivocationDetector
+	}
 
 	return nil
 }
@@ -245,6 +254,8 @@ func (wrk *Worker) ReceivedHeader(headerHandler data.HeaderHandler, _ []byte) {
 		return
 	}
 
+	wrk.checkHeaderEquivocation(headerHandler)
+
 	wrk.mutReceivedHeadersHandler.RLock()
 	for _, handler := range wrk.receivedHeadersHandlers {
 		handler(headerHandler)
@@ -257,6 +268,19 @@ func (wrk *Worker) ReceivedHeader(headerHandler data.HeaderHandler, _ []byte) {
 	}
 }
 
+func (wrk *Worker) checkHeaderEquivocation(headerHandler data.HeaderHandler) {
+	if wrk.consensusState.RoundIndex != int64(headerHandler.GetRound()) {
+		return
+	}
+
+	leader, err := wrk.consensusState.GetLeader()
+	if err != nil {
+		return
+	}
+
+	wrk.equivocationDetector.CheckHeader(headerHandler, []byte(leader))
+}
+
 // AddReceivedHeaderHandler adds a new handler function for a received header
 func (wrk *Worker) AddReceivedHeaderHandler(handler func(data.HeaderHandler)) {
 	wrk.mutReceivedHeadersHandler.Lock()
@@ -344,6 +368,8 @@ func (wrk *Worker) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedP
 	}
 
 	go wrk.updateNetworkShardingVals(message, cnsMsg)
+	wrk.traceReceivedMessage(cnsMsg)
+	wrk.equivocationDetector.CheckConsensusMessage(cnsMsg, message.Data())
 
 	isMessageWithBlockBody := wrk.consensusService.IsMessageWithBlockBody(msgType)
 	isMessageWithBlockHeader := wrk.consensusService.IsMessageWithBlockHeader(msgType)
@@ -458,6 +484,21 @@ func (wrk *Worker) processReceivedHeaderMetric(cnsDta *consensus.Message) {
 	wrk.appStatusHandler.SetUInt64Value(core.MetricReceivedProposedBlock, uint64(percent))
 }
 
+// traceReceivedMessage records the arrival of the message relative to the start of the round it belongs to, as the
+// messages of the next round can be received before the current round ends
+func (wrk *Worker) traceReceivedMessage(cnsMsg *consensus.Message) {
+	roundsAhead := cnsMsg.RoundIndex - wrk.rounder.Index()
+	sinceRoundStart := time.Since(wrk.rounder.TimeStamp()) - time.Duration(roundsAhead)*wrk.rounder.TimeDuration()
+	msgType := consensus.MessageType(cnsMsg.MsgType)
+
+	wrk.roundTracer.AddReceivedMessage(
+		cnsMsg.RoundIndex,
+		wrk.consensusService.GetStringValue(msgType),
+		cnsMsg.PubKey,
+		sinceRoundStart,
+	)
+}
+
 func (wrk *Worker) updateNetworkShardingVals(message p2p.MessageP2P, cnsMsg *consensus.Message) {
 	wrk.networkShardingCollector.UpdatePeerIdPublicKey(message.Peer(), cnsMsg.PubKey)
 	wrk.networkShardingCollector.UpdatePublicKeyShardId(cnsMsg.PubKey, wrk.shardCoordinator.SelfId())
@@ -581,6 +622,11 @@ func (wrk *Worker) Extend(subroundId int) {
 
 	wrk.DisplayStatistics()
 
+	// only the leader collects the signatures of the consensus group
+	if wrk.consensusService.IsSubroundSignature(subroundId) && wrk.consensusState.IsSelfLeaderInCurrentRound() {
+		wrk.roundTracer.SetMissingSigners(wrk.consensusState.RoundIndex, wrk.consensusState.NodesWithoutJobDone(subroundId))
+	}
+
 	if wrk.consensusService.IsSubroundStartRound(subroundId) {
 		return
 	}
@@ -637,6 +683,16 @@ func (wrk *Worker) SetAppStatusHandler(ash core.AppStatusHandler) error {
 	return nil
 }
 
+// SetRoundTracer sets the round tracer used to record the arrival of the consensus messages
+func (wrk *Worker) SetRoundTracer(roundTracer consensus.RoundTracer) error {
+	if check.IfNil(roundTracer) {
+		return ErrNilRoundTracer
+	}
+	wrk.roundTracer = roundTracer
+
+	return nil
+}
+
 // Close will close the endless running go routine
 func (wrk *Worker) Close() error {
 	if wrk.cancelFunc != nil {
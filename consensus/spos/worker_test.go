@@ -1,6 +1,7 @@
 package spos_test
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"sync/atomic"
@@ -19,6 +20,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const roundTimeDuration = 100 * time.Millisecond
@@ -92,6 +94,7 @@ func createDefaultWorkerArgs() *spos.WorkerArgs {
 		NetworkShardingCollector: createMockNetworkShardingCollector(),
 		AntifloodHandler:         createMockP2PAntifloodHandler(),
 		PoolAdder:                poolAdder,
+		EquivocationDetector:     &mock.EquivocationDetectorStub{},
 		SignatureSize:            SignatureSize,
 		PublicKeySize:            PublicKeySize,
 	}
@@ -356,6 +359,17 @@ func TestWorker_NewWorkerPoolAdderNilShouldFail(t *testing.T) {
 	assert.Equal(t, spos.ErrNilPoolAdder, err)
 }
 
+func TestWorker_NewWorkerEquivocationDetectorNilShouldFail(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs()
+	workerArgs.EquivocationDetector = nil
+	wrk, err := spos.NewWorker(workerArgs)
+
+	assert.Nil(t, wrk)
+	assert.Equal(t, spos.ErrNilEquivocationDetector, err)
+}
+
 func TestWorker_NewWorkerShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -935,6 +949,63 @@ func TestWorker_ProcessReceivedMessageOkValsShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestWorker_ProcessReceivedMessageShouldCheckEquivocation(t *testing.T) {
+	t.Parallel()
+
+	var checkedMsg *consensus.Message
+	var checkedRawMsg []byte
+	workerArgs := createDefaultWorkerArgs()
+	workerArgs.EquivocationDetector = &mock.EquivocationDetectorStub{
+		CheckConsensusMessageCalled: func(cnsMsg *consensus.Message, rawMessage []byte) {
+			checkedMsg = cnsMsg
+			checkedRawMsg = rawMessage
+		},
+	}
+	wrk, _ := spos.NewWorker(workerArgs)
+
+	hdrHash := make([]byte, mock.HasherMock{}.Size())
+	cnsMsg := consensus.NewConsensusMessage(
+		hdrHash,
+		bytes.Repeat([]byte("a"), SignatureSize),
+		nil,
+		nil,
+		[]byte(wrk.ConsensusState().ConsensusGroup()[1]),
+		signature,
+		int(bls.MtSignature),
+		0,
+		chainID,
+		nil,
+		nil,
+		nil,
+	)
+	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+	err := wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff}, fromConnectedPeerId)
+
+	assert.Nil(t, err)
+	require.NotNil(t, checkedMsg)
+	assert.Equal(t, hdrHash, checkedMsg.BlockHeaderHash)
+	assert.Equal(t, buff, checkedRawMsg)
+}
+
+func TestWorker_ReceivedHeaderShouldCheckEquivocationWithTheLeader(t *testing.T) {
+	t.Parallel()
+
+	var checkedLeader []byte
+	workerArgs := createDefaultWorkerArgs()
+	workerArgs.EquivocationDetector = &mock.EquivocationDetectorStub{
+		CheckHeaderCalled: func(header data.HeaderHandler, leaderPubKey []byte) {
+			checkedLeader = leaderPubKey
+		},
+	}
+	wrk, _ := spos.NewWorker(workerArgs)
+
+	wrk.ReceivedHeader(&block.Header{Round: 1}, nil)
+	assert.Nil(t, checkedLeader)
+
+	wrk.ReceivedHeader(&block.Header{Round: 0}, nil)
+	assert.Equal(t, []byte(wrk.ConsensusState().ConsensusGroup()[0]), checkedLeader)
+}
+
 func TestWorker_CheckSelfStateShouldErrMessageFromItself(t *testing.T) {
 	t.Parallel()
 	wrk := *initWorker()
@@ -1513,6 +1584,119 @@ func TestWorker_SetAppStatusHandlerShouldWork(t *testing.T) {
 	assert.True(t, handler == wrk.AppStatusHandler())
 }
 
+func TestWorker_SetRoundTracerNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	wrk := spos.Worker{}
+	err := wrk.SetRoundTracer(nil)
+
+	assert.Equal(t, spos.ErrNilRoundTracer, err)
+}
+
+func TestWorker_SetRoundTracerShouldWork(t *testing.T) {
+	t.Parallel()
+
+	wrk := spos.Worker{}
+	roundTracer := &mock.RoundTracerStub{}
+	err := wrk.SetRoundTracer(roundTracer)
+
+	assert.Nil(t, err)
+	assert.True(t, roundTracer == wrk.RoundTracer())
+}
+
+func TestWorker_ProcessReceivedMessageShouldTraceTheMessage(t *testing.T) {
+	t.Parallel()
+
+	wrk := *initWorker()
+	wrk.SetBlockProcessor(
+		&mock.BlockProcessorMock{
+			DecodeBlockHeaderCalled: func(dta []byte) data.HeaderHandler {
+				return &mock.HeaderHandlerStub{
+					CheckChainIDCalled: func(reference []byte) error {
+						return nil
+					},
+					GetPrevHashCalled: func() []byte {
+						return make([]byte, 0)
+					},
+				}
+			},
+			DecodeBlockBodyCalled: func(dta []byte) data.BodyHandler {
+				return nil
+			},
+		},
+	)
+
+	var tracedMsgType string
+	var tracedPubKey []byte
+	_ = wrk.SetRoundTracer(&mock.RoundTracerStub{
+		AddReceivedMessageCalled: func(round int64, msgType string, pubKey []byte, sinceRoundStart time.Duration) {
+			tracedMsgType = msgType
+			tracedPubKey = pubKey
+		},
+	})
+
+	hdr := &block.Header{ChainID: chainID}
+	hdrHash, _ := core.CalculateHash(mock.MarshalizerMock{}, mock.HasherMock{}, hdr)
+	hdrStr, _ := mock.MarshalizerMock{}.Marshal(hdr)
+	leader := []byte(wrk.ConsensusState().ConsensusGroup()[0])
+	cnsMsg := consensus.NewConsensusMessage(
+		hdrHash,
+		nil,
+		nil,
+		hdrStr,
+		leader,
+		signature,
+		int(bls.MtBlockHeader),
+		0,
+		chainID,
+		nil,
+		nil,
+		nil,
+	)
+	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+	err := wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff}, fromConnectedPeerId)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "(BLOCK_HEADER)", tracedMsgType)
+	assert.Equal(t, leader, tracedPubKey)
+}
+
+func TestWorker_ExtendSignatureSubroundByLeaderShouldTraceMissingSigners(t *testing.T) {
+	t.Parallel()
+
+	wrk := *initWorker()
+	consensusGroup := wrk.ConsensusState().ConsensusGroup()
+	wrk.ConsensusState().SetSelfPubKey(consensusGroup[0])
+	for _, pubKey := range consensusGroup[1:] {
+		_ = wrk.ConsensusState().SetJobDone(pubKey, bls.SrSignature, true)
+	}
+
+	var missingSigners []string
+	_ = wrk.SetRoundTracer(&mock.RoundTracerStub{
+		SetMissingSignersCalled: func(round int64, pubKeys []string) {
+			missingSigners = pubKeys
+		},
+	})
+	wrk.Extend(bls.SrSignature)
+
+	assert.Equal(t, []string{consensusGroup[0]}, missingSigners)
+}
+
+func TestWorker_ExtendSignatureSubroundByValidatorShouldNotTraceMissingSigners(t *testing.T) {
+	t.Parallel()
+
+	wrk := *initWorker()
+	setMissingSignersCalled := false
+	_ = wrk.SetRoundTracer(&mock.RoundTracerStub{
+		SetMissingSignersCalled: func(round int64, pubKeys []string) {
+			setMissingSignersCalled = true
+		},
+	})
+	wrk.Extend(bls.SrSignature)
+
+	assert.False(t, setMissingSignersCalled)
+}
+
 func TestWorker_ProcessReceivedMessageWrongHeaderShouldErr(t *testing.T) {
 	t.Parallel()
 
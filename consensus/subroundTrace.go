@@ -0,0 +1,14 @@
+package consensus
+
+import "time"
+
+// SubroundTrace holds the timings of a subround, relative to the start of the round, together with the configured
+// deadlines of the subround
+type SubroundTrace struct {
+	Name            string
+	ConfiguredStart time.Duration
+	ConfiguredEnd   time.Duration
+	Started         time.Duration
+	Ended           time.Duration
+	IsFinished      bool
+}
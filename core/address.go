@@ -2,6 +2,7 @@ package core
 
 import (
 	"bytes"
+	"encoding/binary"
 )
 
 // NumInitCharactersForScAddress numbers of characters for smart contract address identifier
@@ -17,6 +18,27 @@ const ShardIdentiferLen = 2
 const metaChainShardIdentifier uint8 = 255
 const numInitCharactersForOnMetachainSC = 15
 
+// esdtGlobalSettingsPrefix starts the addresses of the accounts keeping the esdt settings of each shard. The shard ID is
+// written on the last bytes, so the account resides in the shard it belongs to
+var esdtGlobalSettingsPrefix = []byte("esdt-global-settings-address")
+
+// ESDTGlobalSettingsAddress returns the address of the account which keeps, in the provided shard, the esdt settings
+// shared by all the token holders from that shard, like the paused state of the tokens
+func ESDTGlobalSettingsAddress(shardID uint32) []byte {
+	shardIDBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(shardIDBytes, shardID)
+
+	address := make([]byte, 0, len(esdtGlobalSettingsPrefix)+len(shardIDBytes))
+	address = append(address, esdtGlobalSettingsPrefix...)
+
+	return append(address, shardIDBytes...)
+}
+
+// IsESDTGlobalSettingsAddress verifies if the provided address is the esdt global settings address of a shard
+func IsESDTGlobalSettingsAddress(address []byte) bool {
+	return len(address) == len(esdtGlobalSettingsPrefix)+4 && bytes.HasPrefix(address, esdtGlobalSettingsPrefix)
+}
+
 // IsSmartContractAddress verifies if a set address is of type smart contract
 func IsSmartContractAddress(rcvAddress []byte) bool {
 	if len(rcvAddress) <= NumInitCharactersForScAddress {
@@ -75,3 +75,17 @@ func TestIsSmartContractOnMetachain_ShouldReturnTrue(t *testing.T) {
 	scAddress, _ := hex.DecodeString("000000000000000000000000000000000000000000000000000000b51e0eb3a1")
 	assert.True(t, IsSmartContractOnMetachain(identifier, scAddress))
 }
+
+func TestESDTGlobalSettingsAddress(t *testing.T) {
+	t.Parallel()
+
+	address := ESDTGlobalSettingsAddress(3)
+	assert.Equal(t, 32, len(address))
+	assert.Equal(t, byte(3), address[len(address)-1])
+	assert.False(t, IsSmartContractAddress(address))
+	assert.True(t, IsESDTGlobalSettingsAddress(address))
+	assert.NotEqual(t, address, ESDTGlobalSettingsAddress(4))
+
+	assert.False(t, IsESDTGlobalSettingsAddress([]byte("esdt-global-settings-address")))
+	assert.False(t, IsESDTGlobalSettingsAddress(make([]byte, 32)))
+}
@@ -65,6 +65,10 @@ const ConsensusTopic = "consensus"
 // HeartbeatTopic is the topic used for heartbeat signaling
 const HeartbeatTopic = "heartbeat"
 
+// EquivocationProofsTopic is the topic used to propagate the proofs of the keys signing two different blocks in the
+// same round
+const EquivocationProofsTopic = "equivocationProofs"
+
 // PathShardPlaceholder represents the placeholder for the shard ID in paths
 const PathShardPlaceholder = "[S]"
 
@@ -339,6 +343,12 @@ const (
 	TxStatusExecuted TransactionStatus = "executed"
 	// TxStatusUnknown represents the status returned for a missing transaction
 	TxStatusUnknown TransactionStatus = "unknown"
+	// TxStatusSuccess represents the status of a simulated transaction which was executed without errors
+	TxStatusSuccess TransactionStatus = "success"
+	// TxStatusFail represents the status of a simulated transaction which was executed, but failed
+	TxStatusFail TransactionStatus = "fail"
+	// TxStatusInvalid represents the status of a simulated transaction which would not be included in a block
+	TxStatusInvalid TransactionStatus = "invalid"
 )
 
 const (
@@ -352,6 +362,8 @@ const (
 	NetworkShardingOrder
 	// IndexerOrder defines the order in which Indexer is notified of a start of epoch event
 	IndexerOrder
+	// EventsNotifierOrder defines the order in which the events notifier is notified of a start of epoch event
+	EventsNotifierOrder
 )
 
 // NodeState specifies what type of state a node could have
@@ -387,6 +399,24 @@ const MetricP2PUnknownPeers = "erd_p2p_unknown_shard_peers"
 // MetricP2PNumConnectedPeersClassification is the metric for monitoring the number of connected peers split on the connection type
 const MetricP2PNumConnectedPeersClassification = "erd_p2p_num_connected_peers_classification"
 
+// MetricTxPoolSize is the metric for monitoring the number of transactions held in the transactions pool
+const MetricTxPoolSize = "erd_tx_pool_size"
+
+// MetricMiniBlocksPoolSize is the metric for monitoring the number of miniblocks held in the miniblocks pool
+const MetricMiniBlocksPoolSize = "erd_miniblocks_pool_size"
+
+// MetricTrieNumSnapshots is the metric for monitoring the number of snapshots of a trie storage
+const MetricTrieNumSnapshots = "erd_trie_num_snapshots"
+
+// MetricTrieNumQueuedSnapshots is the metric for monitoring the number of snapshot requests waiting to be processed
+const MetricTrieNumQueuedSnapshots = "erd_trie_num_queued_snapshots"
+
+// MetricTrieNumSnapshotsInProgress is the metric for monitoring the number of snapshots currently in progress
+const MetricTrieNumSnapshotsInProgress = "erd_trie_num_snapshots_in_progress"
+
+// MetricTriePruningBufferLen is the metric for monitoring the number of pruning requests delayed by the snapshots
+const MetricTriePruningBufferLen = "erd_trie_pruning_buffer_len"
+
 // HighestRoundFromBootStorage is the key for the highest round that is saved in storage
 const HighestRoundFromBootStorage = "highestRoundFromBootStorage"
 
@@ -414,6 +444,24 @@ const BuiltInFunctionSaveKeyValue = "SaveKeyValue"
 // BuiltInFunctionESDTTransfer is the key for the elrond standard digital token transfer built-in function
 const BuiltInFunctionESDTTransfer = "ESDTTransfer"
 
+// BuiltInFunctionESDTBurn is the key for the elrond standard digital token burn built-in function
+const BuiltInFunctionESDTBurn = "ESDTBurn"
+
+// BuiltInFunctionESDTFreeze is the key for the elrond standard digital token freeze built-in function
+const BuiltInFunctionESDTFreeze = "ESDTFreeze"
+
+// BuiltInFunctionESDTUnFreeze is the key for the elrond standard digital token unfreeze built-in function
+const BuiltInFunctionESDTUnFreeze = "ESDTUnFreeze"
+
+// BuiltInFunctionESDTWipe is the key for the elrond standard digital token wipe built-in function
+const BuiltInFunctionESDTWipe = "ESDTWipe"
+
+// BuiltInFunctionESDTPause is the key for the elrond standard digital token pause built-in function
+const BuiltInFunctionESDTPause = "ESDTPause"
+
+// BuiltInFunctionESDTUnPause is the key for the elrond standard digital token unpause built-in function
+const BuiltInFunctionESDTUnPause = "ESDTUnPause"
+
 // SCDeployInitFunctionName is the key for the function which is called at smart contract deploy time
 const SCDeployInitFunctionName = "_init"
 
@@ -445,3 +493,6 @@ const CommitMaxTime = time.Second
 
 // DefaultUnstakedEpoch represents the default epoch that is set for a validator that has not unstaked yet
 const DefaultUnstakedEpoch = math.MaxUint32
+
+// MetricIndexerRetryQueueSize is the metric that outputs the number of indexer requests waiting to be resent
+const MetricIndexerRetryQueueSize = "erd_indexer_retry_queue_size"
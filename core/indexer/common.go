@@ -18,6 +18,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 )
 
@@ -71,17 +72,7 @@ func prepareGeneralInfo(tpsBenchmark statistics.TPSBenchmark) bytes.Buffer {
 	var buff bytes.Buffer
 
 	meta := []byte(fmt.Sprintf(`{ "index" : { "_id" : "%s", "_type" : "%s" } }%s`, metachainTpsDocID, tpsIndex, "\n"))
-	generalInfo := TPS{
-		LiveTPS:               tpsBenchmark.LiveTPS(),
-		PeakTPS:               tpsBenchmark.PeakTPS(),
-		NrOfShards:            tpsBenchmark.NrOfShards(),
-		BlockNumber:           tpsBenchmark.BlockNumber(),
-		RoundNumber:           tpsBenchmark.RoundNumber(),
-		RoundTime:             tpsBenchmark.RoundTime(),
-		AverageBlockTxCount:   tpsBenchmark.AverageBlockTxCount(),
-		LastBlockTxCount:      tpsBenchmark.LastBlockTxCount(),
-		TotalProcessedTxCount: tpsBenchmark.TotalProcessedTxCount(),
-	}
+	generalInfo := prepareGeneralTPS(tpsBenchmark)
 
 	serializedInfo, err := json.Marshal(generalInfo)
 	if err != nil {
@@ -108,17 +99,7 @@ func serializeShardInfo(shardInfo statistics.ShardStatistic) ([]byte, []byte) {
 	meta := []byte(fmt.Sprintf(`{ "index" : { "_id" : "%s%d", "_type" : "%s" } }%s`,
 		shardTpsDocIDPrefix, shardInfo.ShardID(), tpsIndex, "\n"))
 
-	bigTxCount := big.NewInt(int64(shardInfo.AverageBlockTxCount()))
-	shardTPS := TPS{
-		ShardID:               shardInfo.ShardID(),
-		LiveTPS:               shardInfo.LiveTPS(),
-		PeakTPS:               shardInfo.PeakTPS(),
-		AverageTPS:            shardInfo.AverageTPS(),
-		AverageBlockTxCount:   bigTxCount,
-		CurrentBlockNonce:     shardInfo.CurrentBlockNonce(),
-		LastBlockTxCount:      shardInfo.LastBlockTxCount(),
-		TotalProcessedTxCount: shardInfo.TotalProcessedTxCount(),
-	}
+	shardTPS := prepareShardTPS(shardInfo)
 
 	serializedInfo, err := json.Marshal(shardTPS)
 	if err != nil {
@@ -384,3 +365,152 @@ func computeSizeOfTxs(marshalizer marshal.Marshalizer, txs map[string]data.Trans
 
 	return txsSize
 }
+
+// prepareBlock builds the indexer block structure of the given header and body, also returning the header's hash
+func prepareBlock(
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	header data.HeaderHandler,
+	signersIndexes []uint64,
+	body *block.Body,
+	notarizedHeadersHashes []string,
+	sizeTxs int,
+) (*Block, []byte) {
+	headerBytes, err := marshalizer.Marshal(header)
+	if err != nil {
+		log.Debug("indexer: marshal header", "error", err)
+		return nil, nil
+	}
+	bodyBytes, err := marshalizer.Marshal(body)
+	if err != nil {
+		log.Debug("indexer: marshal body", "error", err)
+		return nil, nil
+	}
+
+	blockSizeInBytes := len(headerBytes) + len(bodyBytes)
+
+	miniblocksHashes := make([]string, 0)
+	for _, miniblock := range body.MiniBlocks {
+		mbHash, errComputeHash := core.CalculateHash(marshalizer, hasher, miniblock)
+		if errComputeHash != nil {
+			log.Warn("internal error computing hash", "error", errComputeHash)
+
+			continue
+		}
+
+		encodedMbHash := hex.EncodeToString(mbHash)
+		miniblocksHashes = append(miniblocksHashes, encodedMbHash)
+	}
+
+	headerHash := hasher.Compute(string(headerBytes))
+	dbBlock := &Block{
+		Nonce:                 header.GetNonce(),
+		Round:                 header.GetRound(),
+		Epoch:                 header.GetEpoch(),
+		ShardID:               header.GetShardID(),
+		Hash:                  hex.EncodeToString(headerHash),
+		MiniBlocksHashes:      miniblocksHashes,
+		NotarizedBlocksHashes: notarizedHeadersHashes,
+		Proposer:              signersIndexes[0],
+		Validators:            signersIndexes,
+		PubKeyBitmap:          hex.EncodeToString(header.GetPubKeysBitmap()),
+		Size:                  int64(blockSizeInBytes),
+		SizeTxs:               int64(sizeTxs),
+		Timestamp:             time.Duration(header.GetTimeStamp()),
+		TxCount:               header.GetTxCount(),
+		StateRootHash:         hex.EncodeToString(header.GetRootHash()),
+		PrevHash:              hex.EncodeToString(header.GetPrevHash()),
+	}
+
+	return dbBlock, headerHash
+}
+
+// prepareMiniblocks builds the indexer miniblock structures of the given body. The sender block hash is set only
+// on the sender shard while the receiver block hash is set on the receiver shard
+func prepareMiniblocks(
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	header data.HeaderHandler,
+	body *block.Body,
+) []*Miniblock {
+	headerHash, err := core.CalculateHash(marshalizer, hasher, header)
+	if err != nil {
+		log.Warn("indexer: could not calculate header hash", "error", err.Error())
+		return nil
+	}
+
+	encodedHeaderHash := hex.EncodeToString(headerHash)
+
+	miniblocks := make([]*Miniblock, 0)
+	for _, miniblock := range body.MiniBlocks {
+		mbHash, errComputeHash := core.CalculateHash(marshalizer, hasher, miniblock)
+		if errComputeHash != nil {
+			log.Warn("internal error computing hash", "error", errComputeHash)
+
+			continue
+		}
+
+		encodedMbHash := hex.EncodeToString(mbHash)
+
+		mb := &Miniblock{
+			Hash:            encodedMbHash,
+			SenderShardID:   miniblock.SenderShardID,
+			ReceiverShardID: miniblock.ReceiverShardID,
+			Type:            miniblock.Type.String(),
+		}
+
+		if mb.SenderShardID == header.GetShardID() {
+			mb.SenderBlockHash = encodedHeaderHash
+		} else {
+			mb.ReceiverBlockHash = encodedHeaderHash
+		}
+
+		if mb.SenderShardID == mb.ReceiverShardID {
+			mb.ReceiverBlockHash = encodedHeaderHash
+		}
+
+		miniblocks = append(miniblocks, mb)
+	}
+
+	return miniblocks
+}
+
+func prepareValidatorsPublicKeys(pubkeyConverter core.PubkeyConverter, validatorsPubKeys [][]byte) ValidatorsPublicKeys {
+	shardValPubKeys := ValidatorsPublicKeys{
+		PublicKeys: make([]string, 0, len(validatorsPubKeys)),
+	}
+	for _, validatorPk := range validatorsPubKeys {
+		strValidatorPk := pubkeyConverter.Encode(validatorPk)
+		shardValPubKeys.PublicKeys = append(shardValPubKeys.PublicKeys, strValidatorPk)
+	}
+
+	return shardValPubKeys
+}
+
+func prepareGeneralTPS(tpsBenchmark statistics.TPSBenchmark) TPS {
+	return TPS{
+		LiveTPS:               tpsBenchmark.LiveTPS(),
+		PeakTPS:               tpsBenchmark.PeakTPS(),
+		NrOfShards:            tpsBenchmark.NrOfShards(),
+		BlockNumber:           tpsBenchmark.BlockNumber(),
+		RoundNumber:           tpsBenchmark.RoundNumber(),
+		RoundTime:             tpsBenchmark.RoundTime(),
+		AverageBlockTxCount:   tpsBenchmark.AverageBlockTxCount(),
+		LastBlockTxCount:      tpsBenchmark.LastBlockTxCount(),
+		TotalProcessedTxCount: tpsBenchmark.TotalProcessedTxCount(),
+	}
+}
+
+func prepareShardTPS(shardInfo statistics.ShardStatistic) TPS {
+	bigTxCount := big.NewInt(int64(shardInfo.AverageBlockTxCount()))
+	return TPS{
+		ShardID:               shardInfo.ShardID(),
+		LiveTPS:               shardInfo.LiveTPS(),
+		PeakTPS:               shardInfo.PeakTPS(),
+		AverageTPS:            shardInfo.AverageTPS(),
+		AverageBlockTxCount:   bigTxCount,
+		CurrentBlockNonce:     shardInfo.CurrentBlockNonce(),
+		LastBlockTxCount:      shardInfo.LastBlockTxCount(),
+		TotalProcessedTxCount: shardInfo.TotalProcessedTxCount(),
+	}
+}
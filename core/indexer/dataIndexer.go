@@ -0,0 +1,148 @@
+package indexer
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+type dataIndexerArgs struct {
+	shardID            uint32
+	options            *Options
+	nodesCoordinator   sharding.NodesCoordinator
+	epochStartNotifier sharding.EpochStartEventNotifier
+	marshalizer        marshal.Marshalizer
+}
+
+// dataIndexer prepares the node's data and hands it to the database handler of the configured driver
+type dataIndexer struct {
+	database     databaseHandler
+	options      *Options
+	coordinator  sharding.NodesCoordinator
+	marshalizer  marshal.Marshalizer
+	isNilIndexer bool
+}
+
+func newDataIndexer(database databaseHandler, args dataIndexerArgs) *dataIndexer {
+	indexer := &dataIndexer{
+		database:     database,
+		options:      args.options,
+		coordinator:  args.nodesCoordinator,
+		marshalizer:  args.marshalizer,
+		isNilIndexer: false,
+	}
+
+	if args.shardID == core.MetachainShardId {
+		args.epochStartNotifier.RegisterHandler(indexer.epochStartEventHandler())
+	}
+
+	return indexer
+}
+
+// SaveBlock will prepare and save the block, its miniblocks and, if enabled, its transactions
+func (di *dataIndexer) SaveBlock(
+	bodyHandler data.BodyHandler,
+	headerHandler data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	signersIndexes []uint64,
+	notarizedHeadersHashes []string,
+) {
+	body, ok := bodyHandler.(*block.Body)
+	if !ok {
+		log.Debug("indexer", "error", ErrBodyTypeAssertion.Error())
+		return
+	}
+
+	if check.IfNil(headerHandler) {
+		log.Debug("indexer: no header", "error", ErrNoHeader.Error())
+		return
+	}
+
+	txsSizeInBytes := computeSizeOfTxs(di.marshalizer, txPool)
+	go di.database.SaveHeader(headerHandler, signersIndexes, body, notarizedHeadersHashes, txsSizeInBytes)
+
+	if len(body.MiniBlocks) == 0 {
+		return
+	}
+
+	go di.database.SaveMiniblocks(headerHandler, body)
+
+	if di.options.TxIndexingEnabled {
+		go di.database.SaveTransactions(body, headerHandler, txPool, headerHandler.GetShardID())
+	}
+}
+
+// SaveRoundInfo will save data about a round
+func (di *dataIndexer) SaveRoundInfo(roundInfo RoundInfo) {
+	di.database.SaveRoundInfo(roundInfo)
+}
+
+func (di *dataIndexer) epochStartEventHandler() epochStart.ActionHandler {
+	subscribeHandler := notifier.NewHandlerForEpochStart(func(hdr data.HeaderHandler) {
+		currentEpoch := hdr.GetEpoch()
+		validatorsPubKeys, err := di.coordinator.GetAllEligibleValidatorsPublicKeys(currentEpoch)
+		if err != nil {
+			log.Warn("GetAllEligibleValidatorPublicKeys for current epoch failed",
+				"epoch", currentEpoch,
+				"error", err.Error())
+		}
+
+		di.SaveValidatorsPubKeys(validatorsPubKeys, currentEpoch)
+
+	}, func(_ data.HeaderHandler) {}, core.IndexerOrder)
+
+	return subscribeHandler
+}
+
+// SaveValidatorsRating will save all validators rating info
+func (di *dataIndexer) SaveValidatorsRating(indexID string, validatorsRatingInfo []ValidatorRatingInfo) {
+	if validatorsRatingInfo != nil && indexID != "" {
+		di.database.SaveValidatorsRating(indexID, validatorsRatingInfo)
+	}
+}
+
+// SaveValidatorsPubKeys will save all validators public keys
+func (di *dataIndexer) SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32) {
+	for shardID, shardPubKeys := range validatorsPubKeys {
+		go func(id, epochNumber uint32, publicKeys [][]byte) {
+			di.database.SaveShardValidatorsPubKeys(id, epochNumber, publicKeys)
+		}(shardID, epoch, shardPubKeys)
+	}
+}
+
+// UpdateTPS updates the tps and statistics
+func (di *dataIndexer) UpdateTPS(tpsBenchmark statistics.TPSBenchmark) {
+	if tpsBenchmark == nil {
+		log.Debug("indexer: update tps called, but the tpsBenchmark is nil")
+		return
+	}
+
+	di.database.SaveShardStatistics(tpsBenchmark)
+}
+
+// SetTxLogsProcessor will set tx logs processor
+func (di *dataIndexer) SetTxLogsProcessor(txLogsProc process.TransactionLogProcessorDatabase) {
+	di.database.SetTxLogsProcessor(txLogsProc)
+}
+
+// Close closes the underlying database
+func (di *dataIndexer) Close() error {
+	return di.database.Close()
+}
+
+// IsNilIndexer will return a bool value that signals if the indexer's implementation is a NilIndexer
+func (di *dataIndexer) IsNilIndexer() bool {
+	return di.isNilIndexer
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (di *dataIndexer) IsInterfaceNil() bool {
+	return di == nil
+}
@@ -0,0 +1,188 @@
+package indexer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const (
+	// ElasticSearchDriver is the name of the driver which indexes the node's data in an elasticsearch server
+	ElasticSearchDriver = "elasticsearch"
+	// FileDriver is the name of the driver which appends the node's data as JSON lines in rotating files
+	FileDriver = "file"
+	// SQLDriver is the name of the driver which saves the node's data in a SQL database
+	SQLDriver = "sql"
+)
+
+const bytesInMegabyte = 1024 * 1024
+
+// DataIndexerArgs is struct that is used to store all components that are needed to create an indexer,
+// whatever its driver
+type DataIndexerArgs struct {
+	ShardId                  uint32
+	Marshalizer              marshal.Marshalizer
+	Hasher                   hashing.Hasher
+	EpochStartNotifier       sharding.EpochStartEventNotifier
+	NodesCoordinator         sharding.NodesCoordinator
+	AddressPubkeyConverter   core.PubkeyConverter
+	ValidatorPubkeyConverter core.PubkeyConverter
+	Options                  *Options
+	ExternalConfig           config.ExternalConfig
+	// RetryQueueStorer keeps the elastic search requests which could not be sent. It is needed only by the
+	// elastic search driver when its retry queue is enabled
+	RetryQueueStorer storage.Storer
+	StatusHandler    core.AppStatusHandler
+}
+
+type databaseCreator func(args DataIndexerArgs) (databaseHandler, error)
+
+var databaseCreators = map[string]databaseCreator{
+	ElasticSearchDriver: createElasticSearchDatabase,
+	FileDriver:          createFileDatabase,
+	SQLDriver:           createSQLDatabase,
+}
+
+// GetEnabledDriver returns the name of the indexer driver enabled in the external config or an empty string
+// if indexing is disabled. At most one driver can be enabled at a time
+func GetEnabledDriver(externalConfig config.ExternalConfig) (string, error) {
+	enabledDrivers := make([]string, 0)
+	if externalConfig.ElasticSearchConnector.Enabled {
+		enabledDrivers = append(enabledDrivers, ElasticSearchDriver)
+	}
+	if externalConfig.FileIndexerConnector.Enabled {
+		enabledDrivers = append(enabledDrivers, FileDriver)
+	}
+	if externalConfig.SQLIndexerConnector.Enabled {
+		enabledDrivers = append(enabledDrivers, SQLDriver)
+	}
+
+	switch len(enabledDrivers) {
+	case 0:
+		return "", nil
+	case 1:
+		return enabledDrivers[0], nil
+	default:
+		return "", fmt.Errorf("%w, enabled drivers: %v", ErrMultipleIndexerDriversEnabled, enabledDrivers)
+	}
+}
+
+// NewDataIndexer creates an indexer which persists the node's data through the database of the given driver
+func NewDataIndexer(driver string, arguments DataIndexerArgs) (Indexer, error) {
+	createDatabase, ok := databaseCreators[driver]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownIndexerDriver, driver)
+	}
+
+	err := checkDataIndexerArgs(arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	database, err := createDatabase(arguments)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create %s indexer: %w", driver, err)
+	}
+
+	return newDataIndexer(database, dataIndexerArgs{
+		shardID:            arguments.ShardId,
+		options:            arguments.Options,
+		nodesCoordinator:   arguments.NodesCoordinator,
+		epochStartNotifier: arguments.EpochStartNotifier,
+		marshalizer:        arguments.Marshalizer,
+	}), nil
+}
+
+func checkDataIndexerArgs(arguments DataIndexerArgs) error {
+	if check.IfNil(arguments.AddressPubkeyConverter) {
+		return fmt.Errorf("%w when setting addressPubkeyConverter in indexer", ErrNilPubkeyConverter)
+	}
+	if check.IfNil(arguments.ValidatorPubkeyConverter) {
+		return fmt.Errorf("%w when setting validatorPubkeyConverter in indexer", ErrNilPubkeyConverter)
+	}
+	if check.IfNil(arguments.Marshalizer) {
+		return core.ErrNilMarshalizer
+	}
+	if check.IfNil(arguments.Hasher) {
+		return core.ErrNilHasher
+	}
+	if check.IfNil(arguments.NodesCoordinator) {
+		return core.ErrNilNodesCoordinator
+	}
+	if arguments.EpochStartNotifier == nil {
+		return core.ErrNilEpochStartNotifier
+	}
+
+	return nil
+}
+
+func createElasticSearchDatabase(arguments DataIndexerArgs) (databaseHandler, error) {
+	elasticConfig := arguments.ExternalConfig.ElasticSearchConnector
+	if elasticConfig.URL == "" {
+		return nil, core.ErrNilUrl
+	}
+	if elasticConfig.Username == "" {
+		return nil, ErrEmptyUserName
+	}
+	if elasticConfig.Password == "" {
+		return nil, ErrEmptyPassword
+	}
+
+	databaseArgs := elasticSearchDatabaseArgs{
+		url:                      elasticConfig.URL,
+		userName:                 elasticConfig.Username,
+		password:                 elasticConfig.Password,
+		marshalizer:              arguments.Marshalizer,
+		hasher:                   arguments.Hasher,
+		addressPubkeyConverter:   arguments.AddressPubkeyConverter,
+		validatorPubkeyConverter: arguments.ValidatorPubkeyConverter,
+	}
+
+	retryQueueConfig := elasticConfig.RetryQueue
+	if retryQueueConfig.Enabled {
+		databaseArgs.retryQueue = &retryQueueArgs{
+			storer:         arguments.RetryQueueStorer,
+			statusHandler:  arguments.StatusHandler,
+			initialBackoff: time.Duration(retryQueueConfig.InitialBackoffInMs) * time.Millisecond,
+			maxBackoff:     time.Duration(retryQueueConfig.MaxBackoffInMs) * time.Millisecond,
+			maxQueueSize:   retryQueueConfig.MaxQueueSize,
+		}
+	}
+
+	return newElasticSearchDatabase(databaseArgs)
+}
+
+func createFileDatabase(arguments DataIndexerArgs) (databaseHandler, error) {
+	fileConfig := arguments.ExternalConfig.FileIndexerConnector
+
+	return newFileDatabase(fileDatabaseArgs{
+		directory:                fileConfig.Directory,
+		maxFileSize:              int64(fileConfig.MaxFileSizeInMB) * bytesInMegabyte,
+		maxNumFiles:              fileConfig.MaxNumFiles,
+		marshalizer:              arguments.Marshalizer,
+		hasher:                   arguments.Hasher,
+		addressPubkeyConverter:   arguments.AddressPubkeyConverter,
+		validatorPubkeyConverter: arguments.ValidatorPubkeyConverter,
+	})
+}
+
+func createSQLDatabase(arguments DataIndexerArgs) (databaseHandler, error) {
+	sqlConfig := arguments.ExternalConfig.SQLIndexerConnector
+
+	return newSQLDatabase(sqlDatabaseArgs{
+		driverName:               sqlConfig.DriverName,
+		dataSourceName:           sqlConfig.DataSourceName,
+		maxOpenConnections:       sqlConfig.MaxOpenConnections,
+		marshalizer:              arguments.Marshalizer,
+		hasher:                   arguments.Hasher,
+		addressPubkeyConverter:   arguments.AddressPubkeyConverter,
+		validatorPubkeyConverter: arguments.ValidatorPubkeyConverter,
+	})
+}
@@ -0,0 +1,124 @@
+package indexer_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockDataIndexerArgs() indexer.DataIndexerArgs {
+	return indexer.DataIndexerArgs{
+		Marshalizer:              &mock.MarshalizerMock{},
+		Hasher:                   &mock.HasherMock{},
+		Options:                  &indexer.Options{},
+		NodesCoordinator:         &mock.NodesCoordinatorMock{},
+		EpochStartNotifier:       &mock.EpochStartNotifierStub{},
+		AddressPubkeyConverter:   mock.NewPubkeyConverterMock(32),
+		ValidatorPubkeyConverter: mock.NewPubkeyConverterMock(96),
+		ExternalConfig: config.ExternalConfig{
+			SQLIndexerConnector: config.SQLIndexerConfig{
+				Enabled:            true,
+				DriverName:         "sqlite3",
+				DataSourceName:     ":memory:",
+				MaxOpenConnections: 1,
+			},
+		},
+	}
+}
+
+func TestGetEnabledDriver(t *testing.T) {
+	t.Parallel()
+
+	driver, err := indexer.GetEnabledDriver(config.ExternalConfig{})
+	assert.Nil(t, err)
+	assert.Equal(t, "", driver)
+
+	driver, err = indexer.GetEnabledDriver(config.ExternalConfig{
+		ElasticSearchConnector: config.ElasticSearchConfig{Enabled: true},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, indexer.ElasticSearchDriver, driver)
+
+	driver, err = indexer.GetEnabledDriver(config.ExternalConfig{
+		FileIndexerConnector: config.FileIndexerConfig{Enabled: true},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, indexer.FileDriver, driver)
+
+	driver, err = indexer.GetEnabledDriver(config.ExternalConfig{
+		FileIndexerConnector: config.FileIndexerConfig{Enabled: true},
+		SQLIndexerConnector:  config.SQLIndexerConfig{Enabled: true},
+	})
+	assert.True(t, errors.Is(err, indexer.ErrMultipleIndexerDriversEnabled))
+	assert.Equal(t, "", driver)
+}
+
+func TestNewDataIndexer_UnknownDriverShouldErr(t *testing.T) {
+	t.Parallel()
+
+	di, err := indexer.NewDataIndexer("unknown", createMockDataIndexerArgs())
+
+	assert.True(t, check.IfNil(di))
+	assert.True(t, errors.Is(err, indexer.ErrUnknownIndexerDriver))
+}
+
+func TestNewDataIndexer_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockDataIndexerArgs()
+	args.Hasher = nil
+	di, err := indexer.NewDataIndexer(indexer.SQLDriver, args)
+
+	assert.True(t, check.IfNil(di))
+	assert.Equal(t, core.ErrNilHasher, err)
+}
+
+func TestNewDataIndexer_ElasticSearchDriverEmptyUrlShouldErr(t *testing.T) {
+	t.Parallel()
+
+	di, err := indexer.NewDataIndexer(indexer.ElasticSearchDriver, createMockDataIndexerArgs())
+
+	assert.True(t, check.IfNil(di))
+	assert.True(t, errors.Is(err, core.ErrNilUrl))
+}
+
+func TestNewDataIndexer_FileDriverShouldWork(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "indexer")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	args := createMockDataIndexerArgs()
+	args.ExternalConfig.FileIndexerConnector = config.FileIndexerConfig{
+		Enabled:         true,
+		Directory:       dir,
+		MaxFileSizeInMB: 1,
+	}
+	di, err := indexer.NewDataIndexer(indexer.FileDriver, args)
+
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(di))
+	assert.False(t, di.IsNilIndexer())
+}
+
+func TestNewDataIndexer_SQLDriverShouldWork(t *testing.T) {
+	t.Parallel()
+
+	di, err := indexer.NewDataIndexer(indexer.SQLDriver, createMockDataIndexerArgs())
+
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(di))
+	assert.False(t, di.IsNilIndexer())
+}
@@ -0,0 +1,399 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+)
+
+var _ databaseWriterHandler = (*elasticRetryQueue)(nil)
+
+var retryQueueIndexesKey = []byte("retryQueueIndexes")
+
+// retryQueueItem holds a request which could not be sent to the elastic search server
+type retryQueueItem struct {
+	Index      string `json:"index"`
+	DocumentID string `json:"documentID,omitempty"`
+	Refresh    string `json:"refresh,omitempty"`
+	IsBulk     bool   `json:"isBulk"`
+	Body       []byte `json:"body"`
+}
+
+// retryQueueIndexes holds the sequence number of the oldest queued item and the one of the next item to be queued
+type retryQueueIndexes struct {
+	First uint64 `json:"first"`
+	Next  uint64 `json:"next"`
+}
+
+type elasticRetryQueueArgs struct {
+	writer         databaseWriterHandler
+	storer         storage.Storer
+	statusHandler  core.AppStatusHandler
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	maxQueueSize   uint64
+}
+
+// elasticRetryQueue wraps the elastic search writer and saves in a storer, in order, the requests which failed
+// because the server was unreachable or overloaded. The saved requests are resent from a go routine, waiting
+// an exponentially increasing time between failed attempts. Since the queue is saved on disk, the requests
+// left after a node restart are resent as well. At most maxQueueSize requests are kept, the oldest one being
+// dropped when a new request has to be queued in a full queue
+type elasticRetryQueue struct {
+	writer         databaseWriterHandler
+	storer         storage.Storer
+	statusHandler  core.AppStatusHandler
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	maxQueueSize   uint64
+	chanNewItem    chan struct{}
+	chanLoopDone   chan struct{}
+	cancelFunc     context.CancelFunc
+	closeOnce      sync.Once
+
+	// mutSend serializes the sending of the requests, so that a new request can not overtake a queued one
+	mutSend    sync.Mutex
+	mutIndexes sync.Mutex
+	indexes    retryQueueIndexes
+}
+
+func newElasticRetryQueue(args elasticRetryQueueArgs) (*elasticRetryQueue, error) {
+	if args.writer == nil {
+		return nil, ErrNilDatabaseWriter
+	}
+	if check.IfNil(args.storer) {
+		return nil, ErrNilStorer
+	}
+	if check.IfNil(args.statusHandler) {
+		return nil, ErrNilStatusHandler
+	}
+	if args.initialBackoff <= 0 || args.maxBackoff < args.initialBackoff {
+		return nil, ErrInvalidBackoff
+	}
+	if args.maxQueueSize == 0 {
+		return nil, ErrInvalidMaxQueueSize
+	}
+
+	rq := &elasticRetryQueue{
+		writer:         args.writer,
+		storer:         args.storer,
+		statusHandler:  args.statusHandler,
+		initialBackoff: args.initialBackoff,
+		maxBackoff:     args.maxBackoff,
+		maxQueueSize:   args.maxQueueSize,
+		chanNewItem:    make(chan struct{}, 1),
+		chanLoopDone:   make(chan struct{}),
+	}
+
+	buff, err := rq.storer.Get(retryQueueIndexesKey)
+	if err == nil {
+		err = json.Unmarshal(buff, &rq.indexes)
+		if err != nil {
+			return nil, err
+		}
+	}
+	rq.updateMetric()
+
+	var ctx context.Context
+	ctx, rq.cancelFunc = context.WithCancel(context.Background())
+	go rq.processLoop(ctx)
+
+	return rq, nil
+}
+
+// CheckAndCreateIndex will check if an index exists and if not will create a new one
+func (rq *elasticRetryQueue) CheckAndCreateIndex(index string, body io.Reader) error {
+	return rq.writer.CheckAndCreateIndex(index, body)
+}
+
+// DoRequest will send the request to the elastic search server or queue it if it can not be sent now
+func (rq *elasticRetryQueue) DoRequest(req *esapi.IndexRequest) error {
+	body, err := readRequestBody(req.Body)
+	if err != nil {
+		return err
+	}
+
+	return rq.sendOrQueue(&retryQueueItem{
+		Index:      req.Index,
+		DocumentID: req.DocumentID,
+		Refresh:    req.Refresh,
+		Body:       body,
+	})
+}
+
+// DoBulkRequest will send the bulk request to the elastic search server or queue it if it can not be sent now
+func (rq *elasticRetryQueue) DoBulkRequest(buff *bytes.Buffer, index string) error {
+	body := make([]byte, buff.Len())
+	copy(body, buff.Bytes())
+
+	return rq.sendOrQueue(&retryQueueItem{
+		Index:  index,
+		IsBulk: true,
+		Body:   body,
+	})
+}
+
+func readRequestBody(body io.Reader) ([]byte, error) {
+	if body == nil {
+		return make([]byte, 0), nil
+	}
+
+	return ioutil.ReadAll(body)
+}
+
+// sendOrQueue sends the item right away only if there are no older queued items, so that the requests
+// reach the server in the order they were made. The check and the sending are done while holding mutSend,
+// which the process loop also holds while resending the oldest queued item
+func (rq *elasticRetryQueue) sendOrQueue(item *retryQueueItem) error {
+	rq.mutSend.Lock()
+	defer rq.mutSend.Unlock()
+
+	if rq.Len() == 0 {
+		err := rq.send(item)
+		if !shouldRetry(err) {
+			return err
+		}
+
+		log.Debug("indexer: request failed, adding it to the retry queue", "index", item.Index, "error", err.Error())
+	}
+
+	err := rq.push(item)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case rq.chanNewItem <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+func (rq *elasticRetryQueue) send(item *retryQueueItem) error {
+	if item.IsBulk {
+		return rq.writer.DoBulkRequest(bytes.NewBuffer(item.Body), item.Index)
+	}
+
+	return rq.writer.DoRequest(&esapi.IndexRequest{
+		Index:      item.Index,
+		DocumentID: item.DocumentID,
+		Body:       bytes.NewReader(item.Body),
+		Refresh:    item.Refresh,
+	})
+}
+
+func shouldRetry(err error) bool {
+	return err != nil && !errors.Is(err, ErrElasticRequestRejected)
+}
+
+func (rq *elasticRetryQueue) processLoop(ctx context.Context) {
+	defer close(rq.chanLoopDone)
+
+	backoff := rq.initialBackoff
+	for {
+		rq.mutSend.Lock()
+		item, err := rq.resendOldest()
+		rq.mutSend.Unlock()
+		if item == nil {
+			select {
+			case <-ctx.Done():
+				return
+			case <-rq.chanNewItem:
+			}
+			continue
+		}
+
+		if shouldRetry(err) {
+			log.Debug("indexer: resending queued request failed", "index", item.Index, "retry in", backoff, "error", err.Error())
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+
+			backoff *= 2
+			if backoff > rq.maxBackoff {
+				backoff = rq.maxBackoff
+			}
+			continue
+		}
+
+		backoff = rq.initialBackoff
+	}
+}
+
+// resendOldest sends the oldest queued item, if any, and removes it from the queue if it should not be retried.
+// It must be called while holding mutSend
+func (rq *elasticRetryQueue) resendOldest() (*retryQueueItem, error) {
+	item, ok := rq.peek()
+	if !ok {
+		return nil, nil
+	}
+
+	err := rq.send(item)
+	if shouldRetry(err) {
+		return item, err
+	}
+	if err != nil {
+		log.Warn("indexer: queued request rejected, dropping it", "index", item.Index, "error", err.Error())
+	}
+
+	rq.pop()
+
+	return item, err
+}
+
+func (rq *elasticRetryQueue) push(item *retryQueueItem) error {
+	buff, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+
+	rq.mutIndexes.Lock()
+	defer rq.mutIndexes.Unlock()
+
+	for rq.indexes.Next-rq.indexes.First >= rq.maxQueueSize {
+		log.Warn("indexer: retry queue is full, dropping the oldest queued request",
+			"max queue size", rq.maxQueueSize, "sequence", rq.indexes.First)
+		rq.removeFirst()
+	}
+
+	err = rq.storer.Put(createRetryQueueKey(rq.indexes.Next), buff)
+	if err != nil {
+		return err
+	}
+
+	newIndexes := rq.indexes
+	newIndexes.Next++
+	err = rq.saveIndexes(newIndexes)
+	if err != nil {
+		return err
+	}
+
+	rq.updateMetric()
+
+	return nil
+}
+
+// peek returns the oldest queued item. Items which can not be read are removed from the queue
+func (rq *elasticRetryQueue) peek() (*retryQueueItem, bool) {
+	rq.mutIndexes.Lock()
+	defer rq.mutIndexes.Unlock()
+
+	for rq.indexes.First < rq.indexes.Next {
+		item, err := rq.getItem(rq.indexes.First)
+		if err == nil {
+			return item, true
+		}
+
+		log.Warn("indexer: could not read queued request, dropping it", "sequence", rq.indexes.First, "error", err.Error())
+		rq.removeFirst()
+	}
+
+	return nil, false
+}
+
+func (rq *elasticRetryQueue) pop() {
+	rq.mutIndexes.Lock()
+	defer rq.mutIndexes.Unlock()
+
+	if rq.indexes.First < rq.indexes.Next {
+		rq.removeFirst()
+	}
+}
+
+func (rq *elasticRetryQueue) removeFirst() {
+	key := createRetryQueueKey(rq.indexes.First)
+
+	newIndexes := rq.indexes
+	newIndexes.First++
+	err := rq.saveIndexes(newIndexes)
+	if err != nil {
+		log.Warn("indexer: could not save retry queue indexes", "error", err.Error())
+	}
+
+	err = rq.storer.Remove(key)
+	if err != nil {
+		log.Debug("indexer: could not remove queued request", "error", err.Error())
+	}
+
+	rq.updateMetric()
+}
+
+func (rq *elasticRetryQueue) getItem(sequence uint64) (*retryQueueItem, error) {
+	buff, err := rq.storer.Get(createRetryQueueKey(sequence))
+	if err != nil {
+		return nil, err
+	}
+
+	item := &retryQueueItem{}
+	err = json.Unmarshal(buff, item)
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+func (rq *elasticRetryQueue) saveIndexes(indexes retryQueueIndexes) error {
+	buff, err := json.Marshal(&indexes)
+	if err != nil {
+		return err
+	}
+
+	err = rq.storer.Put(retryQueueIndexesKey, buff)
+	if err != nil {
+		return err
+	}
+
+	rq.indexes = indexes
+
+	return nil
+}
+
+func (rq *elasticRetryQueue) updateMetric() {
+	rq.statusHandler.SetUInt64Value(core.MetricIndexerRetryQueueSize, rq.indexes.Next-rq.indexes.First)
+}
+
+// Len returns the number of queued requests
+func (rq *elasticRetryQueue) Len() uint64 {
+	rq.mutIndexes.Lock()
+	defer rq.mutIndexes.Unlock()
+
+	return rq.indexes.Next - rq.indexes.First
+}
+
+// Close stops resending the queued requests and closes the storer, which writes its pending batch on disk.
+// The queued requests are resent after the node restarts
+func (rq *elasticRetryQueue) Close() error {
+	var err error
+	rq.closeOnce.Do(func() {
+		rq.cancelFunc()
+		<-rq.chanLoopDone
+
+		rq.mutIndexes.Lock()
+		err = rq.storer.Close()
+		rq.mutIndexes.Unlock()
+	})
+
+	return err
+}
+
+func createRetryQueueKey(sequence uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, sequence)
+
+	return key
+}
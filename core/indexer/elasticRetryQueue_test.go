@@ -0,0 +1,414 @@
+package indexer
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errServerUnavailable = errors.New("server unavailable")
+
+type retryQueueTestWriter struct {
+	*mock.DatabaseWriterStub
+	mut       sync.Mutex
+	sendErr   error
+	sentItems []string
+}
+
+func newRetryQueueTestWriter() *retryQueueTestWriter {
+	writer := &retryQueueTestWriter{}
+	writer.DatabaseWriterStub = &mock.DatabaseWriterStub{
+		DoRequestCalled: func(req *esapi.IndexRequest) error {
+			body, _ := ioutil.ReadAll(req.Body)
+			return writer.record(req.Index + ":" + req.DocumentID + ":" + string(body))
+		},
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			return writer.record(index + ":bulk:" + buff.String())
+		},
+	}
+
+	return writer
+}
+
+func (w *retryQueueTestWriter) record(item string) error {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+
+	if w.sendErr != nil {
+		return w.sendErr
+	}
+	w.sentItems = append(w.sentItems, item)
+
+	return nil
+}
+
+func (w *retryQueueTestWriter) setSendErr(err error) {
+	w.mut.Lock()
+	w.sendErr = err
+	w.mut.Unlock()
+}
+
+func (w *retryQueueTestWriter) getSentItems() []string {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+
+	return append([]string(nil), w.sentItems...)
+}
+
+func createTestRetryQueueStorer(t *testing.T) storage.Storer {
+	cacher, err := lrucache.NewCache(10)
+	require.Nil(t, err)
+	storer, err := storageUnit.NewStorageUnit(cacher, memorydb.New())
+	require.Nil(t, err)
+
+	return storer
+}
+
+func createMockElasticRetryQueueArgs(t *testing.T) elasticRetryQueueArgs {
+	return elasticRetryQueueArgs{
+		writer: newRetryQueueTestWriter(),
+		storer: createTestRetryQueueStorer(t),
+		statusHandler: &mock.AppStatusHandlerStub{
+			SetUInt64ValueHandler: func(key string, value uint64) {},
+		},
+		initialBackoff: time.Millisecond,
+		maxBackoff:     5 * time.Millisecond,
+		maxQueueSize:   100,
+	}
+}
+
+func doTestIndexRequest(rq *elasticRetryQueue, documentID string) error {
+	return rq.DoRequest(&esapi.IndexRequest{
+		Index:      blockIndex,
+		DocumentID: documentID,
+		Body:       bytes.NewReader([]byte("body")),
+	})
+}
+
+func TestNewElasticRetryQueue_NilWriterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockElasticRetryQueueArgs(t)
+	args.writer = nil
+	rq, err := newElasticRetryQueue(args)
+
+	assert.Nil(t, rq)
+	assert.Equal(t, ErrNilDatabaseWriter, err)
+}
+
+func TestNewElasticRetryQueue_NilStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockElasticRetryQueueArgs(t)
+	args.storer = nil
+	rq, err := newElasticRetryQueue(args)
+
+	assert.Nil(t, rq)
+	assert.Equal(t, ErrNilStorer, err)
+}
+
+func TestNewElasticRetryQueue_NilStatusHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockElasticRetryQueueArgs(t)
+	args.statusHandler = nil
+	rq, err := newElasticRetryQueue(args)
+
+	assert.Nil(t, rq)
+	assert.Equal(t, ErrNilStatusHandler, err)
+}
+
+func TestNewElasticRetryQueue_InvalidBackoffShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockElasticRetryQueueArgs(t)
+	args.maxBackoff = args.initialBackoff / 2
+	rq, err := newElasticRetryQueue(args)
+
+	assert.Nil(t, rq)
+	assert.Equal(t, ErrInvalidBackoff, err)
+}
+
+func TestNewElasticRetryQueue_InvalidMaxQueueSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockElasticRetryQueueArgs(t)
+	args.maxQueueSize = 0
+	rq, err := newElasticRetryQueue(args)
+
+	assert.Nil(t, rq)
+	assert.Equal(t, ErrInvalidMaxQueueSize, err)
+}
+
+func TestElasticRetryQueue_ServerAvailableShouldSendDirectly(t *testing.T) {
+	t.Parallel()
+
+	args := createMockElasticRetryQueueArgs(t)
+	writer := args.writer.(*retryQueueTestWriter)
+	rq, err := newElasticRetryQueue(args)
+	require.Nil(t, err)
+	defer rq.Close()
+
+	err = doTestIndexRequest(rq, "a")
+	assert.Nil(t, err)
+	err = rq.DoBulkRequest(bytes.NewBufferString("b"), txIndex)
+	assert.Nil(t, err)
+
+	assert.Equal(t, uint64(0), rq.Len())
+	assert.Equal(t, []string{blockIndex + ":a:body", txIndex + ":bulk:b"}, writer.getSentItems())
+}
+
+func TestElasticRetryQueue_ServerUnavailableShouldQueueAndResendInOrder(t *testing.T) {
+	t.Parallel()
+
+	args := createMockElasticRetryQueueArgs(t)
+	writer := args.writer.(*retryQueueTestWriter)
+	writer.setSendErr(errServerUnavailable)
+	rq, err := newElasticRetryQueue(args)
+	require.Nil(t, err)
+	defer rq.Close()
+
+	assert.Nil(t, doTestIndexRequest(rq, "a"))
+	assert.Nil(t, doTestIndexRequest(rq, "b"))
+	assert.Nil(t, doTestIndexRequest(rq, "c"))
+	assert.Equal(t, uint64(3), rq.Len())
+
+	writer.setSendErr(nil)
+	assert.Eventually(t, func() bool {
+		return rq.Len() == 0
+	}, time.Second, time.Millisecond)
+
+	expected := []string{blockIndex + ":a:body", blockIndex + ":b:body", blockIndex + ":c:body"}
+	assert.Equal(t, expected, writer.getSentItems())
+}
+
+func TestElasticRetryQueue_FullQueueShouldDropTheOldestRequest(t *testing.T) {
+	t.Parallel()
+
+	args := createMockElasticRetryQueueArgs(t)
+	args.maxQueueSize = 2
+	writer := args.writer.(*retryQueueTestWriter)
+	writer.setSendErr(errServerUnavailable)
+	rq, err := newElasticRetryQueue(args)
+	require.Nil(t, err)
+	defer rq.Close()
+
+	assert.Nil(t, doTestIndexRequest(rq, "a"))
+	assert.Nil(t, doTestIndexRequest(rq, "b"))
+	assert.Nil(t, doTestIndexRequest(rq, "c"))
+	assert.Equal(t, uint64(2), rq.Len())
+
+	writer.setSendErr(nil)
+	assert.Eventually(t, func() bool {
+		return rq.Len() == 0
+	}, time.Second, time.Millisecond)
+
+	expected := []string{blockIndex + ":b:body", blockIndex + ":c:body"}
+	assert.Equal(t, expected, writer.getSentItems())
+}
+
+func TestElasticRetryQueue_RequestMadeWhileSendingShouldNotOvertakeTheSentOne(t *testing.T) {
+	t.Parallel()
+
+	chanFirstSendStarted := make(chan struct{})
+	chanFailFirstSend := make(chan struct{})
+	args := createMockElasticRetryQueueArgs(t)
+	writer := args.writer.(*retryQueueTestWriter)
+	firstSend := true
+	writer.DoRequestCalled = func(req *esapi.IndexRequest) error {
+		if req.DocumentID == "a" && firstSend {
+			firstSend = false
+			close(chanFirstSendStarted)
+			<-chanFailFirstSend
+			return errServerUnavailable
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		return writer.record(req.Index + ":" + req.DocumentID + ":" + string(body))
+	}
+	rq, err := newElasticRetryQueue(args)
+	require.Nil(t, err)
+	defer rq.Close()
+
+	chanFirstDone := make(chan struct{})
+	go func() {
+		assert.Nil(t, doTestIndexRequest(rq, "a"))
+		close(chanFirstDone)
+	}()
+	<-chanFirstSendStarted
+
+	chanSecondDone := make(chan struct{})
+	go func() {
+		assert.Nil(t, doTestIndexRequest(rq, "b"))
+		close(chanSecondDone)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	close(chanFailFirstSend)
+	<-chanFirstDone
+	<-chanSecondDone
+
+	assert.Eventually(t, func() bool {
+		return rq.Len() == 0
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, []string{blockIndex + ":a:body", blockIndex + ":b:body"}, writer.getSentItems())
+}
+
+func TestElasticRetryQueue_RejectedRequestShouldNotBeQueued(t *testing.T) {
+	t.Parallel()
+
+	args := createMockElasticRetryQueueArgs(t)
+	writer := args.writer.(*retryQueueTestWriter)
+	writer.setSendErr(ErrElasticRequestRejected)
+	rq, err := newElasticRetryQueue(args)
+	require.Nil(t, err)
+	defer rq.Close()
+
+	err = doTestIndexRequest(rq, "a")
+
+	assert.True(t, errors.Is(err, ErrElasticRequestRejected))
+	assert.Equal(t, uint64(0), rq.Len())
+}
+
+func TestElasticRetryQueue_QueuedRequestRejectedShouldBeDropped(t *testing.T) {
+	t.Parallel()
+
+	args := createMockElasticRetryQueueArgs(t)
+	writer := args.writer.(*retryQueueTestWriter)
+	writer.setSendErr(errServerUnavailable)
+	rq, err := newElasticRetryQueue(args)
+	require.Nil(t, err)
+	defer rq.Close()
+
+	assert.Nil(t, doTestIndexRequest(rq, "a"))
+
+	writer.setSendErr(ErrElasticRequestRejected)
+	assert.Eventually(t, func() bool {
+		return rq.Len() == 0
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, 0, len(writer.getSentItems()))
+}
+
+func TestElasticRetryQueue_QueueShouldSurviveRestart(t *testing.T) {
+	t.Parallel()
+
+	args := createMockElasticRetryQueueArgs(t)
+	writer := args.writer.(*retryQueueTestWriter)
+	writer.setSendErr(errServerUnavailable)
+	rq, err := newElasticRetryQueue(args)
+	require.Nil(t, err)
+
+	assert.Nil(t, doTestIndexRequest(rq, "a"))
+	assert.Nil(t, rq.DoBulkRequest(bytes.NewBufferString("b"), txIndex))
+	rq.Close()
+
+	newWriter := newRetryQueueTestWriter()
+	args.writer = newWriter
+	rq, err = newElasticRetryQueue(args)
+	require.Nil(t, err)
+	defer rq.Close()
+
+	assert.Eventually(t, func() bool {
+		return rq.Len() == 0
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, []string{blockIndex + ":a:body", txIndex + ":bulk:b"}, newWriter.getSentItems())
+}
+
+func TestElasticRetryQueue_CloseShouldPersistTheStorerBatch(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	createSerialStorer := func() storage.Storer {
+		cacher, err := lrucache.NewCache(10)
+		require.Nil(t, err)
+		// the batch is written only on close, the delay and the size being large enough
+		persister, err := leveldb.NewSerialDB(dir, 100, 100, 10)
+		require.Nil(t, err)
+		storer, err := storageUnit.NewStorageUnit(cacher, persister)
+		require.Nil(t, err)
+
+		return storer
+	}
+
+	args := createMockElasticRetryQueueArgs(t)
+	args.storer = createSerialStorer()
+	writer := args.writer.(*retryQueueTestWriter)
+	writer.setSendErr(errServerUnavailable)
+	rq, err := newElasticRetryQueue(args)
+	require.Nil(t, err)
+
+	assert.Nil(t, doTestIndexRequest(rq, "a"))
+	err = rq.Close()
+	assert.Nil(t, err)
+
+	newWriter := newRetryQueueTestWriter()
+	args.writer = newWriter
+	args.storer = createSerialStorer()
+	rq, err = newElasticRetryQueue(args)
+	require.Nil(t, err)
+	defer func() {
+		_ = rq.Close()
+	}()
+
+	assert.Eventually(t, func() bool {
+		return rq.Len() == 0
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, []string{blockIndex + ":a:body"}, newWriter.getSentItems())
+}
+
+func TestElasticRetryQueue_ShouldUpdateQueueSizeMetric(t *testing.T) {
+	t.Parallel()
+
+	mutMetric := sync.Mutex{}
+	queueSize := uint64(0)
+	args := createMockElasticRetryQueueArgs(t)
+	args.statusHandler = &mock.AppStatusHandlerStub{
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			if key != core.MetricIndexerRetryQueueSize {
+				return
+			}
+
+			mutMetric.Lock()
+			queueSize = value
+			mutMetric.Unlock()
+		},
+	}
+	writer := args.writer.(*retryQueueTestWriter)
+	writer.setSendErr(errServerUnavailable)
+	rq, err := newElasticRetryQueue(args)
+	require.Nil(t, err)
+	defer rq.Close()
+
+	assert.Nil(t, doTestIndexRequest(rq, "a"))
+	assert.Nil(t, doTestIndexRequest(rq, "b"))
+
+	mutMetric.Lock()
+	assert.Equal(t, uint64(2), queueSize)
+	mutMetric.Unlock()
+
+	writer.setSendErr(nil)
+	assert.Eventually(t, func() bool {
+		mutMetric.Lock()
+		defer mutMetric.Unlock()
+
+		return queueSize == 0
+	}, time.Second, time.Millisecond)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/elastic/go-elasticsearch/v7"
 	"github.com/elastic/go-elasticsearch/v7/esapi"
 )
@@ -28,12 +29,23 @@ type elasticSearchDatabaseArgs struct {
 	hasher                   hashing.Hasher
 	addressPubkeyConverter   core.PubkeyConverter
 	validatorPubkeyConverter core.PubkeyConverter
+	retryQueue               *retryQueueArgs
+}
+
+// retryQueueArgs holds the parameters of the optional queue in which the failed requests are saved to be resent
+type retryQueueArgs struct {
+	storer         storage.Storer
+	statusHandler  core.AppStatusHandler
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	maxQueueSize   uint64
 }
 
 // elasticSearchDatabase object it contains business logic built over databaseWriterHandler glue code wrapper
 type elasticSearchDatabase struct {
 	*txDatabaseProcessor
 	dbWriter    databaseWriterHandler
+	retryQueue  *elasticRetryQueue
 	marshalizer marshal.Marshalizer
 	hasher      hashing.Hasher
 }
@@ -50,8 +62,26 @@ func newElasticSearchDatabase(arguments elasticSearchDatabaseArgs) (*elasticSear
 		return nil, err
 	}
 
+	var dbWriter databaseWriterHandler = es
+	var retryQueue *elasticRetryQueue
+	if arguments.retryQueue != nil {
+		retryQueue, err = newElasticRetryQueue(elasticRetryQueueArgs{
+			writer:         es,
+			storer:         arguments.retryQueue.storer,
+			statusHandler:  arguments.retryQueue.statusHandler,
+			initialBackoff: arguments.retryQueue.initialBackoff,
+			maxBackoff:     arguments.retryQueue.maxBackoff,
+			maxQueueSize:   arguments.retryQueue.maxQueueSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		dbWriter = retryQueue
+	}
+
 	esdb := &elasticSearchDatabase{
-		dbWriter:    es,
+		dbWriter:    dbWriter,
+		retryQueue:  retryQueue,
 		marshalizer: arguments.marshalizer,
 		hasher:      arguments.hasher,
 	}
@@ -64,6 +94,7 @@ func newElasticSearchDatabase(arguments elasticSearchDatabaseArgs) (*elasticSear
 
 	err = esdb.createIndexes()
 	if err != nil {
+		_ = esdb.Close()
 		return nil, err
 	}
 
@@ -148,52 +179,11 @@ func (esd *elasticSearchDatabase) getSerializedElasticBlockAndHeaderHash(
 	notarizedHeadersHashes []string,
 	sizeTxs int,
 ) ([]byte, []byte) {
-	headerBytes, err := esd.marshalizer.Marshal(header)
-	if err != nil {
-		log.Debug("indexer: marshal header", "error", err)
-		return nil, nil
-	}
-	bodyBytes, err := esd.marshalizer.Marshal(body)
-	if err != nil {
-		log.Debug("indexer: marshal body", "error", err)
+	elasticBlock, headerHash := prepareBlock(esd.marshalizer, esd.hasher, header, signersIndexes, body, notarizedHeadersHashes, sizeTxs)
+	if elasticBlock == nil {
 		return nil, nil
 	}
 
-	blockSizeInBytes := len(headerBytes) + len(bodyBytes)
-
-	miniblocksHashes := make([]string, 0)
-	for _, miniblock := range body.MiniBlocks {
-		mbHash, errComputeHash := core.CalculateHash(esd.marshalizer, esd.hasher, miniblock)
-		if errComputeHash != nil {
-			log.Warn("internal error computing hash", "error", errComputeHash)
-
-			continue
-		}
-
-		encodedMbHash := hex.EncodeToString(mbHash)
-		miniblocksHashes = append(miniblocksHashes, encodedMbHash)
-	}
-
-	headerHash := esd.hasher.Compute(string(headerBytes))
-	elasticBlock := Block{
-		Nonce:                 header.GetNonce(),
-		Round:                 header.GetRound(),
-		Epoch:                 header.GetEpoch(),
-		ShardID:               header.GetShardID(),
-		Hash:                  hex.EncodeToString(headerHash),
-		MiniBlocksHashes:      miniblocksHashes,
-		NotarizedBlocksHashes: notarizedHeadersHashes,
-		Proposer:              signersIndexes[0],
-		Validators:            signersIndexes,
-		PubKeyBitmap:          hex.EncodeToString(header.GetPubKeysBitmap()),
-		Size:                  int64(blockSizeInBytes),
-		SizeTxs:               int64(sizeTxs),
-		Timestamp:             time.Duration(header.GetTimeStamp()),
-		TxCount:               header.GetTxCount(),
-		StateRootHash:         hex.EncodeToString(header.GetRootHash()),
-		PrevHash:              hex.EncodeToString(header.GetPrevHash()),
-	}
-
 	serializedBlock, err := json.Marshal(elasticBlock)
 	if err != nil {
 		log.Debug("indexer: marshal", "error", "could not marshal elastic header")
@@ -255,7 +245,7 @@ func (esd *elasticSearchDatabase) buildTransactionBulks(
 
 // SaveMiniblocks will prepare and save information about miniblocks in elasticsearch server
 func (esd *elasticSearchDatabase) SaveMiniblocks(header data.HeaderHandler, body *block.Body) {
-	miniblocks := esd.getMiniblocks(header, body)
+	miniblocks := prepareMiniblocks(esd.marshalizer, esd.hasher, header, body)
 	if miniblocks == nil {
 		log.Warn("indexer: could not index miniblocks")
 		return
@@ -271,49 +261,6 @@ func (esd *elasticSearchDatabase) SaveMiniblocks(header data.HeaderHandler, body
 	}
 }
 
-func (esd *elasticSearchDatabase) getMiniblocks(header data.HeaderHandler, body *block.Body) []*Miniblock {
-	headerHash, err := core.CalculateHash(esd.marshalizer, esd.hasher, header)
-	if err != nil {
-		log.Warn("indexer: could not calculate header hash", "error", err.Error())
-		return nil
-	}
-
-	encodedHeaderHash := hex.EncodeToString(headerHash)
-
-	miniblocks := make([]*Miniblock, 0)
-	for _, miniblock := range body.MiniBlocks {
-		mbHash, errComputeHash := core.CalculateHash(esd.marshalizer, esd.hasher, miniblock)
-		if errComputeHash != nil {
-			log.Warn("internal error computing hash", "error", errComputeHash)
-
-			continue
-		}
-
-		encodedMbHash := hex.EncodeToString(mbHash)
-
-		mb := &Miniblock{
-			Hash:            encodedMbHash,
-			SenderShardID:   miniblock.SenderShardID,
-			ReceiverShardID: miniblock.ReceiverShardID,
-			Type:            miniblock.Type.String(),
-		}
-
-		if mb.SenderShardID == header.GetShardID() {
-			mb.SenderBlockHash = encodedHeaderHash
-		} else {
-			mb.ReceiverBlockHash = encodedHeaderHash
-		}
-
-		if mb.SenderShardID == mb.ReceiverShardID {
-			mb.ReceiverBlockHash = encodedHeaderHash
-		}
-
-		miniblocks = append(miniblocks, mb)
-	}
-
-	return miniblocks
-}
-
 // SaveRoundInfo will prepare and save information about a round in elasticsearch server
 func (esd *elasticSearchDatabase) SaveRoundInfo(info RoundInfo) {
 	var buff bytes.Buffer
@@ -349,14 +296,7 @@ func (esd *elasticSearchDatabase) SaveRoundInfo(info RoundInfo) {
 func (esd *elasticSearchDatabase) SaveShardValidatorsPubKeys(shardID, epoch uint32, shardValidatorsPubKeys [][]byte) {
 	var buff bytes.Buffer
 
-	shardValPubKeys := ValidatorsPublicKeys{
-		PublicKeys: make([]string, 0, len(shardValidatorsPubKeys)),
-	}
-	for _, validatorPk := range shardValidatorsPubKeys {
-		strValidatorPk := esd.validatorPubkeyConverter.Encode(validatorPk)
-		shardValPubKeys.PublicKeys = append(shardValPubKeys.PublicKeys, strValidatorPk)
-	}
-
+	shardValPubKeys := prepareValidatorsPublicKeys(esd.validatorPubkeyConverter, shardValidatorsPubKeys)
 	marshalizedValidatorPubKeys, err := json.Marshal(shardValPubKeys)
 	if err != nil {
 		log.Debug("indexer: marshal", "error", "could not marshal validators public keys")
@@ -442,3 +382,12 @@ func (esd *elasticSearchDatabase) SaveShardStatistics(tpsBenchmark statistics.TP
 		}
 	}
 }
+
+// Close closes the retry queue, if enabled, keeping the requests which were not sent yet for the next start
+func (esd *elasticSearchDatabase) Close() error {
+	if esd.retryQueue == nil {
+		return nil
+	}
+
+	return esd.retryQueue.Close()
+}
@@ -99,6 +99,7 @@ func (dw *databaseWriter) DoRequest(req *esapi.IndexRequest) error {
 
 	if res.IsError() {
 		log.Warn("indexer", "error", res.String())
+		return responseError(res)
 	}
 
 	return nil
@@ -121,12 +122,22 @@ func (dw *databaseWriter) DoBulkRequest(buff *bytes.Buffer, index string) error
 
 	if res.IsError() {
 		log.Warn("indexer", "error", res.String())
-		return fmt.Errorf("do bulk requrest %s", res.String())
+		return fmt.Errorf("do bulk requrest: %w", responseError(res))
 	}
 
 	return nil
 }
 
+// responseError returns ErrElasticRequestRejected if the request was refused because of its content, as resending
+// it will not help, or a plain error if the server is overloaded or failed internally
+func responseError(res *esapi.Response) error {
+	if res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("elastic search request failed: %s", res.String())
+	}
+
+	return fmt.Errorf("%w: %s", ErrElasticRequestRejected, res.String())
+}
+
 func closeESResponseBody(res *esapi.Response) {
 	if res != nil && res.Body != nil {
 		err := res.Body.Close()
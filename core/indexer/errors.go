@@ -24,3 +24,39 @@ var ErrEmptyPassword = errors.New("password is empty")
 
 // ErrNilPubkeyConverter signals that an operation has been attempted to or with a nil public key converter implementation
 var ErrNilPubkeyConverter = errors.New("nil pubkey converter")
+
+// ErrUnknownIndexerDriver signals that the requested indexer driver is not registered
+var ErrUnknownIndexerDriver = errors.New("unknown indexer driver")
+
+// ErrMultipleIndexerDriversEnabled signals that more than one indexer driver is enabled in the external config
+var ErrMultipleIndexerDriversEnabled = errors.New("only one indexer driver can be enabled")
+
+// ErrEmptyDirectory signals that the directory of the file indexer is empty
+var ErrEmptyDirectory = errors.New("empty directory")
+
+// ErrInvalidMaxFileSize signals that the maximum size of the file indexer's files is invalid
+var ErrInvalidMaxFileSize = errors.New("invalid maximum file size")
+
+// ErrEmptySQLDriverName signals that the name of the SQL driver is empty
+var ErrEmptySQLDriverName = errors.New("empty SQL driver name")
+
+// ErrEmptyDataSourceName signals that the data source name of the SQL database is empty
+var ErrEmptyDataSourceName = errors.New("empty data source name")
+
+// ErrElasticRequestRejected signals that the elastic search server rejected a request because of its content
+var ErrElasticRequestRejected = errors.New("elastic search request rejected")
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilStatusHandler signals that a nil status handler has been provided
+var ErrNilStatusHandler = errors.New("nil status handler")
+
+// ErrInvalidBackoff signals that the backoff durations of the retry queue are invalid
+var ErrInvalidBackoff = errors.New("invalid backoff")
+
+// ErrInvalidMaxQueueSize signals that the maximum number of requests kept by the retry queue is invalid
+var ErrInvalidMaxQueueSize = errors.New("invalid max queue size")
+
+// ErrNilDatabaseWriter signals that a nil database writer has been provided
+var ErrNilDatabaseWriter = errors.New("nil database writer")
@@ -0,0 +1,189 @@
+package indexer
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// fileDatabaseArgs is struct that is used to store all parameters that are needed to create a file database
+type fileDatabaseArgs struct {
+	directory                string
+	maxFileSize              int64
+	maxNumFiles              uint32
+	marshalizer              marshal.Marshalizer
+	hasher                   hashing.Hasher
+	addressPubkeyConverter   core.PubkeyConverter
+	validatorPubkeyConverter core.PubkeyConverter
+}
+
+// fileRecord is a line written by the file database. Type holds the name of the collection the record belongs to,
+// the same as the elasticsearch index name, while Update signals that only the fields set by the current shard
+// should be merged over an already existing record with the same ID
+type fileRecord struct {
+	Type   string      `json:"type"`
+	ID     string      `json:"id"`
+	Update bool        `json:"update,omitempty"`
+	Data   interface{} `json:"data"`
+}
+
+// fileTransaction adds the transaction's log, which is not part of the transaction's JSON representation
+type fileTransaction struct {
+	*Transaction
+	Log TxLog `json:"log"`
+}
+
+// fileDatabase writes the node's data as JSON lines in rotating files
+type fileDatabase struct {
+	*txDatabaseProcessor
+	writer      *rotatingFileWriter
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+}
+
+// newFileDatabase creates a new file database writing in the provided directory
+func newFileDatabase(arguments fileDatabaseArgs) (*fileDatabase, error) {
+	writer, err := newRotatingFileWriter(arguments.directory, arguments.maxFileSize, arguments.maxNumFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	fdb := &fileDatabase{
+		writer:      writer,
+		marshalizer: arguments.marshalizer,
+		hasher:      arguments.hasher,
+	}
+	fdb.txDatabaseProcessor = newTxDatabaseProcessor(
+		arguments.hasher,
+		arguments.marshalizer,
+		arguments.addressPubkeyConverter,
+		arguments.validatorPubkeyConverter,
+	)
+
+	return fdb, nil
+}
+
+// SetTxLogsProcessor will set tx logs processor
+func (fdb *fileDatabase) SetTxLogsProcessor(txLogsProc process.TransactionLogProcessorDatabase) {
+	fdb.txLogsProcessor = txLogsProc
+}
+
+// SaveHeader will prepare and write information about a header
+func (fdb *fileDatabase) SaveHeader(
+	header data.HeaderHandler,
+	signersIndexes []uint64,
+	body *block.Body,
+	notarizedHeadersHashes []string,
+	txsSize int,
+) {
+	dbBlock, _ := prepareBlock(fdb.marshalizer, fdb.hasher, header, signersIndexes, body, notarizedHeadersHashes, txsSize)
+	if dbBlock == nil {
+		return
+	}
+
+	fdb.writeRecord(&fileRecord{Type: blockIndex, ID: dbBlock.Hash, Data: dbBlock})
+}
+
+// SaveMiniblocks will prepare and write information about miniblocks
+func (fdb *fileDatabase) SaveMiniblocks(header data.HeaderHandler, body *block.Body) {
+	miniblocks := prepareMiniblocks(fdb.marshalizer, fdb.hasher, header, body)
+	if miniblocks == nil {
+		log.Warn("indexer: could not index miniblocks")
+		return
+	}
+
+	for _, mb := range miniblocks {
+		fdb.writeRecord(&fileRecord{
+			Type:   miniblocksIndex,
+			ID:     mb.Hash,
+			Update: header.GetShardID() != mb.SenderShardID,
+			Data:   mb,
+		})
+	}
+}
+
+// SaveTransactions will prepare and write information about transactions
+func (fdb *fileDatabase) SaveTransactions(
+	body *block.Body,
+	header data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	selfShardID uint32,
+) {
+	txs := fdb.prepareTransactionsForDatabase(body, header, txPool, selfShardID)
+	for _, tx := range txs {
+		fdb.writeRecord(&fileRecord{
+			Type:   txIndex,
+			ID:     tx.Hash,
+			Update: isCrossShardDstMe(tx, selfShardID) && tx.Status != txStatusInvalid,
+			Data:   &fileTransaction{Transaction: tx, Log: tx.Log},
+		})
+	}
+}
+
+// SaveRoundInfo will write information about a round
+func (fdb *fileDatabase) SaveRoundInfo(info RoundInfo) {
+	fdb.writeRecord(&fileRecord{
+		Type: roundIndex,
+		ID:   fmt.Sprintf("%d_%d", info.ShardId, info.Index),
+		Data: &info,
+	})
+}
+
+// SaveShardValidatorsPubKeys will write the public keys of a shard's validators
+func (fdb *fileDatabase) SaveShardValidatorsPubKeys(shardID, epoch uint32, shardValidatorsPubKeys [][]byte) {
+	shardValPubKeys := prepareValidatorsPublicKeys(fdb.validatorPubkeyConverter, shardValidatorsPubKeys)
+	fdb.writeRecord(&fileRecord{
+		Type: validatorsIndex,
+		ID:   fmt.Sprintf("%d_%d", shardID, epoch),
+		Data: &shardValPubKeys,
+	})
+}
+
+// SaveValidatorsRating will write validators rating
+func (fdb *fileDatabase) SaveValidatorsRating(index string, validatorsRatingInfo []ValidatorRatingInfo) {
+	fdb.writeRecord(&fileRecord{
+		Type: ratingIndex,
+		ID:   index,
+		Data: &ValidatorsRatingInfo{ValidatorsInfos: validatorsRatingInfo},
+	})
+}
+
+// SaveShardStatistics will write the general and the per shard statistics
+func (fdb *fileDatabase) SaveShardStatistics(tpsBenchmark statistics.TPSBenchmark) {
+	generalInfo := prepareGeneralTPS(tpsBenchmark)
+	fdb.writeRecord(&fileRecord{Type: tpsIndex, ID: metachainTpsDocID, Data: &generalInfo})
+
+	for _, shardInfo := range tpsBenchmark.ShardStatistics() {
+		shardTPS := prepareShardTPS(shardInfo)
+		fdb.writeRecord(&fileRecord{
+			Type: tpsIndex,
+			ID:   fmt.Sprintf("%s%d", shardTpsDocIDPrefix, shardInfo.ShardID()),
+			Data: &shardTPS,
+		})
+	}
+}
+
+func (fdb *fileDatabase) writeRecord(record *fileRecord) {
+	line, err := json.Marshal(record)
+	if err != nil {
+		log.Debug("indexer: marshal", "type", record.Type, "id", record.ID, "error", err.Error())
+		return
+	}
+
+	err = fdb.writer.WriteLine(line)
+	if err != nil {
+		log.Warn("indexer: could not write record", "type", record.Type, "id", record.ID, "error", err.Error())
+	}
+}
+
+// Close closes the file currently written
+func (fdb *fileDatabase) Close() error {
+	return fdb.writer.Close()
+}
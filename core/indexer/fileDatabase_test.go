@@ -0,0 +1,86 @@
+package indexer
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockFileDatabaseArgs(dir string) fileDatabaseArgs {
+	return fileDatabaseArgs{
+		directory:                dir,
+		maxFileSize:              bytesInMegabyte,
+		addressPubkeyConverter:   mock.NewPubkeyConverterMock(32),
+		validatorPubkeyConverter: mock.NewPubkeyConverterMock(32),
+		hasher:                   &mock.HasherMock{},
+		marshalizer:              &mock.MarshalizerMock{},
+	}
+}
+
+func readFileRecords(t *testing.T, dir string) []map[string]interface{} {
+	file, err := os.Open(filepath.Join(dir, "indexer_0000000001.jsonl"))
+	require.Nil(t, err)
+	defer func() {
+		_ = file.Close()
+	}()
+
+	records := make([]map[string]interface{}, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		record := make(map[string]interface{})
+		err = json.Unmarshal(scanner.Bytes(), &record)
+		require.Nil(t, err)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+func TestFileDatabase_SaveDataShouldWriteRecords(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	fdb, err := newFileDatabase(createMockFileDatabaseArgs(dir))
+	require.Nil(t, err)
+
+	header := &dataBlock.Header{Nonce: 1, ShardID: 2}
+	body := newTestBlockBody()
+	fdb.SaveHeader(header, []uint64{0}, body, nil, 10)
+	fdb.SaveMiniblocks(header, body)
+	fdb.SaveTransactions(body, header, newTestTxPool(), 2)
+	fdb.SaveRoundInfo(RoundInfo{Index: 7, ShardId: 2})
+	fdb.SaveShardValidatorsPubKeys(2, 1, [][]byte{[]byte("key")})
+	fdb.SaveValidatorsRating("2_1", []ValidatorRatingInfo{{PublicKey: "key", Rating: 50}})
+	err = fdb.Close()
+	require.Nil(t, err)
+
+	records := readFileRecords(t, dir)
+	types := make([]string, 0, len(records))
+	for _, record := range records {
+		types = append(types, record["type"].(string))
+	}
+	assert.Equal(t, []string{
+		blockIndex,
+		miniblocksIndex, miniblocksIndex,
+		txIndex, txIndex, txIndex,
+		roundIndex,
+		validatorsIndex,
+		ratingIndex,
+	}, types)
+
+	// the second miniblock was sent by shard 1 so shard 2 only updates its receiver block hash
+	assert.Nil(t, records[1]["update"])
+	assert.Equal(t, true, records[2]["update"])
+	assert.Equal(t, "2_7", records[6]["id"])
+}
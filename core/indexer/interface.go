@@ -20,6 +20,7 @@ type Indexer interface {
 	UpdateTPS(tpsBenchmark statistics.TPSBenchmark)
 	SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32)
 	SaveValidatorsRating(indexID string, infoRating []ValidatorRatingInfo)
+	Close() error
 	IsInterfaceNil() bool
 	IsNilIndexer() bool
 }
@@ -34,6 +35,7 @@ type databaseHandler interface {
 	SaveShardValidatorsPubKeys(shardId, epoch uint32, shardValidatorsPubKeys [][]byte)
 	SaveValidatorsRating(Index string, validatorsRatingInfo []ValidatorRatingInfo)
 	SaveShardStatistics(tpsBenchmark statistics.TPSBenchmark)
+	Close() error
 }
 
 // databaseWriterHandler is an interface that do requests to elasticsearch server do save data
@@ -39,6 +39,11 @@ func (ni *NilIndexer) SaveValidatorsRating(_ string, _ []ValidatorRatingInfo) {
 func (ni *NilIndexer) SaveValidatorsPubKeys(_ map[uint32][][]byte, _ uint32) {
 }
 
+// Close will do nothing
+func (ni *NilIndexer) Close() error {
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ni *NilIndexer) IsInterfaceNil() bool {
 	return ni == nil
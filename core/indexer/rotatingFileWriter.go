@@ -0,0 +1,168 @@
+package indexer
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+const indexerFilePrefix = "indexer_"
+const indexerFileExtension = ".jsonl"
+
+// rotatingFileWriter appends lines to numbered files from a directory, starting a new file whenever the current
+// one would exceed the maximum size. Only the newest maxNumFiles files are kept, 0 meaning no file is removed
+type rotatingFileWriter struct {
+	mut         sync.Mutex
+	directory   string
+	maxFileSize int64
+	maxNumFiles uint32
+	file        *os.File
+	fileSize    int64
+	fileIndex   uint64
+}
+
+func newRotatingFileWriter(directory string, maxFileSize int64, maxNumFiles uint32) (*rotatingFileWriter, error) {
+	if len(directory) == 0 {
+		return nil, ErrEmptyDirectory
+	}
+	if maxFileSize <= 0 {
+		return nil, ErrInvalidMaxFileSize
+	}
+
+	err := os.MkdirAll(directory, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	rfw := &rotatingFileWriter{
+		directory:   directory,
+		maxFileSize: maxFileSize,
+		maxNumFiles: maxNumFiles,
+	}
+
+	indexes, err := rfw.getFilesIndexes()
+	if err != nil {
+		return nil, err
+	}
+	if len(indexes) > 0 {
+		rfw.fileIndex = indexes[len(indexes)-1]
+	}
+
+	err = rfw.openNextFile()
+	if err != nil {
+		return nil, err
+	}
+
+	return rfw, nil
+}
+
+// WriteLine appends the given line, followed by a new line character, to the current file
+func (rfw *rotatingFileWriter) WriteLine(line []byte) error {
+	rfw.mut.Lock()
+	defer rfw.mut.Unlock()
+
+	lineSize := int64(len(line)) + 1
+	if rfw.fileSize > 0 && rfw.fileSize+lineSize > rfw.maxFileSize {
+		err := rfw.rotate()
+		if err != nil {
+			return err
+		}
+	}
+
+	n, err := rfw.file.Write(append(line, '\n'))
+	rfw.fileSize += int64(n)
+
+	return err
+}
+
+// Close closes the current file
+func (rfw *rotatingFileWriter) Close() error {
+	rfw.mut.Lock()
+	defer rfw.mut.Unlock()
+
+	return rfw.file.Close()
+}
+
+func (rfw *rotatingFileWriter) rotate() error {
+	err := rfw.file.Close()
+	if err != nil {
+		return err
+	}
+
+	err = rfw.openNextFile()
+	if err != nil {
+		return err
+	}
+
+	return rfw.removeOldFiles()
+}
+
+func (rfw *rotatingFileWriter) openNextFile() error {
+	rfw.fileIndex++
+	file, err := os.OpenFile(rfw.filePath(rfw.fileIndex), os.O_CREATE|os.O_WRONLY|os.O_APPEND, core.FileModeUserReadWrite)
+	if err != nil {
+		return err
+	}
+
+	rfw.file = file
+	rfw.fileSize = 0
+
+	return nil
+}
+
+func (rfw *rotatingFileWriter) removeOldFiles() error {
+	if rfw.maxNumFiles == 0 {
+		return nil
+	}
+
+	indexes, err := rfw.getFilesIndexes()
+	if err != nil {
+		return err
+	}
+
+	for len(indexes) > int(rfw.maxNumFiles) {
+		err = os.Remove(rfw.filePath(indexes[0]))
+		if err != nil {
+			return err
+		}
+		indexes = indexes[1:]
+	}
+
+	return nil
+}
+
+func (rfw *rotatingFileWriter) getFilesIndexes() ([]uint64, error) {
+	files, err := ioutil.ReadDir(rfw.directory)
+	if err != nil {
+		return nil, err
+	}
+
+	indexes := make([]uint64, 0, len(files))
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+
+		var index uint64
+		_, errScan := fmt.Sscanf(file.Name(), indexerFilePrefix+"%d"+indexerFileExtension, &index)
+		if errScan != nil {
+			continue
+		}
+		indexes = append(indexes, index)
+	}
+
+	sort.Slice(indexes, func(i, j int) bool {
+		return indexes[i] < indexes[j]
+	})
+
+	return indexes, nil
+}
+
+func (rfw *rotatingFileWriter) filePath(index uint64) string {
+	return filepath.Join(rfw.directory, fmt.Sprintf("%s%010d%s", indexerFilePrefix, index, indexerFileExtension))
+}
@@ -0,0 +1,77 @@
+package indexer
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "indexer")
+	require.Nil(t, err)
+
+	return dir
+}
+
+func TestNewRotatingFileWriter_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rfw, err := newRotatingFileWriter("", 10, 0)
+	assert.Nil(t, rfw)
+	assert.Equal(t, ErrEmptyDirectory, err)
+
+	rfw, err = newRotatingFileWriter("dir", 0, 0)
+	assert.Nil(t, rfw)
+	assert.Equal(t, ErrInvalidMaxFileSize, err)
+}
+
+func TestRotatingFileWriter_WriteLineShouldRotateAndRemoveOldFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	rfw, err := newRotatingFileWriter(dir, 10, 2)
+	require.Nil(t, err)
+
+	for _, line := range []string{"line1", "line2", "line3", "line4"} {
+		err = rfw.WriteLine([]byte(line))
+		require.Nil(t, err)
+	}
+	_ = rfw.Close()
+
+	indexes, _ := rfw.getFilesIndexes()
+	assert.Equal(t, []uint64{3, 4}, indexes)
+
+	content, _ := ioutil.ReadFile(filepath.Join(dir, "indexer_0000000004.jsonl"))
+	assert.Equal(t, "line4\n", string(content))
+}
+
+func TestRotatingFileWriter_ShouldContinueAfterExistingFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	rfw, _ := newRotatingFileWriter(dir, 100, 0)
+	_ = rfw.WriteLine([]byte("first run"))
+	_ = rfw.Close()
+
+	rfw, _ = newRotatingFileWriter(dir, 100, 0)
+	_ = rfw.WriteLine([]byte("second run"))
+	_ = rfw.Close()
+
+	indexes, _ := rfw.getFilesIndexes()
+	assert.Equal(t, []uint64{1, 2}, indexes)
+
+	content, _ := ioutil.ReadFile(filepath.Join(dir, "indexer_0000000001.jsonl"))
+	assert.Equal(t, "first run\n", string(content))
+}
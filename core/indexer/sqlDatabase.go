@@ -0,0 +1,461 @@
+package indexer
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	// the sqlite3 driver is registered so that the SQL indexer can be used without external services
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// sqlSchema holds the statements creating the tables of the SQL indexer. The tables mirror the elasticsearch
+// indexes, list fields being stored as JSON text
+var sqlSchema = []string{
+	`CREATE TABLE IF NOT EXISTS blocks (
+		hash VARCHAR(128) PRIMARY KEY,
+		nonce INTEGER,
+		round INTEGER,
+		epoch INTEGER,
+		shard_id INTEGER,
+		miniblocks_hashes TEXT,
+		notarized_blocks_hashes TEXT,
+		proposer INTEGER,
+		validators TEXT,
+		pub_key_bitmap TEXT,
+		size INTEGER,
+		size_txs INTEGER,
+		timestamp INTEGER,
+		state_root_hash TEXT,
+		prev_hash TEXT,
+		tx_count INTEGER
+	)`,
+	`CREATE TABLE IF NOT EXISTS miniblocks (
+		hash VARCHAR(128) PRIMARY KEY,
+		sender_shard INTEGER,
+		receiver_shard INTEGER,
+		sender_block_hash TEXT,
+		receiver_block_hash TEXT,
+		type TEXT
+	)`,
+	`CREATE TABLE IF NOT EXISTS transactions (
+		hash VARCHAR(128) PRIMARY KEY,
+		miniblock_hash TEXT,
+		nonce INTEGER,
+		round INTEGER,
+		value TEXT,
+		receiver TEXT,
+		sender TEXT,
+		receiver_shard INTEGER,
+		sender_shard INTEGER,
+		gas_price INTEGER,
+		gas_limit INTEGER,
+		gas_used INTEGER,
+		data TEXT,
+		signature TEXT,
+		timestamp INTEGER,
+		status TEXT,
+		sc_results TEXT,
+		log TEXT
+	)`,
+	`CREATE TABLE IF NOT EXISTS rounds (
+		shard_id INTEGER,
+		round INTEGER,
+		signers_indexes TEXT,
+		block_was_proposed BOOLEAN,
+		timestamp INTEGER,
+		PRIMARY KEY (shard_id, round)
+	)`,
+	`CREATE TABLE IF NOT EXISTS validators (
+		shard_id INTEGER,
+		epoch INTEGER,
+		public_keys TEXT,
+		PRIMARY KEY (shard_id, epoch)
+	)`,
+	`CREATE TABLE IF NOT EXISTS rating (
+		id VARCHAR(128) PRIMARY KEY,
+		validators_rating TEXT
+	)`,
+	`CREATE TABLE IF NOT EXISTS tps (
+		id VARCHAR(32) PRIMARY KEY,
+		live_tps REAL,
+		peak_tps REAL,
+		block_number INTEGER,
+		round_number INTEGER,
+		round_time INTEGER,
+		average_block_tx_count TEXT,
+		total_processed_tx_count TEXT,
+		average_tps TEXT,
+		current_block_nonce INTEGER,
+		nr_of_shards INTEGER,
+		nr_of_nodes INTEGER,
+		last_block_tx_count INTEGER,
+		shard_id INTEGER
+	)`,
+}
+
+var blockColumns = []string{"hash", "nonce", "round", "epoch", "shard_id", "miniblocks_hashes", "notarized_blocks_hashes",
+	"proposer", "validators", "pub_key_bitmap", "size", "size_txs", "timestamp", "state_root_hash", "prev_hash", "tx_count"}
+var miniblockColumns = []string{"hash", "sender_shard", "receiver_shard", "sender_block_hash", "receiver_block_hash", "type"}
+var transactionColumns = []string{"hash", "miniblock_hash", "nonce", "round", "value", "receiver", "sender", "receiver_shard",
+	"sender_shard", "gas_price", "gas_limit", "gas_used", "data", "signature", "timestamp", "status", "sc_results", "log"}
+var roundColumns = []string{"shard_id", "round", "signers_indexes", "block_was_proposed", "timestamp"}
+var validatorsColumns = []string{"shard_id", "epoch", "public_keys"}
+var ratingColumns = []string{"id", "validators_rating"}
+var tpsColumns = []string{"id", "live_tps", "peak_tps", "block_number", "round_number", "round_time", "average_block_tx_count",
+	"total_processed_tx_count", "average_tps", "current_block_nonce", "nr_of_shards", "nr_of_nodes", "last_block_tx_count", "shard_id"}
+
+// sqlDatabaseArgs is struct that is used to store all parameters that are needed to create a SQL database
+type sqlDatabaseArgs struct {
+	driverName               string
+	dataSourceName           string
+	maxOpenConnections       int
+	marshalizer              marshal.Marshalizer
+	hasher                   hashing.Hasher
+	addressPubkeyConverter   core.PubkeyConverter
+	validatorPubkeyConverter core.PubkeyConverter
+}
+
+// sqlDatabase saves the node's data in a SQL database. The statements use the SQLite dialect
+type sqlDatabase struct {
+	*txDatabaseProcessor
+	db          *sql.DB
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+}
+
+// newSQLDatabase opens the SQL database and creates the missing tables
+func newSQLDatabase(arguments sqlDatabaseArgs) (*sqlDatabase, error) {
+	if len(arguments.driverName) == 0 {
+		return nil, ErrEmptySQLDriverName
+	}
+	if len(arguments.dataSourceName) == 0 {
+		return nil, ErrEmptyDataSourceName
+	}
+
+	db, err := sql.Open(arguments.driverName, arguments.dataSourceName)
+	if err != nil {
+		return nil, err
+	}
+	if arguments.maxOpenConnections > 0 {
+		db.SetMaxOpenConns(arguments.maxOpenConnections)
+	}
+
+	sdb := &sqlDatabase{
+		db:          db,
+		marshalizer: arguments.marshalizer,
+		hasher:      arguments.hasher,
+	}
+	sdb.txDatabaseProcessor = newTxDatabaseProcessor(
+		arguments.hasher,
+		arguments.marshalizer,
+		arguments.addressPubkeyConverter,
+		arguments.validatorPubkeyConverter,
+	)
+
+	err = sdb.createTables()
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	return sdb, nil
+}
+
+func (sdb *sqlDatabase) createTables() error {
+	for _, statement := range sqlSchema {
+		_, err := sdb.db.Exec(statement)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetTxLogsProcessor will set tx logs processor
+func (sdb *sqlDatabase) SetTxLogsProcessor(txLogsProc process.TransactionLogProcessorDatabase) {
+	sdb.txLogsProcessor = txLogsProc
+}
+
+// SaveHeader will prepare and save information about a header
+func (sdb *sqlDatabase) SaveHeader(
+	header data.HeaderHandler,
+	signersIndexes []uint64,
+	body *block.Body,
+	notarizedHeadersHashes []string,
+	txsSize int,
+) {
+	dbBlock, _ := prepareBlock(sdb.marshalizer, sdb.hasher, header, signersIndexes, body, notarizedHeadersHashes, txsSize)
+	if dbBlock == nil {
+		return
+	}
+
+	query := buildUpsertQuery("blocks", blockColumns, 1, blockColumns[1:])
+	_, err := sdb.db.Exec(query,
+		dbBlock.Hash,
+		dbBlock.Nonce,
+		dbBlock.Round,
+		dbBlock.Epoch,
+		dbBlock.ShardID,
+		toJSONText(dbBlock.MiniBlocksHashes),
+		toJSONText(dbBlock.NotarizedBlocksHashes),
+		dbBlock.Proposer,
+		toJSONText(dbBlock.Validators),
+		dbBlock.PubKeyBitmap,
+		dbBlock.Size,
+		dbBlock.SizeTxs,
+		int64(dbBlock.Timestamp),
+		dbBlock.StateRootHash,
+		dbBlock.PrevHash,
+		dbBlock.TxCount,
+	)
+	if err != nil {
+		log.Warn("indexer: could not index block header", "error", err.Error())
+	}
+}
+
+// SaveMiniblocks will prepare and save information about miniblocks. The block hashes already saved by the
+// other shard involved in a cross shard miniblock are kept
+func (sdb *sqlDatabase) SaveMiniblocks(header data.HeaderHandler, body *block.Body) {
+	miniblocks := prepareMiniblocks(sdb.marshalizer, sdb.hasher, header, body)
+	if miniblocks == nil {
+		log.Warn("indexer: could not index miniblocks")
+		return
+	}
+
+	err := sdb.executeInTransaction(func(dbTx *sql.Tx) error {
+		for _, mb := range miniblocks {
+			updateColumns := []string{"receiver_block_hash"}
+			if header.GetShardID() == mb.SenderShardID {
+				updateColumns = []string{"sender_shard", "receiver_shard", "sender_block_hash", "type"}
+				if len(mb.ReceiverBlockHash) > 0 {
+					updateColumns = append(updateColumns, "receiver_block_hash")
+				}
+			}
+
+			query := buildUpsertQuery("miniblocks", miniblockColumns, 1, updateColumns)
+			_, err := dbTx.Exec(query,
+				mb.Hash,
+				mb.SenderShardID,
+				mb.ReceiverShardID,
+				mb.SenderBlockHash,
+				mb.ReceiverBlockHash,
+				mb.Type,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Warn("indexer: could not index miniblocks", "error", err.Error())
+	}
+}
+
+// SaveTransactions will prepare and save information about transactions. For the cross shard transactions
+// received by the current shard only the fields resulting from their execution are updated
+func (sdb *sqlDatabase) SaveTransactions(
+	body *block.Body,
+	header data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	selfShardID uint32,
+) {
+	txs := sdb.prepareTransactionsForDatabase(body, header, txPool, selfShardID)
+	if len(txs) == 0 {
+		return
+	}
+
+	err := sdb.executeInTransaction(func(dbTx *sql.Tx) error {
+		for _, tx := range txs {
+			updateColumns := transactionColumns[1:]
+			if isCrossShardDstMe(tx, selfShardID) && tx.Status != txStatusInvalid {
+				updateColumns = []string{"log", "sc_results", "status", "timestamp"}
+				if tx.GasUsed != tx.GasLimit {
+					updateColumns = append(updateColumns, "gas_used")
+				}
+			}
+
+			query := buildUpsertQuery("transactions", transactionColumns, 1, updateColumns)
+			_, err := dbTx.Exec(query,
+				tx.Hash,
+				tx.MBHash,
+				tx.Nonce,
+				tx.Round,
+				tx.Value,
+				tx.Receiver,
+				tx.Sender,
+				tx.ReceiverShard,
+				tx.SenderShard,
+				tx.GasPrice,
+				tx.GasLimit,
+				tx.GasUsed,
+				tx.Data,
+				tx.Signature,
+				int64(tx.Timestamp),
+				tx.Status,
+				toJSONText(tx.SmartContractResults),
+				toJSONText(tx.Log),
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Warn("indexer: could not index transactions", "error", err.Error())
+	}
+}
+
+// SaveRoundInfo will save information about a round
+func (sdb *sqlDatabase) SaveRoundInfo(info RoundInfo) {
+	query := buildUpsertQuery("rounds", roundColumns, 2, roundColumns[2:])
+	_, err := sdb.db.Exec(query,
+		info.ShardId,
+		info.Index,
+		toJSONText(info.SignersIndexes),
+		info.BlockWasProposed,
+		int64(info.Timestamp),
+	)
+	if err != nil {
+		log.Warn("indexer: can not index round info", "error", err.Error())
+	}
+}
+
+// SaveShardValidatorsPubKeys will save the public keys of a shard's validators
+func (sdb *sqlDatabase) SaveShardValidatorsPubKeys(shardID, epoch uint32, shardValidatorsPubKeys [][]byte) {
+	shardValPubKeys := prepareValidatorsPublicKeys(sdb.validatorPubkeyConverter, shardValidatorsPubKeys)
+
+	query := buildUpsertQuery("validators", validatorsColumns, 2, validatorsColumns[2:])
+	_, err := sdb.db.Exec(query, shardID, epoch, toJSONText(shardValPubKeys.PublicKeys))
+	if err != nil {
+		log.Warn("indexer: can not index validators pubkey", "error", err.Error())
+	}
+}
+
+// SaveValidatorsRating will save validators rating
+func (sdb *sqlDatabase) SaveValidatorsRating(index string, validatorsRatingInfo []ValidatorRatingInfo) {
+	query := buildUpsertQuery("rating", ratingColumns, 1, ratingColumns[1:])
+	_, err := sdb.db.Exec(query, index, toJSONText(validatorsRatingInfo))
+	if err != nil {
+		log.Warn("indexer: can not index validators rating", "error", err.Error())
+	}
+}
+
+// SaveShardStatistics will save the general and the per shard statistics
+func (sdb *sqlDatabase) SaveShardStatistics(tpsBenchmark statistics.TPSBenchmark) {
+	err := sdb.executeInTransaction(func(dbTx *sql.Tx) error {
+		err := saveTPS(dbTx, metachainTpsDocID, prepareGeneralTPS(tpsBenchmark))
+		if err != nil {
+			return err
+		}
+
+		for _, shardInfo := range tpsBenchmark.ShardStatistics() {
+			id := fmt.Sprintf("%s%d", shardTpsDocIDPrefix, shardInfo.ShardID())
+			err = saveTPS(dbTx, id, prepareShardTPS(shardInfo))
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Warn("indexer: error indexing tps information", "error", err.Error())
+	}
+}
+
+func saveTPS(dbTx *sql.Tx, id string, tps TPS) error {
+	query := buildUpsertQuery("tps", tpsColumns, 1, tpsColumns[1:])
+	_, err := dbTx.Exec(query,
+		id,
+		tps.LiveTPS,
+		tps.PeakTPS,
+		tps.BlockNumber,
+		tps.RoundNumber,
+		tps.RoundTime,
+		bigIntToText(tps.AverageBlockTxCount),
+		bigIntToText(tps.TotalProcessedTxCount),
+		bigIntToText(tps.AverageTPS),
+		tps.CurrentBlockNonce,
+		tps.NrOfShards,
+		tps.NrOfNodes,
+		tps.LastBlockTxCount,
+		tps.ShardID,
+	)
+
+	return err
+}
+
+func (sdb *sqlDatabase) executeInTransaction(handler func(dbTx *sql.Tx) error) error {
+	dbTx, err := sdb.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	err = handler(dbTx)
+	if err != nil {
+		_ = dbTx.Rollback()
+		return err
+	}
+
+	return dbTx.Commit()
+}
+
+// buildUpsertQuery creates an insert statement which, if a row with the same key already exists, updates only
+// the given columns. The first numKeyColumns columns form the table's primary key
+func buildUpsertQuery(table string, columns []string, numKeyColumns int, updateColumns []string) string {
+	placeholders := make([]string, len(columns))
+	for i := range placeholders {
+		placeholders[i] = "?"
+	}
+
+	assignments := make([]string, 0, len(updateColumns))
+	for _, column := range updateColumns {
+		assignments = append(assignments, fmt.Sprintf("%s = excluded.%s", column, column))
+	}
+
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s) ON CONFLICT (%s) DO UPDATE SET %s",
+		table,
+		strings.Join(columns, ", "),
+		strings.Join(placeholders, ", "),
+		strings.Join(columns[:numKeyColumns], ", "),
+		strings.Join(assignments, ", "),
+	)
+}
+
+func toJSONText(value interface{}) string {
+	buff, err := json.Marshal(value)
+	if err != nil {
+		log.Debug("indexer: marshal", "error", err.Error())
+		return ""
+	}
+
+	return string(buff)
+}
+
+func bigIntToText(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
+
+// Close closes the SQL database
+func (sdb *sqlDatabase) Close() error {
+	return sdb.db.Close()
+}
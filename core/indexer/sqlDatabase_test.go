@@ -0,0 +1,135 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockSQLDatabaseArgs() sqlDatabaseArgs {
+	return sqlDatabaseArgs{
+		driverName:               "sqlite3",
+		dataSourceName:           ":memory:",
+		maxOpenConnections:       1,
+		addressPubkeyConverter:   mock.NewPubkeyConverterMock(32),
+		validatorPubkeyConverter: mock.NewPubkeyConverterMock(32),
+		hasher:                   &mock.HasherMock{},
+		marshalizer:              &mock.MarshalizerMock{},
+	}
+}
+
+func countRows(t *testing.T, sdb *sqlDatabase, table string) int {
+	count := 0
+	err := sdb.db.QueryRow("SELECT COUNT(*) FROM " + table).Scan(&count)
+	require.Nil(t, err)
+
+	return count
+}
+
+func TestNewSQLDatabase_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockSQLDatabaseArgs()
+	args.driverName = ""
+	sdb, err := newSQLDatabase(args)
+	assert.Nil(t, sdb)
+	assert.Equal(t, ErrEmptySQLDriverName, err)
+
+	args = createMockSQLDatabaseArgs()
+	args.dataSourceName = ""
+	sdb, err = newSQLDatabase(args)
+	assert.Nil(t, sdb)
+	assert.Equal(t, ErrEmptyDataSourceName, err)
+
+	args = createMockSQLDatabaseArgs()
+	args.driverName = "unknown driver"
+	sdb, err = newSQLDatabase(args)
+	assert.Nil(t, sdb)
+	assert.NotNil(t, err)
+}
+
+func TestSQLDatabase_SaveDataShouldWork(t *testing.T) {
+	t.Parallel()
+
+	sdb, err := newSQLDatabase(createMockSQLDatabaseArgs())
+	require.Nil(t, err)
+
+	header := &dataBlock.Header{Nonce: 1, ShardID: 2}
+	body := newTestBlockBody()
+	sdb.SaveHeader(header, []uint64{0}, body, nil, 10)
+	sdb.SaveMiniblocks(header, body)
+	sdb.SaveTransactions(body, header, newTestTxPool(), 2)
+	sdb.SaveRoundInfo(RoundInfo{Index: 7, ShardId: 2, SignersIndexes: []uint64{0, 1}})
+	sdb.SaveRoundInfo(RoundInfo{Index: 7, ShardId: 2, SignersIndexes: []uint64{0, 1, 2}})
+	sdb.SaveShardValidatorsPubKeys(2, 1, [][]byte{[]byte("key")})
+	sdb.SaveValidatorsRating("2_1", []ValidatorRatingInfo{{PublicKey: "key", Rating: 50}})
+
+	tpsBenchmark := &mock.TpsBenchmarkMock{}
+	tpsBenchmark.UpdateWithShardStats(&dataBlock.MetaBlock{
+		TxCount: 2, Nonce: 1,
+		ShardInfo: []dataBlock.ShardData{{HeaderHash: []byte("hash")}},
+	})
+	sdb.SaveShardStatistics(tpsBenchmark)
+
+	assert.Equal(t, 1, countRows(t, sdb, "blocks"))
+	assert.Equal(t, 2, countRows(t, sdb, "miniblocks"))
+	assert.Equal(t, 3, countRows(t, sdb, "transactions"))
+	assert.Equal(t, 1, countRows(t, sdb, "rounds"))
+	assert.Equal(t, 1, countRows(t, sdb, "validators"))
+	assert.Equal(t, 1, countRows(t, sdb, "rating"))
+	assert.Equal(t, 2, countRows(t, sdb, "tps"))
+
+	signersIndexes := ""
+	_ = sdb.db.QueryRow("SELECT signers_indexes FROM rounds WHERE shard_id = 2 AND round = 7").Scan(&signersIndexes)
+	assert.Equal(t, "[0,1,2]", signersIndexes)
+}
+
+func TestSQLDatabase_SaveMiniblocksShouldKeepTheOtherShardBlockHash(t *testing.T) {
+	t.Parallel()
+
+	sdb, _ := newSQLDatabase(createMockSQLDatabaseArgs())
+	body := &dataBlock.Body{
+		MiniBlocks: []*dataBlock.MiniBlock{
+			{TxHashes: [][]byte{[]byte("tx1")}, SenderShardID: 0, ReceiverShardID: 1},
+		},
+	}
+	senderHeader := &dataBlock.Header{Nonce: 1, ShardID: 0}
+	receiverHeader := &dataBlock.Header{Nonce: 2, ShardID: 1}
+
+	// the receiver shard might index the miniblock before the sender shard
+	sdb.SaveMiniblocks(receiverHeader, body)
+	sdb.SaveMiniblocks(senderHeader, body)
+
+	var senderBlockHash, receiverBlockHash string
+	err := sdb.db.QueryRow("SELECT sender_block_hash, receiver_block_hash FROM miniblocks").Scan(&senderBlockHash, &receiverBlockHash)
+	require.Nil(t, err)
+
+	expectedSenderBlock, _ := prepareBlock(sdb.marshalizer, sdb.hasher, senderHeader, []uint64{0}, body, nil, 0)
+	expectedReceiverBlock, _ := prepareBlock(sdb.marshalizer, sdb.hasher, receiverHeader, []uint64{0}, body, nil, 0)
+	assert.Equal(t, expectedSenderBlock.Hash, senderBlockHash)
+	assert.Equal(t, expectedReceiverBlock.Hash, receiverBlockHash)
+}
+
+func TestSQLDatabase_CloseShouldCloseTheDatabase(t *testing.T) {
+	t.Parallel()
+
+	sdb, err := newSQLDatabase(createMockSQLDatabaseArgs())
+	require.Nil(t, err)
+
+	err = sdb.Close()
+	assert.Nil(t, err)
+	assert.NotNil(t, sdb.db.Ping())
+}
+
+func TestBuildUpsertQuery(t *testing.T) {
+	t.Parallel()
+
+	query := buildUpsertQuery("rounds", []string{"shard_id", "round", "timestamp"}, 2, []string{"timestamp"})
+	assert.Equal(t,
+		"INSERT INTO rounds (shard_id, round, timestamp) VALUES (?, ?, ?) ON CONFLICT (shard_id, round) DO UPDATE SET timestamp = excluded.timestamp",
+		query,
+	)
+}
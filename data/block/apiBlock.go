@@ -0,0 +1,55 @@
+package block
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+// ApiBlock is the data transfer object which will be returned on the get block by nonce or by hash endpoints
+type ApiBlock struct {
+	Nonce           uint64               `json:"nonce"`
+	Round           uint64               `json:"round"`
+	Hash            string               `json:"hash"`
+	PrevBlockHash   string               `json:"prevBlockHash"`
+	Epoch           uint32               `json:"epoch"`
+	Shard           uint32               `json:"shard"`
+	NumTxs          uint32               `json:"numTxs"`
+	TimeStamp       uint64               `json:"timestamp"`
+	RootHash        string               `json:"rootHash"`
+	AccumulatedFees string               `json:"accumulatedFees,omitempty"`
+	DeveloperFees   string               `json:"developerFees,omitempty"`
+	MiniBlocks      []*ApiMiniBlock      `json:"miniBlocks,omitempty"`
+	NotarizedBlocks []*ApiNotarizedBlock `json:"notarizedBlocks,omitempty"`
+	EpochStartInfo  *ApiEpochStartInfo   `json:"epochStartInfo,omitempty"`
+}
+
+// ApiMiniBlock is the data transfer object for a miniblock contained in an ApiBlock
+type ApiMiniBlock struct {
+	Hash             string                              `json:"hash"`
+	Type             string                              `json:"type"`
+	SourceShard      uint32                              `json:"sourceShard"`
+	DestinationShard uint32                              `json:"destinationShard"`
+	NumTxs           uint32                              `json:"numTxs"`
+	Transactions     []*transaction.ApiTransactionResult `json:"transactions,omitempty"`
+}
+
+// ApiNotarizedBlock is the data transfer object for a shard block notarized or finalized by a metachain block
+type ApiNotarizedBlock struct {
+	Hash     string `json:"hash"`
+	Nonce    uint64 `json:"nonce"`
+	Round    uint64 `json:"round"`
+	Shard    uint32 `json:"shard"`
+	RootHash string `json:"rootHash,omitempty"`
+}
+
+// ApiEpochStartInfo is the data transfer object for the epoch start data of a metachain block
+type ApiEpochStartInfo struct {
+	TotalSupply          string               `json:"totalSupply"`
+	TotalToDistribute    string               `json:"totalToDistribute"`
+	TotalNewlyMinted     string               `json:"totalNewlyMinted"`
+	RewardsPerBlock      string               `json:"rewardsPerBlock"`
+	RewardsForCommunity  string               `json:"rewardsForCommunity"`
+	NodePrice            string               `json:"nodePrice"`
+	PrevEpochStartRound  uint64               `json:"prevEpochStartRound"`
+	PrevEpochStartHash   string               `json:"prevEpochStartHash"`
+	LastFinalizedHeaders []*ApiNotarizedBlock `json:"lastFinalizedHeaders"`
+}
@@ -535,6 +535,63 @@ func (m *EpochStart) GetEconomics() Economics {
 	return Economics{}
 }
 
+// Slashing holds an equivocation proof included by the metachain along with the key it slashes and the round
+// in which the key signed two different blocks
+type Slashing struct {
+	PublicKey []byte `protobuf:"bytes,1,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`
+	Round     uint64 `protobuf:"varint,2,opt,name=Round,proto3" json:"Round,omitempty"`
+	Proof     []byte `protobuf:"bytes,3,opt,name=Proof,proto3" json:"Proof,omitempty"`
+}
+
+func (m *Slashing) Reset()      { *m = Slashing{} }
+func (*Slashing) ProtoMessage() {}
+func (*Slashing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87b91ab531130b2b, []int{5}
+}
+func (m *Slashing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Slashing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Slashing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Slashing.Merge(m, src)
+}
+func (m *Slashing) XXX_Size() int {
+	return m.Size()
+}
+func (m *Slashing) XXX_DiscardUnknown() {
+	xxx_messageInfo_Slashing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Slashing proto.InternalMessageInfo
+
+func (m *Slashing) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *Slashing) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *Slashing) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
 // MetaBlock holds the data that will be saved to the metachain each round
 type MetaBlock struct {
 	Nonce                  uint64            `protobuf:"varint,1,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
@@ -561,12 +618,13 @@ type MetaBlock struct {
 	DeveloperFees          *math_big.Int     `protobuf:"bytes,23,opt,name=DeveloperFees,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"DeveloperFees,omitempty"`
 	DevFeesInEpoch         *math_big.Int     `protobuf:"bytes,24,opt,name=DevFeesInEpoch,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"DevFeesInEpoch,omitempty"`
 	TxCount                uint32            `protobuf:"varint,25,opt,name=TxCount,proto3" json:"TxCount,omitempty"`
+	Slashings              []Slashing        `protobuf:"bytes,26,rep,name=Slashings,proto3" json:"Slashings"`
 }
 
 func (m *MetaBlock) Reset()      { *m = MetaBlock{} }
 func (*MetaBlock) ProtoMessage() {}
 func (*MetaBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_87b91ab531130b2b, []int{5}
+	return fileDescriptor_87b91ab531130b2b, []int{6}
 }
 func (m *MetaBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -759,6 +817,13 @@ func (m *MetaBlock) GetTxCount() uint32 {
 	return 0
 }
 
+func (m *MetaBlock) GetSlashings() []Slashing {
+	if m != nil {
+		return m.Slashings
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("proto.PeerAction", PeerAction_name, PeerAction_value)
 	proto.RegisterType((*PeerData)(nil), "proto.PeerData")
@@ -766,91 +831,94 @@ func init() {
 	proto.RegisterType((*EpochStartShardData)(nil), "proto.EpochStartShardData")
 	proto.RegisterType((*Economics)(nil), "proto.Economics")
 	proto.RegisterType((*EpochStart)(nil), "proto.EpochStart")
+	proto.RegisterType((*Slashing)(nil), "proto.Slashing")
 	proto.RegisterType((*MetaBlock)(nil), "proto.MetaBlock")
 }
 
 func init() { proto.RegisterFile("metaBlock.proto", fileDescriptor_87b91ab531130b2b) }
 
 var fileDescriptor_87b91ab531130b2b = []byte{
-	// 1237 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6e, 0xdb, 0x46,
-	0x13, 0x17, 0x6d, 0xcb, 0xb6, 0x46, 0x96, 0x4d, 0xaf, 0x1d, 0x87, 0x9f, 0xf1, 0x81, 0x31, 0x84,
-	0x1e, 0xdc, 0x02, 0x91, 0x5b, 0x37, 0x68, 0x0f, 0x3d, 0x14, 0x96, 0x65, 0x23, 0x6a, 0x12, 0x43,
-	0xa0, 0x5c, 0x1f, 0x7a, 0x5b, 0x91, 0x1b, 0x6a, 0x61, 0x72, 0x57, 0x25, 0x97, 0x76, 0x5d, 0xa0,
-	0x40, 0x5f, 0xa0, 0x40, 0x8f, 0x7d, 0x80, 0x1e, 0x8a, 0xf6, 0x31, 0x7a, 0xc9, 0x31, 0xc7, 0x9c,
-	0xda, 0x46, 0xb9, 0xf4, 0x98, 0x02, 0x7d, 0x80, 0x82, 0xbb, 0xa4, 0x48, 0x51, 0x74, 0x9b, 0x83,
-	0x72, 0xb2, 0xe7, 0x37, 0x3b, 0x33, 0xda, 0xf9, 0xf3, 0xdb, 0x21, 0x6c, 0xf8, 0x44, 0xe0, 0xb6,
-	0xc7, 0xed, 0xcb, 0xd6, 0x28, 0xe0, 0x82, 0xa3, 0xaa, 0xfc, 0xb3, 0x7b, 0xdf, 0xa5, 0x62, 0x18,
-	0x0d, 0x5a, 0x36, 0xf7, 0x0f, 0x5c, 0xee, 0xf2, 0x03, 0x09, 0x0f, 0xa2, 0xa7, 0x52, 0x92, 0x82,
-	0xfc, 0x4f, 0x59, 0xed, 0xd6, 0x07, 0x99, 0x8b, 0xe6, 0xdf, 0x1a, 0xac, 0xf6, 0x08, 0x09, 0x3a,
-	0x58, 0x60, 0x64, 0xc0, 0xca, 0x91, 0xe3, 0x04, 0x24, 0x0c, 0x0d, 0x6d, 0x4f, 0xdb, 0x5f, 0xb3,
-	0x52, 0x11, 0xfd, 0x1f, 0x6a, 0xbd, 0x68, 0xe0, 0x51, 0xfb, 0x11, 0xb9, 0x31, 0x16, 0xa4, 0x2e,
-	0x03, 0xd0, 0xbb, 0xb0, 0x7c, 0x64, 0x0b, 0xca, 0x99, 0xb1, 0xb8, 0xa7, 0xed, 0xaf, 0x1f, 0x6e,
-	0x2a, 0xe7, 0xad, 0xd8, 0xb1, 0x52, 0x58, 0xc9, 0x81, 0xd8, 0xd1, 0x39, 0xf5, 0x49, 0x5f, 0x60,
-	0x7f, 0x64, 0x2c, 0xed, 0x69, 0xfb, 0x4b, 0x56, 0x06, 0x20, 0x17, 0xea, 0x17, 0xd8, 0x8b, 0xc8,
-	0xf1, 0x10, 0x33, 0x97, 0x18, 0xd5, 0x38, 0x50, 0xfb, 0xe4, 0xe7, 0xdf, 0xef, 0x1d, 0xf9, 0x58,
-	0x0c, 0x0f, 0x06, 0xd4, 0x6d, 0x75, 0x99, 0xf8, 0x24, 0x77, 0xdf, 0x13, 0x2f, 0xe0, 0xcc, 0x39,
-	0x23, 0xe2, 0x9a, 0x07, 0x97, 0x07, 0x44, 0x4a, 0xf7, 0x5d, 0x7e, 0xe0, 0x60, 0x81, 0x5b, 0x6d,
-	0xea, 0x76, 0x99, 0x38, 0xc6, 0xa1, 0x20, 0x81, 0x95, 0xf7, 0xdc, 0xfc, 0xa5, 0x0a, 0xb5, 0xfe,
-	0x10, 0x07, 0x8e, 0xbc, 0xb7, 0x09, 0xf0, 0x90, 0x60, 0x87, 0x04, 0x0f, 0x71, 0x38, 0x4c, 0xae,
-	0x97, 0x43, 0x90, 0x05, 0x77, 0xe4, 0xe1, 0x27, 0x94, 0x51, 0x99, 0x7f, 0xa5, 0x0b, 0x8d, 0xc5,
-	0xbd, 0xc5, 0xfd, 0xfa, 0xe1, 0x4e, 0x72, 0xdd, 0x82, 0xba, 0xbd, 0xf4, 0xec, 0xb7, 0x7b, 0x15,
-	0xab, 0xdc, 0x14, 0x35, 0x61, 0xad, 0x17, 0x90, 0x2b, 0x0b, 0x33, 0xa7, 0x4f, 0x88, 0x23, 0x73,
-	0xb1, 0x66, 0x4d, 0x61, 0xe8, 0x1d, 0x68, 0xf4, 0xa2, 0xc1, 0x23, 0x72, 0x13, 0xb6, 0xa9, 0xf0,
-	0xf1, 0x48, 0x25, 0xc4, 0x9a, 0x06, 0xe3, 0x94, 0xf6, 0xa9, 0xcb, 0xb0, 0x88, 0x02, 0x62, 0x2c,
-	0xab, 0xda, 0x4c, 0x00, 0xb4, 0x0d, 0x55, 0x8b, 0x47, 0xcc, 0x31, 0x56, 0x65, 0xb2, 0x95, 0x80,
-	0x76, 0x61, 0x35, 0x8e, 0x24, 0xef, 0x5b, 0x93, 0x26, 0x13, 0x39, 0xb6, 0x38, 0xe3, 0xcc, 0x26,
-	0x06, 0x28, 0x0b, 0x29, 0x20, 0x0e, 0x1b, 0x47, 0xb6, 0x1d, 0xf9, 0x91, 0x87, 0x05, 0x71, 0x4e,
-	0x09, 0x09, 0x8d, 0xb5, 0x79, 0x96, 0xa7, 0xe8, 0x1d, 0x5d, 0x42, 0xa3, 0x43, 0xae, 0x88, 0xc7,
-	0x47, 0x24, 0x90, 0xe1, 0xd6, 0xe7, 0x19, 0x6e, 0xda, 0x37, 0x3a, 0x84, 0xed, 0xb3, 0xc8, 0xef,
-	0x11, 0xe6, 0x50, 0xe6, 0x4e, 0x6a, 0x15, 0x1a, 0xf5, 0x3d, 0x6d, 0xbf, 0x61, 0x95, 0xea, 0xd0,
-	0x03, 0xb8, 0xf3, 0x18, 0x87, 0xa2, 0xcb, 0x6c, 0x2f, 0x72, 0x88, 0xf3, 0x84, 0x08, 0xac, 0xf2,
-	0xd6, 0x90, 0x79, 0x2b, 0x57, 0xc6, 0x33, 0x26, 0x1b, 0xa2, 0xdb, 0x91, 0x33, 0xd6, 0xb0, 0x52,
-	0x31, 0xd6, 0x9c, 0x7f, 0x75, 0xcc, 0x23, 0x26, 0x8c, 0x15, 0xa5, 0x49, 0xc4, 0xe6, 0x5f, 0x0b,
-	0xb0, 0x75, 0x32, 0xe2, 0xf6, 0xb0, 0x2f, 0x70, 0x20, 0xb2, 0xbe, 0xbd, 0xdd, 0xd7, 0x36, 0x54,
-	0xa5, 0x81, 0x2c, 0x6e, 0xc3, 0x52, 0x42, 0xd6, 0x0b, 0x2b, 0xf9, 0x5e, 0x98, 0xd4, 0x7b, 0x35,
-	0x5f, 0xef, 0xff, 0x9a, 0x89, 0x5d, 0x58, 0xb5, 0x38, 0x17, 0x52, 0xbb, 0xa8, 0x3a, 0x28, 0x95,
-	0xe3, 0xcc, 0x9c, 0xd2, 0x20, 0x14, 0x69, 0xce, 0x52, 0xda, 0x4a, 0x9a, 0xbc, 0x5c, 0x99, 0xe6,
-	0xf3, 0x94, 0x32, 0x1a, 0x0e, 0x55, 0xca, 0x94, 0x95, 0xea, 0xfa, 0x72, 0x25, 0xba, 0x80, 0xbb,
-	0xc5, 0xd2, 0xa4, 0xd3, 0xb9, 0xfc, 0x06, 0xd3, 0x79, 0x9b, 0x71, 0xf3, 0xbb, 0x65, 0xa8, 0x9d,
-	0xd8, 0x9c, 0x71, 0x9f, 0xda, 0x61, 0x4c, 0x4c, 0xe7, 0x5c, 0x60, 0xaf, 0x1f, 0x8d, 0x46, 0xde,
-	0x8d, 0x62, 0xc7, 0xb9, 0x11, 0x53, 0xce, 0x33, 0x0a, 0x61, 0x53, 0x8a, 0xe7, 0xbc, 0x43, 0x43,
-	0x11, 0xd0, 0x41, 0x24, 0x88, 0xca, 0xfe, 0xbc, 0xc2, 0xcd, 0xfa, 0x47, 0x5f, 0x82, 0x2e, 0xc1,
-	0x33, 0x72, 0xed, 0xdd, 0x3c, 0xa1, 0x4c, 0x10, 0x47, 0xd5, 0x74, 0x5e, 0x31, 0x67, 0xdc, 0xc7,
-	0x74, 0x62, 0x91, 0x6b, 0x1c, 0x38, 0x61, 0x8f, 0x04, 0xb9, 0xe6, 0x98, 0x1b, 0x9d, 0x14, 0xbc,
-	0xa3, 0x6b, 0xd8, 0x4a, 0xa0, 0x53, 0x1e, 0x1c, 0x73, 0xdf, 0x8f, 0x18, 0x15, 0x37, 0xf3, 0x7d,
-	0x62, 0xca, 0x22, 0x20, 0x1b, 0x6a, 0x67, 0xdc, 0x21, 0xbd, 0x80, 0xda, 0x09, 0x3d, 0xcf, 0x2b,
-	0x5c, 0xe6, 0x17, 0xbd, 0x0f, 0x5b, 0x31, 0x7f, 0x67, 0x24, 0x91, 0x9f, 0xf3, 0x32, 0x15, 0x6a,
-	0x01, 0x9a, 0x86, 0xe5, 0x24, 0xaf, 0xca, 0x51, 0x2b, 0xd1, 0x34, 0x7f, 0xd0, 0x00, 0x32, 0x08,
-	0x9d, 0xc3, 0x76, 0x32, 0x8f, 0xd8, 0xa3, 0x5f, 0x13, 0x27, 0x9d, 0x39, 0x4d, 0xce, 0xdc, 0x6e,
-	0x32, 0x73, 0x25, 0xa4, 0x95, 0xcc, 0x5d, 0xa9, 0x35, 0x7a, 0x90, 0x9b, 0x39, 0xd9, 0xf5, 0xf5,
-	0x43, 0x3d, 0x75, 0x95, 0xe2, 0x89, 0x83, 0xec, 0x60, 0xf3, 0xd7, 0x1a, 0xd4, 0x32, 0x42, 0x98,
-	0xd0, 0x99, 0x96, 0xa7, 0xb3, 0x09, 0x21, 0x2e, 0x94, 0x12, 0xe2, 0x62, 0x9e, 0x10, 0xff, 0x7d,
-	0x47, 0x79, 0x90, 0x6c, 0x0e, 0x5d, 0xf6, 0x94, 0x1b, 0x55, 0x79, 0xdd, 0xf4, 0x37, 0x16, 0x2f,
-	0x99, 0x1d, 0x44, 0x1f, 0xa8, 0x35, 0x4b, 0x1a, 0x29, 0x5e, 0xda, 0xc8, 0x2d, 0x49, 0x39, 0x9b,
-	0xc9, 0xb1, 0xe9, 0x77, 0x7d, 0xa5, 0xf8, 0xae, 0xef, 0xc3, 0xc6, 0x63, 0x99, 0xb5, 0xec, 0x8c,
-	0x2a, 0x5e, 0x11, 0x9e, 0xdd, 0x22, 0x6a, 0x65, 0x5b, 0x44, 0x7e, 0x23, 0x80, 0xc2, 0x46, 0x50,
-	0xdc, 0x55, 0xea, 0x25, 0xbb, 0x4a, 0xfc, 0x1e, 0xa4, 0xfa, 0xb5, 0xe4, 0x3d, 0xc8, 0xeb, 0xd2,
-	0xb7, 0xa2, 0x51, 0x78, 0x2b, 0x3e, 0x82, 0x9d, 0x0b, 0xec, 0x51, 0x07, 0x0b, 0x1e, 0xf4, 0x05,
-	0x16, 0xe1, 0xe4, 0xa4, 0x7c, 0xef, 0xad, 0x5b, 0xb4, 0xe8, 0x21, 0xe8, 0x33, 0x84, 0xaf, 0xbf,
-	0x01, 0xe1, 0xeb, 0x65, 0x9b, 0x98, 0x45, 0x6c, 0x42, 0x47, 0x22, 0x94, 0x71, 0x37, 0xd5, 0xed,
-	0xf2, 0x18, 0xfa, 0x38, 0xdf, 0xfc, 0x06, 0x92, 0x9d, 0xb9, 0x39, 0xd3, 0xe4, 0x49, 0x88, 0xfc,
-	0x9c, 0x18, 0xb0, 0x72, 0x3c, 0xc4, 0x94, 0x75, 0x3b, 0xc6, 0x96, 0x5a, 0xa9, 0x13, 0x31, 0x2e,
-	0x60, 0x9f, 0x3f, 0x15, 0xd7, 0x38, 0x20, 0x17, 0x24, 0x08, 0xe3, 0xed, 0x79, 0x5b, 0x15, 0xb0,
-	0x00, 0x97, 0xad, 0x5e, 0x77, 0xde, 0xea, 0xea, 0xf5, 0x0d, 0xec, 0x14, 0xa0, 0x2e, 0x53, 0xd3,
-	0xb3, 0x33, 0xcf, 0xb8, 0xb7, 0x04, 0x99, 0xdd, 0xfc, 0xee, 0xbe, 0xc5, 0xcd, 0xcf, 0x87, 0xf5,
-	0x0e, 0xb9, 0xca, 0xdf, 0xd1, 0x98, 0x67, 0xb4, 0x82, 0xf3, 0xfc, 0x92, 0xf7, 0xbf, 0xa9, 0x25,
-	0xef, 0xbd, 0x1f, 0x35, 0x80, 0xec, 0x83, 0x09, 0x6d, 0x42, 0xa3, 0xcb, 0xae, 0xe2, 0xde, 0x57,
-	0x80, 0x5e, 0x41, 0xdb, 0xa0, 0xc7, 0x07, 0x2c, 0xe2, 0xc6, 0x4f, 0x37, 0x96, 0xa8, 0x16, 0x1f,
-	0x8c, 0xd1, 0xcf, 0x59, 0x28, 0xf0, 0x25, 0x65, 0xae, 0xbe, 0x80, 0x76, 0x00, 0x49, 0x56, 0x21,
-	0x41, 0xfe, 0xe8, 0x22, 0x5a, 0x57, 0x11, 0x3e, 0xc3, 0xd4, 0x23, 0x8e, 0xbe, 0x84, 0x74, 0x58,
-	0x53, 0xa6, 0x09, 0x52, 0x45, 0x1b, 0x50, 0x8f, 0x91, 0xbe, 0x87, 0xe3, 0x2d, 0x4b, 0x5f, 0x4e,
-	0x01, 0x2b, 0x26, 0xbf, 0x4b, 0xa2, 0xaf, 0xb4, 0x3f, 0x7d, 0xfe, 0xd2, 0xac, 0xbc, 0x78, 0x69,
-	0x56, 0x5e, 0xbf, 0x34, 0xb5, 0x6f, 0xc7, 0xa6, 0xf6, 0xd3, 0xd8, 0xd4, 0x9e, 0x8d, 0x4d, 0xed,
-	0xf9, 0xd8, 0xd4, 0x5e, 0x8c, 0x4d, 0xed, 0x8f, 0xb1, 0xa9, 0xfd, 0x39, 0x36, 0x2b, 0xaf, 0xc7,
-	0xa6, 0xf6, 0xfd, 0x2b, 0xb3, 0xf2, 0xfc, 0x95, 0x59, 0x79, 0xf1, 0xca, 0xac, 0x7c, 0x51, 0x95,
-	0xdf, 0x9d, 0x83, 0x65, 0x39, 0x35, 0x1f, 0xfe, 0x13, 0x00, 0x00, 0xff, 0xff, 0xb1, 0xd6, 0xb1,
-	0x27, 0xce, 0x0e, 0x00, 0x00,
+	// 1273 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x9b, 0xa6, 0x4d, 0x5e, 0x9a, 0xd6, 0x9d, 0x76, 0xbb, 0xa6, 0x42, 0xde, 0x2a, 0xe2,
+	0x50, 0x90, 0x36, 0x85, 0xee, 0x0a, 0x0e, 0x1c, 0x50, 0xd3, 0xb4, 0xda, 0xb0, 0xbb, 0x55, 0xe4,
+	0x84, 0x1e, 0xb8, 0x4d, 0xec, 0xa9, 0x33, 0xaa, 0x3d, 0x13, 0xec, 0x71, 0x4b, 0x91, 0x90, 0xf8,
+	0x03, 0x48, 0x1c, 0xf9, 0x01, 0x1c, 0x10, 0xfc, 0x91, 0x3d, 0xee, 0x71, 0x4f, 0xc0, 0x66, 0x2f,
+	0x1c, 0x17, 0x89, 0x03, 0x47, 0xe4, 0xb1, 0x1d, 0x3b, 0x8e, 0x0b, 0x7b, 0xc8, 0x9e, 0xda, 0xf7,
+	0xbd, 0x99, 0xf7, 0x32, 0x6f, 0xde, 0xfb, 0xe6, 0x33, 0x6c, 0xb8, 0x44, 0xe0, 0xb6, 0xc3, 0xcd,
+	0xcb, 0xd6, 0xd8, 0xe3, 0x82, 0xa3, 0x8a, 0xfc, 0xb3, 0x7b, 0xdf, 0xa6, 0x62, 0x14, 0x0c, 0x5b,
+	0x26, 0x77, 0x0f, 0x6c, 0x6e, 0xf3, 0x03, 0x09, 0x0f, 0x83, 0x0b, 0x69, 0x49, 0x43, 0xfe, 0x17,
+	0xed, 0xda, 0xad, 0x0f, 0xd3, 0x10, 0xcd, 0xbf, 0x15, 0xa8, 0xf6, 0x08, 0xf1, 0x3a, 0x58, 0x60,
+	0xa4, 0xc1, 0xea, 0x91, 0x65, 0x79, 0xc4, 0xf7, 0x35, 0x65, 0x4f, 0xd9, 0x5f, 0x33, 0x12, 0x13,
+	0xbd, 0x0b, 0xb5, 0x5e, 0x30, 0x74, 0xa8, 0xf9, 0x98, 0xdc, 0x68, 0x4b, 0xd2, 0x97, 0x02, 0xe8,
+	0x7d, 0x58, 0x39, 0x32, 0x05, 0xe5, 0x4c, 0x2b, 0xef, 0x29, 0xfb, 0xeb, 0x87, 0x9b, 0x51, 0xf0,
+	0x56, 0x18, 0x38, 0x72, 0x18, 0xf1, 0x82, 0x30, 0xd0, 0x80, 0xba, 0xa4, 0x2f, 0xb0, 0x3b, 0xd6,
+	0x96, 0xf7, 0x94, 0xfd, 0x65, 0x23, 0x05, 0x90, 0x0d, 0xf5, 0x73, 0xec, 0x04, 0xe4, 0x78, 0x84,
+	0x99, 0x4d, 0xb4, 0x4a, 0x98, 0xa8, 0x7d, 0xf2, 0xcb, 0xef, 0xf7, 0x8e, 0x5c, 0x2c, 0x46, 0x07,
+	0x43, 0x6a, 0xb7, 0xba, 0x4c, 0x7c, 0x9a, 0x39, 0xef, 0x89, 0xe3, 0x71, 0x66, 0x9d, 0x11, 0x71,
+	0xcd, 0xbd, 0xcb, 0x03, 0x22, 0xad, 0xfb, 0x36, 0x3f, 0xb0, 0xb0, 0xc0, 0xad, 0x36, 0xb5, 0xbb,
+	0x4c, 0x1c, 0x63, 0x5f, 0x10, 0xcf, 0xc8, 0x46, 0x6e, 0xfe, 0x5a, 0x81, 0x5a, 0x7f, 0x84, 0x3d,
+	0x4b, 0x9e, 0x5b, 0x07, 0x78, 0x44, 0xb0, 0x45, 0xbc, 0x47, 0xd8, 0x1f, 0xc5, 0xc7, 0xcb, 0x20,
+	0xc8, 0x80, 0x3b, 0x72, 0xf1, 0x53, 0xca, 0xa8, 0xac, 0x7f, 0xe4, 0xf3, 0xb5, 0xf2, 0x5e, 0x79,
+	0xbf, 0x7e, 0xb8, 0x13, 0x1f, 0x37, 0xe7, 0x6e, 0x2f, 0x3f, 0xfb, 0xed, 0x5e, 0xc9, 0x28, 0xde,
+	0x8a, 0x9a, 0xb0, 0xd6, 0xf3, 0xc8, 0x95, 0x81, 0x99, 0xd5, 0x27, 0xc4, 0x92, 0xb5, 0x58, 0x33,
+	0x66, 0x30, 0xf4, 0x1e, 0x34, 0x7a, 0xc1, 0xf0, 0x31, 0xb9, 0xf1, 0xdb, 0x54, 0xb8, 0x78, 0x1c,
+	0x15, 0xc4, 0x98, 0x05, 0xc3, 0x92, 0xf6, 0xa9, 0xcd, 0xb0, 0x08, 0x3c, 0xa2, 0xad, 0x44, 0x77,
+	0x33, 0x05, 0xd0, 0x36, 0x54, 0x0c, 0x1e, 0x30, 0x4b, 0xab, 0xca, 0x62, 0x47, 0x06, 0xda, 0x85,
+	0x6a, 0x98, 0x49, 0x9e, 0xb7, 0x26, 0xb7, 0x4c, 0xed, 0x70, 0xc7, 0x19, 0x67, 0x26, 0xd1, 0x20,
+	0xda, 0x21, 0x0d, 0xc4, 0x61, 0xe3, 0xc8, 0x34, 0x03, 0x37, 0x70, 0xb0, 0x20, 0xd6, 0x29, 0x21,
+	0xbe, 0xb6, 0xb6, 0xc8, 0xeb, 0xc9, 0x47, 0x47, 0x97, 0xd0, 0xe8, 0x90, 0x2b, 0xe2, 0xf0, 0x31,
+	0xf1, 0x64, 0xba, 0xf5, 0x45, 0xa6, 0x9b, 0x8d, 0x8d, 0x0e, 0x61, 0xfb, 0x2c, 0x70, 0x7b, 0x84,
+	0x59, 0x94, 0xd9, 0xd3, 0xbb, 0xf2, 0xb5, 0xfa, 0x9e, 0xb2, 0xdf, 0x30, 0x0a, 0x7d, 0xe8, 0x21,
+	0xdc, 0x79, 0x82, 0x7d, 0xd1, 0x65, 0xa6, 0x13, 0x58, 0xc4, 0x7a, 0x4a, 0x04, 0x8e, 0xea, 0xd6,
+	0x90, 0x75, 0x2b, 0x76, 0x86, 0x33, 0x26, 0x1b, 0xa2, 0xdb, 0x91, 0x33, 0xd6, 0x30, 0x12, 0x33,
+	0xf4, 0x0c, 0xbe, 0x3e, 0xe6, 0x01, 0x13, 0xda, 0x6a, 0xe4, 0x89, 0xcd, 0xe6, 0x5f, 0x4b, 0xb0,
+	0x75, 0x32, 0xe6, 0xe6, 0xa8, 0x2f, 0xb0, 0x27, 0xd2, 0xbe, 0xbd, 0x3d, 0xd6, 0x36, 0x54, 0xe4,
+	0x06, 0x79, 0xb9, 0x0d, 0x23, 0x32, 0xd2, 0x5e, 0x58, 0xcd, 0xf6, 0xc2, 0xf4, 0xbe, 0xab, 0xd9,
+	0xfb, 0xfe, 0xbf, 0x99, 0xd8, 0x85, 0xaa, 0xc1, 0xb9, 0x90, 0xde, 0x72, 0xd4, 0x41, 0x89, 0x1d,
+	0x56, 0xe6, 0x94, 0x7a, 0xbe, 0x48, 0x6a, 0x96, 0xd0, 0x56, 0xdc, 0xe4, 0xc5, 0xce, 0xa4, 0x9e,
+	0xa7, 0x94, 0x51, 0x7f, 0x44, 0xac, 0xa9, 0x23, 0xee, 0xfa, 0x62, 0x27, 0x3a, 0x87, 0xbb, 0xf9,
+	0xab, 0x49, 0xa6, 0x73, 0xe5, 0x0d, 0xa6, 0xf3, 0xb6, 0xcd, 0xcd, 0xef, 0x57, 0xa0, 0x76, 0x62,
+	0x72, 0xc6, 0x5d, 0x6a, 0xfa, 0x21, 0x31, 0x0d, 0xb8, 0xc0, 0x4e, 0x3f, 0x18, 0x8f, 0x9d, 0x1b,
+	0x4d, 0x59, 0x64, 0x2b, 0x66, 0x23, 0x23, 0x1f, 0x36, 0xa5, 0x39, 0xe0, 0x1d, 0xea, 0x0b, 0x8f,
+	0x0e, 0x03, 0x41, 0xb4, 0xa5, 0x45, 0xa6, 0x9b, 0x8f, 0x8f, 0xbe, 0x02, 0x55, 0x82, 0x67, 0xe4,
+	0xda, 0xb9, 0x79, 0x4a, 0x99, 0x20, 0x96, 0x56, 0x5e, 0x64, 0xce, 0xb9, 0xf0, 0x21, 0x9d, 0x18,
+	0xe4, 0x1a, 0x7b, 0x96, 0xdf, 0x23, 0x5e, 0xa6, 0x39, 0x16, 0x46, 0x27, 0xb9, 0xe8, 0xe8, 0x1a,
+	0xb6, 0x62, 0xe8, 0x94, 0x7b, 0xc7, 0xdc, 0x75, 0x03, 0x46, 0xc5, 0xcd, 0x62, 0x9f, 0x98, 0xa2,
+	0x0c, 0xc8, 0x84, 0xda, 0x19, 0xb7, 0x48, 0xcf, 0xa3, 0x66, 0x4c, 0xcf, 0x8b, 0x4a, 0x97, 0xc6,
+	0x45, 0x1f, 0xc2, 0x56, 0xc8, 0xdf, 0x29, 0x49, 0x64, 0xe7, 0xbc, 0xc8, 0x85, 0x5a, 0x80, 0x66,
+	0x61, 0x39, 0xc9, 0x55, 0x39, 0x6a, 0x05, 0x9e, 0xe6, 0x8f, 0x0a, 0x40, 0x0a, 0xa1, 0x01, 0x6c,
+	0xc7, 0xf3, 0x88, 0x1d, 0xfa, 0x0d, 0xb1, 0x92, 0x99, 0x53, 0xe4, 0xcc, 0xed, 0xc6, 0x33, 0x57,
+	0x40, 0x5a, 0xf1, 0xdc, 0x15, 0xee, 0x46, 0x0f, 0x33, 0x33, 0x27, 0xbb, 0xbe, 0x7e, 0xa8, 0x26,
+	0xa1, 0x12, 0x3c, 0x0e, 0x90, 0x2e, 0x6c, 0x0e, 0xa0, 0xda, 0x77, 0xb0, 0x3f, 0xa2, 0xcc, 0x9e,
+	0x15, 0x2a, 0x4a, 0x5e, 0xa8, 0x4c, 0x09, 0x70, 0x29, 0x47, 0x80, 0x3d, 0x8f, 0xf3, 0x8b, 0x98,
+	0xc7, 0x22, 0xa3, 0xf9, 0x4f, 0x0d, 0x6a, 0x29, 0xcd, 0x4c, 0x49, 0x52, 0xc9, 0x92, 0xe4, 0x94,
+	0x66, 0x97, 0x0a, 0x69, 0xb6, 0x9c, 0xcd, 0xf2, 0xdf, 0xca, 0xe7, 0x61, 0xac, 0x47, 0xba, 0xec,
+	0x82, 0x6b, 0x95, 0xbd, 0x72, 0xe6, 0xe4, 0xf9, 0xd2, 0xa5, 0x0b, 0xd1, 0x47, 0x91, 0x78, 0x93,
+	0x9b, 0x22, 0xb6, 0xdb, 0xc8, 0x48, 0xaf, 0xcc, 0x9e, 0xe9, 0xb2, 0x59, 0xb5, 0xb0, 0x9a, 0x57,
+	0x0b, 0xfb, 0xb0, 0xf1, 0x44, 0xde, 0x45, 0xba, 0x26, 0x6a, 0x89, 0x3c, 0x3c, 0xaf, 0x4d, 0x6a,
+	0x45, 0xda, 0x24, 0xab, 0x33, 0x20, 0xa7, 0x33, 0xf2, 0x0a, 0xa8, 0x5e, 0xa0, 0x80, 0xc2, 0x57,
+	0x26, 0xf1, 0xaf, 0xc5, 0xaf, 0x4c, 0xd6, 0x97, 0xbc, 0x40, 0x8d, 0xdc, 0x0b, 0xf4, 0x31, 0xec,
+	0x9c, 0x63, 0x87, 0x5a, 0x58, 0x70, 0xaf, 0x2f, 0xb0, 0xf0, 0xa7, 0x2b, 0xa5, 0x8a, 0x30, 0x6e,
+	0xf1, 0xa2, 0x47, 0xa0, 0xce, 0x3d, 0x23, 0xea, 0x1b, 0x3c, 0x23, 0x6a, 0x91, 0xbe, 0x33, 0x88,
+	0x49, 0xe8, 0x58, 0xf8, 0x32, 0xef, 0x66, 0x74, 0xba, 0x2c, 0x86, 0x3e, 0xc9, 0x8e, 0x94, 0x86,
+	0x64, 0xbf, 0x6f, 0xce, 0x8d, 0x4e, 0x9c, 0x22, 0x3b, 0x7d, 0x1a, 0xac, 0x1e, 0x8f, 0x30, 0x65,
+	0xdd, 0x8e, 0xb6, 0x15, 0x09, 0xf5, 0xd8, 0x0c, 0x2f, 0xb0, 0xcf, 0x2f, 0xc4, 0x35, 0xf6, 0xc8,
+	0x39, 0xf1, 0xfc, 0x50, 0x93, 0x6f, 0x47, 0x17, 0x98, 0x83, 0x8b, 0x04, 0xdd, 0x9d, 0xb7, 0x2a,
+	0xe8, 0xbe, 0x85, 0x9d, 0x1c, 0xd4, 0x65, 0xd1, 0xf4, 0xec, 0x2c, 0x32, 0xef, 0x2d, 0x49, 0xe6,
+	0xf5, 0xe4, 0xdd, 0xb7, 0xa8, 0x27, 0x5d, 0x58, 0xef, 0x90, 0xab, 0xec, 0x19, 0xb5, 0x45, 0x66,
+	0xcb, 0x05, 0xcf, 0x4a, 0xc7, 0x77, 0x66, 0xa4, 0x23, 0x7a, 0x00, 0xb5, 0x84, 0x1b, 0x7d, 0x6d,
+	0x77, 0x86, 0x22, 0x12, 0x7c, 0x4a, 0x2b, 0xc9, 0xba, 0x0f, 0x7e, 0x52, 0x00, 0xd2, 0x6f, 0x37,
+	0xb4, 0x09, 0x8d, 0x2e, 0xbb, 0x0a, 0x07, 0x26, 0x02, 0xd4, 0x12, 0xda, 0x06, 0x35, 0x5c, 0x60,
+	0x10, 0x3b, 0x54, 0x11, 0x58, 0xa2, 0x4a, 0xb8, 0x30, 0x44, 0xbf, 0x60, 0xbe, 0xc0, 0x97, 0x94,
+	0xd9, 0xea, 0x12, 0xda, 0x01, 0x24, 0xa9, 0x88, 0x78, 0xd9, 0xa5, 0x65, 0xb4, 0x1e, 0x65, 0xf8,
+	0x1c, 0x53, 0x87, 0x58, 0xea, 0x32, 0x52, 0x61, 0x2d, 0xda, 0x1a, 0x23, 0x15, 0xb4, 0x01, 0xf5,
+	0x10, 0x91, 0xbf, 0x8a, 0x58, 0xea, 0x4a, 0x02, 0x18, 0x21, 0x63, 0x5e, 0x12, 0x75, 0xb5, 0xfd,
+	0xd9, 0xf3, 0x97, 0x7a, 0xe9, 0xc5, 0x4b, 0xbd, 0xf4, 0xfa, 0xa5, 0xae, 0x7c, 0x37, 0xd1, 0x95,
+	0x9f, 0x27, 0xba, 0xf2, 0x6c, 0xa2, 0x2b, 0xcf, 0x27, 0xba, 0xf2, 0x62, 0xa2, 0x2b, 0x7f, 0x4c,
+	0x74, 0xe5, 0xcf, 0x89, 0x5e, 0x7a, 0x3d, 0xd1, 0x95, 0x1f, 0x5e, 0xe9, 0xa5, 0xe7, 0xaf, 0xf4,
+	0xd2, 0x8b, 0x57, 0x7a, 0xe9, 0xcb, 0x8a, 0xfc, 0x04, 0x1e, 0xae, 0xc8, 0x42, 0x3c, 0xf8, 0x77,
+	0x00, 0x79, 0x7c, 0xd6, 0xc4, 0x59, 0x0f, 0x00, 0x00,
 }
 
 func (x PeerAction) String() string {
@@ -1121,6 +1189,36 @@ func (this *EpochStart) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Slashing) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Slashing)
+	if !ok {
+		that2, ok := that.(Slashing)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.PublicKey, that1.PublicKey) {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	if !bytes.Equal(this.Proof, that1.Proof) {
+		return false
+	}
+	return true
+}
 func (this *MetaBlock) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1239,6 +1337,14 @@ func (this *MetaBlock) Equal(that interface{}) bool {
 	if this.TxCount != that1.TxCount {
 		return false
 	}
+	if len(this.Slashings) != len(that1.Slashings) {
+		return false
+	}
+	for i := range this.Slashings {
+		if !this.Slashings[i].Equal(&that1.Slashings[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *PeerData) GoString() string {
@@ -1342,11 +1448,23 @@ func (this *EpochStart) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *Slashing) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&block.Slashing{")
+	s = append(s, "PublicKey: "+fmt.Sprintf("%#v", this.PublicKey)+",\n")
+	s = append(s, "Round: "+fmt.Sprintf("%#v", this.Round)+",\n")
+	s = append(s, "Proof: "+fmt.Sprintf("%#v", this.Proof)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *MetaBlock) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 28)
+	s := make([]string, 0, 29)
 	s = append(s, "&block.MetaBlock{")
 	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
 	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
@@ -1390,6 +1508,13 @@ func (this *MetaBlock) GoString() string {
 	s = append(s, "DeveloperFees: "+fmt.Sprintf("%#v", this.DeveloperFees)+",\n")
 	s = append(s, "DevFeesInEpoch: "+fmt.Sprintf("%#v", this.DevFeesInEpoch)+",\n")
 	s = append(s, "TxCount: "+fmt.Sprintf("%#v", this.TxCount)+",\n")
+	if this.Slashings != nil {
+		vs := make([]Slashing, len(this.Slashings))
+		for i := range vs {
+			vs[i] = this.Slashings[i]
+		}
+		s = append(s, "Slashings: "+fmt.Sprintf("%#v", vs)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1816,6 +1941,48 @@ func (m *EpochStart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Slashing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Slashing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Slashing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintMetaBlock(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Round != 0 {
+		i = encodeVarintMetaBlock(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintMetaBlock(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MetaBlock) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1836,6 +2003,22 @@ func (m *MetaBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Slashings) > 0 {
+		for iNdEx := len(m.Slashings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slashings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMetaBlock(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
 	if m.TxCount != 0 {
 		i = encodeVarintMetaBlock(dAtA, i, uint64(m.TxCount))
 		i--
@@ -2265,6 +2448,26 @@ func (m *EpochStart) Size() (n int) {
 	return n
 }
 
+func (m *Slashing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovMetaBlock(uint64(l))
+	}
+	if m.Round != 0 {
+		n += 1 + sovMetaBlock(uint64(m.Round))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovMetaBlock(uint64(l))
+	}
+	return n
+}
+
 func (m *MetaBlock) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2370,6 +2573,12 @@ func (m *MetaBlock) Size() (n int) {
 	if m.TxCount != 0 {
 		n += 2 + sovMetaBlock(uint64(m.TxCount))
 	}
+	if len(m.Slashings) > 0 {
+		for _, e := range m.Slashings {
+			l = e.Size()
+			n += 2 + l + sovMetaBlock(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2477,6 +2686,18 @@ func (this *EpochStart) String() string {
 	}, "")
 	return s
 }
+func (this *Slashing) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Slashing{`,
+		`PublicKey:` + fmt.Sprintf("%v", this.PublicKey) + `,`,
+		`Round:` + fmt.Sprintf("%v", this.Round) + `,`,
+		`Proof:` + fmt.Sprintf("%v", this.Proof) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *MetaBlock) String() string {
 	if this == nil {
 		return "nil"
@@ -2496,6 +2717,11 @@ func (this *MetaBlock) String() string {
 		repeatedStringForMiniBlockHeaders += fmt.Sprintf("%v", f) + ","
 	}
 	repeatedStringForMiniBlockHeaders += "}"
+	repeatedStringForSlashings := "[]Slashing{"
+	for _, f := range this.Slashings {
+		repeatedStringForSlashings += strings.Replace(strings.Replace(f.String(), "Slashing", "Slashing", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSlashings += "}"
 	s := strings.Join([]string{`&MetaBlock{`,
 		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
 		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
@@ -2521,6 +2747,7 @@ func (this *MetaBlock) String() string {
 		`DeveloperFees:` + fmt.Sprintf("%v", this.DeveloperFees) + `,`,
 		`DevFeesInEpoch:` + fmt.Sprintf("%v", this.DevFeesInEpoch) + `,`,
 		`TxCount:` + fmt.Sprintf("%v", this.TxCount) + `,`,
+		`Slashings:` + repeatedStringForSlashings + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3930,6 +4157,146 @@ func (m *EpochStart) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Slashing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetaBlock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Slashing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Slashing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetaBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetaBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetaBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetaBlock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MetaBlock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4715,6 +5082,40 @@ func (m *MetaBlock) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetaBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slashings = append(m.Slashings, Slashing{})
+			if err := m.Slashings[len(m.Slashings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetaBlock(dAtA[iNdEx:])
@@ -36,3 +36,6 @@ var ErrNilThrottler = errors.New("nil throttler")
 
 // ErrTimeIsOut signals that time is out
 var ErrTimeIsOut = errors.New("time is out")
+
+// ErrBlockNotFound signals that the requested block could not be found in storage
+var ErrBlockNotFound = errors.New("block not found")
@@ -161,6 +161,8 @@ type Trie interface {
 	Database() DBWriteCacher
 	GetSerializedNodes([]byte, uint64) ([][]byte, uint64, error)
 	GetAllLeaves() (map[string][]byte, error)
+	GetProof(key []byte) ([][]byte, error)
+	VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error)
 	IsPruningEnabled() bool
 	EnterSnapshotMode()
 	ExitSnapshotMode()
@@ -697,6 +697,14 @@ func (adb *AccountsDB) GetAllLeaves(rootHash []byte) (map[string][]byte, error)
 	return allAccounts, nil
 }
 
+// GetProof returns the Merkle inclusion proof of the account with the given address in the main trie
+func (adb *AccountsDB) GetProof(address []byte) ([][]byte, error) {
+	adb.mutOp.Lock()
+	defer adb.mutOp.Unlock()
+
+	return adb.mainTrie.GetProof(address)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (adb *AccountsDB) IsInterfaceNil() bool {
 	return adb == nil
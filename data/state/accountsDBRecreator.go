@@ -0,0 +1,77 @@
+package state
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+var _ AccountsAdapterRecreator = (*accountsDBRecreator)(nil)
+
+type accountsDBRecreator struct {
+	trie           data.Trie
+	hasher         hashing.Hasher
+	marshalizer    marshal.Marshalizer
+	accountFactory AccountFactory
+}
+
+// NewAccountsDBRecreator creates a component which recreates accounts DBs over past states of the given trie.
+// The recreated accounts DBs share the trie storage but hold their own trie, so they can be used for queries
+// while the node keeps processing blocks
+func NewAccountsDBRecreator(
+	trie data.Trie,
+	hasher hashing.Hasher,
+	marshalizer marshal.Marshalizer,
+	accountFactory AccountFactory,
+) (*accountsDBRecreator, error) {
+	if check.IfNil(trie) {
+		return nil, ErrNilTrie
+	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(accountFactory) {
+		return nil, ErrNilAccountFactory
+	}
+
+	return &accountsDBRecreator{
+		trie:           trie,
+		hasher:         hasher,
+		marshalizer:    marshalizer,
+		accountFactory: accountFactory,
+	}, nil
+}
+
+// RecreateAccountsAdapter returns a new accounts DB over the state with the given root hash. If the trie nodes
+// of that state were pruned or never synced, ErrStateNotAvailable is returned
+func (adr *accountsDBRecreator) RecreateAccountsAdapter(rootHash []byte) (AccountsAdapter, error) {
+	recreatedTrie, err := adr.trie.Recreate(rootHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w for root hash %s: %s", ErrStateNotAvailable, hex.EncodeToString(rootHash), err.Error())
+	}
+	if check.IfNil(recreatedTrie) {
+		return nil, ErrNilTrie
+	}
+
+	adb, err := NewAccountsDB(recreatedTrie, adr.hasher, adr.marshalizer, adr.accountFactory)
+	if err != nil {
+		return nil, err
+	}
+
+	// reverting to the first snapshot should go back to the recreated state, not to an empty trie
+	adb.lastRootHash = rootHash
+
+	return adb, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (adr *accountsDBRecreator) IsInterfaceNil() bool {
+	return adr == nil
+}
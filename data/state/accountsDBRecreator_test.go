@@ -0,0 +1,86 @@
+package state_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAccountsDBRecreator_NilTrieShouldErr(t *testing.T) {
+	t.Parallel()
+
+	adr, err := state.NewAccountsDBRecreator(nil, mock.HasherMock{}, &mock.MarshalizerMock{}, factory.NewAccountCreator())
+
+	assert.True(t, check.IfNil(adr))
+	assert.Equal(t, state.ErrNilTrie, err)
+}
+
+func TestNewAccountsDBRecreator_NilAccountFactoryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	adr, err := state.NewAccountsDBRecreator(&mock.TrieStub{}, mock.HasherMock{}, &mock.MarshalizerMock{}, nil)
+
+	assert.True(t, check.IfNil(adr))
+	assert.Equal(t, state.ErrNilAccountFactory, err)
+}
+
+func TestAccountsDBRecreator_RecreateAccountsAdapterShouldReadPastState(t *testing.T) {
+	t.Parallel()
+
+	marsh := &mock.MarshalizerMock{}
+	hsh := mock.HasherMock{}
+	accFactory := factory.NewAccountCreator()
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(mock.NewMemDbMock())
+	maxTrieLevelInMemory := uint(5)
+	tr, _ := trie.NewTrie(storageManager, marsh, hsh, maxTrieLevelInMemory)
+	adb, _ := state.NewAccountsDB(tr, hsh, marsh, accFactory)
+
+	address := make([]byte, 32)
+	acc, _ := adb.LoadAccount(address)
+	_ = acc.(state.UserAccountHandler).AddToBalance(big.NewInt(10))
+	_ = adb.SaveAccount(acc)
+	oldRootHash, err := adb.Commit()
+	require.Nil(t, err)
+
+	acc, _ = adb.LoadAccount(address)
+	_ = acc.(state.UserAccountHandler).AddToBalance(big.NewInt(5))
+	_ = adb.SaveAccount(acc)
+	_, err = adb.Commit()
+	require.Nil(t, err)
+
+	adr, err := state.NewAccountsDBRecreator(tr, hsh, marsh, accFactory)
+	require.Nil(t, err)
+	pastAdb, err := adr.RecreateAccountsAdapter(oldRootHash)
+	require.Nil(t, err)
+
+	pastAcc, err := pastAdb.GetExistingAccount(address)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(10), pastAcc.(state.UserAccountHandler).GetBalance())
+
+	currentAcc, err := adb.GetExistingAccount(address)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(15), currentAcc.(state.UserAccountHandler).GetBalance())
+}
+
+func TestAccountsDBRecreator_RecreateAccountsAdapterMissingStateShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marsh := &mock.MarshalizerMock{}
+	hsh := mock.HasherMock{}
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(mock.NewMemDbMock())
+	tr, _ := trie.NewTrie(storageManager, marsh, hsh, 5)
+
+	adr, _ := state.NewAccountsDBRecreator(tr, hsh, marsh, factory.NewAccountCreator())
+	pastAdb, err := adr.RecreateAccountsAdapter([]byte("missing root hash"))
+
+	assert.Nil(t, pastAdb)
+	assert.True(t, errors.Is(err, state.ErrStateNotAvailable))
+}
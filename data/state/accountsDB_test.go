@@ -940,3 +940,21 @@ func TestAccountsDB_GetAllLeaves(t *testing.T) {
 	assert.True(t, recreateCalled)
 	assert.True(t, getAllLeavesCalled)
 }
+
+func TestAccountsDB_GetProofShouldUseMainTrie(t *testing.T) {
+	t.Parallel()
+
+	expectedProof := [][]byte{[]byte("root node"), []byte("leaf node")}
+	address := []byte("address")
+	trieStub := &mock.TrieStub{
+		GetProofCalled: func(key []byte) ([][]byte, error) {
+			assert.Equal(t, address, key)
+			return expectedProof, nil
+		},
+	}
+
+	adb := generateAccountDBFromTrie(trieStub)
+	proof, err := adb.GetProof(address)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedProof, proof)
+}
@@ -0,0 +1,9 @@
+package state
+
+// ApiProof is the data transfer object which holds a hex encoded Merkle inclusion proof of a key in a trie
+type ApiProof struct {
+	RootHash string   `json:"rootHash"`
+	Key      string   `json:"key"`
+	Value    string   `json:"value,omitempty"`
+	Proof    []string `json:"proof"`
+}
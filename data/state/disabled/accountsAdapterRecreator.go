@@ -0,0 +1,25 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+var _ state.AccountsAdapterRecreator = (*accountsAdapterRecreator)(nil)
+
+type accountsAdapterRecreator struct {
+}
+
+// NewAccountsAdapterRecreator returns a recreator for components which never work on past states
+func NewAccountsAdapterRecreator() *accountsAdapterRecreator {
+	return &accountsAdapterRecreator{}
+}
+
+// RecreateAccountsAdapter returns ErrStateNotAvailable
+func (aar *accountsAdapterRecreator) RecreateAccountsAdapter(_ []byte) (state.AccountsAdapter, error) {
+	return nil, state.ErrStateNotAvailable
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (aar *accountsAdapterRecreator) IsInterfaceNil() bool {
+	return aar == nil
+}
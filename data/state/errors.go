@@ -115,3 +115,12 @@ var ErrInvalidPubkeyConverterType = errors.New("invalid pubkey converter type")
 
 // ErrInvalidHash signals that the given hash is invalid
 var ErrInvalidHash = errors.New("invalid hash provided")
+
+// ErrStateNotAvailable signals that the trie nodes of the requested state are not available on this node
+var ErrStateNotAvailable = errors.New("state not available")
+
+// ErrInvalidQueryOptions signals that the provided state query options are invalid
+var ErrInvalidQueryOptions = errors.New("invalid state query options")
+
+// ErrKeyNotFound signals that the key was not found in the account's data trie
+var ErrKeyNotFound = errors.New("key was not found")
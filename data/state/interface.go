@@ -134,10 +134,18 @@ type AccountsAdapter interface {
 	SetStateCheckpoint(rootHash []byte)
 	IsPruningEnabled() bool
 	GetAllLeaves(rootHash []byte) (map[string][]byte, error)
+	GetProof(address []byte) ([][]byte, error)
 	RecreateAllTries(rootHash []byte) (map[string]data.Trie, error)
 	IsInterfaceNil() bool
 }
 
+// AccountsAdapterRecreator creates accounts adapters over the state found at a given root hash, without
+// altering the accounts adapter used by the node
+type AccountsAdapterRecreator interface {
+	RecreateAccountsAdapter(rootHash []byte) (AccountsAdapter, error)
+	IsInterfaceNil() bool
+}
+
 // JournalEntry will be used to implement different state changes to be able to easily revert them
 type JournalEntry interface {
 	Revert() (AccountHandler, error)
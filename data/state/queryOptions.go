@@ -0,0 +1,22 @@
+package state
+
+// QueryOptions selects the state against which an account or a smart contract query is made. At most one of
+// the fields can be set. The zero value selects the current state
+type QueryOptions struct {
+	BlockNonce *uint64
+	RootHash   []byte
+}
+
+// IsCurrentState returns true if the options do not select a past state
+func (qo QueryOptions) IsCurrentState() bool {
+	return qo.BlockNonce == nil && len(qo.RootHash) == 0
+}
+
+// Check returns an error if the options select the state in more than one way
+func (qo QueryOptions) Check() error {
+	if qo.BlockNonce != nil && len(qo.RootHash) > 0 {
+		return ErrInvalidQueryOptions
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. accountHistory.proto
+package transaction
+
+// ApiAccountHistory is the data transfer object which will be returned on the get transactions by address endpoint.
+// Truncated is set when older transactions of the address were pruned from the history
+type ApiAccountHistory struct {
+	Transactions []*ApiAccountHistoryEntry `json:"transactions"`
+	Truncated    bool                      `json:"truncated"`
+}
+
+// ApiAccountHistoryEntry holds a transaction returned on the get transactions by address endpoint
+type ApiAccountHistoryEntry struct {
+	Hash       string `json:"hash"`
+	BlockHash  string `json:"blockHash"`
+	BlockNonce uint64 `json:"blockNonce"`
+	Round      uint64 `json:"round"`
+	Epoch      uint32 `json:"epoch"`
+}
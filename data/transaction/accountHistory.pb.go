@@ -0,0 +1,852 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: accountHistory.proto
+
+package transaction
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AccountHistoryEntry holds the information saved for a transaction which touched an account
+type AccountHistoryEntry struct {
+	TxHash     []byte `protobuf:"bytes,1,opt,name=TxHash,proto3" json:"txHash"`
+	BlockHash  []byte `protobuf:"bytes,2,opt,name=BlockHash,proto3" json:"blockHash"`
+	BlockNonce uint64 `protobuf:"varint,3,opt,name=BlockNonce,proto3" json:"blockNonce"`
+	Round      uint64 `protobuf:"varint,4,opt,name=Round,proto3" json:"round"`
+	Epoch      uint32 `protobuf:"varint,5,opt,name=Epoch,proto3" json:"epoch"`
+}
+
+func (m *AccountHistoryEntry) Reset()      { *m = AccountHistoryEntry{} }
+func (*AccountHistoryEntry) ProtoMessage() {}
+func (*AccountHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f8030e23b808c67, []int{0}
+}
+func (m *AccountHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AccountHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountHistoryEntry.Merge(m, src)
+}
+func (m *AccountHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountHistoryEntry proto.InternalMessageInfo
+
+func (m *AccountHistoryEntry) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *AccountHistoryEntry) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *AccountHistoryEntry) GetBlockNonce() uint64 {
+	if m != nil {
+		return m.BlockNonce
+	}
+	return 0
+}
+
+func (m *AccountHistoryEntry) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *AccountHistoryEntry) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+// AccountHistoryEntries holds the history entries of an account saved in an epoch. Truncated is set once the oldest
+// entries were pruned
+type AccountHistoryEntries struct {
+	Entries   []*AccountHistoryEntry `protobuf:"bytes,1,rep,name=Entries,proto3" json:"entries"`
+	Truncated bool                   `protobuf:"varint,2,opt,name=Truncated,proto3" json:"truncated"`
+}
+
+func (m *AccountHistoryEntries) Reset()      { *m = AccountHistoryEntries{} }
+func (*AccountHistoryEntries) ProtoMessage() {}
+func (*AccountHistoryEntries) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f8030e23b808c67, []int{1}
+}
+func (m *AccountHistoryEntries) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountHistoryEntries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AccountHistoryEntries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountHistoryEntries.Merge(m, src)
+}
+func (m *AccountHistoryEntries) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountHistoryEntries) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountHistoryEntries.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountHistoryEntries proto.InternalMessageInfo
+
+func (m *AccountHistoryEntries) GetEntries() []*AccountHistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *AccountHistoryEntries) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*AccountHistoryEntry)(nil), "proto.AccountHistoryEntry")
+	proto.RegisterType((*AccountHistoryEntries)(nil), "proto.AccountHistoryEntries")
+}
+
+func init() { proto.RegisterFile("accountHistory.proto", fileDescriptor_3f8030e23b808c67) }
+
+var fileDescriptor_3f8030e23b808c67 = []byte{
+	// 350 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x6e, 0xe2, 0x40,
+	0x10, 0x86, 0x3d, 0x07, 0x86, 0x63, 0x39, 0xae, 0xf0, 0x5d, 0x24, 0x8b, 0x62, 0x6c, 0x51, 0x59,
+	0x8a, 0x62, 0xa4, 0xe4, 0x09, 0xb0, 0x84, 0x44, 0x95, 0x62, 0x45, 0x95, 0xce, 0x5e, 0x1c, 0xb0,
+	0x92, 0x78, 0x91, 0xbd, 0x96, 0x42, 0x97, 0x2e, 0x6d, 0x1e, 0x23, 0x8f, 0x92, 0x92, 0xd2, 0x95,
+	0x15, 0x96, 0x26, 0x72, 0xc5, 0x23, 0x44, 0x5e, 0x43, 0x48, 0x94, 0x54, 0x9e, 0xf9, 0xe6, 0x1b,
+	0x6b, 0xff, 0x21, 0xff, 0x7d, 0xc6, 0x78, 0x16, 0x8b, 0x49, 0x94, 0x0a, 0x9e, 0xac, 0xdc, 0x65,
+	0xc2, 0x05, 0x37, 0x74, 0xf5, 0xe9, 0x9f, 0xcd, 0x23, 0xb1, 0xc8, 0x02, 0x97, 0xf1, 0xbb, 0xe1,
+	0x9c, 0xcf, 0xf9, 0x50, 0xe1, 0x20, 0xbb, 0x56, 0x9d, 0x6a, 0x54, 0x55, 0x6f, 0x0d, 0x72, 0x20,
+	0xff, 0x46, 0x5f, 0x7e, 0x37, 0x8e, 0x45, 0xb2, 0x32, 0x06, 0xa4, 0x35, 0xbd, 0x9f, 0xf8, 0xe9,
+	0xc2, 0x04, 0x1b, 0x9c, 0x3f, 0x1e, 0x29, 0x0b, 0xab, 0x25, 0x14, 0xa1, 0xfb, 0x89, 0x71, 0x4a,
+	0x3a, 0xde, 0x2d, 0x67, 0x37, 0x4a, 0xfb, 0xa5, 0xb4, 0x5e, 0x59, 0x58, 0x9d, 0xe0, 0x00, 0xe9,
+	0x71, 0x6e, 0xb8, 0x84, 0xa8, 0xe6, 0x92, 0xc7, 0x2c, 0x34, 0x1b, 0x36, 0x38, 0x4d, 0xef, 0x6f,
+	0x59, 0x58, 0x24, 0xf8, 0xa0, 0xf4, 0x93, 0x61, 0x58, 0x44, 0xa7, 0x3c, 0x8b, 0x67, 0x66, 0x53,
+	0xa9, 0x9d, 0xb2, 0xb0, 0xf4, 0xa4, 0x02, 0xb4, 0xe6, 0x95, 0x30, 0x5e, 0x72, 0xb6, 0x30, 0x75,
+	0x1b, 0x9c, 0x5e, 0x2d, 0x84, 0x15, 0xa0, 0x35, 0x1f, 0x3c, 0x02, 0x39, 0xf9, 0x1e, 0x2d, 0x0a,
+	0x53, 0x63, 0x44, 0xda, 0xfb, 0xd2, 0x04, 0xbb, 0xe1, 0x74, 0xcf, 0xfb, 0xf5, 0x35, 0xdc, 0x1f,
+	0x2e, 0xe1, 0x75, 0xcb, 0xc2, 0x6a, 0x87, 0xb5, 0x4e, 0x0f, 0x7b, 0x55, 0xf6, 0x69, 0x92, 0xc5,
+	0xcc, 0x17, 0xe1, 0x4c, 0x65, 0xff, 0x5d, 0x67, 0x17, 0x07, 0x48, 0x8f, 0x73, 0x6f, 0xbc, 0xde,
+	0xa0, 0x96, 0x6f, 0x50, 0xdb, 0x6d, 0x10, 0x1e, 0x24, 0xc2, 0xb3, 0x44, 0x78, 0x91, 0x08, 0x6b,
+	0x89, 0x90, 0x4b, 0x84, 0x57, 0x89, 0xf0, 0x26, 0x51, 0xdb, 0x49, 0x84, 0xa7, 0x2d, 0x6a, 0xeb,
+	0x2d, 0x6a, 0xf9, 0x16, 0xb5, 0xab, 0xae, 0x48, 0xfc, 0x38, 0xf5, 0x99, 0x88, 0x78, 0x1c, 0xb4,
+	0xd4, 0x23, 0x2f, 0xde, 0x07, 0x00, 0x53, 0xa2, 0x79, 0x5c, 0x00, 0x02, 0x00, 0x00,
+}
+
+func (this *AccountHistoryEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountHistoryEntry)
+	if !ok {
+		that2, ok := that.(AccountHistoryEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.TxHash, that1.TxHash) {
+		return false
+	}
+	if !bytes.Equal(this.BlockHash, that1.BlockHash) {
+		return false
+	}
+	if this.BlockNonce != that1.BlockNonce {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	return true
+}
+func (this *AccountHistoryEntries) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountHistoryEntries)
+	if !ok {
+		that2, ok := that.(AccountHistoryEntries)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Entries) != len(that1.Entries) {
+		return false
+	}
+	for i := range this.Entries {
+		if !this.Entries[i].Equal(that1.Entries[i]) {
+			return false
+		}
+	}
+	if this.Truncated != that1.Truncated {
+		return false
+	}
+	return true
+}
+func (this *AccountHistoryEntry) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&transaction.AccountHistoryEntry{")
+	s = append(s, "TxHash: "+fmt.Sprintf("%#v", this.TxHash)+",\n")
+	s = append(s, "BlockHash: "+fmt.Sprintf("%#v", this.BlockHash)+",\n")
+	s = append(s, "BlockNonce: "+fmt.Sprintf("%#v", this.BlockNonce)+",\n")
+	s = append(s, "Round: "+fmt.Sprintf("%#v", this.Round)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AccountHistoryEntries) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&transaction.AccountHistoryEntries{")
+	if this.Entries != nil {
+		s = append(s, "Entries: "+fmt.Sprintf("%#v", this.Entries)+",\n")
+	}
+	s = append(s, "Truncated: "+fmt.Sprintf("%#v", this.Truncated)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringAccountHistory(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *AccountHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintAccountHistory(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Round != 0 {
+		i = encodeVarintAccountHistory(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlockNonce != 0 {
+		i = encodeVarintAccountHistory(dAtA, i, uint64(m.BlockNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintAccountHistory(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintAccountHistory(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountHistoryEntries) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountHistoryEntries) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountHistoryEntries) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Truncated {
+		i--
+		if m.Truncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAccountHistory(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAccountHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAccountHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AccountHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovAccountHistory(uint64(l))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovAccountHistory(uint64(l))
+	}
+	if m.BlockNonce != 0 {
+		n += 1 + sovAccountHistory(uint64(m.BlockNonce))
+	}
+	if m.Round != 0 {
+		n += 1 + sovAccountHistory(uint64(m.Round))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovAccountHistory(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *AccountHistoryEntries) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovAccountHistory(uint64(l))
+		}
+	}
+	if m.Truncated {
+		n += 2
+	}
+	return n
+}
+
+func sovAccountHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAccountHistory(x uint64) (n int) {
+	return sovAccountHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *AccountHistoryEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AccountHistoryEntry{`,
+		`TxHash:` + fmt.Sprintf("%v", this.TxHash) + `,`,
+		`BlockHash:` + fmt.Sprintf("%v", this.BlockHash) + `,`,
+		`BlockNonce:` + fmt.Sprintf("%v", this.BlockNonce) + `,`,
+		`Round:` + fmt.Sprintf("%v", this.Round) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AccountHistoryEntries) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForEntries := "[]*AccountHistoryEntry{"
+	for _, f := range this.Entries {
+		repeatedStringForEntries += strings.Replace(f.String(), "AccountHistoryEntry", "AccountHistoryEntry", 1) + ","
+	}
+	repeatedStringForEntries += "}"
+	s := strings.Join([]string{`&AccountHistoryEntries{`,
+		`Entries:` + repeatedStringForEntries + `,`,
+		`Truncated:` + fmt.Sprintf("%v", this.Truncated) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringAccountHistory(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *AccountHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccountHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccountHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccountHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNonce", wireType)
+			}
+			m.BlockNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccountHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAccountHistory
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAccountHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountHistoryEntries) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccountHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountHistoryEntries: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountHistoryEntries: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccountHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &AccountHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Truncated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccountHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAccountHistory
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAccountHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAccountHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAccountHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccountHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccountHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAccountHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAccountHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAccountHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAccountHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAccountHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAccountHistory = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,63 @@
+package transaction
+
+// SimulationResults is the data transfer object which will be returned on the simulate transaction endpoint
+type SimulationResults struct {
+	Status        string                    `json:"status"`
+	FailReason    string                    `json:"failReason,omitempty"`
+	Hash          string                    `json:"hash"`
+	GasUsed       uint64                    `json:"gasUsed"`
+	Fee           string                    `json:"fee"`
+	ScResults     []*ApiSmartContractResult `json:"scResults,omitempty"`
+	Receipts      []*ApiReceipt             `json:"receipts,omitempty"`
+	Logs          *ApiLogs                  `json:"logs,omitempty"`
+	AccountDeltas []*ApiAccountDelta        `json:"accountDeltas,omitempty"`
+}
+
+// ApiSmartContractResult is the data transfer object for a smart contract result generated by a simulated transaction
+type ApiSmartContractResult struct {
+	Hash           string `json:"hash"`
+	Nonce          uint64 `json:"nonce"`
+	Value          string `json:"value"`
+	Receiver       string `json:"receiver"`
+	Sender         string `json:"sender"`
+	Data           string `json:"data,omitempty"`
+	PrevTxHash     string `json:"prevTxHash"`
+	OriginalTxHash string `json:"originalTxHash"`
+	GasLimit       uint64 `json:"gasLimit"`
+	GasPrice       uint64 `json:"gasPrice"`
+	CallType       int    `json:"callType"`
+	ReturnMessage  string `json:"returnMessage,omitempty"`
+	ReceiverShard  uint32 `json:"receiverShard"`
+}
+
+// ApiReceipt is the data transfer object for a receipt generated by a simulated transaction
+type ApiReceipt struct {
+	Value  string `json:"value"`
+	Sender string `json:"sender"`
+	Data   string `json:"data,omitempty"`
+	TxHash string `json:"txHash"`
+}
+
+// ApiLogs is the data transfer object for the log events generated by a simulated transaction
+type ApiLogs struct {
+	Address string      `json:"address"`
+	Events  []*ApiEvent `json:"events"`
+}
+
+// ApiEvent is the data transfer object for a log event
+type ApiEvent struct {
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     []string `json:"topics"`
+	Data       string   `json:"data,omitempty"`
+}
+
+// ApiAccountDelta holds the changes a simulated transaction made on an account of the node's shard
+type ApiAccountDelta struct {
+	Address        string `json:"address"`
+	BalanceBefore  string `json:"balanceBefore"`
+	BalanceAfter   string `json:"balanceAfter"`
+	NonceBefore    uint64 `json:"nonceBefore"`
+	NonceAfter     uint64 `json:"nonceAfter"`
+	StorageChanged bool   `json:"storageChanged"`
+}
@@ -0,0 +1,55 @@
+package transaction
+
+// ApiTransactionsPool is the data transfer object which will be returned on the transactions pool endpoint
+type ApiTransactionsPool struct {
+	NumTxs   uint64                      `json:"numTxs"`
+	NumBytes uint64                      `json:"numBytes"`
+	Caches   []*ApiTransactionsPoolCache `json:"caches"`
+}
+
+// ApiTransactionsPoolCache holds the size of one of the caches of the transactions pool, identified by the
+// source and destination shards of the transactions it holds
+type ApiTransactionsPoolCache struct {
+	Name       string `json:"name"`
+	NumTxs     uint64 `json:"numTxs"`
+	NumBytes   uint64 `json:"numBytes"`
+	NumSenders uint64 `json:"numSenders"`
+}
+
+// ApiTransactionsPoolForSender is the data transfer object which will be returned on the transactions pool by sender
+// endpoint. The nonce gaps explain why the transactions of a sender are not selected for processing
+type ApiTransactionsPoolForSender struct {
+	Sender              string                  `json:"sender"`
+	AccountNonce        uint64                  `json:"accountNonce"`
+	AccountNonceKnown   bool                    `json:"accountNonceKnown"`
+	Score               uint32                  `json:"score"`
+	NumBytes            uint64                  `json:"numBytes"`
+	TotalGas            uint64                  `json:"totalGas"`
+	TotalFee            uint64                  `json:"totalFee"`
+	NumFailedSelections int64                   `json:"numFailedSelections"`
+	IsInGracePeriod     bool                    `json:"isInGracePeriod"`
+	NonceGaps           []*ApiNonceGap          `json:"nonceGaps"`
+	Transactions        []*ApiTransactionInPool `json:"transactions"`
+}
+
+// ApiNonceGap is a range of nonces, both ends included, missing from the transactions of a sender
+type ApiNonceGap struct {
+	From uint64 `json:"from"`
+	To   uint64 `json:"to"`
+}
+
+// ApiTransactionInPool is the data transfer object for a transaction held in the transactions pool
+type ApiTransactionInPool struct {
+	Hash               string `json:"hash"`
+	Cache              string `json:"cache,omitempty"`
+	Nonce              uint64 `json:"nonce"`
+	Sender             string `json:"sender"`
+	Receiver           string `json:"receiver"`
+	Value              string `json:"value"`
+	GasPrice           uint64 `json:"gasPrice"`
+	GasLimit           uint64 `json:"gasLimit"`
+	Size               int    `json:"size"`
+	SenderShard        uint32 `json:"senderShard"`
+	ReceiverShard      uint32 `json:"receiverShard"`
+	IsImmuneToEviction bool   `json:"isImmuneToEviction"`
+}
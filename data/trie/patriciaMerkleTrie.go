@@ -3,6 +3,7 @@ package trie
 import (
 	"bytes"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"sync"
 
@@ -570,6 +571,106 @@ func (tr *patriciaMerkleTrie) GetAllLeaves() (map[string][]byte, error) {
 	return leaves, nil
 }
 
+// GetProof returns the Merkle inclusion proof for the given key. The proof contains the encoded nodes found on
+// the path from the root to the leaf holding the key, starting with the root node. Hashing each node gives the hash
+// referenced by its parent, while hashing the first node gives the trie root hash
+func (tr *patriciaMerkleTrie) GetProof(key []byte) ([][]byte, error) {
+	tr.mutOperation.Lock()
+	defer tr.mutOperation.Unlock()
+
+	if tr.root == nil {
+		return nil, fmt.Errorf("%w for key %v", ErrNodeNotFound, hex.EncodeToString(key))
+	}
+
+	hexKey := keyBytesToHex(key)
+	currentNode := tr.root
+	proof := make([][]byte, 0)
+	for {
+		encNode, err := getEncodedCollapsedNode(currentNode)
+		if err != nil {
+			return nil, err
+		}
+		proof = append(proof, encNode)
+
+		currentNode, hexKey, err = currentNode.getNext(hexKey, tr.Database())
+		if err != nil {
+			return nil, fmt.Errorf("%w for key %v", err, hex.EncodeToString(key))
+		}
+		if currentNode == nil {
+			return proof, nil
+		}
+	}
+}
+
+// VerifyProof checks that the given proof, as returned by GetProof, proves the inclusion of the key
+// in the trie with the given root hash
+func (tr *patriciaMerkleTrie) VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error) {
+	_, err := getValueFromProof(rootHash, key, proof, tr.marshalizer, tr.hasher)
+	if errors.Is(err, ErrInvalidProof) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func getEncodedCollapsedNode(n node) ([]byte, error) {
+	collapsed, err := n.getCollapsed()
+	if err != nil {
+		return nil, err
+	}
+
+	return collapsed.getEncodedNode()
+}
+
+func getValueFromProof(
+	rootHash []byte,
+	key []byte,
+	proof [][]byte,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) ([]byte, error) {
+	hexKey := keyBytesToHex(key)
+	expectedHash := rootHash
+	for i, encNode := range proof {
+		if !bytes.Equal(hasher.Compute(string(encNode)), expectedHash) {
+			return nil, ErrInvalidProof
+		}
+
+		decodedNode, err := decodeNode(encNode, marshalizer, hasher)
+		if err != nil {
+			return nil, err
+		}
+
+		isLastNode := i == len(proof)-1
+		switch n := decodedNode.(type) {
+		case *leafNode:
+			if !isLastNode || !bytes.Equal(n.Key, hexKey) {
+				return nil, ErrInvalidProof
+			}
+			return n.Value, nil
+		case *extensionNode:
+			if len(hexKey) < len(n.Key) || !bytes.Equal(n.Key, hexKey[:len(n.Key)]) {
+				return nil, ErrInvalidProof
+			}
+			hexKey = hexKey[len(n.Key):]
+			expectedHash = n.EncodedChild
+		case *branchNode:
+			if len(hexKey) == 0 || int(hexKey[firstByte]) >= len(n.EncodedChildren) {
+				return nil, ErrInvalidProof
+			}
+			expectedHash = n.EncodedChildren[hexKey[firstByte]]
+			hexKey = hexKey[1:]
+		default:
+			return nil, ErrInvalidNode
+		}
+	}
+
+	return nil, ErrInvalidProof
+}
+
 // IsPruningEnabled returns true if state pruning is enabled
 func (tr *patriciaMerkleTrie) IsPruningEnabled() bool {
 	return tr.trieStorage.IsPruningEnabled()
@@ -2,6 +2,7 @@ package trie_test
 
 import (
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"math/rand"
@@ -20,6 +21,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var emptyTrieHash = make([]byte, 32)
@@ -615,6 +617,108 @@ func TestPatriciaMerkleTrie_GetSerializedNodesShouldCheckFirstInSnapshotsDB(t *t
 	assert.True(t, getSnapshotCalled)
 }
 
+func TestPatriciaMerkleTrie_GetProofEmptyTrieShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := emptyTrie()
+	proof, err := tr.GetProof([]byte("dog"))
+
+	assert.Nil(t, proof)
+	assert.True(t, errors.Is(err, trie.ErrNodeNotFound))
+}
+
+func TestPatriciaMerkleTrie_GetProofMissingKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	proof, err := tr.GetProof([]byte("cat"))
+
+	assert.Nil(t, proof)
+	assert.True(t, errors.Is(err, trie.ErrNodeNotFound))
+}
+
+func TestPatriciaMerkleTrie_GetProofAndVerifyProof(t *testing.T) {
+	t.Parallel()
+
+	tr, values := initTrieMultipleValues(1000)
+	rootHash, _ := tr.Root()
+
+	for _, key := range values {
+		proof, err := tr.GetProof(key)
+		require.Nil(t, err)
+
+		ok, err := tr.VerifyProof(rootHash, key, proof)
+		assert.Nil(t, err)
+		assert.True(t, ok)
+	}
+}
+
+func TestPatriciaMerkleTrie_GetProofAfterCommitAndRecreate(t *testing.T) {
+	t.Parallel()
+
+	tr, values := initTrieMultipleValues(1000)
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+
+	recreatedTrie, err := tr.Recreate(rootHash)
+	require.Nil(t, err)
+
+	for _, key := range values {
+		proof, errGet := recreatedTrie.GetProof(key)
+		require.Nil(t, errGet)
+
+		ok, errVerify := tr.VerifyProof(rootHash, key, proof)
+		assert.Nil(t, errVerify)
+		assert.True(t, ok)
+	}
+}
+
+func TestPatriciaMerkleTrie_VerifyProofInvalidProofShouldReturnFalse(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	rootHash, _ := tr.Root()
+	proof, err := tr.GetProof([]byte("dog"))
+	require.Nil(t, err)
+	require.True(t, len(proof) > 1)
+
+	ok, err := tr.VerifyProof([]byte("wrong root hash"), []byte("dog"), proof)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	ok, err = tr.VerifyProof(rootHash, []byte("doe"), proof)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	ok, err = tr.VerifyProof(rootHash, []byte("dog"), proof[:len(proof)-1])
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	ok, err = tr.VerifyProof(rootHash, []byte("dog"), [][]byte{})
+	assert.Nil(t, err)
+	assert.False(t, ok)
+}
+
+func TestPatriciaMerkleTrie_VerifyProofAfterValueChangedShouldReturnFalse(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	rootHash, _ := tr.Root()
+	_ = tr.Update([]byte("dog"), []byte("wolf"))
+
+	proof, err := tr.GetProof([]byte("dog"))
+	require.Nil(t, err)
+
+	ok, err := tr.VerifyProof(rootHash, []byte("dog"), proof)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	newRootHash, _ := tr.Root()
+	ok, err = tr.VerifyProof(newRootHash, []byte("dog"), proof)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+}
+
 func BenchmarkPatriciaMerkleTree_Insert(b *testing.B) {
 	tr := emptyTrie()
 	hsh := keccak.Keccak{}
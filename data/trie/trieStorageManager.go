@@ -40,6 +40,14 @@ type trieStorageManager struct {
 	storageOperationMutex sync.RWMutex
 }
 
+// StorageStatistics holds the current state of the snapshot and pruning operations of a trie storage manager
+type StorageStatistics struct {
+	NumSnapshots           int
+	NumQueuedSnapshots     int
+	NumSnapshotsInProgress uint32
+	PruningBufferLen       int
+}
+
 type snapshotsQueueEntry struct {
 	rootHash []byte
 	newDb    bool
@@ -510,6 +518,23 @@ func directoryExists(path string) bool {
 	return !os.IsNotExist(err)
 }
 
+// StorageStatistics returns the current number of snapshots, queued snapshot requests and buffered pruning requests
+func (tsm *trieStorageManager) StorageStatistics() StorageStatistics {
+	tsm.storageOperationMutex.RLock()
+	defer tsm.storageOperationMutex.RUnlock()
+
+	stats := StorageStatistics{
+		NumSnapshots:           len(tsm.snapshots),
+		NumQueuedSnapshots:     len(tsm.snapshotReq),
+		NumSnapshotsInProgress: tsm.snapshotInProgress,
+	}
+	if tsm.pruningBuffer != nil {
+		stats.PruningBufferLen = tsm.pruningBuffer.len()
+	}
+
+	return stats
+}
+
 // IsPruningEnabled returns true if the trie pruning is enabled
 func (tsm *trieStorageManager) IsPruningEnabled() bool {
 	return true
@@ -65,3 +65,10 @@ func TestTrieStorageManagerWithoutPruning_IsPruningEnabled(t *testing.T) {
 	ts, _ := NewTrieStorageManagerWithoutPruning(mock.NewMemDbMock())
 	assert.False(t, ts.IsPruningEnabled())
 }
+
+func TestTrieStorageManagerWithoutPruning_StorageStatisticsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	ts, _ := NewTrieStorageManagerWithoutPruning(mock.NewMemDbMock())
+	assert.Equal(t, StorageStatistics{}, ts.StorageStatistics())
+}
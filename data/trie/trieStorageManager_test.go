@@ -410,6 +410,28 @@ func TestTriePruneAndCancelPruneWhileSnapshotInProgressAddsToPruningBuffer(t *te
 	assert.Equal(t, 2, trieStorage.pruningBuffer.len())
 }
 
+func TestTrieStorageManager_StorageStatistics(t *testing.T) {
+	t.Parallel()
+
+	tr, trieStorage, _ := newEmptyTrie()
+	_ = tr.Update([]byte("doe"), []byte("reindeer"))
+	_ = tr.Commit()
+	oldRootHash, _ := tr.Root()
+
+	_ = tr.Update([]byte("doe"), []byte("deer"))
+	_ = tr.Commit()
+
+	tr.EnterSnapshotMode()
+	tr.Prune(oldRootHash, data.OldRoot)
+
+	stats := trieStorage.StorageStatistics()
+	assert.Equal(t, uint32(1), stats.NumSnapshotsInProgress)
+	assert.Equal(t, 1, stats.PruningBufferLen)
+	assert.Equal(t, 0, stats.NumQueuedSnapshots)
+
+	tr.ExitSnapshotMode()
+}
+
 func TestTriePruneOnRollbackWhileSnapshotInProgressCancelsPrune(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,112 @@
+package trie
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// IntegrityReport holds the result of walking a trie directly from its database
+type IntegrityReport struct {
+	NumNodes       uint64
+	NumLeaves      uint64
+	MissingNodes   [][]byte
+	HashMismatches [][]byte
+}
+
+// IsValid returns true if no missing nodes and no hash mismatches were found
+func (ir *IntegrityReport) IsValid() bool {
+	return len(ir.MissingNodes) == 0 && len(ir.HashMismatches) == 0
+}
+
+type nodeToWalk struct {
+	hash   []byte
+	hexKey []byte
+}
+
+// WalkTrie walks all the nodes reachable from the given root hash, reading them directly from the provided database,
+// and reports the nodes that are missing or whose content does not match the hash under which they were stored.
+// The walk does not stop on a faulty node, so the report covers the whole trie. If not nil, the leaf handler
+// is called for each leaf found
+func WalkTrie(
+	rootHash []byte,
+	db data.DBWriteCacher,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	leafHandler func(key []byte, value []byte),
+) (*IntegrityReport, error) {
+	if check.IfNil(db) {
+		return nil, ErrNilDatabase
+	}
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+
+	report := &IntegrityReport{
+		MissingNodes:   make([][]byte, 0),
+		HashMismatches: make([][]byte, 0),
+	}
+	if emptyTrie(rootHash) {
+		return report, nil
+	}
+
+	stack := []nodeToWalk{{hash: rootHash, hexKey: []byte{}}}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		encNode, err := db.Get(current.hash)
+		if err != nil {
+			report.MissingNodes = append(report.MissingNodes, current.hash)
+			continue
+		}
+
+		report.NumNodes++
+		if !bytes.Equal(hasher.Compute(string(encNode)), current.hash) {
+			report.HashMismatches = append(report.HashMismatches, current.hash)
+		}
+
+		decodedNode, err := decodeNode(encNode, marshalizer, hasher)
+		if err != nil {
+			return nil, err
+		}
+
+		switch n := decodedNode.(type) {
+		case *leafNode:
+			report.NumLeaves++
+			if leafHandler == nil {
+				continue
+			}
+			key, errKey := hexToKeyBytes(concat(current.hexKey, n.Key...))
+			if errKey != nil {
+				return nil, errKey
+			}
+			leafHandler(key, n.Value)
+		case *extensionNode:
+			stack = append(stack, nodeToWalk{
+				hash:   n.EncodedChild,
+				hexKey: concat(current.hexKey, n.Key...),
+			})
+		case *branchNode:
+			for i := len(n.EncodedChildren) - 1; i >= 0; i-- {
+				if len(n.EncodedChildren[i]) == 0 {
+					continue
+				}
+				stack = append(stack, nodeToWalk{
+					hash:   n.EncodedChildren[i],
+					hexKey: concat(current.hexKey, byte(i)),
+				})
+			}
+		default:
+			return nil, ErrInvalidNode
+		}
+	}
+
+	return report, nil
+}
@@ -0,0 +1,78 @@
+package trie_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWalkTrie_NilDatabaseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	report, err := trie.WalkTrie([]byte("root"), nil, &mock.ProtobufMarshalizerMock{}, &mock.KeccakMock{}, nil)
+
+	assert.Nil(t, report)
+	assert.Equal(t, trie.ErrNilDatabase, err)
+}
+
+func TestWalkTrie_EmptyTrieShouldReturnEmptyReport(t *testing.T) {
+	t.Parallel()
+
+	report, err := trie.WalkTrie(trie.EmptyTrieHash, mock.NewMemDbMock(), &mock.ProtobufMarshalizerMock{}, &mock.KeccakMock{}, nil)
+
+	require.Nil(t, err)
+	assert.True(t, report.IsValid())
+	assert.Equal(t, uint64(0), report.NumNodes)
+}
+
+func TestWalkTrie_ShouldVisitAllLeaves(t *testing.T) {
+	t.Parallel()
+
+	tr, values := initTrieMultipleValues(100)
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+
+	leaves := make(map[string][]byte)
+	report, err := trie.WalkTrie(rootHash, tr.Database(), &mock.ProtobufMarshalizerMock{}, &mock.KeccakMock{},
+		func(key []byte, value []byte) {
+			leaves[string(key)] = value
+		},
+	)
+
+	require.Nil(t, err)
+	assert.True(t, report.IsValid())
+	assert.Equal(t, uint64(len(values)), report.NumLeaves)
+	assert.True(t, report.NumNodes > report.NumLeaves)
+	for _, val := range values {
+		assert.Equal(t, val, leaves[string(val)])
+	}
+}
+
+func TestWalkTrie_ShouldReportMissingNodesAndHashMismatches(t *testing.T) {
+	t.Parallel()
+
+	tr, values := initTrieMultipleValues(100)
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+	proofA, err := tr.GetProof(values[0])
+	require.Nil(t, err)
+	proofB, err := tr.GetProof(values[1])
+	require.Nil(t, err)
+
+	hasher := &mock.KeccakMock{}
+	db := tr.Database()
+	corruptedNodeHash := hasher.Compute(string(proofA[len(proofA)-1]))
+	missingNodeHash := hasher.Compute(string(proofB[len(proofB)-1]))
+	_ = db.Put(corruptedNodeHash, proofB[len(proofB)-1])
+	_ = db.Remove(missingNodeHash)
+
+	report, err := trie.WalkTrie(rootHash, db, &mock.ProtobufMarshalizerMock{}, hasher, nil)
+
+	require.Nil(t, err)
+	assert.False(t, report.IsValid())
+	assert.Equal(t, [][]byte{corruptedNodeHash}, report.HashMismatches)
+	assert.Equal(t, [][]byte{missingNodeHash}, report.MissingNodes)
+}
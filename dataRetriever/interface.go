@@ -38,6 +38,10 @@ func (ut UnitType) String() string {
 		return "BootstrapUnit"
 	case StatusMetricsUnit:
 		return "StatusMetricsUnit"
+	case AccountHistoryUnit:
+		return "AccountHistoryUnit"
+	case TxPoolSnapshotUnit:
+		return "TxPoolSnapshotUnit"
 	}
 
 	if ut < ShardHdrNonceHashDataUnit {
@@ -72,6 +76,10 @@ const (
 	StatusMetricsUnit UnitType = 10
 	// TxLogsUnit is the status metrics storage unit identifier
 	TxLogsUnit UnitType = 11
+	// AccountHistoryUnit is the account history storage unit identifier
+	AccountHistoryUnit UnitType = 12
+	// TxPoolSnapshotUnit is the transactions pool snapshot storage unit identifier
+	TxPoolSnapshotUnit UnitType = 13
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
@@ -241,6 +249,7 @@ type ShardedDataCacherNotifier interface {
 
 	ShardDataStore(cacheId string) (c storage.Cacher)
 	AddData(key []byte, data interface{}, sizeInBytes int, cacheId string)
+	AddRequestedData(key []byte, data interface{}, sizeInBytes int, cacheId string)
 	SearchFirstData(key []byte) (value interface{}, ok bool)
 	RemoveData(key []byte, cacheId string)
 	RemoveSetOfDataFromPool(keys [][]byte, cacheId string)
@@ -127,6 +127,11 @@ func (sd *shardedData) AddData(key []byte, value interface{}, sizeInBytes int, c
 	}
 }
 
+// AddRequestedData will add data to the corresponding shard store, the same as AddData
+func (sd *shardedData) AddRequestedData(key []byte, value interface{}, sizeInBytes int, cacheId string) {
+	sd.AddData(key, value, sizeInBytes, cacheId)
+}
+
 // SearchFirstData searches the key against all shard data store, retrieving first value found
 func (sd *shardedData) SearchFirstData(key []byte) (value interface{}, ok bool) {
 	sd.mutShardedDataStore.RLock()
@@ -6,6 +6,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 )
 
 // ArgShardedTxPool is the argument for ShardedTxPool's constructor
@@ -35,6 +36,9 @@ func (args *ArgShardedTxPool) verify() error {
 	if config.Shards == 0 {
 		return fmt.Errorf("%w: config.Shards (map chunks) is not valid", dataRetriever.ErrCacheConfigInvalidShards)
 	}
+	if !txcache.IsKnownSelectionPolicy(config.SelectionPolicy) {
+		return fmt.Errorf("%w: config.SelectionPolicy is not valid", dataRetriever.ErrCacheConfigInvalidSelectionPolicy)
+	}
 	if args.MinGasPrice == 0 {
 		return fmt.Errorf("%w: MinGasPrice is not valid", dataRetriever.ErrCacheConfigInvalidEconomics)
 	}
@@ -9,8 +9,15 @@ type txCache interface {
 	storage.Cacher
 
 	AddTx(tx *txcache.WrappedTransaction) (ok bool, added bool)
+	AddRequestedTx(tx *txcache.WrappedTransaction) (ok bool, added bool)
 	GetByTxHash(txHash []byte) (*txcache.WrappedTransaction, bool)
 	RemoveTxByHash(txHash []byte) bool
 	ImmunizeTxsAgainstEviction(keys [][]byte)
 	ForEachTransaction(function txcache.ForEachTransaction)
+	GetStatistics() txcache.CacheStatistics
+	CheckReplacement(tx *txcache.WrappedTransaction) error
+}
+
+type senderInfoProvider interface {
+	GetSenderInfo(sender []byte) (*txcache.SenderInfo, bool)
 }
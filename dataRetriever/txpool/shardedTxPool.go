@@ -1,6 +1,7 @@
 package txpool
 
 import (
+	"sort"
 	"strconv"
 	"sync"
 
@@ -56,6 +57,8 @@ func NewShardedTxPool(args ArgShardedTxPool) (dataRetriever.ShardedDataCacherNot
 		CountPerSenderThreshold:       args.Config.SizePerSender,
 		NumSendersToPreemptivelyEvict: dataRetriever.TxPoolNumSendersToPreemptivelyEvict,
 		MinGasPriceNanoErd:            uint32(args.MinGasPrice / oneBillion),
+		MinGasPriceBumpPercentage:     args.Config.MinGasPriceBumpPercentage,
+		SelectionPolicy:               args.Config.SelectionPolicy,
 	}
 
 	configPrototypeDestinationMe := txcache.ConfigDestinationMe{
@@ -156,16 +159,37 @@ func (txPool *shardedTxPool) ImmunizeSetOfDataAgainstEviction(keys [][]byte, cac
 }
 
 // AddData adds the transaction to the cache
+// A transaction having the same sender and nonce as an existing one replaces it only if it pays sufficiently more
 func (txPool *shardedTxPool) AddData(key []byte, value interface{}, _ int, cacheID string) {
-	valueAsTransaction, ok := value.(data.TransactionHandler)
+	wrapper, ok := wrapTransaction(key, value, cacheID)
+	if !ok {
+		return
+	}
+
+	txPool.addTx(wrapper, cacheID, false)
+}
+
+// AddRequestedData adds a transaction requested by the block processing to the cache
+// Such a transaction is kept along with the transactions having the same sender and nonce, as it might be already included in a block
+func (txPool *shardedTxPool) AddRequestedData(key []byte, value interface{}, _ int, cacheID string) {
+	wrapper, ok := wrapTransaction(key, value, cacheID)
 	if !ok {
 		return
 	}
 
+	txPool.addTx(wrapper, cacheID, true)
+}
+
+func wrapTransaction(key []byte, value interface{}, cacheID string) (*txcache.WrappedTransaction, bool) {
+	valueAsTransaction, ok := value.(data.TransactionHandler)
+	if !ok {
+		return nil, false
+	}
+
 	sourceShardID, destinationShardID, err := process.ParseShardCacherIdentifier(cacheID)
 	if err != nil {
 		log.Error("shardedTxPool.AddData()", "err", err)
-		return
+		return nil, false
 	}
 
 	wrapper := &txcache.WrappedTransaction{
@@ -175,14 +199,20 @@ func (txPool *shardedTxPool) AddData(key []byte, value interface{}, _ int, cache
 		ReceiverShardID: destinationShardID,
 	}
 
-	txPool.addTx(wrapper, cacheID)
+	return wrapper, true
 }
 
 // addTx adds the transaction to the cache
-func (txPool *shardedTxPool) addTx(tx *txcache.WrappedTransaction, cacheID string) {
+func (txPool *shardedTxPool) addTx(tx *txcache.WrappedTransaction, cacheID string, isRequested bool) {
 	shard := txPool.getOrCreateShard(cacheID)
 	cache := shard.Cache
-	_, added := cache.AddTx(tx)
+
+	var added bool
+	if isRequested {
+		_, added = cache.AddRequestedTx(tx)
+	} else {
+		_, added = cache.AddTx(tx)
+	}
 	if added {
 		txPool.onAdded(tx.TxHash, tx)
 	}
@@ -273,7 +303,7 @@ func (txPool *shardedTxPool) MergeShardStores(sourceCacheID, destCacheID string)
 	sourceCache := sourceShard.Cache
 
 	sourceCache.ForEachTransaction(func(txHash []byte, tx *txcache.WrappedTransaction) {
-		txPool.addTx(tx, destCacheID)
+		txPool.addTx(tx, destCacheID, true)
 	})
 
 	txPool.mutexBackingMap.Lock()
@@ -325,6 +355,85 @@ func (txPool *shardedTxPool) GetCounts() counting.Counts {
 	return counts
 }
 
+// GetCachesStatistics returns the size of each cache of the pool, sorted by the cache identifier
+func (txPool *shardedTxPool) GetCachesStatistics() []txcache.CacheStatistics {
+	txPool.mutexBackingMap.RLock()
+	defer txPool.mutexBackingMap.RUnlock()
+
+	statistics := make([]txcache.CacheStatistics, 0, len(txPool.backingMap))
+	for cacheID, shard := range txPool.backingMap {
+		cacheStatistics := shard.Cache.GetStatistics()
+		cacheStatistics.Name = cacheID
+		statistics = append(statistics, cacheStatistics)
+	}
+
+	sort.Slice(statistics, func(i, j int) bool {
+		return statistics[i].Name < statistics[j].Name
+	})
+
+	return statistics
+}
+
+// GetSenderInfo returns a snapshot of the transactions of the given sender. Only the senders from the self shard
+// are tracked, since the cross shard transactions are held in caches without a per sender structure
+func (txPool *shardedTxPool) GetSenderInfo(sender []byte) (*txcache.SenderInfo, bool) {
+	cache := txPool.getTxCache(strconv.Itoa(int(txPool.selfShardID)))
+	provider, ok := cache.(senderInfoProvider)
+	if !ok {
+		return nil, false
+	}
+
+	return provider.GetSenderInfo(sender)
+}
+
+// GetWrappedTransaction searches the transaction in all the caches of the pool and returns it along with the
+// identifier of the cache holding it
+func (txPool *shardedTxPool) GetWrappedTransaction(txHash []byte) (*txcache.WrappedTransaction, string, bool) {
+	txPool.mutexBackingMap.RLock()
+	defer txPool.mutexBackingMap.RUnlock()
+
+	for cacheID, shard := range txPool.backingMap {
+		tx, ok := shard.Cache.GetByTxHash(txHash)
+		if ok {
+			return tx, cacheID, true
+		}
+	}
+
+	return nil, "", false
+}
+
+// ForEachTransaction iterates over the transactions in all the caches of the pool
+func (txPool *shardedTxPool) ForEachTransaction(function txcache.ForEachTransaction) {
+	txPool.mutexBackingMap.RLock()
+	caches := make([]txCache, 0, len(txPool.backingMap))
+	for _, shard := range txPool.backingMap {
+		caches = append(caches, shard.Cache)
+	}
+	txPool.mutexBackingMap.RUnlock()
+
+	for _, cache := range caches {
+		cache.ForEachTransaction(function)
+	}
+}
+
+// CheckReplacement verifies whether the given transaction would be accepted by the cache identified by cacheID,
+// with respect to the transaction having the same sender and nonce, if any
+func (txPool *shardedTxPool) CheckReplacement(tx data.TransactionHandler, txHash []byte, cacheID string) error {
+	sourceShardID, destinationShardID, err := process.ParseShardCacherIdentifier(cacheID)
+	if err != nil {
+		return err
+	}
+
+	wrapper := &txcache.WrappedTransaction{
+		Tx:              tx,
+		TxHash:          txHash,
+		SenderShardID:   sourceShardID,
+		ReceiverShardID: destinationShardID,
+	}
+
+	return txPool.getTxCache(cacheID).CheckReplacement(wrapper)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (txPool *shardedTxPool) IsInterfaceNil() bool {
 	return txPool == nil
@@ -1,6 +1,7 @@
 package txpool
 
 import (
+	"errors"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -11,7 +12,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/stretchr/testify/require"
 )
 
@@ -71,6 +74,12 @@ func Test_NewShardedTxPool_WhenBadConfig(t *testing.T) {
 	require.NotNil(t, err)
 	require.Errorf(t, err, dataRetriever.ErrCacheConfigInvalidShards.Error())
 
+	args = goodArgs
+	args.Config.SelectionPolicy = "foo"
+	pool, err = NewShardedTxPool(args)
+	require.Nil(t, pool)
+	require.True(t, errors.Is(err, dataRetriever.ErrCacheConfigInvalidSelectionPolicy))
+
 	args = goodArgs
 	args.MinGasPrice = 0
 	pool, err = NewShardedTxPool(args)
@@ -197,8 +206,9 @@ func Test_SearchFirstData(t *testing.T) {
 	pool := poolAsInterface.(*shardedTxPool)
 
 	tx := createTx("alice", 42)
+	txNext := createTx("alice", 43)
 	pool.AddData([]byte("hash-x"), tx, 0, "0")
-	pool.AddData([]byte("hash-y"), tx, 0, "0_1")
+	pool.AddData([]byte("hash-y"), txNext, 0, "0_1")
 	pool.AddData([]byte("hash-z"), tx, 0, "2_3")
 
 	foundTx, ok := pool.SearchFirstData([]byte("hash-x"))
@@ -207,7 +217,7 @@ func Test_SearchFirstData(t *testing.T) {
 
 	foundTx, ok = pool.SearchFirstData([]byte("hash-y"))
 	require.True(t, ok)
-	require.Equal(t, tx, foundTx)
+	require.Equal(t, txNext, foundTx)
 
 	foundTx, ok = pool.SearchFirstData([]byte("hash-z"))
 	require.True(t, ok)
@@ -319,6 +329,95 @@ func Test_GetCounts(t *testing.T) {
 	require.Equal(t, int64(0), pool.GetCounts().GetTotal())
 }
 
+func Test_GetCachesStatistics(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+
+	pool.AddData([]byte("hash-x"), createTx("alice", 42), 0, "0")
+	pool.AddData([]byte("hash-y"), createTx("bob", 43), 0, "0_1")
+	pool.AddData([]byte("hash-z"), createTx("carol", 15), 0, "2_0")
+
+	statistics := pool.GetCachesStatistics()
+	require.Len(t, statistics, 2)
+	require.Equal(t, "0", statistics[0].Name)
+	require.Equal(t, uint64(2), statistics[0].NumTxs)
+	require.Equal(t, uint64(2), statistics[0].NumSenders)
+	require.True(t, statistics[0].NumBytes > 0)
+	require.Equal(t, "2_0", statistics[1].Name)
+	require.Equal(t, uint64(1), statistics[1].NumTxs)
+}
+
+func Test_GetSenderInfo(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+
+	pool.AddData([]byte("hash-x"), createTx("alice", 42), 0, "0")
+	pool.AddData([]byte("hash-y"), createTx("alice", 44), 0, "0_1")
+	pool.AddData([]byte("hash-z"), createTx("bob", 15), 0, "2_0")
+
+	info, ok := pool.GetSenderInfo([]byte("alice"))
+	require.True(t, ok)
+	require.Len(t, info.Transactions, 2)
+	require.Equal(t, []txcache.NonceGap{{From: 43, To: 43}}, info.NonceGaps)
+
+	_, ok = pool.GetSenderInfo([]byte("bob"))
+	require.False(t, ok)
+}
+
+func Test_CheckReplacement(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+
+	pool.AddData([]byte("hash-x"), &transaction.Transaction{SndAddr: []byte("alice"), Nonce: 42, GasPrice: 100}, 0, "0")
+	pool.AddData([]byte("hash-z"), &transaction.Transaction{SndAddr: []byte("bob"), Nonce: 15, GasPrice: 100}, 0, "2_0")
+
+	err := pool.CheckReplacement(&transaction.Transaction{SndAddr: []byte("alice"), Nonce: 42, GasPrice: 100}, []byte("hash-y"), "0")
+	require.True(t, errors.Is(err, storage.ErrInsufficientGasPriceBump))
+
+	err = pool.CheckReplacement(&transaction.Transaction{SndAddr: []byte("alice"), Nonce: 42, GasPrice: 101}, []byte("hash-y"), "0")
+	require.Nil(t, err)
+
+	// Transactions from other shards are not tracked by sender
+	err = pool.CheckReplacement(&transaction.Transaction{SndAddr: []byte("bob"), Nonce: 15, GasPrice: 100}, []byte("hash-w"), "2_0")
+	require.Nil(t, err)
+
+	err = pool.CheckReplacement(&transaction.Transaction{SndAddr: []byte("alice"), Nonce: 42}, []byte("hash-y"), "foo")
+	require.NotNil(t, err)
+}
+
+func Test_GetWrappedTransaction(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+
+	tx := createTx("alice", 42)
+	pool.AddData([]byte("hash-x"), tx, 0, "2_0")
+
+	wrappedTx, cacheID, ok := pool.GetWrappedTransaction([]byte("hash-x"))
+	require.True(t, ok)
+	require.Equal(t, "2_0", cacheID)
+	require.Equal(t, tx, wrappedTx.Tx)
+	require.Equal(t, uint32(2), wrappedTx.SenderShardID)
+
+	_, _, ok = pool.GetWrappedTransaction([]byte("hash-y"))
+	require.False(t, ok)
+}
+
+func Test_ForEachTransaction(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+
+	pool.AddData([]byte("hash-x"), createTx("alice", 42), 0, "0")
+	pool.AddData([]byte("hash-y"), createTx("bob", 43), 0, "0_1")
+	pool.AddData([]byte("hash-z"), createTx("carol", 15), 0, "2_0")
+
+	visited := make(map[string]uint32)
+	pool.ForEachTransaction(func(txHash []byte, tx *txcache.WrappedTransaction) {
+		visited[string(txHash)] = tx.SenderShardID
+	})
+
+	require.Equal(t, map[string]uint32{"hash-x": 0, "hash-y": 0, "hash-z": 2}, visited)
+}
+
 func Test_IsInterfaceNil(t *testing.T) {
 	poolAsInterface, _ := newTxPoolToTest()
 	require.False(t, check.IfNil(poolAsInterface))
@@ -0,0 +1,45 @@
+package consensusTrace
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+type disabledRoundTracer struct {
+}
+
+// NewDisabledRoundTracer returns a disabled instance of the round tracer
+func NewDisabledRoundTracer() *disabledRoundTracer {
+	return &disabledRoundTracer{}
+}
+
+// StartRound does nothing
+func (drt *disabledRoundTracer) StartRound(_ int64, _ string, _ []string) {
+}
+
+// AddSubround does nothing
+func (drt *disabledRoundTracer) AddSubround(_ int64, _ consensus.SubroundTrace) {
+}
+
+// AddReceivedMessage does nothing
+func (drt *disabledRoundTracer) AddReceivedMessage(_ int64, _ string, _ []byte, _ time.Duration) {
+}
+
+// SetMissingSigners does nothing
+func (drt *disabledRoundTracer) SetMissingSigners(_ int64, _ []string) {
+}
+
+// EndRound does nothing
+func (drt *disabledRoundTracer) EndRound(_ int64, _ string) {
+}
+
+// Query returns an empty slice
+func (drt *disabledRoundTracer) Query(_ string) []string {
+	return make([]string, 0)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (drt *disabledRoundTracer) IsInterfaceNil() bool {
+	return drt == nil
+}
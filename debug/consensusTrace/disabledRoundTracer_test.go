@@ -0,0 +1,23 @@
+package consensusTrace
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledRoundTracer(t *testing.T) {
+	t.Parallel()
+
+	drt := NewDisabledRoundTracer()
+	assert.False(t, check.IfNil(drt))
+
+	drt.StartRound(0, "", nil)
+	drt.AddSubround(0, consensus.SubroundTrace{})
+	drt.AddReceivedMessage(0, "", nil, 0)
+	drt.SetMissingSigners(0, nil)
+	drt.EndRound(0, "")
+	assert.Equal(t, 0, len(drt.Query("*")))
+}
@@ -0,0 +1,222 @@
+package consensusTrace
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/debug"
+)
+
+const minRoundsToKeep = 1
+const queryAll = "*"
+const queryFailed = "failed"
+
+// OutcomeFinished is the outcome of a round in which all the subrounds finished in time
+const OutcomeFinished = "finished"
+
+type receivedMessage struct {
+	msgType         string
+	pubKey          string
+	sinceRoundStart time.Duration
+}
+
+type roundTrace struct {
+	round          int64
+	leader         string
+	consensusGroup []string
+	subrounds      []consensus.SubroundTrace
+	messages       []receivedMessage
+	missingSigners []string
+	outcome        string
+}
+
+func (rt *roundTrace) isFailed() bool {
+	return len(rt.outcome) > 0 && rt.outcome != OutcomeFinished
+}
+
+func (rt *roundTrace) lines() []string {
+	outcome := rt.outcome
+	if len(outcome) == 0 {
+		outcome = "in progress"
+	}
+
+	lines := []string{
+		fmt.Sprintf("round %d: leader: %s; outcome: %s", rt.round, rt.leader, outcome),
+		fmt.Sprintf("    consensus group: %s", strings.Join(rt.consensusGroup, ", ")),
+	}
+
+	for _, sr := range rt.subrounds {
+		status := "finished"
+		if !sr.IsFinished {
+			status = "not finished"
+		}
+		if sr.Ended > sr.ConfiguredEnd {
+			status += ", deadline exceeded"
+		}
+
+		lines = append(lines, fmt.Sprintf("    subround %s: started at %v, ended at %v, took %v; deadlines: %v - %v; %s",
+			sr.Name, sr.Started, sr.Ended, sr.Ended-sr.Started, sr.ConfiguredStart, sr.ConfiguredEnd, status))
+	}
+
+	for _, msg := range rt.messages {
+		lines = append(lines, fmt.Sprintf("    message %s from %s received at %v", msg.msgType, msg.pubKey, msg.sinceRoundStart))
+	}
+
+	if len(rt.missingSigners) > 0 {
+		lines = append(lines, fmt.Sprintf("    missing signatures: %s", strings.Join(rt.missingSigners, ", ")))
+	}
+
+	return lines
+}
+
+type roundTracer struct {
+	mut       sync.RWMutex
+	traces    []*roundTrace
+	nextIndex int
+}
+
+// NewRoundTracer creates a new round tracer able to keep the traces of the last configured number of rounds
+func NewRoundTracer(config config.ConsensusDebugConfig) (*roundTracer, error) {
+	if config.NumRoundsToKeep < minRoundsToKeep {
+		return nil, fmt.Errorf("%w for NumRoundsToKeep, minimum is %d", debug.ErrInvalidValue, minRoundsToKeep)
+	}
+
+	return &roundTracer{
+		traces: make([]*roundTrace, config.NumRoundsToKeep),
+	}, nil
+}
+
+// getOrCreateTrace returns the trace of the provided round, overwriting the oldest trace from the ring buffer
+// if the round is not traced yet. Should be called under mutex protection
+func (rt *roundTracer) getOrCreateTrace(round int64) *roundTrace {
+	for _, trace := range rt.traces {
+		if trace != nil && trace.round == round {
+			return trace
+		}
+	}
+
+	trace := &roundTrace{
+		round: round,
+	}
+	rt.traces[rt.nextIndex] = trace
+	rt.nextIndex = (rt.nextIndex + 1) % len(rt.traces)
+
+	return trace
+}
+
+// StartRound records the leader and the consensus group of the provided round
+func (rt *roundTracer) StartRound(round int64, leader string, consensusGroup []string) {
+	group := make([]string, 0, len(consensusGroup))
+	for _, pubKey := range consensusGroup {
+		group = append(group, displayPubKey([]byte(pubKey)))
+	}
+
+	rt.mut.Lock()
+	defer rt.mut.Unlock()
+
+	trace := rt.getOrCreateTrace(round)
+	trace.leader = displayPubKey([]byte(leader))
+	trace.consensusGroup = group
+}
+
+// AddSubround records the timings of a subround of the provided round
+func (rt *roundTracer) AddSubround(round int64, subround consensus.SubroundTrace) {
+	rt.mut.Lock()
+	defer rt.mut.Unlock()
+
+	trace := rt.getOrCreateTrace(round)
+	trace.subrounds = append(trace.subrounds, subround)
+}
+
+// AddReceivedMessage records the moment, relative to the round start, a consensus message has been received
+func (rt *roundTracer) AddReceivedMessage(round int64, msgType string, pubKey []byte, sinceRoundStart time.Duration) {
+	msg := receivedMessage{
+		msgType:         msgType,
+		pubKey:          displayPubKey(pubKey),
+		sinceRoundStart: sinceRoundStart,
+	}
+
+	rt.mut.Lock()
+	defer rt.mut.Unlock()
+
+	trace := rt.getOrCreateTrace(round)
+	trace.messages = append(trace.messages, msg)
+}
+
+// SetMissingSigners records the consensus group members whose signatures were not received in the provided round
+func (rt *roundTracer) SetMissingSigners(round int64, pubKeys []string) {
+	missingSigners := make([]string, 0, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		missingSigners = append(missingSigners, displayPubKey([]byte(pubKey)))
+	}
+
+	rt.mut.Lock()
+	defer rt.mut.Unlock()
+
+	trace := rt.getOrCreateTrace(round)
+	trace.missingSigners = missingSigners
+}
+
+// EndRound records the outcome of the provided round
+func (rt *roundTracer) EndRound(round int64, outcome string) {
+	rt.mut.Lock()
+	defer rt.mut.Unlock()
+
+	trace := rt.getOrCreateTrace(round)
+	trace.outcome = outcome
+}
+
+// Query returns the traces of the rounds matching the search. The search can be "*" for all the kept rounds,
+// "failed" for the rounds which did not finish or a round number
+func (rt *roundTracer) Query(search string) []string {
+	acceptTrace := func(trace *roundTrace) bool {
+		switch search {
+		case queryAll:
+			return true
+		case queryFailed:
+			return trace.isFailed()
+		default:
+			return strconv.FormatInt(trace.round, 10) == search
+		}
+	}
+
+	rt.mut.RLock()
+	defer rt.mut.RUnlock()
+
+	traces := make([]*roundTrace, 0, len(rt.traces))
+	for _, trace := range rt.traces {
+		if trace == nil || !acceptTrace(trace) {
+			continue
+		}
+
+		traces = append(traces, trace)
+	}
+
+	sort.Slice(traces, func(i, j int) bool {
+		return traces[i].round < traces[j].round
+	})
+
+	lines := make([]string, 0)
+	for _, trace := range traces {
+		lines = append(lines, trace.lines()...)
+	}
+
+	return lines
+}
+
+func displayPubKey(pubKey []byte) string {
+	return core.GetTrimmedPk(hex.EncodeToString(pubKey))
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rt *roundTracer) IsInterfaceNil() bool {
+	return rt == nil
+}
@@ -0,0 +1,150 @@
+package consensusTrace
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/debug"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createRoundTracer(numRoundsToKeep int) *roundTracer {
+	rt, _ := NewRoundTracer(config.ConsensusDebugConfig{
+		Enabled:         true,
+		NumRoundsToKeep: numRoundsToKeep,
+	})
+
+	return rt
+}
+
+func containsLine(lines []string, substrings ...string) bool {
+	for _, line := range lines {
+		containsAll := true
+		for _, substring := range substrings {
+			containsAll = containsAll && strings.Contains(line, substring)
+		}
+
+		if containsAll {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestNewRoundTracer_InvalidNumRoundsToKeepShouldError(t *testing.T) {
+	t.Parallel()
+
+	rt, err := NewRoundTracer(config.ConsensusDebugConfig{
+		NumRoundsToKeep: 0,
+	})
+
+	assert.True(t, check.IfNil(rt))
+	assert.True(t, errors.Is(err, debug.ErrInvalidValue))
+}
+
+func TestNewRoundTracer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	rt, err := NewRoundTracer(config.ConsensusDebugConfig{
+		NumRoundsToKeep: 10,
+	})
+
+	assert.False(t, check.IfNil(rt))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(rt.Query("*")))
+}
+
+func TestRoundTracer_QueryShouldReturnTheWholeRound(t *testing.T) {
+	t.Parallel()
+
+	rt := createRoundTracer(10)
+	rt.StartRound(5, "leader", []string{"leader", "validator"})
+	rt.AddSubround(5, consensus.SubroundTrace{
+		Name:            "(BLOCK)",
+		ConfiguredStart: 100 * time.Millisecond,
+		ConfiguredEnd:   200 * time.Millisecond,
+		Started:         110 * time.Millisecond,
+		Ended:           150 * time.Millisecond,
+		IsFinished:      true,
+	})
+	rt.AddSubround(5, consensus.SubroundTrace{
+		Name:            "(SIGNATURE)",
+		ConfiguredStart: 200 * time.Millisecond,
+		ConfiguredEnd:   300 * time.Millisecond,
+		Started:         150 * time.Millisecond,
+		Ended:           400 * time.Millisecond,
+		IsFinished:      false,
+	})
+	rt.AddReceivedMessage(5, "(SIGNATURE)", []byte("validator"), 250*time.Millisecond)
+	rt.SetMissingSigners(5, []string{"validator"})
+	rt.EndRound(5, "canceled in subround (SIGNATURE)")
+
+	lines := rt.Query("5")
+	require.Equal(t, 6, len(lines))
+
+	leader := displayPubKey([]byte("leader"))
+	validator := displayPubKey([]byte("validator"))
+	assert.True(t, containsLine(lines, "round 5", leader, "canceled in subround (SIGNATURE)"))
+	assert.True(t, containsLine(lines, "consensus group", leader, validator))
+	assert.True(t, containsLine(lines, "subround (BLOCK)", "took 40ms", "finished"))
+	assert.True(t, containsLine(lines, "subround (SIGNATURE)", "not finished", "deadline exceeded"))
+	assert.True(t, containsLine(lines, "message (SIGNATURE)", validator, "250ms"))
+	assert.True(t, containsLine(lines, "missing signatures", validator))
+}
+
+func TestRoundTracer_MessagesBeforeStartRoundShouldBeKept(t *testing.T) {
+	t.Parallel()
+
+	rt := createRoundTracer(10)
+	rt.AddReceivedMessage(7, "(BLOCK_BODY)", []byte("leader"), -10*time.Millisecond)
+	rt.StartRound(7, "leader", []string{"leader"})
+
+	lines := rt.Query("7")
+	assert.True(t, containsLine(lines, "round 7", displayPubKey([]byte("leader"))))
+	assert.True(t, containsLine(lines, "message (BLOCK_BODY)", "-10ms"))
+}
+
+func TestRoundTracer_QueryFailedShouldReturnOnlyTheUnfinishedRounds(t *testing.T) {
+	t.Parallel()
+
+	rt := createRoundTracer(10)
+	rt.StartRound(1, "leader", nil)
+	rt.EndRound(1, OutcomeFinished)
+	rt.StartRound(2, "leader", nil)
+	rt.EndRound(2, "canceled in subround (BLOCK)")
+	rt.StartRound(3, "leader", nil)
+
+	lines := rt.Query("failed")
+	assert.True(t, containsLine(lines, "round 2"))
+	assert.False(t, containsLine(lines, "round 1"))
+	assert.False(t, containsLine(lines, "round 3"))
+
+	lines = rt.Query("*")
+	assert.True(t, containsLine(lines, "round 1", OutcomeFinished))
+	assert.True(t, containsLine(lines, "round 2"))
+	assert.True(t, containsLine(lines, "round 3", "in progress"))
+}
+
+func TestRoundTracer_ShouldKeepOnlyTheLastRounds(t *testing.T) {
+	t.Parallel()
+
+	numRoundsToKeep := 3
+	rt := createRoundTracer(numRoundsToKeep)
+	for round := int64(1); round <= 5; round++ {
+		rt.StartRound(round, "leader", nil)
+	}
+
+	lines := rt.Query("*")
+	assert.Equal(t, numRoundsToKeep*2, len(lines))
+	assert.False(t, containsLine(lines, "round 1:"))
+	assert.False(t, containsLine(lines, "round 2:"))
+	assert.True(t, strings.HasPrefix(lines[0], "round 3:"))
+	assert.True(t, strings.HasPrefix(lines[4], "round 5:"))
+}
@@ -0,0 +1,16 @@
+package factory
+
+import (
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/debug/consensusTrace"
+)
+
+// NewConsensusRoundTracerFactory will instantiate a consensus RoundTracer based on the provided config
+func NewConsensusRoundTracerFactory(config config.ConsensusDebugConfig) (consensus.RoundTracer, error) {
+	if !config.Enabled {
+		return consensusTrace.NewDisabledRoundTracer(), nil
+	}
+
+	return consensusTrace.NewRoundTracer(config)
+}
@@ -0,0 +1,41 @@
+package factory
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/debug/consensusTrace"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewConsensusRoundTracerFactory_DisabledShouldWork(t *testing.T) {
+	t.Parallel()
+
+	rt, err := NewConsensusRoundTracerFactory(
+		config.ConsensusDebugConfig{
+			Enabled: false,
+		},
+	)
+
+	assert.Nil(t, err)
+	expected := consensusTrace.NewDisabledRoundTracer()
+	assert.IsType(t, expected, rt)
+}
+
+func TestNewConsensusRoundTracerFactory_RoundTracer(t *testing.T) {
+	t.Parallel()
+
+	rt, err := NewConsensusRoundTracerFactory(
+		config.ConsensusDebugConfig{
+			Enabled:         true,
+			NumRoundsToKeep: 100,
+		},
+	)
+
+	assert.Nil(t, err)
+	expected, _ := consensusTrace.NewRoundTracer(config.ConsensusDebugConfig{
+		Enabled:         false,
+		NumRoundsToKeep: 1,
+	})
+	assert.IsType(t, expected, rt)
+}
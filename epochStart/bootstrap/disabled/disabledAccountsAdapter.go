@@ -89,6 +89,11 @@ func (a *accountsAdapter) GetAllLeaves(_ []byte) (map[string][]byte, error) {
 	return nil, nil
 }
 
+// GetProof -
+func (a *accountsAdapter) GetProof(_ []byte) ([][]byte, error) {
+	return nil, nil
+}
+
 // RecreateAllTries -
 func (a *accountsAdapter) RecreateAllTries(_ []byte) (map[string]data.Trie, error) {
 	return nil, nil
@@ -129,14 +129,16 @@ func (rc *rewardsCreator) CreateRewardsMiniBlocks(metaBlock *block.MetaBlock, va
 
 	rc.clean()
 
-	miniBlocks := make(block.MiniBlockSlice, rc.shardCoordinator.NumberOfShards())
-	for i := uint32(0); i < rc.shardCoordinator.NumberOfShards(); i++ {
+	// the last miniblock holds the rewards of the metachain addresses, e.g. of the delegation pools
+	miniBlocks := make(block.MiniBlockSlice, rc.shardCoordinator.NumberOfShards()+1)
+	for i := uint32(0); i <= rc.shardCoordinator.NumberOfShards(); i++ {
 		miniBlocks[i] = &block.MiniBlock{}
 		miniBlocks[i].SenderShardID = core.MetachainShardId
 		miniBlocks[i].ReceiverShardID = i
 		miniBlocks[i].Type = block.RewardsBlock
 		miniBlocks[i].TxHashes = make([][]byte, 0)
 	}
+	miniBlocks[rc.shardCoordinator.NumberOfShards()].ReceiverShardID = core.MetachainShardId
 
 	err := rc.addCommunityRewardToMiniBlocks(miniBlocks, metaBlock)
 	if err != nil {
@@ -149,16 +151,17 @@ func (rc *rewardsCreator) CreateRewardsMiniBlocks(metaBlock *block.MetaBlock, va
 		return nil, err
 	}
 
-	for shId := uint32(0); shId < rc.shardCoordinator.NumberOfShards(); shId++ {
-		sort.Slice(miniBlocks[shId].TxHashes, func(i, j int) bool {
-			return bytes.Compare(miniBlocks[shId].TxHashes[i], miniBlocks[shId].TxHashes[j]) < 0
+	for _, miniBlock := range miniBlocks {
+		txHashes := miniBlock.TxHashes
+		sort.Slice(txHashes, func(i, j int) bool {
+			return bytes.Compare(txHashes[i], txHashes[j]) < 0
 		})
 	}
 
 	finalMiniBlocks := make(block.MiniBlockSlice, 0)
-	for i := uint32(0); i < rc.shardCoordinator.NumberOfShards(); i++ {
-		if len(miniBlocks[i].TxHashes) > 0 {
-			finalMiniBlocks = append(finalMiniBlocks, miniBlocks[i])
+	for _, miniBlock := range miniBlocks {
+		if len(miniBlock.TxHashes) > 0 {
+			finalMiniBlocks = append(finalMiniBlocks, miniBlock)
 		}
 	}
 
@@ -207,13 +210,22 @@ func (rc *rewardsCreator) addValidatorRewardsToMiniBlocks(
 
 		rc.currTxs.AddTx(rwdTxHash, rwdTx)
 
-		shardId := rc.shardCoordinator.ComputeId([]byte(rwdInfo.address))
-		miniBlocks[shardId].TxHashes = append(miniBlocks[shardId].TxHashes, rwdTxHash)
+		mbIndex := rc.getMiniBlockIndex(rc.shardCoordinator.ComputeId([]byte(rwdInfo.address)))
+		miniBlocks[mbIndex].TxHashes = append(miniBlocks[mbIndex].TxHashes, rwdTxHash)
 	}
 
 	return nil
 }
 
+// getMiniBlockIndex returns the index of the rewards miniblock for the given shard, the metachain one being the last
+func (rc *rewardsCreator) getMiniBlockIndex(shardID uint32) uint32 {
+	if shardID == core.MetachainShardId {
+		return rc.shardCoordinator.NumberOfShards()
+	}
+
+	return shardID
+}
+
 func (rc *rewardsCreator) addCommunityRewardToMiniBlocks(
 	miniBlocks block.MiniBlockSlice,
 	epochStartMetablock *block.MetaBlock,
@@ -227,7 +239,9 @@ func (rc *rewardsCreator) addCommunityRewardToMiniBlocks(
 	}
 
 	rc.currTxs.AddTx(rwdTxHash, rwdTx)
-	miniBlocks[shardId].TxHashes = append(miniBlocks[shardId].TxHashes, rwdTxHash)
+
+	mbIndex := rc.getMiniBlockIndex(shardId)
+	miniBlocks[mbIndex].TxHashes = append(miniBlocks[mbIndex].TxHashes, rwdTxHash)
 
 	return nil
 }
@@ -363,6 +377,10 @@ func (rc *rewardsCreator) CreateMarshalizedData(body *block.Body) map[string][][
 		if miniBlock.Type != block.RewardsBlock {
 			continue
 		}
+		// the rewards of the metachain addresses are created by each metachain node
+		if miniBlock.ReceiverShardID == core.MetachainShardId {
+			continue
+		}
 
 		broadcastTopic := createBroadcastTopic(rc.shardCoordinator, miniBlock.ReceiverShardID)
 		if _, ok := mrsTxs[broadcastTopic]; !ok {
@@ -1,6 +1,7 @@
 package metachain
 
 import (
+	"bytes"
 	"math/big"
 	"testing"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/epochStart/mock"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewEpochStartRewardsCreator_NilShardCoordinator(t *testing.T) {
@@ -545,6 +547,59 @@ func TestRewardsCreator_addValidatorRewardsToMiniBlocks(t *testing.T) {
 	assert.Equal(t, cloneMb, miniBlocks[0])
 }
 
+func TestRewardsCreator_CreateRewardsMiniBlocksMetachainAddressShouldCreateMetachainMiniBlock(t *testing.T) {
+	t.Parallel()
+
+	metachainAddress := []byte("metachain address")
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if bytes.Equal(address, metachainAddress) {
+			return core.MetachainShardId
+		}
+		return 0
+	}
+
+	args := getRewardsArguments()
+	args.ShardCoordinator = shardCoordinator
+	rwdc, _ := NewEpochStartRewardsCreator(args)
+
+	mb := &block.MetaBlock{
+		EpochStart: getDefaultEpochStart(),
+	}
+	valInfo := make(map[uint32][]*state.ValidatorInfo)
+	valInfo[0] = []*state.ValidatorInfo{
+		{
+			PublicKey:       []byte("pubkey"),
+			RewardAddress:   metachainAddress,
+			ShardId:         0,
+			AccumulatedFees: big.NewInt(100),
+		},
+	}
+
+	miniBlocks, err := rwdc.CreateRewardsMiniBlocks(mb, valInfo)
+	assert.Nil(t, err)
+
+	var metachainMiniBlock *block.MiniBlock
+	for _, miniBlock := range miniBlocks {
+		if miniBlock.ReceiverShardID == core.MetachainShardId {
+			metachainMiniBlock = miniBlock
+		}
+	}
+	require.NotNil(t, metachainMiniBlock)
+	assert.Equal(t, core.MetachainShardId, metachainMiniBlock.SenderShardID)
+	assert.Equal(t, block.RewardsBlock, metachainMiniBlock.Type)
+	require.Equal(t, 1, len(metachainMiniBlock.TxHashes))
+
+	rwdTxs := rwdc.GetRewardsTxs(&block.Body{MiniBlocks: miniBlocks})
+	rwdTx, ok := rwdTxs[string(metachainMiniBlock.TxHashes[0])].(*rewardTx.RewardTx)
+	require.True(t, ok)
+	assert.Equal(t, metachainAddress, rwdTx.RcvAddr)
+	assert.Equal(t, big.NewInt(100), rwdTx.Value)
+
+	marshalizedData := rwdc.CreateMarshalizedData(&block.Body{MiniBlocks: []*block.MiniBlock{metachainMiniBlock}})
+	assert.Equal(t, 0, len(marshalizedData))
+}
+
 func TestRewardsCreator_ProtocolRewardsForValidatorFromMultipleShards(t *testing.T) {
 	t.Parallel()
 
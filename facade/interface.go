@@ -4,12 +4,14 @@ import (
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/events"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
@@ -18,8 +20,8 @@ type NodeHandler interface {
 	// StartConsensus will start the consesus service for the current node
 	StartConsensus() error
 
-	//GetBalance returns the balance for a specific address
-	GetBalance(address string) (*big.Int, error)
+	//GetBalance returns the balance for a specific address, in the state selected by the query options
+	GetBalance(address string, options state.QueryOptions) (*big.Int, error)
 
 	// GetValueForKey returns the value of a key from a given account
 	GetValueForKey(address string, key string) (string, error)
@@ -40,9 +42,39 @@ type NodeHandler interface {
 	//GetTransactionStatus gets the transaction status
 	GetTransactionStatus(hash string) (string, error)
 
+	// GetTransactionsPool returns the number of transactions and bytes held in each cache of the transactions pool
+	GetTransactionsPool() (*transaction.ApiTransactionsPool, error)
+
+	// GetTransactionsPoolForSender returns the transactions of a sender held in the transactions pool
+	GetTransactionsPoolForSender(address string) (*transaction.ApiTransactionsPoolForSender, error)
+
+	// GetTransactionFromPool returns a transaction held in the transactions pool
+	GetTransactionFromPool(hash string) (*transaction.ApiTransactionInPool, error)
+
 	// GetAccount returns an accountResponse containing information
-	//  about the account corelated with provided address
-	GetAccount(address string) (state.UserAccountHandler, error)
+	//  about the account corelated with provided address, in the state selected by the query options
+	GetAccount(address string, options state.QueryOptions) (state.UserAccountHandler, error)
+
+	// GetStateRootHash returns the root hash of the state selected by the query options
+	GetStateRootHash(options state.QueryOptions) ([]byte, error)
+
+	// GetProof returns the Merkle inclusion proof of the account with the provided address
+	GetProof(address string, options state.QueryOptions) (*state.ApiProof, error)
+
+	// GetProofDataTrie returns the Merkle inclusion proofs of the account and of the key in the account's data trie
+	GetProofDataTrie(address string, key string, options state.QueryOptions) (*state.ApiProof, *state.ApiProof, error)
+
+	// GetTransactionsForAddress returns the transactions which touched the provided address, newest first
+	GetTransactionsForAddress(address string, from uint32, size uint32) (*transaction.ApiAccountHistory, error)
+
+	// SubscribeEvents creates a subscription for the events selected by the filter
+	SubscribeEvents(filter events.Filter) (events.Subscription, error)
+
+	// GetBlockByNonce returns the self shard block with the provided nonce
+	GetBlockByNonce(nonce uint64, withTxs bool) (*block.ApiBlock, error)
+
+	// GetBlockByHash returns the self shard block with the provided hex encoded hash
+	GetBlockByHash(hash string, withTxs bool) (*block.ApiBlock, error)
 
 	// GetHeartbeats returns the heartbeat status for each public key defined in genesis.json
 	GetHeartbeats() []data.PubKeyHeartbeat
@@ -57,6 +89,7 @@ type NodeHandler interface {
 
 	EncodeAddressPubkey(pk []byte) (string, error)
 	DecodeAddressPubkey(pk string) ([]byte, error)
+	GetQueryGasLimit() uint64
 
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
@@ -66,7 +99,9 @@ type NodeHandler interface {
 type ApiResolver interface {
 	ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64, error)
+	SimulateTransaction(tx *transaction.Transaction) (*transaction.SimulationResults, error)
 	StatusMetrics() external.StatusMetricsHandler
+	PrometheusMetrics() string
 	IsInterfaceNil() bool
 }
 
@@ -12,6 +12,8 @@ type ApiResolverStub struct {
 	ExecuteSCQueryHandler             func(query *process.SCQuery) (*vmcommon.VMOutput, error)
 	StatusMetricsHandler              func() external.StatusMetricsHandler
 	ComputeTransactionGasLimitHandler func(tx *transaction.Transaction) (uint64, error)
+	SimulateTransactionHandler        func(tx *transaction.Transaction) (*transaction.SimulationResults, error)
+	PrometheusMetricsHandler          func() string
 }
 
 // ExecuteSCQuery -
@@ -29,6 +31,16 @@ func (ars *ApiResolverStub) ComputeTransactionGasLimit(tx *transaction.Transacti
 	return ars.ComputeTransactionGasLimitHandler(tx)
 }
 
+// SimulateTransaction -
+func (ars *ApiResolverStub) SimulateTransaction(tx *transaction.Transaction) (*transaction.SimulationResults, error) {
+	return ars.SimulateTransactionHandler(tx)
+}
+
+// PrometheusMetrics -
+func (ars *ApiResolverStub) PrometheusMetrics() string {
+	return ars.PrometheusMetricsHandler()
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ars *ApiResolverStub) IsInterfaceNil() bool {
 	return ars == nil
@@ -5,10 +5,12 @@ import (
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/process/events"
 )
 
 // NodeStub -
@@ -16,14 +18,17 @@ type NodeStub struct {
 	AddressHandler             func() (string, error)
 	ConnectToAddressesHandler  func([]string) error
 	StartConsensusHandler      func() error
-	GetBalanceHandler          func(address string) (*big.Int, error)
+	GetBalanceHandler          func(address string, options state.QueryOptions) (*big.Int, error)
 	GenerateTransactionHandler func(sender string, receiver string, amount string, code string) (*transaction.Transaction, error)
 	CreateTransactionHandler   func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64,
 		gasLimit uint64, data string, signatureHex string) (*transaction.Transaction, []byte, error)
 	ValidateTransactionHandler                     func(tx *transaction.Transaction) error
 	GetTransactionHandler                          func(hash string) (*transaction.ApiTransactionResult, error)
 	SendBulkTransactionsHandler                    func(txs []*transaction.Transaction) (uint64, error)
-	GetAccountHandler                              func(address string) (state.UserAccountHandler, error)
+	GetAccountHandler                              func(address string, options state.QueryOptions) (state.UserAccountHandler, error)
+	GetStateRootHashCalled                         func(options state.QueryOptions) ([]byte, error)
+	GetProofCalled                                 func(address string, options state.QueryOptions) (*state.ApiProof, error)
+	GetProofDataTrieCalled                         func(address string, key string, options state.QueryOptions) (*state.ApiProof, *state.ApiProof, error)
 	GetCurrentPublicKeyHandler                     func() string
 	GenerateAndSendBulkTransactionsHandler         func(destination string, value *big.Int, nrTransactions uint64) error
 	GenerateAndSendBulkTransactionsOneByOneHandler func(destination string, value *big.Int, nrTransactions uint64) error
@@ -35,6 +40,77 @@ type NodeStub struct {
 	GetTransactionStatusCalled                     func(hash string) (string, error)
 	GetValueForKeyCalled                           func(address string, key string) (string, error)
 	GetPeerInfoCalled                              func(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetTransactionsForAddressCalled                func(address string, from uint32, size uint32) (*transaction.ApiAccountHistory, error)
+	SubscribeEventsCalled                          func(filter events.Filter) (events.Subscription, error)
+	GetBlockByNonceCalled                          func(nonce uint64, withTxs bool) (*block.ApiBlock, error)
+	GetBlockByHashCalled                           func(hash string, withTxs bool) (*block.ApiBlock, error)
+	GetTransactionsPoolCalled                      func() (*transaction.ApiTransactionsPool, error)
+	GetTransactionsPoolForSenderCalled             func(address string) (*transaction.ApiTransactionsPoolForSender, error)
+	GetTransactionFromPoolCalled                   func(hash string) (*transaction.ApiTransactionInPool, error)
+	GetQueryGasLimitCalled                         func() uint64
+}
+
+// GetTransactionsPool -
+func (ns *NodeStub) GetTransactionsPool() (*transaction.ApiTransactionsPool, error) {
+	if ns.GetTransactionsPoolCalled != nil {
+		return ns.GetTransactionsPoolCalled()
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolForSender -
+func (ns *NodeStub) GetTransactionsPoolForSender(address string) (*transaction.ApiTransactionsPoolForSender, error) {
+	if ns.GetTransactionsPoolForSenderCalled != nil {
+		return ns.GetTransactionsPoolForSenderCalled(address)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionFromPool -
+func (ns *NodeStub) GetTransactionFromPool(hash string) (*transaction.ApiTransactionInPool, error) {
+	if ns.GetTransactionFromPoolCalled != nil {
+		return ns.GetTransactionFromPoolCalled(hash)
+	}
+
+	return nil, nil
+}
+
+// GetBlockByNonce -
+func (ns *NodeStub) GetBlockByNonce(nonce uint64, withTxs bool) (*block.ApiBlock, error) {
+	if ns.GetBlockByNonceCalled != nil {
+		return ns.GetBlockByNonceCalled(nonce, withTxs)
+	}
+
+	return nil, nil
+}
+
+// GetBlockByHash -
+func (ns *NodeStub) GetBlockByHash(hash string, withTxs bool) (*block.ApiBlock, error) {
+	if ns.GetBlockByHashCalled != nil {
+		return ns.GetBlockByHashCalled(hash, withTxs)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsForAddress -
+func (ns *NodeStub) GetTransactionsForAddress(address string, from uint32, size uint32) (*transaction.ApiAccountHistory, error) {
+	if ns.GetTransactionsForAddressCalled != nil {
+		return ns.GetTransactionsForAddressCalled(address, from, size)
+	}
+
+	return &transaction.ApiAccountHistory{Transactions: make([]*transaction.ApiAccountHistoryEntry, 0)}, nil
+}
+
+// SubscribeEvents -
+func (ns *NodeStub) SubscribeEvents(filter events.Filter) (events.Subscription, error) {
+	if ns.SubscribeEventsCalled != nil {
+		return ns.SubscribeEventsCalled(filter)
+	}
+
+	return nil, nil
 }
 
 // GetValueForKey -
@@ -65,14 +141,23 @@ func (ns *NodeStub) DecodeAddressPubkey(pk string) ([]byte, error) {
 	return hex.DecodeString(pk)
 }
 
+// GetQueryGasLimit -
+func (ns *NodeStub) GetQueryGasLimit() uint64 {
+	if ns.GetQueryGasLimitCalled != nil {
+		return ns.GetQueryGasLimitCalled()
+	}
+
+	return 0
+}
+
 // StartConsensus -
 func (ns *NodeStub) StartConsensus() error {
 	return ns.StartConsensusHandler()
 }
 
 // GetBalance -
-func (ns *NodeStub) GetBalance(address string) (*big.Int, error) {
-	return ns.GetBalanceHandler(address)
+func (ns *NodeStub) GetBalance(address string, options state.QueryOptions) (*big.Int, error) {
+	return ns.GetBalanceHandler(address, options)
 }
 
 // CreateTransaction -
@@ -82,7 +167,7 @@ func (ns *NodeStub) CreateTransaction(nonce uint64, value string, receiverHex st
 	return ns.CreateTransactionHandler(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, data, signatureHex)
 }
 
-//ValidateTransaction --
+// ValidateTransaction --
 func (ns *NodeStub) ValidateTransaction(tx *transaction.Transaction) error {
 	return ns.ValidateTransactionHandler(tx)
 }
@@ -98,8 +183,35 @@ func (ns *NodeStub) SendBulkTransactions(txs []*transaction.Transaction) (uint64
 }
 
 // GetAccount -
-func (ns *NodeStub) GetAccount(address string) (state.UserAccountHandler, error) {
-	return ns.GetAccountHandler(address)
+func (ns *NodeStub) GetAccount(address string, options state.QueryOptions) (state.UserAccountHandler, error) {
+	return ns.GetAccountHandler(address, options)
+}
+
+// GetStateRootHash -
+func (ns *NodeStub) GetStateRootHash(options state.QueryOptions) ([]byte, error) {
+	if ns.GetStateRootHashCalled != nil {
+		return ns.GetStateRootHashCalled(options)
+	}
+
+	return options.RootHash, nil
+}
+
+// GetProof -
+func (ns *NodeStub) GetProof(address string, options state.QueryOptions) (*state.ApiProof, error) {
+	if ns.GetProofCalled != nil {
+		return ns.GetProofCalled(address, options)
+	}
+
+	return nil, nil
+}
+
+// GetProofDataTrie -
+func (ns *NodeStub) GetProofDataTrie(address string, key string, options state.QueryOptions) (*state.ApiProof, *state.ApiProof, error) {
+	if ns.GetProofDataTrieCalled != nil {
+		return ns.GetProofDataTrieCalled(address, key, options)
+	}
+
+	return nil, nil, nil
 }
 
 // GetHeartbeats -
@@ -18,6 +18,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/debug"
@@ -25,6 +26,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/events"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
@@ -187,7 +189,23 @@ func (nf *nodeFacade) createMiddlewareLimiters() ([]api.MiddlewareProcessor, err
 		return nil, err
 	}
 
-	return []api.MiddlewareProcessor{sourceLimiter, globalLimiter}, nil
+	limiters := []api.MiddlewareProcessor{sourceLimiter, globalLimiter}
+
+	authConfig := nf.apiRoutesConfig.Authentication
+	if authConfig.Enabled {
+		apiKeyAuthenticator, errAuth := middleware.NewApiKeyAuthenticator(authConfig)
+		if errAuth != nil {
+			return nil, errAuth
+		}
+
+		log.Debug("web server API keys authentication is enabled",
+			"num keys", len(authConfig.Keys),
+			"allow anonymous", authConfig.AllowAnonymous,
+		)
+		limiters = append(limiters, apiKeyAuthenticator)
+	}
+
+	return limiters, nil
 }
 
 func (nf *nodeFacade) sourceLimiterReset(reset resetHandler) {
@@ -199,9 +217,9 @@ func (nf *nodeFacade) sourceLimiterReset(reset resetHandler) {
 	}
 }
 
-// GetBalance gets the current balance for a specified address
-func (nf *nodeFacade) GetBalance(address string) (*big.Int, error) {
-	return nf.node.GetBalance(address)
+// GetBalance gets the balance for a specified address, in the state selected by the query options
+func (nf *nodeFacade) GetBalance(address string, options state.QueryOptions) (*big.Int, error) {
+	return nf.node.GetBalance(address, options)
 }
 
 // GetValueForKey gets the value for a key in a given address
@@ -254,10 +272,71 @@ func (nf *nodeFacade) ComputeTransactionGasLimit(tx *transaction.Transaction) (u
 	return nf.apiResolver.ComputeTransactionGasLimit(tx)
 }
 
+// GetTransactionsPool returns the number of transactions and bytes held in each cache of the transactions pool
+func (nf *nodeFacade) GetTransactionsPool() (*transaction.ApiTransactionsPool, error) {
+	return nf.node.GetTransactionsPool()
+}
+
+// GetTransactionsPoolForSender returns the transactions of a sender held in the transactions pool, along with the
+// nonce gaps that prevent them from being processed
+func (nf *nodeFacade) GetTransactionsPoolForSender(address string) (*transaction.ApiTransactionsPoolForSender, error) {
+	return nf.node.GetTransactionsPoolForSender(address)
+}
+
+// GetTransactionFromPool returns a transaction held in the transactions pool, given its hash
+func (nf *nodeFacade) GetTransactionFromPool(hash string) (*transaction.ApiTransactionInPool, error) {
+	return nf.node.GetTransactionFromPool(hash)
+}
+
+// SimulateTransaction will execute the transaction on a copy of the current state and return its outcome
+func (nf *nodeFacade) SimulateTransaction(tx *transaction.Transaction) (*transaction.SimulationResults, error) {
+	return nf.apiResolver.SimulateTransaction(tx)
+}
+
+// PrometheusMetrics returns all the node metrics in the Prometheus text format
+func (nf *nodeFacade) PrometheusMetrics() string {
+	return nf.apiResolver.PrometheusMetrics()
+}
+
 // GetAccount returns an accountResponse containing information
-// about the account correlated with provided address
-func (nf *nodeFacade) GetAccount(address string) (state.UserAccountHandler, error) {
-	return nf.node.GetAccount(address)
+// about the account correlated with provided address, in the state selected by the query options
+func (nf *nodeFacade) GetAccount(address string, options state.QueryOptions) (state.UserAccountHandler, error) {
+	return nf.node.GetAccount(address, options)
+}
+
+// GetStateRootHash returns the root hash of the state selected by the query options
+func (nf *nodeFacade) GetStateRootHash(options state.QueryOptions) ([]byte, error) {
+	return nf.node.GetStateRootHash(options)
+}
+
+// GetProof returns the Merkle inclusion proof of the account with the provided address
+func (nf *nodeFacade) GetProof(address string, options state.QueryOptions) (*state.ApiProof, error) {
+	return nf.node.GetProof(address, options)
+}
+
+// GetProofDataTrie returns the Merkle inclusion proofs of the account and of the key in the account's data trie
+func (nf *nodeFacade) GetProofDataTrie(address string, key string, options state.QueryOptions) (*state.ApiProof, *state.ApiProof, error) {
+	return nf.node.GetProofDataTrie(address, key, options)
+}
+
+// GetTransactionsForAddress returns the transactions which touched the provided address, newest first
+func (nf *nodeFacade) GetTransactionsForAddress(address string, from uint32, size uint32) (*transaction.ApiAccountHistory, error) {
+	return nf.node.GetTransactionsForAddress(address, from, size)
+}
+
+// SubscribeEvents creates a subscription for the blocks, transactions and smart contract logs selected by the filter
+func (nf *nodeFacade) SubscribeEvents(filter events.Filter) (events.Subscription, error) {
+	return nf.node.SubscribeEvents(filter)
+}
+
+// GetBlockByNonce returns the self shard block with the provided nonce
+func (nf *nodeFacade) GetBlockByNonce(nonce uint64, withTxs bool) (*block.ApiBlock, error) {
+	return nf.node.GetBlockByNonce(nonce, withTxs)
+}
+
+// GetBlockByHash returns the self shard block with the provided hex encoded hash
+func (nf *nodeFacade) GetBlockByHash(hash string, withTxs bool) (*block.ApiBlock, error) {
+	return nf.node.GetBlockByHash(hash, withTxs)
 }
 
 // GetHeartbeats returns the heartbeat status for each public key from initial list or later joined to the network
@@ -305,6 +384,11 @@ func (nf *nodeFacade) DecodeAddressPubkey(pk string) ([]byte, error) {
 	return nf.node.DecodeAddressPubkey(pk)
 }
 
+// GetQueryGasLimit returns the gas provided to the smart contract queries
+func (nf *nodeFacade) GetQueryGasLimit() uint64 {
+	return nf.node.GetQueryGasLimit()
+}
+
 // GetQueryHandler returns the query handler if existing
 func (nf *nodeFacade) GetQueryHandler(name string) (debug.QueryHandler, error) {
 	return nf.node.GetQueryHandler(name)
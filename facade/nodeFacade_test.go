@@ -135,7 +135,7 @@ func TestNodeFacade_GetBalanceWithValidAddressShouldReturnBalance(t *testing.T)
 	balance := big.NewInt(10)
 	addr := "testAddress"
 	node := &mock.NodeStub{
-		GetBalanceHandler: func(address string) (*big.Int, error) {
+		GetBalanceHandler: func(address string, _ state.QueryOptions) (*big.Int, error) {
 			if addr == address {
 				return balance, nil
 			}
@@ -147,7 +147,7 @@ func TestNodeFacade_GetBalanceWithValidAddressShouldReturnBalance(t *testing.T)
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	amount, err := nf.GetBalance(addr)
+	amount, err := nf.GetBalance(addr, state.QueryOptions{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, balance, amount)
@@ -162,7 +162,7 @@ func TestNodeFacade_GetBalanceWithUnknownAddressShouldReturnZeroBalance(t *testi
 	zeroBalance := big.NewInt(0)
 
 	node := &mock.NodeStub{
-		GetBalanceHandler: func(address string) (*big.Int, error) {
+		GetBalanceHandler: func(address string, _ state.QueryOptions) (*big.Int, error) {
 			if addr == address {
 				return balance, nil
 			}
@@ -174,7 +174,7 @@ func TestNodeFacade_GetBalanceWithUnknownAddressShouldReturnZeroBalance(t *testi
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	amount, err := nf.GetBalance(unknownAddr)
+	amount, err := nf.GetBalance(unknownAddr, state.QueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, zeroBalance, amount)
 }
@@ -186,7 +186,7 @@ func TestNodeFacade_GetBalanceWithErrorOnNodeShouldReturnZeroBalanceAndError(t *
 	zeroBalance := big.NewInt(0)
 
 	node := &mock.NodeStub{
-		GetBalanceHandler: func(address string) (*big.Int, error) {
+		GetBalanceHandler: func(address string, _ state.QueryOptions) (*big.Int, error) {
 			return big.NewInt(0), errors.New("error on getBalance on node")
 		},
 	}
@@ -195,7 +195,7 @@ func TestNodeFacade_GetBalanceWithErrorOnNodeShouldReturnZeroBalanceAndError(t *
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	amount, err := nf.GetBalance(addr)
+	amount, err := nf.GetBalance(addr, state.QueryOptions{})
 	assert.NotNil(t, err)
 	assert.Equal(t, zeroBalance, amount)
 }
@@ -223,6 +223,43 @@ func TestNodeFacade_GetTransactionWithValidInputsShouldNotReturnError(t *testing
 	assert.Equal(t, testTx, tx)
 }
 
+func TestNodeFacade_GetTransactionsPoolShouldCallNode(t *testing.T) {
+	t.Parallel()
+
+	expectedPool := &transaction.ApiTransactionsPool{NumTxs: 3}
+	expectedSenderTxs := &transaction.ApiTransactionsPoolForSender{Sender: "alice"}
+	expectedTx := &transaction.ApiTransactionInPool{Hash: "aa"}
+	node := &mock.NodeStub{
+		GetTransactionsPoolCalled: func() (*transaction.ApiTransactionsPool, error) {
+			return expectedPool, nil
+		},
+		GetTransactionsPoolForSenderCalled: func(address string) (*transaction.ApiTransactionsPoolForSender, error) {
+			assert.Equal(t, "alice", address)
+			return expectedSenderTxs, nil
+		},
+		GetTransactionFromPoolCalled: func(hash string) (*transaction.ApiTransactionInPool, error) {
+			assert.Equal(t, "aa", hash)
+			return expectedTx, nil
+		},
+	}
+
+	arg := createMockArguments()
+	arg.Node = node
+	nf, _ := NewNodeFacade(arg)
+
+	pool, err := nf.GetTransactionsPool()
+	assert.Nil(t, err)
+	assert.Equal(t, expectedPool, pool)
+
+	senderTxs, err := nf.GetTransactionsPoolForSender("alice")
+	assert.Nil(t, err)
+	assert.Equal(t, expectedSenderTxs, senderTxs)
+
+	tx, err := nf.GetTransactionFromPool("aa")
+	assert.Nil(t, err)
+	assert.Equal(t, expectedTx, tx)
+}
+
 func TestNodeFacade_SetAndGetTpsBenchmark(t *testing.T) {
 	t.Parallel()
 
@@ -274,7 +311,7 @@ func TestNodeFacade_GetAccount(t *testing.T) {
 
 	called := 0
 	node := &mock.NodeStub{}
-	node.GetAccountHandler = func(address string) (state.UserAccountHandler, error) {
+	node.GetAccountHandler = func(address string, _ state.QueryOptions) (state.UserAccountHandler, error) {
 		called++
 		return nil, nil
 	}
@@ -283,7 +320,7 @@ func TestNodeFacade_GetAccount(t *testing.T) {
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	_, _ = nf.GetAccount("test")
+	_, _ = nf.GetAccount("test", state.QueryOptions{})
 	assert.Equal(t, called, 1)
 }
 
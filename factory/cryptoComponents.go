@@ -118,15 +118,21 @@ func (ccf *cryptoComponentsFactory) Create() (*CryptoComponents, error) {
 
 func (ccf *cryptoComponentsFactory) createSingleSigner() (crypto.SingleSigner, error) {
 	switch ccf.config.Consensus.Type {
-	case consensus.BlsConsensusType:
+	case consensus.BlsConsensusType, consensus.RoundRobinConsensusType:
 		return &mclsig.BlsSingleSigner{}, nil
 	default:
 		return nil, ErrMissingConsensusConfig
 	}
 }
 
+// usesBlsSignatures returns true if the configured consensus signs the blocks with BLS keys
+func (ccf *cryptoComponentsFactory) usesBlsSignatures() bool {
+	return ccf.config.Consensus.Type == consensus.BlsConsensusType ||
+		ccf.config.Consensus.Type == consensus.RoundRobinConsensusType
+}
+
 func (ccf *cryptoComponentsFactory) getMultisigHasherFromConfig() (hashing.Hasher, error) {
-	if ccf.config.Consensus.Type == consensus.BlsConsensusType && ccf.config.MultisigHasher.Type != "blake2b" {
+	if ccf.usesBlsSignatures() && ccf.config.MultisigHasher.Type != "blake2b" {
 		return nil, ErrMultiSigHasherMissmatch
 	}
 
@@ -134,7 +140,7 @@ func (ccf *cryptoComponentsFactory) getMultisigHasherFromConfig() (hashing.Hashe
 	case "sha256":
 		return sha256.Sha256{}, nil
 	case "blake2b":
-		if ccf.config.Consensus.Type == consensus.BlsConsensusType {
+		if ccf.usesBlsSignatures() {
 			return &blake2b.Blake2b{HashSize: multisig.BlsHashSize}, nil
 		}
 		return &blake2b.Blake2b{}, nil
@@ -155,7 +161,7 @@ func (ccf *cryptoComponentsFactory) createMultiSigner(
 	// public keys in their initial order.
 
 	switch ccf.config.Consensus.Type {
-	case consensus.BlsConsensusType:
+	case consensus.BlsConsensusType, consensus.RoundRobinConsensusType:
 		blsSigner := &mclmultisig.BlsMultiSigner{Hasher: hasher}
 		return multisig.NewBLSMultisig(blsSigner, pubKeys, ccf.privKey, ccf.keyGen, uint16(0))
 	default:
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/factory"
 	"github.com/ElrondNetwork/elrond-go/factory/mock"
 	"github.com/stretchr/testify/require"
@@ -81,6 +82,31 @@ func TestCryptoComponentsFactory_Create(t *testing.T) {
 	require.NotNil(t, cc)
 }
 
+func TestCryptoComponentsFactory_CreateRoundRobinConsensusShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := getCryptoArgs()
+	args.Config.Consensus.Type = consensus.RoundRobinConsensusType
+	ccf, _ := factory.NewCryptoComponentsFactory(args)
+
+	cc, err := ccf.Create()
+	require.NoError(t, err)
+	require.NotNil(t, cc)
+}
+
+func TestCryptoComponentsFactory_CreateRoundRobinConsensusWrongMultisigHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getCryptoArgs()
+	args.Config.Consensus.Type = consensus.RoundRobinConsensusType
+	args.Config.MultisigHasher.Type = "sha256"
+	ccf, _ := factory.NewCryptoComponentsFactory(args)
+
+	cc, err := ccf.Create()
+	require.Equal(t, factory.ErrMultiSigHasherMissmatch, err)
+	require.Nil(t, cc)
+}
+
 func getCryptoArgs() factory.CryptoComponentsFactoryArgs {
 	return factory.CryptoComponentsFactoryArgs{
 		Config: config.Config{
@@ -22,6 +22,7 @@ type StateComponents struct {
 	ValidatorPubkeyConverter core.PubkeyConverter
 	PeerAccounts             state.AccountsAdapter
 	AccountsAdapter          state.AccountsAdapter
+	AccountsRecreator        state.AccountsAdapterRecreator
 	InBalanceForShard        map[string]*big.Int
 }
 
@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	disabledState "github.com/ElrondNetwork/elrond-go/data/state/disabled"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/genesis"
@@ -332,7 +333,13 @@ func createProcessorsForMetaGenesisBlock(arg ArgsGenesisBlockCreator) (*genesisP
 		return nil, err
 	}
 
-	queryService, err := smartContract.NewSCQueryService(vmContainer, arg.Economics)
+	argsQueryService := smartContract.ArgsNewSCQueryService{
+		VmContainer:       vmContainer,
+		EconomicsFee:      arg.Economics,
+		AccountsHolder:    virtualMachineFactory.BlockChainHookImpl(),
+		AccountsRecreator: disabledState.NewAccountsAdapterRecreator(),
+	}
+	queryService, err := smartContract.NewSCQueryService(argsQueryService)
 	if err != nil {
 		return nil, err
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	disabledState "github.com/ElrondNetwork/elrond-go/data/state/disabled"
 	"github.com/ElrondNetwork/elrond-go/genesis"
 	"github.com/ElrondNetwork/elrond-go/genesis/process/disabled"
 	"github.com/ElrondNetwork/elrond-go/genesis/process/intermediate"
@@ -214,6 +215,8 @@ func createProcessorsForShard(arg ArgsGenesisBlockCreator) (*genesisProcessors,
 		MapDNSAddresses:      make(map[string]struct{}),
 		EnableUserNameChange: false,
 		Marshalizer:          arg.Marshalizer,
+		Accounts:             arg.Accounts,
+		ShardCoordinator:     arg.ShardCoordinator,
 	}
 	builtInFuncs, err := builtInFunctions.CreateBuiltInFunctionContainer(argsBuiltIn)
 	if err != nil {
@@ -393,7 +396,13 @@ func createProcessorsForShard(arg ArgsGenesisBlockCreator) (*genesisProcessors,
 		return nil, err
 	}
 
-	queryService, err := smartContract.NewSCQueryService(vmContainer, arg.Economics)
+	argsQueryService := smartContract.ArgsNewSCQueryService{
+		VmContainer:       vmContainer,
+		EconomicsFee:      arg.Economics,
+		AccountsHolder:    vmFactoryImpl.BlockChainHookImpl(),
+		AccountsRecreator: disabledState.NewAccountsAdapterRecreator(),
+	}
+	queryService, err := smartContract.NewSCQueryService(argsQueryService)
 	if err != nil {
 		return nil, err
 	}
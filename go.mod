@@ -31,6 +31,7 @@ require (
 	github.com/libp2p/go-libp2p-kad-dht v0.8.0
 	github.com/libp2p/go-libp2p-kbucket v0.4.2
 	github.com/libp2p/go-libp2p-pubsub v0.3.1
+	github.com/mattn/go-sqlite3 v1.14.0
 	github.com/mitchellh/mapstructure v1.1.2
 	github.com/mr-tron/base58 v1.1.3
 	github.com/multiformats/go-multiaddr v0.2.2
@@ -42,6 +43,7 @@ require (
 	github.com/syndtr/goleveldb v1.0.1-0.20190318030020-c3a204f8e965
 	github.com/urfave/cli v1.20.0
 	github.com/whyrusleeping/timecache v0.0.0-20160911033111-cfcb2f1abfee
+	go.etcd.io/bbolt v1.3.5
 	golang.org/x/crypto v0.0.0-20200510223506-06a226fb4e37
 	golang.org/x/net v0.0.0-20200519113804-d87ec0cfa476
 	gopkg.in/go-playground/validator.v8 v8.18.2
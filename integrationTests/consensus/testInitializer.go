@@ -412,6 +412,7 @@ func createConsensusOnlyNode(
 	_ = testMultiSig.Reset(inPubKeys[shardId], uint16(selfId))
 
 	accntAdapter := createAccountsDB(testMarshalizer)
+	equivocationProofsPool, _ := lrucache.NewCache(100)
 
 	n, err := node.NewNode(
 		node.WithInitialNodesPubKeys(inPubKeys),
@@ -456,6 +457,7 @@ func createConsensusOnlyNode(
 		node.WithUint64ByteSliceConverter(&mock.Uint64ByteSliceConverterMock{}),
 		node.WithBlockTracker(&mock.BlockTrackerStub{}),
 		node.WithInputAntifloodHandler(&mock.NilAntifloodHandler{}),
+		node.WithEquivocationProofsPool(equivocationProofsPool),
 		node.WithSignatureSize(signatureSize),
 		node.WithPublicKeySize(publicKeySize),
 	)
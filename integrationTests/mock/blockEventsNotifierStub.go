@@ -0,0 +1,22 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// BlockEventsNotifierStub -
+type BlockEventsNotifierStub struct {
+	NotifyBlockCommittedCalled func(header data.HeaderHandler, headerHash []byte, txs map[string]data.TransactionHandler)
+}
+
+// NotifyBlockCommitted -
+func (bens *BlockEventsNotifierStub) NotifyBlockCommitted(header data.HeaderHandler, headerHash []byte, txs map[string]data.TransactionHandler) {
+	if bens.NotifyBlockCommittedCalled != nil {
+		bens.NotifyBlockCommittedCalled(header, headerHash, txs)
+	}
+}
+
+// IsInterfaceNil -
+func (bens *BlockEventsNotifierStub) IsInterfaceNil() bool {
+	return bens == nil
+}
@@ -0,0 +1,30 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/data/block"
+
+// EquivocationSlasherStub -
+type EquivocationSlasherStub struct {
+	CreateSlashingsCalled  func(haveTime func() bool) ([]block.Slashing, error)
+	ProcessSlashingsCalled func(slashings []block.Slashing) error
+}
+
+// CreateSlashings -
+func (ess *EquivocationSlasherStub) CreateSlashings(haveTime func() bool) ([]block.Slashing, error) {
+	if ess.CreateSlashingsCalled != nil {
+		return ess.CreateSlashingsCalled(haveTime)
+	}
+	return make([]block.Slashing, 0), nil
+}
+
+// ProcessSlashings -
+func (ess *EquivocationSlasherStub) ProcessSlashings(slashings []block.Slashing) error {
+	if ess.ProcessSlashingsCalled != nil {
+		return ess.ProcessSlashingsCalled(slashings)
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (ess *EquivocationSlasherStub) IsInterfaceNil() bool {
+	return ess == nil
+}
@@ -4,7 +4,9 @@ import "github.com/ElrondNetwork/elrond-go/data/block"
 
 // SCToProtocolStub -
 type SCToProtocolStub struct {
-	UpdateProtocolCalled func(body *block.Body, nonce uint64) error
+	UpdateProtocolCalled                func(body *block.Body, nonce uint64) error
+	UpdateProtocolForKeyRotationsCalled func(epoch uint32) error
+	UpdateProtocolForKeysCalled         func(keys [][]byte, nonce uint64) error
 }
 
 // UpdateProtocol -
@@ -15,6 +17,22 @@ func (s *SCToProtocolStub) UpdateProtocol(body *block.Body, nonce uint64) error
 	return nil
 }
 
+// UpdateProtocolForKeyRotations -
+func (s *SCToProtocolStub) UpdateProtocolForKeyRotations(epoch uint32) error {
+	if s.UpdateProtocolForKeyRotationsCalled != nil {
+		return s.UpdateProtocolForKeyRotationsCalled(epoch)
+	}
+	return nil
+}
+
+// UpdateProtocolForKeys -
+func (s *SCToProtocolStub) UpdateProtocolForKeys(keys [][]byte, nonce uint64) error {
+	if s.UpdateProtocolForKeysCalled != nil {
+		return s.UpdateProtocolForKeysCalled(keys, nonce)
+	}
+	return nil
+}
+
 // IsInterfaceNil -
 func (s *SCToProtocolStub) IsInterfaceNil() bool {
 	return s == nil
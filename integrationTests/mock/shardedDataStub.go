@@ -9,6 +9,7 @@ type ShardedDataStub struct {
 	RegisterHandlerCalled                  func(func(key []byte, value interface{}))
 	ShardDataStoreCalled                   func(cacheId string) (c storage.Cacher)
 	AddDataCalled                          func(key []byte, data interface{}, sizeInBytes int, cacheId string)
+	AddRequestedDataCalled                 func(key []byte, data interface{}, sizeInBytes int, cacheId string)
 	SearchFirstDataCalled                  func(key []byte) (value interface{}, ok bool)
 	RemoveDataCalled                       func(key []byte, cacheId string)
 	RemoveDataFromAllShardsCalled          func(key []byte)
@@ -36,6 +37,13 @@ func (sd *ShardedDataStub) AddData(key []byte, data interface{}, sizeInBytes int
 	sd.AddDataCalled(key, data, sizeInBytes, cacheId)
 }
 
+// AddRequestedData -
+func (sd *ShardedDataStub) AddRequestedData(key []byte, data interface{}, sizeInBytes int, cacheId string) {
+	if sd.AddRequestedDataCalled != nil {
+		sd.AddRequestedDataCalled(key, data, sizeInBytes, cacheId)
+	}
+}
+
 // SearchFirstData -
 func (sd *ShardedDataStub) SearchFirstData(key []byte) (value interface{}, ok bool) {
 	return sd.SearchFirstDataCalled(key)
@@ -11,6 +11,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	disabledState "github.com/ElrondNetwork/elrond-go/data/state/disabled"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/vm"
@@ -73,11 +74,17 @@ func SetupTestContext(t *testing.T) TestContext {
 	vmContainer, blockChainHook := vm.CreateVMAndBlockchainHook(context.Accounts, gasSchedule)
 	context.TxProcessor, context.ScProcessor = vm.CreateTxProcessorWithOneSCExecutorWithVMs(context.Accounts, vmContainer, blockChainHook)
 	context.ScAddress, _ = blockChainHook.NewAddress(context.Owner.Address, context.Owner.Nonce, factory.ArwenVirtualMachine)
-	context.QueryService, _ = smartContract.NewSCQueryService(vmContainer, &mock.FeeHandlerStub{
-		MaxGasLimitPerBlockCalled: func() uint64 {
-			return uint64(math.MaxUint64)
+	argsQueryService := smartContract.ArgsNewSCQueryService{
+		VmContainer: vmContainer,
+		EconomicsFee: &mock.FeeHandlerStub{
+			MaxGasLimitPerBlockCalled: func() uint64 {
+				return uint64(math.MaxUint64)
+			},
 		},
-	})
+		AccountsHolder:    blockChainHook,
+		AccountsRecreator: disabledState.NewAccountsAdapterRecreator(),
+	}
+	context.QueryService, _ = smartContract.NewSCQueryService(argsQueryService)
 	context.VMContainer = vmContainer
 
 	require.NotNil(t, context.TxProcessor)
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	disabledState "github.com/ElrondNetwork/elrond-go/data/state/disabled"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/vm"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -25,11 +26,17 @@ func TestVmGetShouldReturnValue(t *testing.T) {
 		GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
 			return mockVM, nil
 		}}
-	service, _ := smartContract.NewSCQueryService(vmContainer, &mock.FeeHandlerStub{
-		MaxGasLimitPerBlockCalled: func() uint64 {
-			return uint64(math.MaxUint64)
+	argsNewSCQueryService := smartContract.ArgsNewSCQueryService{
+		VmContainer: vmContainer,
+		EconomicsFee: &mock.FeeHandlerStub{
+			MaxGasLimitPerBlockCalled: func() uint64 {
+				return uint64(math.MaxUint64)
+			},
 		},
-	})
+		AccountsHolder:    &mock.BlockChainHookHandlerMock{},
+		AccountsRecreator: disabledState.NewAccountsAdapterRecreator(),
+	}
+	service, _ := smartContract.NewSCQueryService(argsNewSCQueryService)
 
 	functionName := "Get"
 	query := process.SCQuery{
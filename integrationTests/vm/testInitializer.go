@@ -14,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core/pubkeyConverter"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	disabledState "github.com/ElrondNetwork/elrond-go/data/state/disabled"
 	dataTransaction "github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/data/trie"
 	"github.com/ElrondNetwork/elrond-go/data/trie/evictionWaitingList"
@@ -266,9 +267,11 @@ func CreateVMAndBlockchainHook(
 	}
 
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasMap:          actualGasSchedule,
-		MapDNSAddresses: make(map[string]struct{}),
-		Marshalizer:     testMarshalizer,
+		GasMap:           actualGasSchedule,
+		MapDNSAddresses:  make(map[string]struct{}),
+		Marshalizer:      testMarshalizer,
+		Accounts:         accnts,
+		ShardCoordinator: oneShardCoordinator,
 	}
 	builtInFuncs, _ := builtInFunctions.CreateBuiltInFunctionContainer(argsBuiltIn)
 
@@ -551,7 +554,7 @@ func GetAccountsBalance(addrBytes []byte, accnts state.AccountsAdapter) *big.Int
 
 // GetIntValueFromSC -
 func GetIntValueFromSC(gasSchedule map[string]map[string]uint64, accnts state.AccountsAdapter, scAddressBytes []byte, funcName string, args ...[]byte) *big.Int {
-	vmContainer, _ := CreateVMAndBlockchainHook(accnts, gasSchedule)
+	vmContainer, blockChainHook := CreateVMAndBlockchainHook(accnts, gasSchedule)
 	defer func() {
 		_ = vmContainer.Close()
 	}()
@@ -562,7 +565,13 @@ func GetIntValueFromSC(gasSchedule map[string]map[string]uint64, accnts state.Ac
 		},
 	}
 
-	scQueryService, _ := smartContract.NewSCQueryService(vmContainer, feeHandler)
+	argsQueryService := smartContract.ArgsNewSCQueryService{
+		VmContainer:       vmContainer,
+		EconomicsFee:      feeHandler,
+		AccountsHolder:    blockChainHook,
+		AccountsRecreator: disabledState.NewAccountsAdapterRecreator(),
+	}
+	scQueryService, _ := smartContract.NewSCQueryService(argsQueryService)
 
 	vmOutput, err := scQueryService.ExecuteQuery(&process.SCQuery{
 		ScAddress: scAddressBytes,
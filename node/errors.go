@@ -168,3 +168,30 @@ var ErrSystemBusyTxHash = errors.New("system busy. try again later")
 
 // ErrUnknownPeerID signals that the provided peer is unknown by the current node
 var ErrUnknownPeerID = errors.New("unknown peer ID")
+
+// ErrNilAccountHistory signals that a nil account history handler has been provided
+var ErrNilAccountHistory = errors.New("nil account history")
+
+// ErrMiniBlockNotFound signals that the requested miniblock could not be found in storage
+var ErrMiniBlockNotFound = errors.New("miniblock not found")
+
+// ErrNilAccountsRecreator signals that a nil accounts recreator has been provided
+var ErrNilAccountsRecreator = errors.New("nil accounts recreator")
+
+// ErrNilEventsSubscriber signals that a nil events subscriber has been provided
+var ErrNilEventsSubscriber = errors.New("nil events subscriber")
+
+// ErrTxPoolInspectionNotSupported signals that the transactions pool can not describe its content
+var ErrTxPoolInspectionNotSupported = errors.New("transactions pool inspection not supported")
+
+// ErrTransactionNotFoundInPool signals that the requested transaction is not held in the transactions pool
+var ErrTransactionNotFoundInPool = errors.New("transaction not found in pool")
+
+// ErrSenderNotFoundInPool signals that the transactions pool holds no transaction of the requested sender
+var ErrSenderNotFoundInPool = errors.New("sender not found in pool")
+
+// ErrNilRoundTracer signals that a nil consensus round tracer has been provided
+var ErrNilRoundTracer = errors.New("nil round tracer")
+
+// ErrNilEquivocationProofsPool signals that a nil equivocation proofs pool has been provided
+var ErrNilEquivocationProofsPool = errors.New("nil equivocation proofs pool")
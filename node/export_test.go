@@ -1,9 +1,14 @@
 package node
 
 import (
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 )
 
 func (n *Node) CreateConsensusTopic(messageProcessor p2p.MessageProcessor) error {
 	return n.createConsensusTopic(messageProcessor)
 }
+
+func (n *Node) CheckTxReplacement(tx data.TransactionHandler, txHash []byte, senderShardID uint32, receiverShardID uint32) error {
+	return n.checkTxReplacement(tx, txHash, senderShardID, receiverShardID)
+}
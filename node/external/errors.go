@@ -10,3 +10,9 @@ var ErrNilStatusMetrics = errors.New("nil status metrics handler")
 
 // ErrNilTransactionCostHandler signals that a nil transaction cost handler was provided
 var ErrNilTransactionCostHandler = errors.New("nil transaction cost handler")
+
+// ErrNilTransactionSimulator signals that a nil transaction simulator was provided
+var ErrNilTransactionSimulator = errors.New("nil transaction simulator")
+
+// ErrNilPrometheusMetrics signals that a nil prometheus metrics handler was provided
+var ErrNilPrometheusMetrics = errors.New("nil prometheus metrics handler")
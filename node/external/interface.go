@@ -22,8 +22,20 @@ type StatusMetricsHandler interface {
 	IsInterfaceNil() bool
 }
 
+// PrometheusMetricsHandler defines the component which exports the node metrics in the Prometheus text format
+type PrometheusMetricsHandler interface {
+	Metrics() string
+	IsInterfaceNil() bool
+}
+
 // TransactionCostHandler defines the actions which should be handler by a transaction cost estimator
 type TransactionCostHandler interface {
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64, error)
 	IsInterfaceNil() bool
 }
+
+// TransactionSimulatorHandler defines the actions which should be handled by a transaction simulator
+type TransactionSimulatorHandler interface {
+	SimulateTransaction(tx *transaction.Transaction) (*transaction.SimulationResults, error)
+	IsInterfaceNil() bool
+}
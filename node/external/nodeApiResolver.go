@@ -12,6 +12,8 @@ type NodeApiResolver struct {
 	scQueryService       SCQueryService
 	statusMetricsHandler StatusMetricsHandler
 	txCostHandler        TransactionCostHandler
+	txSimulator          TransactionSimulatorHandler
+	prometheusMetrics    PrometheusMetricsHandler
 }
 
 // NewNodeApiResolver creates a new NodeApiResolver instance
@@ -19,6 +21,8 @@ func NewNodeApiResolver(
 	scQueryService SCQueryService,
 	statusMetricsHandler StatusMetricsHandler,
 	txCostHandler TransactionCostHandler,
+	txSimulator TransactionSimulatorHandler,
+	prometheusMetrics PrometheusMetricsHandler,
 ) (*NodeApiResolver, error) {
 	if check.IfNil(scQueryService) {
 		return nil, ErrNilSCQueryService
@@ -29,11 +33,19 @@ func NewNodeApiResolver(
 	if check.IfNil(txCostHandler) {
 		return nil, ErrNilTransactionCostHandler
 	}
+	if check.IfNil(txSimulator) {
+		return nil, ErrNilTransactionSimulator
+	}
+	if check.IfNil(prometheusMetrics) {
+		return nil, ErrNilPrometheusMetrics
+	}
 
 	return &NodeApiResolver{
 		scQueryService:       scQueryService,
 		statusMetricsHandler: statusMetricsHandler,
 		txCostHandler:        txCostHandler,
+		txSimulator:          txSimulator,
+		prometheusMetrics:    prometheusMetrics,
 	}, nil
 }
 
@@ -47,11 +59,21 @@ func (nar *NodeApiResolver) StatusMetrics() StatusMetricsHandler {
 	return nar.statusMetricsHandler
 }
 
+// PrometheusMetrics returns all the node metrics in the Prometheus text format
+func (nar *NodeApiResolver) PrometheusMetrics() string {
+	return nar.prometheusMetrics.Metrics()
+}
+
 //ComputeTransactionGasLimit will calculate how many gas a transaction will consume
 func (nar *NodeApiResolver) ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64, error) {
 	return nar.txCostHandler.ComputeTransactionGasLimit(tx)
 }
 
+// SimulateTransaction will execute the transaction on a copy of the current state and return its outcome
+func (nar *NodeApiResolver) SimulateTransaction(tx *transaction.Transaction) (*transaction.SimulationResults, error) {
+	return nar.txSimulator.SimulateTransaction(tx)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nar *NodeApiResolver) IsInterfaceNil() bool {
 	return nar == nil
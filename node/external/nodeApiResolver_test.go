@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -14,7 +15,7 @@ import (
 func TestNewNodeApiResolver_NilSCQueryServiceShouldErr(t *testing.T) {
 	t.Parallel()
 
-	nar, err := external.NewNodeApiResolver(nil, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{})
+	nar, err := external.NewNodeApiResolver(nil, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, &mock.TransactionSimulatorStub{}, &mock.PrometheusMetricsStub{})
 
 	assert.Nil(t, nar)
 	assert.Equal(t, external.ErrNilSCQueryService, err)
@@ -23,7 +24,7 @@ func TestNewNodeApiResolver_NilSCQueryServiceShouldErr(t *testing.T) {
 func TestNewNodeApiResolver_NilStatusMetricsShouldErr(t *testing.T) {
 	t.Parallel()
 
-	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, nil, &mock.TransactionCostEstimatorMock{})
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, nil, &mock.TransactionCostEstimatorMock{}, &mock.TransactionSimulatorStub{}, &mock.PrometheusMetricsStub{})
 
 	assert.Nil(t, nar)
 	assert.Equal(t, external.ErrNilStatusMetrics, err)
@@ -32,16 +33,34 @@ func TestNewNodeApiResolver_NilStatusMetricsShouldErr(t *testing.T) {
 func TestNewNodeApiResolver_NilTransactionCostEstsimator(t *testing.T) {
 	t.Parallel()
 
-	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, nil)
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, nil, &mock.TransactionSimulatorStub{}, &mock.PrometheusMetricsStub{})
 
 	assert.Nil(t, nar)
 	assert.Equal(t, external.ErrNilTransactionCostHandler, err)
 }
 
+func TestNewNodeApiResolver_NilTransactionSimulatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, nil, &mock.PrometheusMetricsStub{})
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilTransactionSimulator, err)
+}
+
+func TestNewNodeApiResolver_NilPrometheusMetricsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, &mock.TransactionSimulatorStub{}, nil)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilPrometheusMetrics, err)
+}
+
 func TestNewNodeApiResolver_ShouldWork(t *testing.T) {
 	t.Parallel()
 
-	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{})
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, &mock.TransactionSimulatorStub{}, &mock.PrometheusMetricsStub{})
 
 	assert.Nil(t, err)
 	assert.False(t, check.IfNil(nar))
@@ -57,7 +76,7 @@ func TestNodeApiResolver_GetDataValueShouldCall(t *testing.T) {
 			return &vmcommon.VMOutput{}, nil
 		},
 	},
-		&mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{})
+		&mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, &mock.TransactionSimulatorStub{}, &mock.PrometheusMetricsStub{})
 
 	_, _ = nar.ExecuteSCQuery(&process.SCQuery{
 		ScAddress: []byte{0},
@@ -80,6 +99,8 @@ func TestNodeApiResolver_StatusMetricsMapWithoutP2PShouldBeCalled(t *testing.T)
 			},
 		},
 		&mock.TransactionCostEstimatorMock{},
+		&mock.TransactionSimulatorStub{},
+		&mock.PrometheusMetricsStub{},
 	)
 	_ = nar.StatusMetrics().StatusMetricsMapWithoutP2P()
 
@@ -99,6 +120,8 @@ func TestNodeApiResolver_StatusP2pMetricsMapShouldBeCalled(t *testing.T) {
 			},
 		},
 		&mock.TransactionCostEstimatorMock{},
+		&mock.TransactionSimulatorStub{},
+		&mock.PrometheusMetricsStub{},
 	)
 	_ = nar.StatusMetrics().StatusP2pMetricsMap()
 
@@ -118,6 +141,8 @@ func TestNodeApiResolver_StatusMetricsMapWhitoutP2PShouldBeCalled(t *testing.T)
 			},
 		},
 		&mock.TransactionCostEstimatorMock{},
+		&mock.TransactionSimulatorStub{},
+		&mock.PrometheusMetricsStub{},
 	)
 	_ = nar.StatusMetrics().StatusMetricsMapWithoutP2P()
 
@@ -137,6 +162,8 @@ func TestNodeApiResolver_StatusP2PMetricsMapShouldBeCalled(t *testing.T) {
 			},
 		},
 		&mock.TransactionCostEstimatorMock{},
+		&mock.TransactionSimulatorStub{},
+		&mock.PrometheusMetricsStub{},
 	)
 	_ = nar.StatusMetrics().StatusP2pMetricsMap()
 
@@ -156,8 +183,50 @@ func TestNodeApiResolver_NetworkMetricsMapShouldBeCalled(t *testing.T) {
 			},
 		},
 		&mock.TransactionCostEstimatorMock{},
+		&mock.TransactionSimulatorStub{},
+		&mock.PrometheusMetricsStub{},
 	)
 	_ = nar.StatusMetrics().NetworkMetrics()
 
 	assert.True(t, wasCalled)
 }
+
+func TestNodeApiResolver_SimulateTransactionShouldCall(t *testing.T) {
+	t.Parallel()
+
+	expectedResults := &transaction.SimulationResults{Status: "success"}
+	nar, _ := external.NewNodeApiResolver(
+		&mock.SCQueryServiceStub{},
+		&mock.StatusMetricsStub{},
+		&mock.TransactionCostEstimatorMock{},
+		&mock.TransactionSimulatorStub{
+			SimulateTransactionCalled: func(tx *transaction.Transaction) (*transaction.SimulationResults, error) {
+				return expectedResults, nil
+			},
+		},
+		&mock.PrometheusMetricsStub{},
+	)
+
+	results, err := nar.SimulateTransaction(&transaction.Transaction{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResults, results)
+}
+
+func TestNodeApiResolver_PrometheusMetricsShouldCall(t *testing.T) {
+	t.Parallel()
+
+	nar, _ := external.NewNodeApiResolver(
+		&mock.SCQueryServiceStub{},
+		&mock.StatusMetricsStub{},
+		&mock.TransactionCostEstimatorMock{},
+		&mock.TransactionSimulatorStub{},
+		&mock.PrometheusMetricsStub{
+			MetricsCalled: func() string {
+				return "erd_nonce 1\n"
+			},
+		},
+	)
+
+	assert.Equal(t, "erd_nonce 1\n", nar.PrometheusMetrics())
+}
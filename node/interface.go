@@ -4,7 +4,10 @@ import (
 	"io"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process/events"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 )
 
 // P2PMessenger defines a subset of the p2p.Messenger interface
@@ -70,3 +73,22 @@ type Throttler interface {
 	EndProcessing()
 	IsInterfaceNil() bool
 }
+
+// EventsSubscriber defines the component which streams the events selected by a filter
+type EventsSubscriber interface {
+	Subscribe(filter events.Filter) (events.Subscription, error)
+	IsInterfaceNil() bool
+}
+
+// TxPoolInspector defines a transactions pool able to describe its content, for diagnosis purposes
+type TxPoolInspector interface {
+	GetCachesStatistics() []txcache.CacheStatistics
+	GetSenderInfo(sender []byte) (*txcache.SenderInfo, bool)
+	GetWrappedTransaction(txHash []byte) (*txcache.WrappedTransaction, string, bool)
+}
+
+// TxReplacementChecker defines a transactions pool able to tell whether a transaction would be accepted, with respect
+// to the transaction having the same sender and nonce already held in the pool
+type TxReplacementChecker interface {
+	CheckReplacement(tx data.TransactionHandler, txHash []byte, cacheID string) error
+}
@@ -24,6 +24,7 @@ type AccountsStub struct {
 	SetStateCheckpointCalled func(rootHash []byte)
 	IsPruningEnabledCalled   func() bool
 	GetAllLeavesCalled       func(rootHash []byte) (map[string][]byte, error)
+	GetProofCalled           func(address []byte) ([][]byte, error)
 	RecreateAllTriesCalled   func(rootHash []byte) (map[string]data.Trie, error)
 }
 
@@ -159,6 +160,15 @@ func (as *AccountsStub) IsPruningEnabled() bool {
 	return false
 }
 
+// GetProof -
+func (as *AccountsStub) GetProof(address []byte) ([][]byte, error) {
+	if as.GetProofCalled != nil {
+		return as.GetProofCalled(address)
+	}
+
+	return nil, errNotImplemented
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (as *AccountsStub) IsInterfaceNil() bool {
 	return as == nil
@@ -0,0 +1,24 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/process/events"
+)
+
+// EventsSubscriberStub -
+type EventsSubscriberStub struct {
+	SubscribeCalled func(filter events.Filter) (events.Subscription, error)
+}
+
+// Subscribe -
+func (ess *EventsSubscriberStub) Subscribe(filter events.Filter) (events.Subscription, error) {
+	if ess.SubscribeCalled != nil {
+		return ess.SubscribeCalled(filter)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (ess *EventsSubscriberStub) IsInterfaceNil() bool {
+	return ess == nil
+}
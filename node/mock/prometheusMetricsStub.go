@@ -0,0 +1,19 @@
+package mock
+
+// PrometheusMetricsStub -
+type PrometheusMetricsStub struct {
+	MetricsCalled func() string
+}
+
+// Metrics -
+func (pms *PrometheusMetricsStub) Metrics() string {
+	if pms.MetricsCalled != nil {
+		return pms.MetricsCalled()
+	}
+	return ""
+}
+
+// IsInterfaceNil -
+func (pms *PrometheusMetricsStub) IsInterfaceNil() bool {
+	return pms == nil
+}
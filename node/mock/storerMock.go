@@ -54,7 +54,7 @@ func (sm *StorerMock) HasInEpoch(key []byte, epoch uint32) error {
 
 // SearchFirst -
 func (sm *StorerMock) SearchFirst(key []byte) ([]byte, error) {
-	return nil, errors.New("not implemented")
+	return sm.Get(key)
 }
 
 // Close -
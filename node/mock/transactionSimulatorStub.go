@@ -0,0 +1,21 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/data/transaction"
+
+// TransactionSimulatorStub -
+type TransactionSimulatorStub struct {
+	SimulateTransactionCalled func(tx *transaction.Transaction) (*transaction.SimulationResults, error)
+}
+
+// SimulateTransaction -
+func (tss *TransactionSimulatorStub) SimulateTransaction(tx *transaction.Transaction) (*transaction.SimulationResults, error) {
+	if tss.SimulateTransactionCalled != nil {
+		return tss.SimulateTransactionCalled(tx)
+	}
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (tss *TransactionSimulatorStub) IsInterfaceNil() bool {
+	return tss == nil
+}
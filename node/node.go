@@ -16,6 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/chronology"
+	"github.com/ElrondNetwork/elrond-go/consensus/slashing"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -31,6 +32,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/provider"
 	"github.com/ElrondNetwork/elrond-go/debug"
+	"github.com/ElrondNetwork/elrond-go/debug/consensusTrace"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/facade"
 	"github.com/ElrondNetwork/elrond-go/hashing"
@@ -48,6 +50,8 @@ import (
 	procTx "github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	vmProcess "github.com/ElrondNetwork/elrond-go/vm/process"
 )
 
 // SendTransactionsPipe is the pipe used for sending new transactions
@@ -82,6 +86,7 @@ type Node struct {
 	epochStartTrigger             epochStart.TriggerHandler
 	epochStartRegistrationHandler epochStart.RegistrationHandler
 	accounts                      state.AccountsAdapter
+	accountsRecreator             state.AccountsAdapterRecreator
 	addressPubkeyConverter        core.PubkeyConverter
 	validatorPubkeyConverter      core.PubkeyConverter
 	uint64ByteSliceConverter      typeConverters.Uint64ByteSliceConverter
@@ -95,6 +100,9 @@ type Node struct {
 	whiteListRequest              process.WhiteListHandler
 	whiteListerVerifiedTxs        process.WhiteListHandler
 	apiTransactionByHashThrottler Throttler
+	accountHistory                process.AccountHistoryHandler
+	eventsSubscriber              EventsSubscriber
+	equivocationProofsPool        storage.Cacher
 
 	pubKey            crypto.PublicKey
 	privKey           crypto.PrivateKey
@@ -121,6 +129,7 @@ type Node struct {
 	bootstrapRoundIndex      uint64
 
 	indexer                 indexer.Indexer
+	roundTracer             consensus.RoundTracer
 	blocksBlackListHandler  process.BlackListHandler
 	bootStorer              process.BootStorer
 	requestedItemsHandler   dataRetriever.RequestedItemsHandler
@@ -168,6 +177,7 @@ func NewNode(opts ...Option) (*Node, error) {
 		ctx:                      context.Background(),
 		currentSendingGoRoutines: 0,
 		appStatusHandler:         statusHandler.NewNilStatusHandler(),
+		roundTracer:              consensusTrace.NewDisabledRoundTracer(),
 		queryHandlers:            make(map[string]debug.QueryHandler),
 	}
 	for _, opt := range opts {
@@ -269,6 +279,11 @@ func (n *Node) StartConsensus() error {
 		netInputMarshalizer = marshal.NewSizeCheckUnmarshalizer(n.internalMarshalizer, n.sizeCheckDelta)
 	}
 
+	equivocationDetector, err := n.createEquivocationDetector()
+	if err != nil {
+		return err
+	}
+
 	workerArgs := &spos.WorkerArgs{
 		ConsensusService:         consensusService,
 		BlockChain:               n.blkc,
@@ -290,6 +305,7 @@ func (n *Node) StartConsensus() error {
 		NetworkShardingCollector: n.networkShardingCollector,
 		AntifloodHandler:         n.inputAntifloodHandler,
 		PoolAdder:                n.dataPool.MiniBlocks(),
+		EquivocationDetector:     equivocationDetector,
 		SignatureSize:            n.signatureSize,
 		PublicKeySize:            n.publicKeySize,
 	}
@@ -343,6 +359,7 @@ func (n *Node) StartConsensus() error {
 		n.consensusType,
 		n.appStatusHandler,
 		n.indexer,
+		n.roundTracer,
 		n.chainID,
 	)
 	if err != nil {
@@ -359,8 +376,8 @@ func (n *Node) StartConsensus() error {
 	return nil
 }
 
-// GetBalance gets the balance for a specific address
-func (n *Node) GetBalance(address string) (*big.Int, error) {
+// GetBalance gets the balance for a specific address, in the state selected by the query options
+func (n *Node) GetBalance(address string, options state.QueryOptions) (*big.Int, error) {
 	if check.IfNil(n.addressPubkeyConverter) || check.IfNil(n.accounts) {
 		return nil, errors.New("initialize AccountsAdapter and PubkeyConverter first")
 	}
@@ -369,7 +386,12 @@ func (n *Node) GetBalance(address string) (*big.Int, error) {
 	if err != nil {
 		return nil, errors.New("invalid address, could not decode from: " + err.Error())
 	}
-	accWrp, err := n.accounts.GetExistingAccount(addr)
+	accounts, err := n.getAccountsAdapter(options)
+	if err != nil {
+		return nil, err
+	}
+
+	accWrp, err := accounts.GetExistingAccount(addr)
 	if err != nil {
 		return nil, errors.New("could not fetch sender address from provided param: " + err.Error())
 	}
@@ -655,6 +677,62 @@ func (n *Node) createConsensusTopic(messageProcessor p2p.MessageProcessor) error
 	return n.messenger.RegisterMessageProcessor(n.consensusTopic, messageProcessor)
 }
 
+// createEquivocationDetector creates the detector of the keys signing two different blocks in the same round, along
+// with the processor of the equivocation proofs topic. Both of them add the proofs to the pool from where the metachain
+// includes them in its blocks
+func (n *Node) createEquivocationDetector() (spos.EquivocationDetector, error) {
+	sigVerifier, err := vmProcess.NewMessageSigVerifier(n.keyGen, n.singleSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	proofVerifier, err := slashing.NewProofVerifier(slashing.ArgsProofVerifier{
+		Marshalizer:       n.internalMarshalizer,
+		Hasher:            n.hasher,
+		SignatureVerifier: sigVerifier,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	proofsProcessor, err := slashing.NewProofsProcessor(slashing.ArgsProofsProcessor{
+		ProofVerifier:    proofVerifier,
+		ProofsPool:       n.equivocationProofsPool,
+		AntifloodHandler: n.inputAntifloodHandler,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = n.createEquivocationProofsTopic(proofsProcessor)
+	if err != nil {
+		return nil, err
+	}
+
+	return slashing.NewEquivocationDetector(slashing.ArgsEquivocationDetector{
+		Marshalizer:       n.internalMarshalizer,
+		Hasher:            n.hasher,
+		SignatureVerifier: sigVerifier,
+		Messenger:         n.messenger,
+		ProofsPool:        n.equivocationProofsPool,
+	})
+}
+
+func (n *Node) createEquivocationProofsTopic(messageProcessor p2p.MessageProcessor) error {
+	if !n.messenger.HasTopic(core.EquivocationProofsTopic) {
+		err := n.messenger.CreateTopic(core.EquivocationProofsTopic, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	if n.messenger.HasTopicValidator(core.EquivocationProofsTopic) {
+		return ErrValidatorAlreadySet
+	}
+
+	return n.messenger.RegisterMessageProcessor(core.EquivocationProofsTopic, messageProcessor)
+}
+
 // SendBulkTransactions sends the provided transactions as a bulk, optimizing transfer between nodes
 func (n *Node) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
 	if len(txs) == 0 {
@@ -812,8 +890,11 @@ func (n *Node) ValidateTransaction(tx *transaction.Transaction) error {
 		// we allow the broadcast of provided transaction even if that transaction is not targeted on the current shard
 		return nil
 	}
+	if err != nil {
+		return err
+	}
 
-	return err
+	return n.checkTxReplacement(intTx.Transaction(), intTx.Hash(), intTx.SenderShardId(), intTx.ReceiverShardId())
 }
 
 func (n *Node) sendBulkTransactionsFromShard(transactions [][]byte, senderShardId uint32) error {
@@ -912,8 +993,8 @@ func (n *Node) CreateTransaction(
 	return tx, txHash, nil
 }
 
-// GetAccount will return account details for a given address
-func (n *Node) GetAccount(address string) (state.UserAccountHandler, error) {
+// GetAccount will return account details for a given address, in the state selected by the query options
+func (n *Node) GetAccount(address string, options state.QueryOptions) (state.UserAccountHandler, error) {
 	if check.IfNil(n.addressPubkeyConverter) {
 		return nil, ErrNilPubkeyConverter
 	}
@@ -926,7 +1007,12 @@ func (n *Node) GetAccount(address string) (state.UserAccountHandler, error) {
 		return nil, err
 	}
 
-	accWrp, err := n.accounts.GetExistingAccount(addr)
+	accounts, err := n.getAccountsAdapter(options)
+	if err != nil {
+		return nil, err
+	}
+
+	accWrp, err := accounts.GetExistingAccount(addr)
 	if err != nil {
 		if err == state.ErrAccNotFound {
 			return state.NewUserAccount(addr)
@@ -1038,6 +1124,11 @@ func (n *Node) DecodeAddressPubkey(pk string) ([]byte, error) {
 	return n.addressPubkeyConverter.Decode(pk)
 }
 
+// GetQueryGasLimit returns the gas provided to the smart contract queries, which is the maximum gas limit per block
+func (n *Node) GetQueryGasLimit() uint64 {
+	return n.feeHandler.MaxGasLimitPerBlock(0)
+}
+
 // AddQueryHandler adds a query handler in cache
 func (n *Node) AddQueryHandler(name string, handler debug.QueryHandler) error {
 	if check.IfNil(handler) {
@@ -0,0 +1,280 @@
+package node
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+)
+
+// GetBlockByNonce returns the self shard block with the given nonce. If withTxs is set, the transactions
+// of each miniblock are fetched from storage and added to the response
+func (n *Node) GetBlockByNonce(nonce uint64, withTxs bool) (*block.ApiBlock, error) {
+	err := n.checkBlockQueryComponents()
+	if err != nil {
+		return nil, err
+	}
+
+	nonceToByteSlice := n.uint64ByteSliceConverter.ToByteSlice(nonce)
+	headerHash, err := n.store.GetStorer(n.getHdrNonceHashDataUnit()).SearchFirst(nonceToByteSlice)
+	if err != nil {
+		return nil, fmt.Errorf("%w for nonce %d", data.ErrBlockNotFound, nonce)
+	}
+
+	return n.getBlockByHash(headerHash, withTxs)
+}
+
+// GetBlockByHash returns the self shard block with the given hex encoded hash. If withTxs is set, the transactions
+// of each miniblock are fetched from storage and added to the response
+func (n *Node) GetBlockByHash(hash string, withTxs bool) (*block.ApiBlock, error) {
+	err := n.checkBlockQueryComponents()
+	if err != nil {
+		return nil, err
+	}
+
+	headerHash, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.getBlockByHash(headerHash, withTxs)
+}
+
+func (n *Node) checkBlockQueryComponents() error {
+	if check.IfNil(n.store) {
+		return ErrNilStore
+	}
+	if check.IfNil(n.shardCoordinator) {
+		return ErrNilShardCoordinator
+	}
+	if check.IfNil(n.internalMarshalizer) {
+		return ErrNilMarshalizer
+	}
+	if check.IfNil(n.uint64ByteSliceConverter) {
+		return ErrNilUint64ByteSliceConverter
+	}
+	if check.IfNil(n.addressPubkeyConverter) {
+		return ErrNilPubkeyConverter
+	}
+
+	return nil
+}
+
+func (n *Node) getHdrNonceHashDataUnit() dataRetriever.UnitType {
+	selfShardID := n.shardCoordinator.SelfId()
+	if selfShardID == core.MetachainShardId {
+		return dataRetriever.MetaHdrNonceHashDataUnit
+	}
+
+	return dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(selfShardID)
+}
+
+func (n *Node) getBlockByHash(headerHash []byte, withTxs bool) (*block.ApiBlock, error) {
+	if n.shardCoordinator.SelfId() == core.MetachainShardId {
+		return n.getMetaBlock(headerHash, withTxs)
+	}
+
+	return n.getShardBlock(headerHash, withTxs)
+}
+
+func (n *Node) getShardBlock(headerHash []byte, withTxs bool) (*block.ApiBlock, error) {
+	headerBytes, err := n.store.GetStorer(dataRetriever.BlockHeaderUnit).SearchFirst(headerHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w for hash %s", data.ErrBlockNotFound, hex.EncodeToString(headerHash))
+	}
+
+	header := &block.Header{}
+	err = n.internalMarshalizer.Unmarshal(header, headerBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	miniBlocks, err := n.getApiMiniBlocks(header.MiniBlockHeaders, withTxs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &block.ApiBlock{
+		Nonce:           header.Nonce,
+		Round:           header.Round,
+		Hash:            hex.EncodeToString(headerHash),
+		PrevBlockHash:   hex.EncodeToString(header.PrevHash),
+		Epoch:           header.Epoch,
+		Shard:           header.ShardID,
+		NumTxs:          header.TxCount,
+		TimeStamp:       header.TimeStamp,
+		RootHash:        hex.EncodeToString(header.RootHash),
+		AccumulatedFees: bigIntToString(header.AccumulatedFees),
+		DeveloperFees:   bigIntToString(header.DeveloperFees),
+		MiniBlocks:      miniBlocks,
+	}, nil
+}
+
+func (n *Node) getMetaBlock(headerHash []byte, withTxs bool) (*block.ApiBlock, error) {
+	headerBytes, err := n.store.GetStorer(dataRetriever.MetaBlockUnit).SearchFirst(headerHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w for hash %s", data.ErrBlockNotFound, hex.EncodeToString(headerHash))
+	}
+
+	header := &block.MetaBlock{}
+	err = n.internalMarshalizer.Unmarshal(header, headerBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	miniBlocks, err := n.getApiMiniBlocks(header.MiniBlockHeaders, withTxs)
+	if err != nil {
+		return nil, err
+	}
+
+	notarizedBlocks := make([]*block.ApiNotarizedBlock, 0, len(header.ShardInfo))
+	for _, shardData := range header.ShardInfo {
+		notarizedBlocks = append(notarizedBlocks, &block.ApiNotarizedBlock{
+			Hash:  hex.EncodeToString(shardData.HeaderHash),
+			Nonce: shardData.Nonce,
+			Round: shardData.Round,
+			Shard: shardData.ShardID,
+		})
+	}
+
+	apiBlock := &block.ApiBlock{
+		Nonce:           header.Nonce,
+		Round:           header.Round,
+		Hash:            hex.EncodeToString(headerHash),
+		PrevBlockHash:   hex.EncodeToString(header.PrevHash),
+		Epoch:           header.Epoch,
+		Shard:           core.MetachainShardId,
+		NumTxs:          header.TxCount,
+		TimeStamp:       header.TimeStamp,
+		RootHash:        hex.EncodeToString(header.RootHash),
+		AccumulatedFees: bigIntToString(header.AccumulatedFees),
+		DeveloperFees:   bigIntToString(header.DeveloperFees),
+		MiniBlocks:      miniBlocks,
+		NotarizedBlocks: notarizedBlocks,
+	}
+
+	if header.IsStartOfEpochBlock() {
+		apiBlock.EpochStartInfo = createApiEpochStartInfo(&header.EpochStart)
+	}
+
+	return apiBlock, nil
+}
+
+func createApiEpochStartInfo(epochStart *block.EpochStart) *block.ApiEpochStartInfo {
+	lastFinalizedHeaders := make([]*block.ApiNotarizedBlock, 0, len(epochStart.LastFinalizedHeaders))
+	for _, shardData := range epochStart.LastFinalizedHeaders {
+		lastFinalizedHeaders = append(lastFinalizedHeaders, &block.ApiNotarizedBlock{
+			Hash:     hex.EncodeToString(shardData.HeaderHash),
+			Nonce:    shardData.Nonce,
+			Round:    shardData.Round,
+			Shard:    shardData.ShardID,
+			RootHash: hex.EncodeToString(shardData.RootHash),
+		})
+	}
+
+	economics := epochStart.Economics
+	return &block.ApiEpochStartInfo{
+		TotalSupply:          bigIntToString(economics.TotalSupply),
+		TotalToDistribute:    bigIntToString(economics.TotalToDistribute),
+		TotalNewlyMinted:     bigIntToString(economics.TotalNewlyMinted),
+		RewardsPerBlock:      bigIntToString(economics.RewardsPerBlock),
+		RewardsForCommunity:  bigIntToString(economics.RewardsForCommunity),
+		NodePrice:            bigIntToString(economics.NodePrice),
+		PrevEpochStartRound:  economics.PrevEpochStartRound,
+		PrevEpochStartHash:   hex.EncodeToString(economics.PrevEpochStartHash),
+		LastFinalizedHeaders: lastFinalizedHeaders,
+	}
+}
+
+func (n *Node) getApiMiniBlocks(miniBlockHeaders []block.MiniBlockHeader, withTxs bool) ([]*block.ApiMiniBlock, error) {
+	miniBlocks := make([]*block.ApiMiniBlock, 0, len(miniBlockHeaders))
+	for _, mbHeader := range miniBlockHeaders {
+		apiMiniBlock := &block.ApiMiniBlock{
+			Hash:             hex.EncodeToString(mbHeader.Hash),
+			Type:             mbHeader.Type.String(),
+			SourceShard:      mbHeader.SenderShardID,
+			DestinationShard: mbHeader.ReceiverShardID,
+			NumTxs:           mbHeader.TxCount,
+		}
+
+		if withTxs {
+			txs, err := n.getMiniBlockTransactions(mbHeader.Hash, mbHeader.Type)
+			if err != nil {
+				return nil, err
+			}
+			apiMiniBlock.Transactions = txs
+		}
+
+		miniBlocks = append(miniBlocks, apiMiniBlock)
+	}
+
+	return miniBlocks, nil
+}
+
+func (n *Node) getMiniBlockTransactions(miniBlockHash []byte, mbType block.Type) ([]*transaction.ApiTransactionResult, error) {
+	unit, txType, ok := getUnitAndTxTypeForMiniBlockType(mbType)
+	if !ok {
+		return nil, nil
+	}
+
+	miniBlockBytes, err := n.store.GetStorer(dataRetriever.MiniBlockUnit).SearchFirst(miniBlockHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w for hash %s", ErrMiniBlockNotFound, hex.EncodeToString(miniBlockHash))
+	}
+
+	miniBlock := &block.MiniBlock{}
+	err = n.internalMarshalizer.Unmarshal(miniBlock, miniBlockBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	txsStorer := n.store.GetStorer(unit)
+	txs := make([]*transaction.ApiTransactionResult, 0, len(miniBlock.TxHashes))
+	for _, txHash := range miniBlock.TxHashes {
+		txBytes, errGet := txsStorer.SearchFirst(txHash)
+		if errGet != nil {
+			log.Debug("getMiniBlockTransactions: transaction not found",
+				"miniblock hash", miniBlockHash,
+				"tx hash", txHash,
+			)
+			continue
+		}
+
+		tx, errUnmarshal := n.unmarshalTransaction(txBytes, txType)
+		if errUnmarshal != nil {
+			return nil, errUnmarshal
+		}
+
+		tx.Hash = hex.EncodeToString(txHash)
+		txs = append(txs, tx)
+	}
+
+	return txs, nil
+}
+
+func getUnitAndTxTypeForMiniBlockType(mbType block.Type) (dataRetriever.UnitType, transactionType, bool) {
+	switch mbType {
+	case block.TxBlock, block.InvalidBlock:
+		return dataRetriever.TransactionUnit, normalTx, true
+	case block.SmartContractResultBlock:
+		return dataRetriever.UnsignedTransactionUnit, unsignedTx, true
+	case block.RewardsBlock:
+		return dataRetriever.RewardTransactionUnit, rewardTx, true
+	default:
+		return 0, invalidTx, false
+	}
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
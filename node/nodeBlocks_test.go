@@ -0,0 +1,176 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createBlocksStore() (*mock.ChainStorerMock, map[dataRetriever.UnitType]*mock.StorerMock) {
+	storers := make(map[dataRetriever.UnitType]*mock.StorerMock)
+	store := &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			storer, ok := storers[unitType]
+			if !ok {
+				storer = mock.NewStorerMock()
+				storers[unitType] = storer
+			}
+			return storer
+		},
+	}
+
+	return store, storers
+}
+
+func createNodeForBlocks(store dataRetriever.StorageService, selfShardID uint32) *node.Node {
+	n, _ := node.NewNode(
+		node.WithDataStore(store),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{SelfShardId: selfShardID}),
+		node.WithInternalMarshalizer(&mock.MarshalizerFake{}, 0),
+		node.WithUint64ByteSliceConverter(mock.NewNonceHashConverterMock()),
+		node.WithAddressPubkeyConverter(&mock.PubkeyConverterMock{}),
+	)
+
+	return n
+}
+
+func putMarshalized(t *testing.T, storer storage.Storer, key []byte, obj interface{}) {
+	buff, err := (&mock.MarshalizerFake{}).Marshal(obj)
+	require.Nil(t, err)
+	require.Nil(t, storer.Put(key, buff))
+}
+
+func TestNode_GetBlockByNonceNilStoreShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+	apiBlock, err := n.GetBlockByNonce(1, false)
+
+	assert.Nil(t, apiBlock)
+	assert.Equal(t, node.ErrNilStore, err)
+}
+
+func TestNode_GetBlockByHashInvalidHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	store, _ := createBlocksStore()
+	n := createNodeForBlocks(store, 0)
+	apiBlock, err := n.GetBlockByHash("not hex", false)
+
+	assert.Nil(t, apiBlock)
+	assert.NotNil(t, err)
+}
+
+func TestNode_GetBlockByNonceNotFoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	store, _ := createBlocksStore()
+	n := createNodeForBlocks(store, 0)
+	apiBlock, err := n.GetBlockByNonce(1, false)
+
+	assert.Nil(t, apiBlock)
+	assert.True(t, errors.Is(err, data.ErrBlockNotFound))
+}
+
+func TestNode_GetBlockByNonceShardBlockWithTxsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	store, _ := createBlocksStore()
+	headerHash := []byte("headerHash")
+	mbHash := []byte("miniBlockHash")
+	txHash := []byte("txHash")
+	header := &block.Header{
+		Nonce:           3,
+		Round:           4,
+		ShardID:         0,
+		TxCount:         1,
+		PrevHash:        []byte("prevHash"),
+		AccumulatedFees: big.NewInt(100),
+		MiniBlockHeaders: []block.MiniBlockHeader{
+			{Hash: mbHash, SenderShardID: 0, ReceiverShardID: 1, TxCount: 1, Type: block.TxBlock},
+			{Hash: []byte("peerMiniBlock"), Type: block.PeerBlock},
+		},
+	}
+	nonceBytes := mock.NewNonceHashConverterMock().ToByteSlice(3)
+	_ = store.GetStorer(dataRetriever.ShardHdrNonceHashDataUnit).Put(nonceBytes, headerHash)
+	putMarshalized(t, store.GetStorer(dataRetriever.BlockHeaderUnit), headerHash, header)
+	putMarshalized(t, store.GetStorer(dataRetriever.MiniBlockUnit), mbHash, &block.MiniBlock{
+		TxHashes: [][]byte{txHash, []byte("missingTx")},
+		Type:     block.TxBlock,
+	})
+	putMarshalized(t, store.GetStorer(dataRetriever.TransactionUnit), txHash, &transaction.Transaction{
+		Nonce: 7,
+		Value: big.NewInt(10),
+	})
+
+	n := createNodeForBlocks(store, 0)
+	apiBlock, err := n.GetBlockByNonce(3, true)
+	require.Nil(t, err)
+
+	assert.Equal(t, uint64(3), apiBlock.Nonce)
+	assert.Equal(t, uint64(4), apiBlock.Round)
+	assert.Equal(t, hex.EncodeToString(headerHash), apiBlock.Hash)
+	assert.Equal(t, hex.EncodeToString([]byte("prevHash")), apiBlock.PrevBlockHash)
+	assert.Equal(t, "100", apiBlock.AccumulatedFees)
+	assert.Equal(t, "0", apiBlock.DeveloperFees)
+	require.Equal(t, 2, len(apiBlock.MiniBlocks))
+
+	txMiniBlock := apiBlock.MiniBlocks[0]
+	assert.Equal(t, hex.EncodeToString(mbHash), txMiniBlock.Hash)
+	assert.Equal(t, block.TxBlock.String(), txMiniBlock.Type)
+	assert.Equal(t, uint32(1), txMiniBlock.DestinationShard)
+	require.Equal(t, 1, len(txMiniBlock.Transactions))
+	assert.Equal(t, hex.EncodeToString(txHash), txMiniBlock.Transactions[0].Hash)
+	assert.Equal(t, uint64(7), txMiniBlock.Transactions[0].Nonce)
+	assert.Equal(t, 0, len(apiBlock.MiniBlocks[1].Transactions))
+}
+
+func TestNode_GetBlockByHashMetaBlockShouldWork(t *testing.T) {
+	t.Parallel()
+
+	store, _ := createBlocksStore()
+	headerHash := []byte("metaHash")
+	header := &block.MetaBlock{
+		Nonce: 10,
+		Round: 11,
+		Epoch: 2,
+		ShardInfo: []block.ShardData{
+			{HeaderHash: []byte("shardHash"), Nonce: 5, Round: 6, ShardID: 1},
+		},
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: []block.EpochStartShardData{
+				{HeaderHash: []byte("lastFinalized"), Nonce: 4, ShardID: 1, RootHash: []byte("root")},
+			},
+			Economics: block.Economics{
+				TotalSupply: big.NewInt(1000),
+			},
+		},
+	}
+	putMarshalized(t, store.GetStorer(dataRetriever.MetaBlockUnit), headerHash, header)
+
+	n := createNodeForBlocks(store, core.MetachainShardId)
+	apiBlock, err := n.GetBlockByHash(hex.EncodeToString(headerHash), false)
+	require.Nil(t, err)
+
+	assert.Equal(t, uint64(10), apiBlock.Nonce)
+	assert.Equal(t, core.MetachainShardId, apiBlock.Shard)
+	require.Equal(t, 1, len(apiBlock.NotarizedBlocks))
+	assert.Equal(t, hex.EncodeToString([]byte("shardHash")), apiBlock.NotarizedBlocks[0].Hash)
+	assert.Equal(t, uint64(5), apiBlock.NotarizedBlocks[0].Nonce)
+	require.NotNil(t, apiBlock.EpochStartInfo)
+	assert.Equal(t, "1000", apiBlock.EpochStartInfo.TotalSupply)
+	require.Equal(t, 1, len(apiBlock.EpochStartInfo.LastFinalizedHeaders))
+	assert.Equal(t, hex.EncodeToString([]byte("root")), apiBlock.EpochStartInfo.LastFinalizedHeaders[0].RootHash)
+}
@@ -0,0 +1,33 @@
+package nodeDebugFactory
+
+import (
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/debug/factory"
+)
+
+// ConsensusRoundTracer is the constant string for the consensus round tracer
+const ConsensusRoundTracer = "consensus round tracer"
+
+// CreateConsensusDebugHandler creates the consensus round tracer and registers it as a query handler on the node
+func CreateConsensusDebugHandler(
+	node NodeWrapper,
+	config config.ConsensusDebugConfig,
+) (consensus.RoundTracer, error) {
+	if check.IfNil(node) {
+		return nil, ErrNilNodeWrapper
+	}
+
+	roundTracer, err := factory.NewConsensusRoundTracerFactory(config)
+	if err != nil {
+		return nil, err
+	}
+
+	err = node.AddQueryHandler(ConsensusRoundTracer, roundTracer)
+	if err != nil {
+		return nil, err
+	}
+
+	return roundTracer, nil
+}
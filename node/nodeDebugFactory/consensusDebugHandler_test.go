@@ -0,0 +1,78 @@
+package nodeDebugFactory
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/debug"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateConsensusDebugHandler_NilNodeWrapperShouldErr(t *testing.T) {
+	t.Parallel()
+
+	roundTracer, err := CreateConsensusDebugHandler(nil, config.ConsensusDebugConfig{})
+
+	assert.True(t, check.IfNil(roundTracer))
+	assert.Equal(t, ErrNilNodeWrapper, err)
+}
+
+func TestCreateConsensusDebugHandler_InvalidDebugConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	roundTracer, err := CreateConsensusDebugHandler(
+		&mock.NodeWrapperStub{},
+		config.ConsensusDebugConfig{
+			Enabled:         true,
+			NumRoundsToKeep: 0,
+		},
+	)
+
+	assert.True(t, check.IfNil(roundTracer))
+	assert.True(t, errors.Is(err, debug.ErrInvalidValue))
+}
+
+func TestCreateConsensusDebugHandler_AddQueryHandlerErrShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected err")
+	roundTracer, err := CreateConsensusDebugHandler(
+		&mock.NodeWrapperStub{
+			AddQueryHandlerCalled: func(name string, handler debug.QueryHandler) error {
+				return expectedErr
+			},
+		},
+		config.ConsensusDebugConfig{},
+	)
+
+	assert.True(t, check.IfNil(roundTracer))
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestCreateConsensusDebugHandler_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	var registeredName string
+	var registeredHandler debug.QueryHandler
+	roundTracer, err := CreateConsensusDebugHandler(
+		&mock.NodeWrapperStub{
+			AddQueryHandlerCalled: func(name string, handler debug.QueryHandler) error {
+				registeredName = name
+				registeredHandler = handler
+				return nil
+			},
+		},
+		config.ConsensusDebugConfig{
+			Enabled:         true,
+			NumRoundsToKeep: 10,
+		},
+	)
+
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(roundTracer))
+	assert.Equal(t, ConsensusRoundTracer, registeredName)
+	assert.True(t, registeredHandler == roundTracer)
+}
@@ -0,0 +1,16 @@
+package node
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/process/events"
+)
+
+// SubscribeEvents creates a subscription for the blocks, transactions, smart contract logs and start of epoch
+// events selected by the filter
+func (n *Node) SubscribeEvents(filter events.Filter) (events.Subscription, error) {
+	if check.IfNil(n.eventsSubscriber) {
+		return nil, ErrNilEventsSubscriber
+	}
+
+	return n.eventsSubscriber.Subscribe(filter)
+}
@@ -0,0 +1,42 @@
+package node_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process/events"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNode_SubscribeEventsNilSubscriberShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+	sub, err := n.SubscribeEvents(events.Filter{Blocks: true})
+
+	assert.Nil(t, sub)
+	assert.Equal(t, node.ErrNilEventsSubscriber, err)
+}
+
+func TestNode_SubscribeEventsShouldForwardFilter(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	filter := events.Filter{Addresses: []string{"address"}}
+	var receivedFilter events.Filter
+	n, _ := node.NewNode(
+		node.WithEventsSubscriber(&mock.EventsSubscriberStub{
+			SubscribeCalled: func(f events.Filter) (events.Subscription, error) {
+				receivedFilter = f
+				return nil, expectedErr
+			},
+		}),
+	)
+
+	_, err := n.SubscribeEvents(filter)
+
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, filter, receivedFilter)
+}
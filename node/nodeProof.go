@@ -0,0 +1,142 @@
+package node
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+// GetProof returns the Merkle inclusion proof of the account with the given address. The proof is built against
+// the root hash of the current block's state or, if the query options say so, of a past state
+func (n *Node) GetProof(address string, options state.QueryOptions) (*state.ApiProof, error) {
+	addr, accounts, rootHash, err := n.prepareProof(address, options)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = accounts.GetExistingAccount(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := accounts.GetProof(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return createApiProof(rootHash, addr, nil, proof), nil
+}
+
+// GetProofDataTrie returns the Merkle inclusion proof of the account with the given address together with the
+// Merkle inclusion proof of the given hex encoded key in the account's data trie. The data trie proof is built
+// against the account's root hash, which is itself proven by the account proof. The returned value is the one
+// stored by the account, while the value held by the proof's leaf also contains the key and the address as suffix
+func (n *Node) GetProofDataTrie(address string, key string, options state.QueryOptions) (*state.ApiProof, *state.ApiProof, error) {
+	keyBytes, err := hex.DecodeString(key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid key: %w", err)
+	}
+
+	addr, accounts, rootHash, err := n.prepareProof(address, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	accWrp, err := accounts.GetExistingAccount(addr)
+	if err != nil {
+		return nil, nil, err
+	}
+	account, ok := accWrp.(state.UserAccountHandler)
+	if !ok {
+		return nil, nil, state.ErrWrongTypeAssertion
+	}
+
+	accountProof, err := accounts.GetProof(addr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dataTrie := account.DataTrie()
+	if check.IfNil(dataTrie) {
+		return nil, nil, fmt.Errorf("%w: account has no data trie", state.ErrKeyNotFound)
+	}
+	value, err := account.DataTrieTracker().RetrieveValue(keyBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(value) == 0 {
+		return nil, nil, fmt.Errorf("%w: %s", state.ErrKeyNotFound, key)
+	}
+
+	dataTrieProof, err := dataTrie.GetProof(keyBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return createApiProof(rootHash, addr, nil, accountProof),
+		createApiProof(account.GetRootHash(), keyBytes, value, dataTrieProof),
+		nil
+}
+
+func (n *Node) prepareProof(address string, options state.QueryOptions) ([]byte, state.AccountsAdapter, []byte, error) {
+	if check.IfNil(n.addressPubkeyConverter) {
+		return nil, nil, nil, ErrNilPubkeyConverter
+	}
+	if check.IfNil(n.accountsRecreator) {
+		return nil, nil, nil, ErrNilAccountsRecreator
+	}
+
+	addr, err := n.addressPubkeyConverter.Decode(address)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	rootHash, err := n.getProofRootHash(options)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	accounts, err := n.accountsRecreator.RecreateAccountsAdapter(rootHash)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return addr, accounts, rootHash, nil
+}
+
+// getProofRootHash returns the root hash the proofs are built against. For the current state, the root hash
+// of the current block header is used, as the accounts adapter might hold changes not yet committed in a block
+func (n *Node) getProofRootHash(options state.QueryOptions) ([]byte, error) {
+	if !options.IsCurrentState() {
+		return n.GetStateRootHash(options)
+	}
+	if check.IfNil(n.blkc) {
+		return nil, ErrNilBlockchain
+	}
+
+	header := n.blkc.GetCurrentBlockHeader()
+	if check.IfNil(header) {
+		header = n.blkc.GetGenesisHeader()
+	}
+	if check.IfNil(header) {
+		return nil, fmt.Errorf("%w: no block header available", state.ErrStateNotAvailable)
+	}
+
+	return header.GetRootHash(), nil
+}
+
+func createApiProof(rootHash []byte, key []byte, value []byte, proof [][]byte) *state.ApiProof {
+	hexProof := make([]string, 0, len(proof))
+	for _, encNode := range proof {
+		hexProof = append(hexProof, hex.EncodeToString(encNode))
+	}
+
+	return &state.ApiProof{
+		RootHash: hex.EncodeToString(rootHash),
+		Key:      hex.EncodeToString(key),
+		Value:    hex.EncodeToString(value),
+		Proof:    hexProof,
+	}
+}
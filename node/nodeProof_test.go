@@ -0,0 +1,163 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNodeForProofs(accounts state.AccountsAdapter, expectedRootHash []byte) *node.Node {
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithAccountsAdapter(&mock.AccountsStub{}),
+		node.WithAccountsRecreator(&mock.AccountsAdapterRecreatorStub{
+			RecreateAccountsAdapterCalled: func(rootHash []byte) (state.AccountsAdapter, error) {
+				if hex.EncodeToString(rootHash) != hex.EncodeToString(expectedRootHash) {
+					return nil, state.ErrStateNotAvailable
+				}
+				return accounts, nil
+			},
+		}),
+		node.WithBlockChain(&mock.BlockChainMock{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{RootHash: expectedRootHash}
+			},
+		}),
+	)
+
+	return n
+}
+
+func TestNode_GetProofWithoutRecreatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+	)
+	proof, err := n.GetProof(createDummyHexAddress(64), state.QueryOptions{})
+
+	assert.Nil(t, proof)
+	assert.Equal(t, node.ErrNilAccountsRecreator, err)
+}
+
+func TestNode_GetProofAccountNotFoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	accounts := &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			return nil, state.ErrAccNotFound
+		},
+	}
+	n := createNodeForProofs(accounts, []byte("root hash"))
+	proof, err := n.GetProof(createDummyHexAddress(64), state.QueryOptions{})
+
+	assert.Nil(t, proof)
+	assert.Equal(t, state.ErrAccNotFound, err)
+}
+
+func TestNode_GetProofShouldUseCurrentBlockRootHash(t *testing.T) {
+	t.Parallel()
+
+	address := createDummyHexAddress(64)
+	accounts := &mock.AccountsStub{
+		GetExistingAccountCalled: func(addr []byte) (state.AccountHandler, error) {
+			return state.NewUserAccount(addr)
+		},
+		GetProofCalled: func(addr []byte) ([][]byte, error) {
+			assert.Equal(t, address, hex.EncodeToString(addr))
+			return [][]byte{[]byte("root"), []byte("leaf")}, nil
+		},
+	}
+	n := createNodeForProofs(accounts, []byte("root hash"))
+	proof, err := n.GetProof(address, state.QueryOptions{})
+
+	require.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString([]byte("root hash")), proof.RootHash)
+	assert.Equal(t, address, proof.Key)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("root")), hex.EncodeToString([]byte("leaf"))}, proof.Proof)
+}
+
+func TestNode_GetProofWithRootHashShouldUsePastState(t *testing.T) {
+	t.Parallel()
+
+	accounts := &mock.AccountsStub{
+		GetExistingAccountCalled: func(addr []byte) (state.AccountHandler, error) {
+			return state.NewUserAccount(addr)
+		},
+		GetProofCalled: func(addr []byte) ([][]byte, error) {
+			return [][]byte{[]byte("leaf")}, nil
+		},
+	}
+	n := createNodeForProofs(accounts, []byte("past root hash"))
+	proof, err := n.GetProof(createDummyHexAddress(64), state.QueryOptions{RootHash: []byte("past root hash")})
+
+	require.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString([]byte("past root hash")), proof.RootHash)
+}
+
+func TestNode_GetProofDataTrieMissingKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	accounts := &mock.AccountsStub{
+		GetExistingAccountCalled: func(addr []byte) (state.AccountHandler, error) {
+			acc, _ := state.NewUserAccount(addr)
+			acc.SetDataTrie(&mock.TrieStub{})
+			return acc, nil
+		},
+		GetProofCalled: func(addr []byte) ([][]byte, error) {
+			return [][]byte{[]byte("leaf")}, nil
+		},
+	}
+	n := createNodeForProofs(accounts, []byte("root hash"))
+	accountProof, dataTrieProof, err := n.GetProofDataTrie(createDummyHexAddress(64), "aabb", state.QueryOptions{})
+
+	assert.Nil(t, accountProof)
+	assert.Nil(t, dataTrieProof)
+	assert.True(t, errors.Is(err, state.ErrKeyNotFound))
+}
+
+func TestNode_GetProofDataTrieShouldWork(t *testing.T) {
+	t.Parallel()
+
+	key := []byte{0xaa, 0xbb}
+	value := []byte("value")
+	accounts := &mock.AccountsStub{
+		GetExistingAccountCalled: func(addr []byte) (state.AccountHandler, error) {
+			acc, _ := state.NewUserAccount(addr)
+			acc.SetRootHash([]byte("data trie root hash"))
+			acc.SetDataTrie(&mock.TrieStub{
+				GetCalled: func(k []byte) ([]byte, error) {
+					leafValue := append([]byte{}, value...)
+					leafValue = append(leafValue, k...)
+					return append(leafValue, addr...), nil
+				},
+				GetProofCalled: func(k []byte) ([][]byte, error) {
+					assert.Equal(t, key, k)
+					return [][]byte{[]byte("data trie leaf")}, nil
+				},
+			})
+			return acc, nil
+		},
+		GetProofCalled: func(addr []byte) ([][]byte, error) {
+			return [][]byte{[]byte("account leaf")}, nil
+		},
+	}
+	n := createNodeForProofs(accounts, []byte("root hash"))
+	accountProof, dataTrieProof, err := n.GetProofDataTrie(createDummyHexAddress(64), hex.EncodeToString(key), state.QueryOptions{})
+
+	require.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString([]byte("root hash")), accountProof.RootHash)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("account leaf"))}, accountProof.Proof)
+	assert.Equal(t, hex.EncodeToString([]byte("data trie root hash")), dataTrieProof.RootHash)
+	assert.Equal(t, hex.EncodeToString(key), dataTrieProof.Key)
+	assert.Equal(t, hex.EncodeToString(value), dataTrieProof.Value)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("data trie leaf"))}, dataTrieProof.Proof)
+}
@@ -0,0 +1,88 @@
+package node
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+)
+
+// GetStateRootHash returns the root hash of the state selected by the query options. If the options select
+// the current state, an empty root hash is returned
+func (n *Node) GetStateRootHash(options state.QueryOptions) ([]byte, error) {
+	err := options.Check()
+	if err != nil {
+		return nil, err
+	}
+	if len(options.RootHash) > 0 {
+		return options.RootHash, nil
+	}
+	if options.BlockNonce == nil {
+		return make([]byte, 0), nil
+	}
+
+	header, err := n.getHeaderByNonce(*options.BlockNonce)
+	if errors.Is(err, data.ErrBlockNotFound) {
+		return nil, fmt.Errorf("%w: %s", state.ErrStateNotAvailable, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return header.GetRootHash(), nil
+}
+
+// getAccountsAdapter returns the node's accounts adapter for the current state or an accounts adapter recreated
+// over the past state selected by the query options
+func (n *Node) getAccountsAdapter(options state.QueryOptions) (state.AccountsAdapter, error) {
+	if options.IsCurrentState() {
+		return n.accounts, nil
+	}
+	if check.IfNil(n.accountsRecreator) {
+		return nil, ErrNilAccountsRecreator
+	}
+
+	rootHash, err := n.GetStateRootHash(options)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.accountsRecreator.RecreateAccountsAdapter(rootHash)
+}
+
+func (n *Node) getHeaderByNonce(nonce uint64) (data.HeaderHandler, error) {
+	err := n.checkBlockQueryComponents()
+	if err != nil {
+		return nil, err
+	}
+
+	nonceToByteSlice := n.uint64ByteSliceConverter.ToByteSlice(nonce)
+	headerHash, err := n.store.GetStorer(n.getHdrNonceHashDataUnit()).SearchFirst(nonceToByteSlice)
+	if err != nil {
+		return nil, fmt.Errorf("%w for nonce %d", data.ErrBlockNotFound, nonce)
+	}
+
+	var header data.HeaderHandler = &block.Header{}
+	unit := dataRetriever.BlockHeaderUnit
+	if n.shardCoordinator.SelfId() == core.MetachainShardId {
+		header = &block.MetaBlock{}
+		unit = dataRetriever.MetaBlockUnit
+	}
+
+	headerBytes, err := n.store.GetStorer(unit).SearchFirst(headerHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w for nonce %d", data.ErrBlockNotFound, nonce)
+	}
+
+	err = n.internalMarshalizer.Unmarshal(header, headerBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
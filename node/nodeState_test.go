@@ -0,0 +1,125 @@
+package node_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_GetStateRootHashCurrentStateShouldReturnEmpty(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+	rootHash, err := n.GetStateRootHash(state.QueryOptions{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(rootHash))
+}
+
+func TestNode_GetStateRootHashBothOptionsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(1)
+	n, _ := node.NewNode()
+	rootHash, err := n.GetStateRootHash(state.QueryOptions{BlockNonce: &nonce, RootHash: []byte("root hash")})
+
+	assert.Nil(t, rootHash)
+	assert.Equal(t, state.ErrInvalidQueryOptions, err)
+}
+
+func TestNode_GetStateRootHashByRootHashShouldReturnIt(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+	rootHash, err := n.GetStateRootHash(state.QueryOptions{RootHash: []byte("root hash")})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("root hash"), rootHash)
+}
+
+func TestNode_GetStateRootHashUnknownNonceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(5)
+	store, _ := createBlocksStore()
+	n := createNodeForBlocks(store, 0)
+	rootHash, err := n.GetStateRootHash(state.QueryOptions{BlockNonce: &nonce})
+
+	assert.Nil(t, rootHash)
+	assert.True(t, errors.Is(err, state.ErrStateNotAvailable))
+}
+
+func TestNode_GetStateRootHashByNonceShouldWork(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(5)
+	headerHash := []byte("headerHash")
+	store, _ := createBlocksStore()
+	nonceBytes := mock.NewNonceHashConverterMock().ToByteSlice(nonce)
+	_ = store.GetStorer(dataRetriever.ShardHdrNonceHashDataUnit).Put(nonceBytes, headerHash)
+	putMarshalized(t, store.GetStorer(dataRetriever.BlockHeaderUnit), headerHash, &block.Header{
+		Nonce:    nonce,
+		RootHash: []byte("root hash"),
+	})
+
+	n := createNodeForBlocks(store, 0)
+	rootHash, err := n.GetStateRootHash(state.QueryOptions{BlockNonce: &nonce})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("root hash"), rootHash)
+}
+
+func TestNode_GetBalancePastStateWithoutRecreatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithAccountsAdapter(&mock.AccountsStub{}),
+	)
+	balance, err := n.GetBalance(createDummyHexAddress(64), state.QueryOptions{RootHash: []byte("root hash")})
+
+	assert.Nil(t, balance)
+	assert.Equal(t, node.ErrNilAccountsRecreator, err)
+}
+
+func TestNode_GetBalancePastStateShouldReadRecreatedAccounts(t *testing.T) {
+	t.Parallel()
+
+	pastAccounts := &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			acc, _ := state.NewUserAccount(address)
+			_ = acc.AddToBalance(big.NewInt(42))
+
+			return acc, nil
+		},
+	}
+	currentAccounts := &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			require.Fail(t, "should have not read the current state")
+			return nil, nil
+		},
+	}
+	recreator := &mock.AccountsAdapterRecreatorStub{
+		RecreateAccountsAdapterCalled: func(rootHash []byte) (state.AccountsAdapter, error) {
+			assert.Equal(t, []byte("root hash"), rootHash)
+			return pastAccounts, nil
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithAccountsAdapter(currentAccounts),
+		node.WithAccountsRecreator(recreator),
+	)
+	balance, err := n.GetBalance(createDummyHexAddress(64), state.QueryOptions{RootHash: []byte("root hash")})
+
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(42), balance)
+}
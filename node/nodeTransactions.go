@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
 	rewardTxData "github.com/ElrondNetwork/elrond-go/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
@@ -223,3 +224,40 @@ func (n *Node) prepareUnsignedTx(tx *smartContractResult.SmartContractResult) (*
 		Signature: "",
 	}, nil
 }
+
+// GetTransactionsForAddress returns the transactions which touched the given address, newest first, as they were
+// saved by the local account history index, along with whether older ones were pruned from the index
+func (n *Node) GetTransactionsForAddress(address string, from uint32, size uint32) (*transaction.ApiAccountHistory, error) {
+	if check.IfNil(n.addressPubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+	if check.IfNil(n.accountHistory) {
+		return nil, ErrNilAccountHistory
+	}
+
+	addr, err := n.addressPubkeyConverter.Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, truncated, err := n.accountHistory.GetTransactions(addr, from, size)
+	if err != nil {
+		return nil, err
+	}
+
+	apiEntries := make([]*transaction.ApiAccountHistoryEntry, 0, len(entries))
+	for _, entry := range entries {
+		apiEntries = append(apiEntries, &transaction.ApiAccountHistoryEntry{
+			Hash:       hex.EncodeToString(entry.TxHash),
+			BlockHash:  hex.EncodeToString(entry.BlockHash),
+			BlockNonce: entry.BlockNonce,
+			Round:      entry.Round,
+			Epoch:      entry.Epoch,
+		})
+	}
+
+	return &transaction.ApiAccountHistory{
+		Transactions: apiEntries,
+		Truncated:    truncated,
+	}, nil
+}
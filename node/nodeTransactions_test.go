@@ -580,3 +580,52 @@ func getUnsignedTx() (*smartContractResult.SmartContractResult, []byte) {
 	txBytes, _ := marshalizer.Marshal(&tx)
 	return &tx, txBytes
 }
+
+func TestNode_GetTransactionsForAddressNilAccountHistoryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(mock.NewPubkeyConverterMock(32)),
+	)
+
+	txs, err := n.GetTransactionsForAddress(hex.EncodeToString([]byte("address")), 0, 10)
+	assert.Nil(t, txs)
+	assert.Equal(t, node.ErrNilAccountHistory, err)
+}
+
+func TestNode_GetTransactionsForAddressShouldWork(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("address")
+	accountHistory := &mock.AccountHistoryStub{
+		GetTransactionsCalled: func(addr []byte, from uint32, size uint32) ([]*transaction.AccountHistoryEntry, bool, error) {
+			assert.Equal(t, address, addr)
+			assert.Equal(t, uint32(1), from)
+			assert.Equal(t, uint32(10), size)
+
+			return []*transaction.AccountHistoryEntry{
+				{TxHash: []byte("txHash"), BlockHash: []byte("blockHash"), BlockNonce: 2, Round: 3, Epoch: 4},
+			}, true, nil
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(mock.NewPubkeyConverterMock(32)),
+		node.WithAccountHistory(accountHistory),
+	)
+
+	history, err := n.GetTransactionsForAddress(hex.EncodeToString(address), 1, 10)
+	assert.Nil(t, err)
+	expectedHistory := &transaction.ApiAccountHistory{
+		Transactions: []*transaction.ApiAccountHistoryEntry{
+			{
+				Hash:       hex.EncodeToString([]byte("txHash")),
+				BlockHash:  hex.EncodeToString([]byte("blockHash")),
+				BlockNonce: 2,
+				Round:      3,
+				Epoch:      4,
+			},
+		},
+		Truncated: true,
+	}
+	assert.Equal(t, expectedHistory, history)
+}
@@ -0,0 +1,149 @@
+package node
+
+import (
+	"encoding/hex"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+)
+
+// GetTransactionsPool returns the number of transactions and bytes held in each cache of the transactions pool
+func (n *Node) GetTransactionsPool() (*transaction.ApiTransactionsPool, error) {
+	inspector, err := n.getTxPoolInspector()
+	if err != nil {
+		return nil, err
+	}
+
+	pool := &transaction.ApiTransactionsPool{
+		Caches: make([]*transaction.ApiTransactionsPoolCache, 0),
+	}
+	for _, statistics := range inspector.GetCachesStatistics() {
+		pool.NumTxs += statistics.NumTxs
+		pool.NumBytes += statistics.NumBytes
+		pool.Caches = append(pool.Caches, &transaction.ApiTransactionsPoolCache{
+			Name:       statistics.Name,
+			NumTxs:     statistics.NumTxs,
+			NumBytes:   statistics.NumBytes,
+			NumSenders: statistics.NumSenders,
+		})
+	}
+
+	return pool, nil
+}
+
+// GetTransactionsPoolForSender returns the transactions of the given sender held in the transactions pool, along
+// with the nonce gaps and the score tracked for the sender
+func (n *Node) GetTransactionsPoolForSender(address string) (*transaction.ApiTransactionsPoolForSender, error) {
+	inspector, err := n.getTxPoolInspector()
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := n.addressPubkeyConverter.Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	info, ok := inspector.GetSenderInfo(sender)
+	if !ok {
+		return nil, ErrSenderNotFoundInPool
+	}
+
+	result := &transaction.ApiTransactionsPoolForSender{
+		Sender:              address,
+		AccountNonce:        info.AccountNonce,
+		AccountNonceKnown:   info.AccountNonceKnown,
+		Score:               info.Score,
+		NumBytes:            info.NumBytes,
+		TotalGas:            info.TotalGas,
+		TotalFee:            info.TotalFee,
+		NumFailedSelections: info.NumFailedSelections,
+		IsInGracePeriod:     info.IsInGracePeriod,
+		NonceGaps:           make([]*transaction.ApiNonceGap, 0, len(info.NonceGaps)),
+		Transactions:        make([]*transaction.ApiTransactionInPool, 0, len(info.Transactions)),
+	}
+	for _, gap := range info.NonceGaps {
+		result.NonceGaps = append(result.NonceGaps, &transaction.ApiNonceGap{From: gap.From, To: gap.To})
+	}
+	for _, tx := range info.Transactions {
+		result.Transactions = append(result.Transactions, n.prepareTransactionInPool(tx, ""))
+	}
+
+	return result, nil
+}
+
+// GetTransactionFromPool returns the transaction with the given hash, if held in the transactions pool, along with
+// the cache holding it
+func (n *Node) GetTransactionFromPool(txHash string) (*transaction.ApiTransactionInPool, error) {
+	inspector, err := n.getTxPoolInspector()
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := hex.DecodeString(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, cacheID, ok := inspector.GetWrappedTransaction(hash)
+	if !ok {
+		return nil, ErrTransactionNotFoundInPool
+	}
+
+	return n.prepareTransactionInPool(tx, cacheID), nil
+}
+
+func (n *Node) getTxPoolInspector() (TxPoolInspector, error) {
+	if check.IfNil(n.dataPool) || check.IfNil(n.addressPubkeyConverter) {
+		return nil, ErrTxPoolInspectionNotSupported
+	}
+
+	inspector, ok := n.dataPool.Transactions().(TxPoolInspector)
+	if !ok {
+		return nil, ErrTxPoolInspectionNotSupported
+	}
+
+	return inspector, nil
+}
+
+// checkTxReplacement rejects a transaction which would not replace the one with the same sender and nonce held
+// in the pool, since its gas price is not sufficiently higher
+func (n *Node) checkTxReplacement(tx data.TransactionHandler, txHash []byte, senderShardID uint32, receiverShardID uint32) error {
+	if check.IfNil(n.dataPool) {
+		return nil
+	}
+
+	checker, ok := n.dataPool.Transactions().(TxReplacementChecker)
+	if !ok {
+		return nil
+	}
+
+	cacheID := process.ShardCacherIdentifier(senderShardID, receiverShardID)
+	return checker.CheckReplacement(tx, txHash, cacheID)
+}
+
+func (n *Node) prepareTransactionInPool(wrappedTx *txcache.WrappedTransaction, cacheID string) *transaction.ApiTransactionInPool {
+	tx := wrappedTx.Tx
+	value := "0"
+	if tx.GetValue() != nil {
+		value = tx.GetValue().String()
+	}
+
+	return &transaction.ApiTransactionInPool{
+		Hash:               hex.EncodeToString(wrappedTx.TxHash),
+		Cache:              cacheID,
+		Nonce:              tx.GetNonce(),
+		Sender:             n.addressPubkeyConverter.Encode(tx.GetSndAddr()),
+		Receiver:           n.addressPubkeyConverter.Encode(tx.GetRcvAddr()),
+		Value:              value,
+		GasPrice:           tx.GetGasPrice(),
+		GasLimit:           tx.GetGasLimit(),
+		Size:               wrappedTx.Size(),
+		SenderShard:        wrappedTx.SenderShardID,
+		ReceiverShard:      wrappedTx.ReceiverShardID,
+		IsImmuneToEviction: wrappedTx.IsImmuneToEviction(),
+	}
+}
@@ -0,0 +1,157 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/txpool"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNodeWithTxPool(t *testing.T) (*node.Node, dataRetriever.ShardedDataCacherNotifier) {
+	txPool, err := txpool.NewShardedTxPool(
+		txpool.ArgShardedTxPool{
+			Config: storageUnit.CacheConfig{
+				Capacity:                  1000,
+				SizePerSender:             100,
+				SizeInBytes:               1000000,
+				SizeInBytesPerSender:      100000,
+				Shards:                    1,
+				MinGasPriceBumpPercentage: 10,
+			},
+			MinGasPrice:    200000000000,
+			NumberOfShards: 2,
+		},
+	)
+	require.Nil(t, err)
+
+	n, _ := node.NewNode(
+		node.WithDataPool(&mock.PoolsHolderStub{
+			TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+				return txPool
+			},
+		}),
+		node.WithAddressPubkeyConverter(mock.NewPubkeyConverterMock(32)),
+	)
+
+	return n, txPool
+}
+
+func createPoolTx(sender string, nonce uint64) *transaction.Transaction {
+	return &transaction.Transaction{
+		Nonce:    nonce,
+		SndAddr:  []byte(sender),
+		RcvAddr:  []byte("receiver"),
+		Value:    big.NewInt(10),
+		GasPrice: 200000000000,
+		GasLimit: 50000,
+	}
+}
+
+func TestNode_GetTransactionsPoolNotSupportedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithDataPool(&mock.PoolsHolderStub{
+			TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+				return &mock.ShardedDataStub{}
+			},
+		}),
+		node.WithAddressPubkeyConverter(mock.NewPubkeyConverterMock(32)),
+	)
+
+	pool, err := n.GetTransactionsPool()
+	assert.Nil(t, pool)
+	assert.Equal(t, node.ErrTxPoolInspectionNotSupported, err)
+}
+
+func TestNode_GetTransactionsPool(t *testing.T) {
+	t.Parallel()
+
+	n, txPool := createNodeWithTxPool(t)
+	txPool.AddData([]byte("hash-1"), createPoolTx("alice", 1), 0, "0")
+	txPool.AddData([]byte("hash-2"), createPoolTx("alice", 2), 0, "0_1")
+	txPool.AddData([]byte("hash-3"), createPoolTx("bob", 1), 0, "1_0")
+
+	pool, err := n.GetTransactionsPool()
+	require.Nil(t, err)
+	assert.Equal(t, uint64(3), pool.NumTxs)
+	require.Len(t, pool.Caches, 2)
+	assert.Equal(t, "0", pool.Caches[0].Name)
+	assert.Equal(t, uint64(2), pool.Caches[0].NumTxs)
+	assert.Equal(t, uint64(1), pool.Caches[0].NumSenders)
+	assert.Equal(t, "1_0", pool.Caches[1].Name)
+	assert.Equal(t, pool.Caches[0].NumBytes+pool.Caches[1].NumBytes, pool.NumBytes)
+}
+
+func TestNode_GetTransactionsPoolForSender(t *testing.T) {
+	t.Parallel()
+
+	n, txPool := createNodeWithTxPool(t)
+	txPool.AddData([]byte("hash-1"), createPoolTx("alice", 1), 0, "0")
+	txPool.AddData([]byte("hash-3"), createPoolTx("alice", 3), 0, "0")
+
+	_, err := n.GetTransactionsPoolForSender(hex.EncodeToString([]byte("bob")))
+	assert.Equal(t, node.ErrSenderNotFoundInPool, err)
+
+	_, err = n.GetTransactionsPoolForSender("not hex")
+	assert.NotNil(t, err)
+
+	sender := hex.EncodeToString([]byte("alice"))
+	result, err := n.GetTransactionsPoolForSender(sender)
+	require.Nil(t, err)
+	assert.Equal(t, sender, result.Sender)
+	require.Len(t, result.Transactions, 2)
+	assert.Equal(t, hex.EncodeToString([]byte("hash-1")), result.Transactions[0].Hash)
+	assert.Equal(t, uint64(3), result.Transactions[1].Nonce)
+	assert.Equal(t, []*transaction.ApiNonceGap{{From: 2, To: 2}}, result.NonceGaps)
+}
+
+func TestNode_GetTransactionFromPool(t *testing.T) {
+	t.Parallel()
+
+	n, txPool := createNodeWithTxPool(t)
+	txPool.AddData([]byte("hash-1"), createPoolTx("alice", 7), 0, "1_0")
+
+	_, err := n.GetTransactionFromPool(hex.EncodeToString([]byte("hash-2")))
+	assert.Equal(t, node.ErrTransactionNotFoundInPool, err)
+
+	_, err = n.GetTransactionFromPool("zz")
+	assert.NotNil(t, err)
+
+	tx, err := n.GetTransactionFromPool(hex.EncodeToString([]byte("hash-1")))
+	require.Nil(t, err)
+	assert.Equal(t, "1_0", tx.Cache)
+	assert.Equal(t, uint64(7), tx.Nonce)
+	assert.Equal(t, "10", tx.Value)
+	assert.Equal(t, hex.EncodeToString([]byte("alice")), tx.Sender)
+	assert.Equal(t, uint32(1), tx.SenderShard)
+	assert.Equal(t, uint32(0), tx.ReceiverShard)
+}
+
+func TestNode_CheckTxReplacement(t *testing.T) {
+	t.Parallel()
+
+	n, txPool := createNodeWithTxPool(t)
+	txPool.AddData([]byte("hash-1"), createPoolTx("alice", 1), 0, "0")
+
+	err := n.CheckTxReplacement(createPoolTx("alice", 1), []byte("hash-2"), 0, 0)
+	assert.True(t, errors.Is(err, storage.ErrInsufficientGasPriceBump))
+
+	replacement := createPoolTx("alice", 1)
+	replacement.GasPrice = 220000000000
+	err = n.CheckTxReplacement(replacement, []byte("hash-2"), 0, 0)
+	assert.Nil(t, err)
+
+	err = n.CheckTxReplacement(createPoolTx("alice", 2), []byte("hash-3"), 0, 1)
+	assert.Nil(t, err)
+}
@@ -107,7 +107,7 @@ func TestGetBalance_NoAddrConverterShouldError(t *testing.T) {
 		node.WithHasher(getHasher()),
 		node.WithAccountsAdapter(&mock.AccountsStub{}),
 	)
-	_, err := n.GetBalance("address")
+	_, err := n.GetBalance("address", state.QueryOptions{})
 	assert.NotNil(t, err)
 	assert.Equal(t, "initialize AccountsAdapter and PubkeyConverter first", err.Error())
 }
@@ -120,7 +120,7 @@ func TestGetBalance_NoAccAdapterShouldError(t *testing.T) {
 		node.WithHasher(getHasher()),
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 	)
-	_, err := n.GetBalance("address")
+	_, err := n.GetBalance("address", state.QueryOptions{})
 	assert.NotNil(t, err)
 	assert.Equal(t, "initialize AccountsAdapter and PubkeyConverter first", err.Error())
 }
@@ -139,7 +139,7 @@ func TestGetBalance_GetAccountFailsShouldError(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 		node.WithAccountsAdapter(accAdapter),
 	)
-	_, err := n.GetBalance(createDummyHexAddress(64))
+	_, err := n.GetBalance(createDummyHexAddress(64), state.QueryOptions{})
 	assert.NotNil(t, err)
 	assert.Contains(t, err.Error(), "could not fetch sender address from provided param")
 }
@@ -169,7 +169,7 @@ func TestGetBalance_GetAccountReturnsNil(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 		node.WithAccountsAdapter(accAdapter),
 	)
-	balance, err := n.GetBalance(createDummyHexAddress(64))
+	balance, err := n.GetBalance(createDummyHexAddress(64), state.QueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, big.NewInt(0), balance)
 }
@@ -184,7 +184,7 @@ func TestGetBalance(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 		node.WithAccountsAdapter(accAdapter),
 	)
-	balance, err := n.GetBalance(createDummyHexAddress(64))
+	balance, err := n.GetBalance(createDummyHexAddress(64), state.QueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, big.NewInt(100), balance)
 }
@@ -1350,6 +1350,7 @@ func TestStartConsensus_ShardBootstrapper(t *testing.T) {
 		node.WithNetworkShardingCollector(&mock.NetworkShardingCollectorStub{}),
 		node.WithInputAntifloodHandler(&mock.P2PAntifloodHandlerStub{}),
 		node.WithHeaderIntegrityVerifier(&mock.HeaderIntegrityVerifierStub{}),
+		node.WithEquivocationProofsPool(&mock.CacherStub{}),
 	)
 
 	err := n.StartConsensus()
@@ -1365,7 +1366,7 @@ func TestNode_GetAccountWithNilAccountsAdapterShouldErr(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), state.QueryOptions{})
 
 	assert.Nil(t, recovAccnt)
 	assert.Equal(t, node.ErrNilAccountsAdapter, err)
@@ -1384,7 +1385,7 @@ func TestNode_GetAccountWithNilPubkeyConverterShouldErr(t *testing.T) {
 		node.WithAccountsAdapter(accDB),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), state.QueryOptions{})
 
 	assert.Nil(t, recovAccnt)
 	assert.Equal(t, node.ErrNilPubkeyConverter, err)
@@ -1410,7 +1411,7 @@ func TestNode_GetAccountPubkeyConverterFailsShouldErr(t *testing.T) {
 			}),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), state.QueryOptions{})
 
 	assert.Nil(t, recovAccnt)
 	assert.Equal(t, errExpected, err)
@@ -1430,7 +1431,7 @@ func TestNode_GetAccountAccountDoesNotExistsShouldRetEmpty(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), state.QueryOptions{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, uint64(0), recovAccnt.GetNonce())
@@ -1454,7 +1455,7 @@ func TestNode_GetAccountAccountsAdapterFailsShouldErr(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), state.QueryOptions{})
 
 	assert.Nil(t, recovAccnt)
 	assert.NotNil(t, err)
@@ -1481,7 +1482,7 @@ func TestNode_GetAccountAccountExistsShouldReturn(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), state.QueryOptions{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, accnt, recovAccnt)
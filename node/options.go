@@ -21,6 +21,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
 // WithMessenger sets up the messenger option for the Node
@@ -101,6 +102,17 @@ func WithAccountsAdapter(accounts state.AccountsAdapter) Option {
 	}
 }
 
+// WithAccountsRecreator sets up the component which recreates the accounts adapter over past states
+func WithAccountsRecreator(accountsRecreator state.AccountsAdapterRecreator) Option {
+	return func(n *Node) error {
+		if check.IfNil(accountsRecreator) {
+			return ErrNilAccountsRecreator
+		}
+		n.accountsRecreator = accountsRecreator
+		return nil
+	}
+}
+
 // WithAddressPubkeyConverter sets up the address public key converter adapter option for the Node
 func WithAddressPubkeyConverter(pubkeyConverter core.PubkeyConverter) Option {
 	return func(n *Node) error {
@@ -428,6 +440,17 @@ func WithIndexer(indexer indexer.Indexer) Option {
 	}
 }
 
+// WithRoundTracer sets up a consensus round tracer for the Node
+func WithRoundTracer(roundTracer consensus.RoundTracer) Option {
+	return func(n *Node) error {
+		if check.IfNil(roundTracer) {
+			return ErrNilRoundTracer
+		}
+		n.roundTracer = roundTracer
+		return nil
+	}
+}
+
 // WithBlockBlackListHandler sets up a block black list handler for the Node
 func WithBlockBlackListHandler(blackListHandler process.BlackListHandler) Option {
 	return func(n *Node) error {
@@ -675,3 +698,36 @@ func WithApiTransactionByHashThrottler(throttler Throttler) Option {
 		return nil
 	}
 }
+
+// WithAccountHistory sets up the account history option for the Node
+func WithAccountHistory(accountHistory process.AccountHistoryHandler) Option {
+	return func(n *Node) error {
+		if check.IfNil(accountHistory) {
+			return ErrNilAccountHistory
+		}
+		n.accountHistory = accountHistory
+		return nil
+	}
+}
+
+// WithEventsSubscriber sets up the events subscriber option for the Node
+func WithEventsSubscriber(eventsSubscriber EventsSubscriber) Option {
+	return func(n *Node) error {
+		if check.IfNil(eventsSubscriber) {
+			return ErrNilEventsSubscriber
+		}
+		n.eventsSubscriber = eventsSubscriber
+		return nil
+	}
+}
+
+// WithEquivocationProofsPool sets up the pool of the equivocation proofs option for the Node
+func WithEquivocationProofsPool(equivocationProofsPool storage.Cacher) Option {
+	return func(n *Node) error {
+		if check.IfNil(equivocationProofsPool) {
+			return ErrNilEquivocationProofsPool
+		}
+		n.equivocationProofsPool = equivocationProofsPool
+		return nil
+	}
+}
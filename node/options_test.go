@@ -171,6 +171,32 @@ func TestWithAccountsAdapter_ShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestWithAccountsRecreator_NilRecreatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithAccountsRecreator(nil)
+	err := opt(node)
+
+	assert.Nil(t, node.accountsRecreator)
+	assert.Equal(t, ErrNilAccountsRecreator, err)
+}
+
+func TestWithAccountsRecreator_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	accountsRecreator := &mock.AccountsAdapterRecreatorStub{}
+
+	opt := WithAccountsRecreator(accountsRecreator)
+	err := opt(node)
+
+	assert.True(t, node.accountsRecreator == accountsRecreator)
+	assert.Nil(t, err)
+}
+
 func TestWithAddressPubkeyConverter_NilConverterShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -854,6 +880,30 @@ func TestWithIndexer_ShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestWithRoundTracer_NilRoundTracerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithRoundTracer(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilRoundTracer, err)
+}
+
+func TestWithRoundTracer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	roundTracer := &mock.RoundTracerStub{}
+	opt := WithRoundTracer(roundTracer)
+	err := opt(node)
+
+	assert.True(t, node.roundTracer == roundTracer)
+	assert.Nil(t, err)
+}
+
 func TestWithKeyGenForAccounts_NilKeygenShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -1308,3 +1358,75 @@ func TestWithNodeStopChannel_OkNodeStopChannelShouldWork(t *testing.T) {
 	assert.True(t, node.chanStopNodeProcess == ch)
 	assert.Nil(t, err)
 }
+
+func TestWithAccountHistory_NilAccountHistoryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithAccountHistory(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilAccountHistory, err)
+}
+
+func TestWithAccountHistory_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	accountHistory := &mock.AccountHistoryStub{}
+	opt := WithAccountHistory(accountHistory)
+	err := opt(node)
+
+	assert.True(t, node.accountHistory == accountHistory)
+	assert.Nil(t, err)
+}
+
+func TestWithEventsSubscriber_NilEventsSubscriberShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithEventsSubscriber(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilEventsSubscriber, err)
+}
+
+func TestWithEventsSubscriber_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	eventsSubscriber := &mock.EventsSubscriberStub{}
+	opt := WithEventsSubscriber(eventsSubscriber)
+	err := opt(node)
+
+	assert.True(t, node.eventsSubscriber == eventsSubscriber)
+	assert.Nil(t, err)
+}
+
+func TestWithEquivocationProofsPool_NilPoolShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithEquivocationProofsPool(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilEquivocationProofsPool, err)
+}
+
+func TestWithEquivocationProofsPool_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	proofsPool := &mock.CacherStub{}
+	opt := WithEquivocationProofsPool(proofsPool)
+	err := opt(node)
+
+	assert.True(t, node.equivocationProofsPool == proofsPool)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,378 @@
+package accountHistory
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/batch"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ process.AccountHistoryHandler = (*accountHistory)(nil)
+
+var log = logger.GetOrCreate("process/accountHistory")
+
+// blockKeyPrefix prefixes the keys under which the addresses touched by each saved block are kept, so that the
+// entries of a reverted block can be found and removed
+const blockKeyPrefix = "block_"
+
+// ArgsAccountHistory defines the arguments needed for creating the account history component
+type ArgsAccountHistory struct {
+	Storer           storage.Storer
+	Marshalizer      marshal.Marshalizer
+	ShardCoordinator sharding.Coordinator
+	StartEpoch       uint32
+	// NumEpochsToKeep limits how many epochs are searched when fetching an account's history. 0 means all epochs
+	NumEpochsToKeep uint32
+	// MaxEntriesPerEpoch bounds the number of entries kept for an address in one epoch, the oldest ones being pruned
+	// and the history of the address being reported as truncated
+	MaxEntriesPerEpoch uint32
+}
+
+type accountHistory struct {
+	storer           storage.Storer
+	marshalizer      marshal.Marshalizer
+	shardCoordinator sharding.Coordinator
+	numEpochsToKeep  uint32
+	maxEntries       int
+
+	mutSave      sync.Mutex
+	mutLastEpoch sync.RWMutex
+	lastEpoch    uint32
+}
+
+// NewAccountHistory creates a component able to index, per epoch, the transactions which touched the
+// accounts of the current shard and to retrieve them afterwards, newest first
+func NewAccountHistory(args ArgsAccountHistory) (*accountHistory, error) {
+	if check.IfNil(args.Storer) {
+		return nil, process.ErrNilStore
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if args.MaxEntriesPerEpoch == 0 {
+		return nil, fmt.Errorf("%w for MaxEntriesPerEpoch", process.ErrInvalidValue)
+	}
+
+	return &accountHistory{
+		storer:           args.Storer,
+		marshalizer:      args.Marshalizer,
+		shardCoordinator: args.ShardCoordinator,
+		numEpochsToKeep:  args.NumEpochsToKeep,
+		maxEntries:       int(args.MaxEntriesPerEpoch),
+		lastEpoch:        args.StartEpoch,
+	}, nil
+}
+
+// SaveTransactions adds the provided transactions, committed in the given block, to the history of their
+// sender and receiver accounts belonging to the current shard
+func (ah *accountHistory) SaveTransactions(
+	header data.HeaderHandler,
+	headerHash []byte,
+	txs map[string]data.TransactionHandler,
+) error {
+	if check.IfNil(header) {
+		return process.ErrNilBlockHeader
+	}
+
+	ah.mutSave.Lock()
+	defer ah.mutSave.Unlock()
+
+	epoch := header.GetEpoch()
+	entriesByAddress := ah.groupEntriesByAddress(header, headerHash, txs)
+	addresses := make([][]byte, 0, len(entriesByAddress))
+	for address, newEntries := range entriesByAddress {
+		err := ah.appendEntries([]byte(address), epoch, newEntries)
+		if err != nil {
+			return err
+		}
+
+		addresses = append(addresses, []byte(address))
+	}
+
+	if len(addresses) > 0 {
+		err := ah.saveBlockAddresses(headerHash, addresses)
+		if err != nil {
+			return err
+		}
+	}
+
+	ah.mutLastEpoch.Lock()
+	if epoch > ah.lastEpoch {
+		ah.lastEpoch = epoch
+	}
+	ah.mutLastEpoch.Unlock()
+
+	return nil
+}
+
+func (ah *accountHistory) groupEntriesByAddress(
+	header data.HeaderHandler,
+	headerHash []byte,
+	txs map[string]data.TransactionHandler,
+) map[string][]*transaction.AccountHistoryEntry {
+	txHashes := make([]string, 0, len(txs))
+	for txHash := range txs {
+		txHashes = append(txHashes, txHash)
+	}
+	sort.Strings(txHashes)
+
+	entriesByAddress := make(map[string][]*transaction.AccountHistoryEntry)
+	for _, txHash := range txHashes {
+		tx := txs[txHash]
+		if check.IfNil(tx) {
+			continue
+		}
+
+		entry := &transaction.AccountHistoryEntry{
+			TxHash:     []byte(txHash),
+			BlockHash:  headerHash,
+			BlockNonce: header.GetNonce(),
+			Round:      header.GetRound(),
+			Epoch:      header.GetEpoch(),
+		}
+
+		sndAddr := tx.GetSndAddr()
+		if ah.isAddressFromSelfShard(sndAddr) {
+			entriesByAddress[string(sndAddr)] = append(entriesByAddress[string(sndAddr)], entry)
+		}
+
+		rcvAddr := tx.GetRcvAddr()
+		if bytes.Equal(sndAddr, rcvAddr) {
+			continue
+		}
+		if ah.isAddressFromSelfShard(rcvAddr) {
+			entriesByAddress[string(rcvAddr)] = append(entriesByAddress[string(rcvAddr)], entry)
+		}
+	}
+
+	return entriesByAddress
+}
+
+func (ah *accountHistory) isAddressFromSelfShard(address []byte) bool {
+	if core.IsEmptyAddress(address) {
+		return false
+	}
+
+	return ah.shardCoordinator.ComputeId(address) == ah.shardCoordinator.SelfId()
+}
+
+func (ah *accountHistory) appendEntries(address []byte, epoch uint32, newEntries []*transaction.AccountHistoryEntry) error {
+	entries, err := ah.getEntries(address, epoch)
+	if err != nil {
+		return err
+	}
+
+	// a transaction might be committed again after a rollback, so the older entry is replaced
+	for _, newEntry := range newEntries {
+		entries.Entries = removeEntryWithTxHash(entries.Entries, newEntry.TxHash)
+	}
+	entries.Entries = append(entries.Entries, newEntries...)
+	if len(entries.Entries) > ah.maxEntries {
+		log.Debug("accountHistory: pruned the oldest entries",
+			"address", address,
+			"epoch", epoch,
+			"num pruned", len(entries.Entries)-ah.maxEntries,
+		)
+		entries.Entries = entries.Entries[len(entries.Entries)-ah.maxEntries:]
+		entries.Truncated = true
+	}
+
+	return ah.saveEntries(address, epoch, entries)
+}
+
+func (ah *accountHistory) saveEntries(address []byte, epoch uint32, entries *transaction.AccountHistoryEntries) error {
+	buff, err := ah.marshalizer.Marshal(entries)
+	if err != nil {
+		return err
+	}
+
+	return ah.storer.Put(createKey(address, epoch), buff)
+}
+
+func (ah *accountHistory) saveBlockAddresses(headerHash []byte, addresses [][]byte) error {
+	buff, err := ah.marshalizer.Marshal(&batch.Batch{Data: addresses})
+	if err != nil {
+		return err
+	}
+
+	return ah.storer.Put(createBlockKey(headerHash), buff)
+}
+
+// RevertBlock removes the entries saved for the given block, which was rolled back
+func (ah *accountHistory) RevertBlock(header data.HeaderHandler, headerHash []byte) error {
+	if check.IfNil(header) {
+		return process.ErrNilBlockHeader
+	}
+
+	ah.mutSave.Lock()
+	defer ah.mutSave.Unlock()
+
+	blockKey := createBlockKey(headerHash)
+	buff, err := ah.storer.Get(blockKey)
+	if err != nil {
+		log.Trace("accountHistory.RevertBlock: no entries", "hash", headerHash, "error", err.Error())
+		return nil
+	}
+
+	addresses := &batch.Batch{}
+	err = ah.marshalizer.Unmarshal(addresses, buff)
+	if err != nil {
+		return err
+	}
+
+	epoch := header.GetEpoch()
+	for _, address := range addresses.Data {
+		err = ah.removeEntriesOfBlock(address, epoch, headerHash)
+		if err != nil {
+			return err
+		}
+	}
+
+	return ah.storer.Remove(blockKey)
+}
+
+func (ah *accountHistory) removeEntriesOfBlock(address []byte, epoch uint32, headerHash []byte) error {
+	entries, err := ah.getEntries(address, epoch)
+	if err != nil {
+		return err
+	}
+
+	kept := make([]*transaction.AccountHistoryEntry, 0, len(entries.Entries))
+	for _, entry := range entries.Entries {
+		if !bytes.Equal(entry.BlockHash, headerHash) {
+			kept = append(kept, entry)
+		}
+	}
+	entries.Entries = kept
+
+	return ah.saveEntries(address, epoch, entries)
+}
+
+func removeEntryWithTxHash(entries []*transaction.AccountHistoryEntry, txHash []byte) []*transaction.AccountHistoryEntry {
+	for i, entry := range entries {
+		if bytes.Equal(entry.TxHash, txHash) {
+			return append(entries[:i], entries[i+1:]...)
+		}
+	}
+
+	return entries
+}
+
+// GetTransactions returns at most size history entries of the given address, newest first, skipping
+// the first from entries. Only the epochs kept by the storage pruning settings are searched. The returned flag
+// is set when the entries reach past the oldest ones kept for an epoch, meaning that older entries were pruned
+func (ah *accountHistory) GetTransactions(address []byte, from uint32, size uint32) ([]*transaction.AccountHistoryEntry, bool, error) {
+	if len(address) == 0 {
+		return nil, false, process.ErrEmptyAddress
+	}
+
+	result := make([]*transaction.AccountHistoryEntry, 0)
+	if size == 0 {
+		return result, false, nil
+	}
+
+	lastEpoch := ah.getLastEpoch()
+	oldestEpoch := ah.computeOldestEpoch(lastEpoch)
+	numSkipped := uint32(0)
+	truncated := false
+	for epoch := int64(lastEpoch); epoch >= int64(oldestEpoch); epoch-- {
+		entries, err := ah.getEntries(address, uint32(epoch))
+		if err != nil {
+			return nil, false, err
+		}
+
+		for i := len(entries.Entries) - 1; i >= 0; i-- {
+			if numSkipped < from {
+				numSkipped++
+				continue
+			}
+
+			result = append(result, entries.Entries[i])
+			if uint32(len(result)) == size {
+				return result, truncated, nil
+			}
+		}
+
+		truncated = truncated || entries.Truncated
+	}
+
+	return result, truncated, nil
+}
+
+func (ah *accountHistory) getLastEpoch() uint32 {
+	ah.mutLastEpoch.RLock()
+	defer ah.mutLastEpoch.RUnlock()
+
+	return ah.lastEpoch
+}
+
+func (ah *accountHistory) computeOldestEpoch(lastEpoch uint32) uint32 {
+	if ah.numEpochsToKeep == 0 || lastEpoch < ah.numEpochsToKeep {
+		return 0
+	}
+
+	return lastEpoch - ah.numEpochsToKeep + 1
+}
+
+func (ah *accountHistory) getEntries(address []byte, epoch uint32) (*transaction.AccountHistoryEntries, error) {
+	entries := &transaction.AccountHistoryEntries{}
+
+	key := createKey(address, epoch)
+	buff, err := ah.storer.Get(key)
+	if err != nil {
+		buff, err = ah.storer.GetFromEpoch(key, epoch)
+	}
+	if err != nil {
+		log.Trace("accountHistory.getEntries: no entries", "epoch", epoch, "error", err.Error())
+		return entries, nil
+	}
+
+	err = ah.marshalizer.Unmarshal(entries, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func createKey(address []byte, epoch uint32) []byte {
+	epochBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(epochBytes, epoch)
+
+	key := make([]byte, 0, len(address)+len(epochBytes))
+	key = append(key, address...)
+
+	return append(key, epochBytes...)
+}
+
+func createBlockKey(headerHash []byte) []byte {
+	key := make([]byte, 0, len(blockKeyPrefix)+len(headerHash))
+	key = append(key, blockKeyPrefix...)
+
+	return append(key, headerHash...)
+}
+
+// IsEnabled returns true as the account history index is active
+func (ah *accountHistory) IsEnabled() bool {
+	return true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ah *accountHistory) IsInterfaceNil() bool {
+	return ah == nil
+}
@@ -0,0 +1,291 @@
+package accountHistory_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/accountHistory"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	addrA = []byte("addressA_in_self_shard__________")
+	addrB = []byte("addressB_in_self_shard__________")
+	addrC = []byte("addressC_in_other_shard_________")
+)
+
+func createMockArgsAccountHistory() accountHistory.ArgsAccountHistory {
+	shardCoordinator := mock.NewOneShardCoordinatorMock()
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if string(address) == string(addrC) {
+			return 1
+		}
+		return 0
+	}
+
+	return accountHistory.ArgsAccountHistory{
+		Storer:             mock.NewStorerMock(),
+		Marshalizer:        &mock.MarshalizerMock{},
+		ShardCoordinator:   shardCoordinator,
+		MaxEntriesPerEpoch: 100,
+	}
+}
+
+func createTx(snd []byte, rcv []byte) data.TransactionHandler {
+	return &transaction.Transaction{SndAddr: snd, RcvAddr: rcv}
+}
+
+func getTxHashes(entries []*transaction.AccountHistoryEntry) []string {
+	hashes := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		hashes = append(hashes, string(entry.TxHash))
+	}
+
+	return hashes
+}
+
+func TestNewAccountHistory_NilStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAccountHistory()
+	args.Storer = nil
+	ah, err := accountHistory.NewAccountHistory(args)
+
+	assert.True(t, check.IfNil(ah))
+	assert.Equal(t, process.ErrNilStore, err)
+}
+
+func TestNewAccountHistory_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAccountHistory()
+	args.Marshalizer = nil
+	ah, err := accountHistory.NewAccountHistory(args)
+
+	assert.True(t, check.IfNil(ah))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewAccountHistory_NilShardCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAccountHistory()
+	args.ShardCoordinator = nil
+	ah, err := accountHistory.NewAccountHistory(args)
+
+	assert.True(t, check.IfNil(ah))
+	assert.Equal(t, process.ErrNilShardCoordinator, err)
+}
+
+func TestNewAccountHistory_ZeroMaxEntriesPerEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAccountHistory()
+	args.MaxEntriesPerEpoch = 0
+	ah, err := accountHistory.NewAccountHistory(args)
+
+	assert.True(t, check.IfNil(ah))
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+}
+
+func TestNewAccountHistory_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ah, err := accountHistory.NewAccountHistory(createMockArgsAccountHistory())
+
+	assert.False(t, check.IfNil(ah))
+	assert.Nil(t, err)
+}
+
+func TestAccountHistory_SaveTransactionsNilHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ah, _ := accountHistory.NewAccountHistory(createMockArgsAccountHistory())
+	err := ah.SaveTransactions(nil, []byte("hash"), make(map[string]data.TransactionHandler))
+
+	assert.Equal(t, process.ErrNilBlockHeader, err)
+}
+
+func TestAccountHistory_GetTransactionsEmptyAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ah, _ := accountHistory.NewAccountHistory(createMockArgsAccountHistory())
+	entries, _, err := ah.GetTransactions(nil, 0, 10)
+
+	assert.Nil(t, entries)
+	assert.Equal(t, process.ErrEmptyAddress, err)
+}
+
+func TestAccountHistory_SaveAndGetTransactionsShouldIndexOnlySelfShardAddresses(t *testing.T) {
+	t.Parallel()
+
+	ah, _ := accountHistory.NewAccountHistory(createMockArgsAccountHistory())
+	header := &block.Header{Nonce: 7, Round: 8, Epoch: 0}
+	txs := map[string]data.TransactionHandler{
+		"tx1": createTx(addrA, addrB),
+		"tx2": createTx(addrA, addrC),
+		"tx3": createTx(addrA, addrA),
+	}
+
+	err := ah.SaveTransactions(header, []byte("blockHash"), txs)
+	require.Nil(t, err)
+
+	entries, _, err := ah.GetTransactions(addrA, 0, 10)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"tx3", "tx2", "tx1"}, getTxHashes(entries))
+	assert.Equal(t, []byte("blockHash"), entries[0].BlockHash)
+	assert.Equal(t, uint64(7), entries[0].BlockNonce)
+	assert.Equal(t, uint64(8), entries[0].Round)
+
+	entries, _, err = ah.GetTransactions(addrB, 0, 10)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"tx1"}, getTxHashes(entries))
+
+	entries, _, err = ah.GetTransactions(addrC, 0, 10)
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(entries))
+}
+
+func TestAccountHistory_GetTransactionsShouldPaginateAcrossEpochsNewestFirst(t *testing.T) {
+	t.Parallel()
+
+	ah, _ := accountHistory.NewAccountHistory(createMockArgsAccountHistory())
+	_ = ah.SaveTransactions(&block.Header{Nonce: 1, Epoch: 0}, []byte("h1"), map[string]data.TransactionHandler{
+		"tx1": createTx(addrA, addrB),
+	})
+	_ = ah.SaveTransactions(&block.Header{Nonce: 2, Epoch: 0}, []byte("h2"), map[string]data.TransactionHandler{
+		"tx2": createTx(addrB, addrA),
+	})
+	_ = ah.SaveTransactions(&block.Header{Nonce: 3, Epoch: 1}, []byte("h3"), map[string]data.TransactionHandler{
+		"tx3": createTx(addrA, addrB),
+	})
+	_ = ah.SaveTransactions(&block.Header{Nonce: 4, Epoch: 2}, []byte("h4"), map[string]data.TransactionHandler{
+		"tx4": createTx(addrA, addrB),
+	})
+
+	entries, _, _ := ah.GetTransactions(addrA, 0, 2)
+	assert.Equal(t, []string{"tx4", "tx3"}, getTxHashes(entries))
+
+	entries, _, _ = ah.GetTransactions(addrA, 2, 2)
+	assert.Equal(t, []string{"tx2", "tx1"}, getTxHashes(entries))
+
+	entries, _, _ = ah.GetTransactions(addrA, 3, 10)
+	assert.Equal(t, []string{"tx1"}, getTxHashes(entries))
+
+	entries, _, _ = ah.GetTransactions(addrA, 0, 0)
+	assert.Equal(t, 0, len(entries))
+}
+
+func TestAccountHistory_GetTransactionsShouldRespectNumEpochsToKeep(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAccountHistory()
+	args.NumEpochsToKeep = 2
+	ah, _ := accountHistory.NewAccountHistory(args)
+	for epoch := uint32(0); epoch < 4; epoch++ {
+		txHash := string([]byte{'t', 'x', byte('0' + epoch)})
+		_ = ah.SaveTransactions(&block.Header{Epoch: epoch}, []byte("h"), map[string]data.TransactionHandler{
+			txHash: createTx(addrA, addrB),
+		})
+	}
+
+	entries, _, _ := ah.GetTransactions(addrA, 0, 10)
+	assert.Equal(t, []string{"tx3", "tx2"}, getTxHashes(entries))
+}
+
+func TestAccountHistory_SaveTransactionsAgainShouldReplaceEntry(t *testing.T) {
+	t.Parallel()
+
+	ah, _ := accountHistory.NewAccountHistory(createMockArgsAccountHistory())
+	txs := map[string]data.TransactionHandler{
+		"tx1": createTx(addrA, addrB),
+	}
+	_ = ah.SaveTransactions(&block.Header{Nonce: 1}, []byte("h1"), txs)
+	_ = ah.SaveTransactions(&block.Header{Nonce: 1}, []byte("h1-fork"), txs)
+
+	entries, _, _ := ah.GetTransactions(addrA, 0, 10)
+	require.Equal(t, 1, len(entries))
+	assert.Equal(t, []byte("h1-fork"), entries[0].BlockHash)
+}
+
+func TestAccountHistory_SaveTransactionsShouldPruneTheOldestEntries(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAccountHistory()
+	args.MaxEntriesPerEpoch = 2
+	ah, _ := accountHistory.NewAccountHistory(args)
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		txHash := string([]byte{'t', 'x', byte('0' + nonce)})
+		_ = ah.SaveTransactions(&block.Header{Nonce: nonce}, []byte("h"), map[string]data.TransactionHandler{
+			txHash: createTx(addrA, addrB),
+		})
+	}
+
+	entries, truncated, _ := ah.GetTransactions(addrA, 0, 10)
+	assert.Equal(t, []string{"tx3", "tx2"}, getTxHashes(entries))
+	assert.True(t, truncated)
+
+	entries, truncated, _ = ah.GetTransactions(addrA, 0, 2)
+	assert.Equal(t, []string{"tx3", "tx2"}, getTxHashes(entries))
+	assert.False(t, truncated)
+
+	entries, truncated, _ = ah.GetTransactions(addrB, 0, 10)
+	assert.Equal(t, []string{"tx3", "tx2"}, getTxHashes(entries))
+	assert.True(t, truncated)
+}
+
+func TestAccountHistory_GetTransactionsShouldNotReportTruncationWhenNothingWasPruned(t *testing.T) {
+	t.Parallel()
+
+	ah, _ := accountHistory.NewAccountHistory(createMockArgsAccountHistory())
+	_ = ah.SaveTransactions(&block.Header{Nonce: 1}, []byte("h1"), map[string]data.TransactionHandler{
+		"tx1": createTx(addrA, addrB),
+	})
+
+	entries, truncated, err := ah.GetTransactions(addrA, 0, 10)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"tx1"}, getTxHashes(entries))
+	assert.False(t, truncated)
+}
+
+func TestAccountHistory_RevertBlockNilHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ah, _ := accountHistory.NewAccountHistory(createMockArgsAccountHistory())
+	err := ah.RevertBlock(nil, []byte("h1"))
+	assert.Equal(t, process.ErrNilBlockHeader, err)
+}
+
+func TestAccountHistory_RevertBlockShouldRemoveTheEntriesOfTheBlock(t *testing.T) {
+	t.Parallel()
+
+	ah, _ := accountHistory.NewAccountHistory(createMockArgsAccountHistory())
+	_ = ah.SaveTransactions(&block.Header{Nonce: 1}, []byte("h1"), map[string]data.TransactionHandler{
+		"tx1": createTx(addrA, addrB),
+	})
+	forkHeader := &block.Header{Nonce: 2}
+	_ = ah.SaveTransactions(forkHeader, []byte("h2"), map[string]data.TransactionHandler{
+		"tx2": createTx(addrA, addrB),
+		"tx3": createTx(addrB, addrC),
+	})
+
+	err := ah.RevertBlock(forkHeader, []byte("h2"))
+	require.Nil(t, err)
+
+	entries, _, _ := ah.GetTransactions(addrA, 0, 10)
+	assert.Equal(t, []string{"tx1"}, getTxHashes(entries))
+	entries, _, _ = ah.GetTransactions(addrB, 0, 10)
+	assert.Equal(t, []string{"tx1"}, getTxHashes(entries))
+
+	err = ah.RevertBlock(forkHeader, []byte("h2"))
+	assert.Nil(t, err)
+	entries, _, _ = ah.GetTransactions(addrA, 0, 10)
+	assert.Equal(t, []string{"tx1"}, getTxHashes(entries))
+}
@@ -0,0 +1,42 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.AccountHistoryHandler = (*accountHistory)(nil)
+
+type accountHistory struct {
+}
+
+// NewNilAccountHistory returns an account history handler used when the account history index is disabled
+func NewNilAccountHistory() *accountHistory {
+	return new(accountHistory)
+}
+
+// SaveTransactions does nothing
+func (ah *accountHistory) SaveTransactions(_ data.HeaderHandler, _ []byte, _ map[string]data.TransactionHandler) error {
+	return nil
+}
+
+// RevertBlock does nothing
+func (ah *accountHistory) RevertBlock(_ data.HeaderHandler, _ []byte) error {
+	return nil
+}
+
+// GetTransactions returns ErrAccountHistoryDisabled
+func (ah *accountHistory) GetTransactions(_ []byte, _ uint32, _ uint32) ([]*transaction.AccountHistoryEntry, bool, error) {
+	return nil, false, process.ErrAccountHistoryDisabled
+}
+
+// IsEnabled returns false
+func (ah *accountHistory) IsEnabled() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ah *accountHistory) IsInterfaceNil() bool {
+	return ah == nil
+}
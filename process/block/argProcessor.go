@@ -39,6 +39,8 @@ type ArgBaseProcessor struct {
 	BlockChain             data.ChainHandler
 	StateCheckpointModulus uint
 	BlockSizeThrottler     process.BlockSizeThrottler
+	AccountHistory         process.AccountHistoryHandler
+	EventsNotifier         process.BlockEventsNotifier
 	Version                string
 }
 
@@ -60,4 +62,6 @@ type ArgMetaProcessor struct {
 	EpochRewardsCreator          process.EpochStartRewardsCreator
 	EpochValidatorInfoCreator    process.EpochStartValidatorInfoCreator
 	ValidatorStatisticsProcessor process.ValidatorStatisticsProcessor
+	EquivocationSlasher          process.EquivocationSlasher
+	RewardsTxProcessor           process.RewardTransactionProcessor
 }
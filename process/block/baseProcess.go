@@ -63,6 +63,8 @@ type baseProcessor struct {
 	dataPool                dataRetriever.PoolsHolder
 	feeHandler              process.TransactionFeeHandler
 	blockChain              data.ChainHandler
+	accountHistory          process.AccountHistoryHandler
+	eventsNotifier          process.BlockEventsNotifier
 	hdrsForCurrBlock        *hdrForBlock
 	genesisNonce            uint64
 	version                 string
@@ -396,6 +398,12 @@ func checkProcessorNilParameters(arguments ArgBaseProcessor) error {
 	if check.IfNil(arguments.BlockSizeThrottler) {
 		return process.ErrNilBlockSizeThrottler
 	}
+	if check.IfNil(arguments.AccountHistory) {
+		return process.ErrNilAccountHistoryHandler
+	}
+	if check.IfNil(arguments.EventsNotifier) {
+		return process.ErrNilEventsNotifier
+	}
 	if len(arguments.Version) == 0 {
 		return process.ErrEmptySoftwareVersion
 	}
@@ -966,6 +974,64 @@ func (bp *baseProcessor) saveBody(body *block.Body) {
 	}
 }
 
+// getAllCommittedTxs returns all the transactions, smart contract results, rewards and invalid transactions
+// used by the block being committed
+func (bp *baseProcessor) getAllCommittedTxs() map[string]data.TransactionHandler {
+	txPool := bp.txCoordinator.GetAllCurrentUsedTxs(block.TxBlock)
+	scPool := bp.txCoordinator.GetAllCurrentUsedTxs(block.SmartContractResultBlock)
+	rewardPool := bp.txCoordinator.GetAllCurrentUsedTxs(block.RewardsBlock)
+	invalidPool := bp.txCoordinator.GetAllCurrentUsedTxs(block.InvalidBlock)
+
+	for hash, tx := range scPool {
+		txPool[hash] = tx
+	}
+	for hash, tx := range rewardPool {
+		txPool[hash] = tx
+	}
+	for hash, tx := range invalidPool {
+		txPool[hash] = tx
+	}
+
+	return txPool
+}
+
+func (bp *baseProcessor) saveAccountHistory(header data.HeaderHandler, headerHash []byte, txs map[string]data.TransactionHandler) {
+	if !bp.accountHistory.IsEnabled() {
+		return
+	}
+
+	startTime := time.Now()
+
+	errNotCritical := bp.accountHistory.SaveTransactions(header, headerHash, txs)
+	if errNotCritical != nil {
+		log.Warn("saveAccountHistory.SaveTransactions", "error", errNotCritical.Error())
+	}
+
+	elapsedTime := time.Since(startTime)
+	if elapsedTime >= core.CommitMaxTime {
+		log.Warn("saveAccountHistory", "elapsed time", elapsedTime)
+	}
+}
+
+// revertAccountHistory removes the account history entries saved when the given block was committed, as the block
+// was rolled back
+func (bp *baseProcessor) revertAccountHistory(header data.HeaderHandler) {
+	if !bp.accountHistory.IsEnabled() {
+		return
+	}
+
+	headerHash, err := core.CalculateHash(bp.marshalizer, bp.hasher, header)
+	if err != nil {
+		log.Warn("revertAccountHistory.CalculateHash", "error", err.Error())
+		return
+	}
+
+	errNotCritical := bp.accountHistory.RevertBlock(header, headerHash)
+	if errNotCritical != nil {
+		log.Warn("revertAccountHistory.RevertBlock", "error", errNotCritical.Error())
+	}
+}
+
 func (bp *baseProcessor) saveShardHeader(header data.HeaderHandler, headerHash []byte, marshalizedHeader []byte) {
 	startTime := time.Now()
 
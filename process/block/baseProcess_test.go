@@ -310,6 +310,8 @@ func CreateMockArguments() blproc.ArgShardProcessor {
 			BlockTracker:       mock.NewBlockTrackerMock(shardCoordinator, startHeaders),
 			BlockChain:         blkc,
 			BlockSizeThrottler: &mock.BlockSizeThrottlerStub{},
+			AccountHistory:     &mock.AccountHistoryStub{},
+			EventsNotifier:     &mock.BlockEventsNotifierStub{},
 			Version:            "softwareVersion",
 		},
 	}
@@ -391,7 +393,7 @@ func TestVerifyStateRoot_ShouldWork(t *testing.T) {
 	assert.True(t, bp.VerifyStateRoot(rootHash))
 }
 
-//------- SetAppStatusHandler
+// ------- SetAppStatusHandler
 func TestBaseProcessor_SetAppStatusHandlerNilHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -413,7 +415,7 @@ func TestBaseProcessor_SetAppStatusHandlerOkHandlerShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
-//------- RevertState
+// ------- RevertState
 func TestBaseProcessor_RevertStateRecreateTrieFailsShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -103,6 +103,8 @@ func NewShardProcessorEmptyWith3shards(
 			DataPool:           tdp,
 			BlockChain:         blockChain,
 			BlockSizeThrottler: &mock.BlockSizeThrottlerStub{},
+			AccountHistory:     &mock.AccountHistoryStub{},
+			EventsNotifier:     &mock.BlockEventsNotifierStub{},
 			Version:            "softwareVersion",
 		},
 	}
@@ -339,6 +341,10 @@ func (sp *shardProcessor) CreateBlockBody(shardHdr *block.Header, haveTime func(
 	return sp.createBlockBody(shardHdr, haveTime)
 }
 
+func (mp *metaProcessor) ProcessEpochStartMetaBlock(header *block.MetaBlock, body *block.Body) error {
+	return mp.processEpochStartMetaBlock(header, body)
+}
+
 func (sp *shardProcessor) CheckEpochCorrectnessCrossChain() error {
 	return sp.checkEpochCorrectnessCrossChain()
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/serviceContainer"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/node/external"
@@ -37,6 +38,8 @@ type metaProcessor struct {
 	validatorInfoCreator         process.EpochStartValidatorInfoCreator
 	pendingMiniBlocksHandler     process.PendingMiniBlocksHandler
 	validatorStatisticsProcessor process.ValidatorStatisticsProcessor
+	equivocationSlasher          process.EquivocationSlasher
+	rewardsTxProcessor           process.RewardTransactionProcessor
 	shardsHeadersNonce           *sync.Map
 	shardBlockFinality           uint32
 	chRcvAllHdrs                 chan bool
@@ -79,6 +82,12 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 	if check.IfNil(arguments.ValidatorStatisticsProcessor) {
 		return nil, process.ErrNilValidatorStatistics
 	}
+	if check.IfNil(arguments.EquivocationSlasher) {
+		return nil, process.ErrNilEquivocationSlasher
+	}
+	if check.IfNil(arguments.RewardsTxProcessor) {
+		return nil, process.ErrNilRewardsTxProcessor
+	}
 
 	genesisHdr := arguments.BlockChain.GetGenesisHeader()
 	base := &baseProcessor{
@@ -103,6 +112,8 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 		blockTracker:           arguments.BlockTracker,
 		dataPool:               arguments.DataPool,
 		blockChain:             arguments.BlockChain,
+		accountHistory:         arguments.AccountHistory,
+		eventsNotifier:         arguments.EventsNotifier,
 		stateCheckpointModulus: arguments.StateCheckpointModulus,
 		genesisNonce:           genesisHdr.GetNonce(),
 		version:                core.TrimSoftwareVersion(arguments.Version),
@@ -120,6 +131,8 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 		epochRewardsCreator:          arguments.EpochRewardsCreator,
 		validatorStatisticsProcessor: arguments.ValidatorStatisticsProcessor,
 		validatorInfoCreator:         arguments.EpochValidatorInfoCreator,
+		equivocationSlasher:          arguments.EquivocationSlasher,
+		rewardsTxProcessor:           arguments.RewardsTxProcessor,
 	}
 
 	mp.txCounter = NewTransactionCounter()
@@ -311,6 +324,11 @@ func (mp *metaProcessor) ProcessBlock(
 		return err
 	}
 
+	err = mp.processSlashings(header)
+	if err != nil {
+		return err
+	}
+
 	err = mp.verifyFees(header)
 	if err != nil {
 		return err
@@ -329,10 +347,29 @@ func (mp *metaProcessor) ProcessBlock(
 	return nil
 }
 
+// processSlashings applies the equivocation slashings carried by the header, first on the staking smart contract and
+// then on the peer state
+func (mp *metaProcessor) processSlashings(header *block.MetaBlock) error {
+	if len(header.Slashings) == 0 {
+		return nil
+	}
+
+	err := mp.equivocationSlasher.ProcessSlashings(header.Slashings)
+	if err != nil {
+		return err
+	}
+
+	return mp.scToProtocol.UpdateProtocolForKeys(getSlashedKeys(header.Slashings), header.Nonce)
+}
+
 func (mp *metaProcessor) processEpochStartMetaBlock(
 	header *block.MetaBlock,
 	body *block.Body,
 ) error {
+	if len(header.Slashings) > 0 {
+		return process.ErrSlashingsOnEpochStartBlock
+	}
+
 	err := mp.epochStartDataCreator.VerifyEpochStartDataForMetablock(header)
 	if err != nil {
 		return err
@@ -343,6 +380,11 @@ func (mp *metaProcessor) processEpochStartMetaBlock(
 		return err
 	}
 
+	err = mp.updateProtocolForKeyRotations(header)
+	if err != nil {
+		return err
+	}
+
 	currentRootHash, err := mp.validatorStatisticsProcessor.RootHash()
 	if err != nil {
 		return err
@@ -363,6 +405,11 @@ func (mp *metaProcessor) processEpochStartMetaBlock(
 		return err
 	}
 
+	err = mp.processMetachainRewards(body)
+	if err != nil {
+		return err
+	}
+
 	err = mp.validatorInfoCreator.VerifyValidatorInfoMiniBlocks(body.MiniBlocks, allValidatorsInfo)
 	if err != nil {
 		return err
@@ -616,6 +663,8 @@ func (mp *metaProcessor) RestoreBlockIntoPools(headerHandler data.HeaderHandler,
 
 	mp.blockTracker.RemoveLastNotarizedHeaders()
 
+	mp.revertAccountHistory(metaBlock)
+
 	return nil
 }
 
@@ -714,6 +763,11 @@ func (mp *metaProcessor) createEpochStartBody(metaBlock *block.MetaBlock) (data.
 		"nonce", metaBlock.GetNonce(),
 	)
 
+	err := mp.updateProtocolForKeyRotations(metaBlock)
+	if err != nil {
+		return nil, err
+	}
+
 	currentRootHash, err := mp.validatorStatisticsProcessor.RootHash()
 	if err != nil {
 		return nil, err
@@ -734,6 +788,11 @@ func (mp *metaProcessor) createEpochStartBody(metaBlock *block.MetaBlock) (data.
 		return nil, err
 	}
 
+	err = mp.processMetachainRewards(&block.Body{MiniBlocks: rewardMiniBlocks})
+	if err != nil {
+		return nil, err
+	}
+
 	validatorMiniBlocks, err := mp.validatorInfoCreator.CreateValidatorInfoMiniBlocks(allValidatorsInfo)
 	if err != nil {
 		return nil, err
@@ -751,6 +810,46 @@ func (mp *metaProcessor) createEpochStartBody(metaBlock *block.MetaBlock) (data.
 	return &block.Body{MiniBlocks: finalMiniBlocks}, nil
 }
 
+// processMetachainRewards executes the rewards sent to the metachain addresses, e.g. to the delegation pools, as they
+// are not carried to any shard
+func (mp *metaProcessor) processMetachainRewards(body *block.Body) error {
+	rewardsTxs := mp.epochRewardsCreator.GetRewardsTxs(body)
+	for _, miniBlock := range body.MiniBlocks {
+		if miniBlock.Type != block.RewardsBlock || miniBlock.ReceiverShardID != core.MetachainShardId {
+			continue
+		}
+
+		for _, txHash := range miniBlock.TxHashes {
+			tx, ok := rewardsTxs[string(txHash)]
+			if !ok {
+				return process.ErrMissingTransaction
+			}
+
+			rwdTx, ok := tx.(*rewardTx.RewardTx)
+			if !ok {
+				return process.ErrWrongTypeAssertion
+			}
+
+			err := mp.rewardsTxProcessor.ProcessRewardTransaction(rwdTx)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// updateProtocolForKeyRotations moves the peers of the validator keys changed during the previous epoch to the new
+// keys, before the validators info of the new epoch is computed
+func (mp *metaProcessor) updateProtocolForKeyRotations(metaBlock *block.MetaBlock) error {
+	if metaBlock.Epoch == 0 {
+		return nil
+	}
+
+	return mp.scToProtocol.UpdateProtocolForKeyRotations(metaBlock.Epoch - 1)
+}
+
 // createBlockBody creates block body of metachain
 func (mp *metaProcessor) createBlockBody(metaBlock *block.MetaBlock, haveTime func() bool) (data.BodyHandler, error) {
 	mp.createBlockStarted()
@@ -772,9 +871,39 @@ func (mp *metaProcessor) createBlockBody(metaBlock *block.MetaBlock, haveTime fu
 		return nil, err
 	}
 
+	err = mp.createSlashings(metaBlock, haveTime)
+	if err != nil {
+		return nil, err
+	}
+
 	return miniBlocks, nil
 }
 
+// createSlashings includes the pooled equivocation proofs in the header as slashings and applies them on the peer state
+func (mp *metaProcessor) createSlashings(metaBlock *block.MetaBlock, haveTime func() bool) error {
+	slashings, err := mp.equivocationSlasher.CreateSlashings(haveTime)
+	if err != nil {
+		return err
+	}
+	if len(slashings) == 0 {
+		return nil
+	}
+
+	log.Debug("included equivocation slashings in meta block", "num slashings", len(slashings))
+	metaBlock.Slashings = slashings
+
+	return mp.scToProtocol.UpdateProtocolForKeys(getSlashedKeys(slashings), metaBlock.Nonce)
+}
+
+func getSlashedKeys(slashings []block.Slashing) [][]byte {
+	keys := make([][]byte, 0, len(slashings))
+	for _, slashing := range slashings {
+		keys = append(keys, slashing.PublicKey)
+	}
+
+	return keys
+}
+
 func (mp *metaProcessor) createMiniBlocks(
 	haveTime func() bool,
 ) (*block.Body, error) {
@@ -1030,6 +1159,8 @@ func (mp *metaProcessor) CommitBlock(
 	headerHash := mp.hasher.Compute(string(marshalizedHeader))
 	mp.saveMetaHeader(header, headerHash, marshalizedHeader)
 	mp.saveBody(body)
+	committedTxs := mp.getAllCommittedTxs()
+	mp.saveAccountHistory(header, headerHash, committedTxs)
 
 	err = mp.commitAll()
 	if err != nil {
@@ -1095,6 +1226,7 @@ func (mp *metaProcessor) CommitBlock(
 	}
 
 	mp.indexBlock(header, body, lastMetaBlock, notarizedHeadersHashes, rewardsTxs)
+	mp.eventsNotifier.NotifyBlockCommitted(header, headerHash, committedTxs)
 
 	saveMetachainCommitBlockMetrics(mp.appStatusHandler, header, headerHash, mp.nodesCoordinator)
 
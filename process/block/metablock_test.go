@@ -13,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/blockchain"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -73,6 +74,8 @@ func createMockMetaArguments() blproc.ArgMetaProcessor {
 			DataPool:           mdp,
 			BlockChain:         createTestBlockchain(),
 			BlockSizeThrottler: &mock.BlockSizeThrottlerStub{},
+			AccountHistory:     &mock.AccountHistoryStub{},
+			EventsNotifier:     &mock.BlockEventsNotifierStub{},
 			Version:            "softwareVersion",
 		},
 		SCDataGetter:                 &mock.ScQueryStub{},
@@ -83,6 +86,8 @@ func createMockMetaArguments() blproc.ArgMetaProcessor {
 		EpochRewardsCreator:          &mock.EpochRewardsCreatorStub{},
 		EpochValidatorInfoCreator:    &mock.EpochValidatorInfoCreatorStub{},
 		ValidatorStatisticsProcessor: &mock.ValidatorStatisticsProcessorStub{},
+		EquivocationSlasher:          &mock.EquivocationSlasherStub{},
+		RewardsTxProcessor:           &mock.RewardTxProcessorMock{},
 	}
 	return arguments
 }
@@ -311,6 +316,28 @@ func TestNewMetaProcessor_NilPendingMiniBlocksShouldErr(t *testing.T) {
 	assert.Nil(t, be)
 }
 
+func TestNewMetaProcessor_NilAccountHistoryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments()
+	arguments.AccountHistory = nil
+
+	be, err := blproc.NewMetaProcessor(arguments)
+	assert.Equal(t, process.ErrNilAccountHistoryHandler, err)
+	assert.Nil(t, be)
+}
+
+func TestNewMetaProcessor_NilEventsNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments()
+	arguments.EventsNotifier = nil
+
+	be, err := blproc.NewMetaProcessor(arguments)
+	assert.Equal(t, process.ErrNilEventsNotifier, err)
+	assert.Nil(t, be)
+}
+
 func TestNewMetaProcessor_NilBlockSizeThrottlerShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -322,6 +349,28 @@ func TestNewMetaProcessor_NilBlockSizeThrottlerShouldErr(t *testing.T) {
 	assert.Nil(t, be)
 }
 
+func TestNewMetaProcessor_NilEquivocationSlasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments()
+	arguments.EquivocationSlasher = nil
+
+	be, err := blproc.NewMetaProcessor(arguments)
+	assert.Equal(t, process.ErrNilEquivocationSlasher, err)
+	assert.Nil(t, be)
+}
+
+func TestNewMetaProcessor_NilRewardsTxProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments()
+	arguments.RewardsTxProcessor = nil
+
+	be, err := blproc.NewMetaProcessor(arguments)
+	assert.Equal(t, process.ErrNilRewardsTxProcessor, err)
+	assert.Nil(t, be)
+}
+
 func TestNewMetaProcessor_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -580,7 +629,206 @@ func TestMetaProcessor_ProcessBlockWithErrOnVerifyStateRootCallShouldRevertState
 	assert.True(t, wasCalled)
 }
 
-//------- requestFinalMissingHeader
+func TestMetaProcessor_ProcessBlockShouldApplyTheSlashings(t *testing.T) {
+	t.Parallel()
+
+	blkc := blockchain.NewMetaChain()
+	_ = blkc.SetCurrentBlockHeader(
+		&block.MetaBlock{
+			Nonce:                  0,
+			AccumulatedFeesInEpoch: big.NewInt(0),
+			DevFeesInEpoch:         big.NewInt(0),
+		},
+	)
+	_ = blkc.SetGenesisHeader(&block.MetaBlock{Nonce: 0})
+	hdr := createMetaBlockHeader()
+	hdr.ShardInfo = make([]block.ShardData, 0)
+	hdr.Slashings = []block.Slashing{{PublicKey: []byte("bls key"), Round: 7, Proof: []byte("proof")}}
+
+	arguments := createMockMetaArguments()
+	arguments.AccountsDB[state.UserAccountsState] = &mock.AccountsStub{
+		JournalLenCalled: func() int {
+			return 0
+		},
+		RootHashCalled: func() ([]byte, error) {
+			return hdr.RootHash, nil
+		},
+	}
+	arguments.BlockChain = blkc
+	var processedSlashings []block.Slashing
+	arguments.EquivocationSlasher = &mock.EquivocationSlasherStub{
+		ProcessSlashingsCalled: func(slashings []block.Slashing) error {
+			processedSlashings = slashings
+			return nil
+		},
+	}
+	var updatedKeys [][]byte
+	arguments.SCToProtocol = &mock.SCToProtocolStub{
+		UpdateProtocolForKeysCalled: func(keys [][]byte, nonce uint64) error {
+			assert.Equal(t, hdr.Nonce, nonce)
+			updatedKeys = keys
+			return nil
+		},
+	}
+	mp, _ := blproc.NewMetaProcessor(arguments)
+
+	go func() {
+		mp.ChRcvAllHdrs() <- true
+	}()
+
+	mp.SetShardBlockFinality(0)
+	err := mp.ProcessBlock(hdr, &block.Body{}, haveTime)
+
+	assert.Nil(t, err)
+	assert.Equal(t, hdr.Slashings, processedSlashings)
+	assert.Equal(t, [][]byte{[]byte("bls key")}, updatedKeys)
+}
+
+func TestMetaProcessor_ProcessBlockWithInvalidSlashingShouldErrAndRevertState(t *testing.T) {
+	t.Parallel()
+
+	blkc := blockchain.NewMetaChain()
+	_ = blkc.SetCurrentBlockHeader(
+		&block.MetaBlock{
+			Nonce:                  0,
+			AccumulatedFeesInEpoch: big.NewInt(0),
+			DevFeesInEpoch:         big.NewInt(0),
+		},
+	)
+	_ = blkc.SetGenesisHeader(&block.MetaBlock{Nonce: 0})
+	hdr := createMetaBlockHeader()
+	hdr.ShardInfo = make([]block.ShardData, 0)
+	hdr.Slashings = []block.Slashing{{PublicKey: []byte("bls key"), Round: 7, Proof: []byte("proof")}}
+
+	wasReverted := false
+	arguments := createMockMetaArguments()
+	arguments.AccountsDB[state.UserAccountsState] = &mock.AccountsStub{
+		JournalLenCalled: func() int {
+			return 0
+		},
+		RevertToSnapshotCalled: func(snapshot int) error {
+			wasReverted = true
+			return nil
+		},
+	}
+	arguments.BlockChain = blkc
+	arguments.EquivocationSlasher = &mock.EquivocationSlasherStub{
+		ProcessSlashingsCalled: func(slashings []block.Slashing) error {
+			return process.ErrInvalidSlashing
+		},
+	}
+	arguments.SCToProtocol = &mock.SCToProtocolStub{
+		UpdateProtocolForKeysCalled: func(keys [][]byte, nonce uint64) error {
+			assert.Fail(t, "should have not updated the peer state for an invalid slashing")
+			return nil
+		},
+	}
+	mp, _ := blproc.NewMetaProcessor(arguments)
+
+	go func() {
+		mp.ChRcvAllHdrs() <- true
+	}()
+
+	mp.SetShardBlockFinality(0)
+	err := mp.ProcessBlock(hdr, &block.Body{}, haveTime)
+
+	assert.Equal(t, process.ErrInvalidSlashing, err)
+	assert.True(t, wasReverted)
+}
+
+func TestMetaProcessor_ProcessEpochStartMetaBlockWithSlashingsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments()
+	arguments.EquivocationSlasher = &mock.EquivocationSlasherStub{
+		ProcessSlashingsCalled: func(slashings []block.Slashing) error {
+			assert.Fail(t, "should have not processed the slashings of an epoch start block")
+			return nil
+		},
+	}
+	mp, _ := blproc.NewMetaProcessor(arguments)
+
+	hdr := createMetaBlockHeader()
+	hdr.Slashings = []block.Slashing{{PublicKey: []byte("bls key"), Round: 7, Proof: []byte("proof")}}
+	err := mp.ProcessEpochStartMetaBlock(hdr, &block.Body{})
+
+	assert.Equal(t, process.ErrSlashingsOnEpochStartBlock, err)
+}
+
+func TestMetaProcessor_ProcessEpochStartMetaBlockShouldProcessTheMetachainRewards(t *testing.T) {
+	t.Parallel()
+
+	shardRewardTx := &rewardTx.RewardTx{RcvAddr: []byte("shard address"), Value: big.NewInt(10)}
+	metaRewardTx := &rewardTx.RewardTx{RcvAddr: []byte("metachain address"), Value: big.NewInt(20)}
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{
+				TxHashes:        [][]byte{[]byte("shard reward")},
+				ReceiverShardID: 0,
+				SenderShardID:   core.MetachainShardId,
+				Type:            block.RewardsBlock,
+			},
+			{
+				TxHashes:        [][]byte{[]byte("metachain reward")},
+				ReceiverShardID: core.MetachainShardId,
+				SenderShardID:   core.MetachainShardId,
+				Type:            block.RewardsBlock,
+			},
+		},
+	}
+
+	processedRewardTxs := make([]*rewardTx.RewardTx, 0)
+	arguments := createMockMetaArguments()
+	arguments.EpochRewardsCreator = &mock.EpochRewardsCreatorStub{
+		GetRewardsTxsCalled: func(body *block.Body) map[string]data.TransactionHandler {
+			return map[string]data.TransactionHandler{
+				"shard reward":     shardRewardTx,
+				"metachain reward": metaRewardTx,
+			}
+		},
+	}
+	arguments.RewardsTxProcessor = &mock.RewardTxProcessorMock{
+		ProcessRewardTransactionCalled: func(rTx *rewardTx.RewardTx) error {
+			processedRewardTxs = append(processedRewardTxs, rTx)
+			return nil
+		},
+	}
+	mp, _ := blproc.NewMetaProcessor(arguments)
+
+	_ = mp.ProcessEpochStartMetaBlock(createMetaBlockHeader(), body)
+
+	assert.Equal(t, []*rewardTx.RewardTx{metaRewardTx}, processedRewardTxs)
+}
+
+func TestMetaProcessor_ProcessEpochStartMetaBlockMissingMetachainRewardShouldErr(t *testing.T) {
+	t.Parallel()
+
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{
+				TxHashes:        [][]byte{[]byte("metachain reward")},
+				ReceiverShardID: core.MetachainShardId,
+				SenderShardID:   core.MetachainShardId,
+				Type:            block.RewardsBlock,
+			},
+		},
+	}
+
+	arguments := createMockMetaArguments()
+	arguments.RewardsTxProcessor = &mock.RewardTxProcessorMock{
+		ProcessRewardTransactionCalled: func(rTx *rewardTx.RewardTx) error {
+			assert.Fail(t, "should have not processed any reward")
+			return nil
+		},
+	}
+	mp, _ := blproc.NewMetaProcessor(arguments)
+
+	err := mp.ProcessEpochStartMetaBlock(createMetaBlockHeader(), body)
+
+	assert.Equal(t, process.ErrMissingTransaction, err)
+}
+
+// ------- requestFinalMissingHeader
 func TestMetaProcessor_RequestFinalMissingHeaderShouldPass(t *testing.T) {
 	t.Parallel()
 
@@ -1533,6 +1781,33 @@ func TestMetaProcessor_RestoreBlockIntoPoolsShouldWork(t *testing.T) {
 	assert.Equal(t, &hdr, hdrFromPool)
 }
 
+func TestMetaProcessor_RestoreBlockIntoPoolsShouldRevertAccountHistory(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments()
+	arguments.Store = initStore()
+	arguments.Hasher = &mock.HasherMock{}
+	mhdr := createMetaBlockHeader()
+	expectedHash, _ := core.CalculateHash(arguments.Marshalizer, arguments.Hasher, mhdr)
+	var revertedHash []byte
+	arguments.AccountHistory = &mock.AccountHistoryStub{
+		IsEnabledCalled: func() bool {
+			return true
+		},
+		RevertBlockCalled: func(header data.HeaderHandler, headerHash []byte) error {
+			assert.Equal(t, mhdr, header)
+			revertedHash = headerHash
+			return nil
+		},
+	}
+	mp, _ := blproc.NewMetaProcessor(arguments)
+
+	err := mp.RestoreBlockIntoPools(mhdr, &block.Body{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedHash, revertedHash)
+}
+
 func TestMetaProcessor_CreateLastNotarizedHdrs(t *testing.T) {
 	t.Parallel()
 
@@ -2183,6 +2458,53 @@ func TestMetaProcessor_CreateMiniBlocksNoTimeShouldReturnEmptyBody(t *testing.T)
 	assert.Equal(t, &block.Body{}, bodyHandler)
 }
 
+func TestMetaProcessor_CreateBlockBodyShouldIncludeTheSlashings(t *testing.T) {
+	t.Parallel()
+
+	slashings := []block.Slashing{{PublicKey: []byte("bls key"), Round: 7, Proof: []byte("proof")}}
+	arguments := createMockMetaArguments()
+	arguments.EquivocationSlasher = &mock.EquivocationSlasherStub{
+		CreateSlashingsCalled: func(haveTime func() bool) ([]block.Slashing, error) {
+			return slashings, nil
+		},
+	}
+	var updatedKeys [][]byte
+	arguments.SCToProtocol = &mock.SCToProtocolStub{
+		UpdateProtocolForKeysCalled: func(keys [][]byte, nonce uint64) error {
+			assert.Equal(t, uint64(11), nonce)
+			updatedKeys = keys
+			return nil
+		},
+	}
+	mp, _ := blproc.NewMetaProcessor(arguments)
+	metaHdr := &block.MetaBlock{Round: 10, Nonce: 11}
+
+	_, err := mp.CreateBlockBody(metaHdr, func() bool { return true })
+	assert.Nil(t, err)
+	assert.Equal(t, slashings, metaHdr.Slashings)
+	assert.Nil(t, metaHdr.PeerInfo)
+	assert.Equal(t, [][]byte{[]byte("bls key")}, updatedKeys)
+}
+
+func TestMetaProcessor_CreateBlockBodySlashingsErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	arguments := createMockMetaArguments()
+	arguments.EquivocationSlasher = &mock.EquivocationSlasherStub{
+		CreateSlashingsCalled: func(haveTime func() bool) ([]block.Slashing, error) {
+			return nil, expectedErr
+		},
+	}
+	mp, _ := blproc.NewMetaProcessor(arguments)
+	metaHdr := &block.MetaBlock{Round: 10, Nonce: 11}
+
+	bodyHandler, err := mp.CreateBlockBody(metaHdr, func() bool { return true })
+	assert.Nil(t, bodyHandler)
+	assert.Equal(t, expectedErr, err)
+	assert.Nil(t, metaHdr.Slashings)
+}
+
 func TestMetaProcessor_CreateMiniBlocksDestMe(t *testing.T) {
 	t.Parallel()
 
@@ -74,6 +74,8 @@ func NewShardProcessor(arguments ArgShardProcessor) (*shardProcessor, error) {
 		dataPool:               arguments.DataPool,
 		stateCheckpointModulus: arguments.StateCheckpointModulus,
 		blockChain:             arguments.BlockChain,
+		accountHistory:         arguments.AccountHistory,
+		eventsNotifier:         arguments.EventsNotifier,
 		feeHandler:             arguments.FeeHandler,
 		genesisNonce:           genesisHdr.GetNonce(),
 		version:                core.TrimSoftwareVersion(arguments.Version),
@@ -601,6 +603,8 @@ func (sp *shardProcessor) RestoreBlockIntoPools(headerHandler data.HeaderHandler
 
 	sp.blockTracker.RemoveLastNotarizedHeaders()
 
+	sp.revertAccountHistory(header)
+
 	return nil
 }
 
@@ -783,6 +787,8 @@ func (sp *shardProcessor) CommitBlock(
 	}
 
 	sp.saveBody(body)
+	committedTxs := sp.getAllCommittedTxs()
+	sp.saveAccountHistory(header, headerHash, committedTxs)
 
 	processedMetaHdrs, err := sp.getOrderedProcessedMetaBlocksFromHeader(header)
 	if err != nil {
@@ -850,6 +856,7 @@ func (sp *shardProcessor) CommitBlock(
 
 	sp.blockChain.SetCurrentBlockHeaderHash(headerHash)
 	sp.indexBlockIfNeeded(bodyHandler, headerHandler, lastBlockHeader)
+	sp.eventsNotifier.NotifyBlockCommitted(header, headerHash, committedTxs)
 
 	lastCrossNotarizedHeader, _, err := sp.blockTracker.GetLastCrossNotarizedHeader(core.MetachainShardId)
 	if err != nil {
@@ -286,6 +286,28 @@ func TestNewShardProcessor_NilUint64ConverterShouldErr(t *testing.T) {
 	assert.Nil(t, sp)
 }
 
+func TestNewShardProcessor_NilAccountHistoryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.AccountHistory = nil
+	sp, err := blproc.NewShardProcessor(arguments)
+
+	assert.Equal(t, process.ErrNilAccountHistoryHandler, err)
+	assert.Nil(t, sp)
+}
+
+func TestNewShardProcessor_NilEventsNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.EventsNotifier = nil
+	sp, err := blproc.NewShardProcessor(arguments)
+
+	assert.Equal(t, process.ErrNilEventsNotifier, err)
+	assert.Nil(t, sp)
+}
+
 func TestNewShardProcessor_NilBlockSizeThrottlerShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -790,3 +790,84 @@ var ErrOriginatorIsBlacklisted = errors.New("originator is blacklisted")
 
 // ErrShardIsStuck signals that a shard is stuck
 var ErrShardIsStuck = errors.New("shard is stuck")
+
+// ErrESDTIsFrozenForAccount signals that the esdt token is frozen for the given account
+var ErrESDTIsFrozenForAccount = errors.New("account is frozen for this esdt token")
+
+// ErrAddressIsNotESDTSystemSC signals that the caller is not the esdt system smart contract
+var ErrAddressIsNotESDTSystemSC = errors.New("caller address is not the esdt system smart contract")
+
+// ErrCannotWipeAccountNotFrozen signals that the esdt token can not be wiped as the account is not frozen
+var ErrCannotWipeAccountNotFrozen = errors.New("cannot wipe because the account is not frozen for this esdt token")
+
+// ErrESDTTokenIsPaused signals that the esdt token is paused
+var ErrESDTTokenIsPaused = errors.New("esdt token is paused")
+
+// ErrNilESDTPauseHandler signals that a nil esdt pause handler has been provided
+var ErrNilESDTPauseHandler = errors.New("nil esdt pause handler")
+
+// ErrAddressIsNotESDTGlobalSettings signals that the destination is not an esdt global settings address
+var ErrAddressIsNotESDTGlobalSettings = errors.New("destination address is not an esdt global settings address")
+
+// ErrNilUserAccount signals that nil user account was provided
+var ErrNilUserAccount = errors.New("nil user account")
+
+// ErrNilAccountHistoryHandler signals that a nil account history handler was provided
+var ErrNilAccountHistoryHandler = errors.New("nil account history handler")
+
+// ErrEmptyAddress signals that an empty address was provided
+var ErrEmptyAddress = errors.New("empty address")
+
+// ErrAccountHistoryDisabled signals that the account history index is not enabled on this node
+var ErrAccountHistoryDisabled = errors.New("account history is disabled")
+
+// ErrNilAccountsAdapterHolder signals that a nil accounts adapter holder has been provided
+var ErrNilAccountsAdapterHolder = errors.New("nil accounts adapter holder")
+
+// ErrNilAccountsAdapterRecreator signals that a nil accounts adapter recreator has been provided
+var ErrNilAccountsAdapterRecreator = errors.New("nil accounts adapter recreator")
+
+// ErrCommitNotAllowed signals that the state changes of a simulation can not be committed
+var ErrCommitNotAllowed = errors.New("commit not allowed on a simulated state")
+
+// ErrTransactionSimulationNotSupported signals that this node can not simulate transactions
+var ErrTransactionSimulationNotSupported = errors.New("transaction simulation not supported")
+
+// ErrNilEventsNotifier signals that a nil events notifier has been provided
+var ErrNilEventsNotifier = errors.New("nil events notifier")
+
+// ErrEventsNotifierDisabled signals that the events streaming is not enabled on this node
+var ErrEventsNotifierDisabled = errors.New("events notifier is disabled")
+
+// ErrEventsNotifierClosed signals that the events notifier was closed and does not stream events anymore
+var ErrEventsNotifierClosed = errors.New("events notifier is closed")
+
+// ErrTooManySubscribers signals that the maximum number of events subscribers has been reached
+var ErrTooManySubscribers = errors.New("too many subscribers")
+
+// ErrTooManyFilterEntries signals that an events filter holds more addresses and topics than allowed
+var ErrTooManyFilterEntries = errors.New("too many filter entries")
+
+// ErrEmptyEventsFilter signals that an events filter does not select any event
+var ErrEmptyEventsFilter = errors.New("empty events filter")
+
+// ErrSubscriberTooSlow signals that a subscriber did not consume its events fast enough and was dropped
+var ErrSubscriberTooSlow = errors.New("subscriber too slow")
+
+// ErrTxNotForCurrentShard signals that a transaction is neither sent from, nor sent to the current shard
+var ErrTxNotForCurrentShard = errors.New("transaction is not related to the current shard")
+
+// ErrNilEquivocationSlasher signals that a nil equivocation slasher has been provided
+var ErrNilEquivocationSlasher = errors.New("nil equivocation slasher")
+
+// ErrNilEquivocationProofVerifier signals that a nil equivocation proof verifier has been provided
+var ErrNilEquivocationProofVerifier = errors.New("nil equivocation proof verifier")
+
+// ErrNilSystemVM signals that a nil system virtual machine has been provided
+var ErrNilSystemVM = errors.New("nil system virtual machine")
+
+// ErrInvalidSlashing signals that a metachain block holds a slashing which can not be applied
+var ErrInvalidSlashing = errors.New("invalid slashing")
+
+// ErrSlashingsOnEpochStartBlock signals that an epoch start metachain block holds slashings
+var ErrSlashingsOnEpochStartBlock = errors.New("slashings on epoch start block")
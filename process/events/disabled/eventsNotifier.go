@@ -0,0 +1,50 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/events"
+)
+
+var _ events.Notifier = (*eventsNotifier)(nil)
+
+type eventsNotifier struct {
+}
+
+// NewDisabledEventsNotifier returns an events notifier used when the events streaming is disabled
+func NewDisabledEventsNotifier() *eventsNotifier {
+	return new(eventsNotifier)
+}
+
+// NotifyBlockCommitted does nothing
+func (en *eventsNotifier) NotifyBlockCommitted(_ data.HeaderHandler, _ []byte, _ map[string]data.TransactionHandler) {
+}
+
+// EpochStartAction does nothing
+func (en *eventsNotifier) EpochStartAction(_ data.HeaderHandler) {
+}
+
+// EpochStartPrepare does nothing
+func (en *eventsNotifier) EpochStartPrepare(_ data.HeaderHandler, _ data.BodyHandler) {
+}
+
+// NotifyOrder returns the order of the events notifier
+func (en *eventsNotifier) NotifyOrder() uint32 {
+	return core.EventsNotifierOrder
+}
+
+// Subscribe returns ErrEventsNotifierDisabled
+func (en *eventsNotifier) Subscribe(_ events.Filter) (events.Subscription, error) {
+	return nil, process.ErrEventsNotifierDisabled
+}
+
+// Close does nothing
+func (en *eventsNotifier) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (en *eventsNotifier) IsInterfaceNil() bool {
+	return en == nil
+}
@@ -0,0 +1,70 @@
+package events
+
+const (
+	// BlockEventType is the type of the events describing a committed block
+	BlockEventType = "block"
+	// TransactionEventType is the type of the events describing a transaction included in a committed block
+	TransactionEventType = "transaction"
+	// LogEventType is the type of the events describing a log entry emitted by a smart contract
+	LogEventType = "log"
+	// EpochStartEventType is the type of the events describing the start of a new epoch
+	EpochStartEventType = "epochStart"
+)
+
+const (
+	normalTxType   = "normal"
+	unsignedTxType = "unsigned"
+	rewardTxType   = "reward"
+)
+
+// Event is the message streamed to the subscribers. Only the field matching its type is set
+type Event struct {
+	Type        string            `json:"type"`
+	Block       *BlockEvent       `json:"block,omitempty"`
+	Transaction *TransactionEvent `json:"transaction,omitempty"`
+	Log         *LogEvent         `json:"log,omitempty"`
+	EpochStart  *EpochStartEvent  `json:"epochStart,omitempty"`
+}
+
+// BlockEvent holds the details of a committed block
+type BlockEvent struct {
+	Hash      string `json:"hash"`
+	Nonce     uint64 `json:"nonce"`
+	Round     uint64 `json:"round"`
+	Epoch     uint32 `json:"epoch"`
+	ShardID   uint32 `json:"shard"`
+	Timestamp uint64 `json:"timestamp"`
+	NumTxs    int    `json:"numTxs"`
+}
+
+// TransactionEvent holds the details of a transaction included in a committed block
+type TransactionEvent struct {
+	Hash       string `json:"hash"`
+	Type       string `json:"type"`
+	Nonce      uint64 `json:"nonce"`
+	Value      string `json:"value"`
+	Sender     string `json:"sender"`
+	Receiver   string `json:"receiver"`
+	Data       []byte `json:"data,omitempty"`
+	BlockHash  string `json:"blockHash"`
+	BlockNonce uint64 `json:"blockNonce"`
+}
+
+// LogEvent holds one event of the log generated by a smart contract call. Topics and data are hex encoded
+type LogEvent struct {
+	TxHash     string   `json:"txHash"`
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     []string `json:"topics"`
+	Data       string   `json:"data"`
+	BlockHash  string   `json:"blockHash"`
+	BlockNonce uint64   `json:"blockNonce"`
+}
+
+// EpochStartEvent holds the details of the block which started a new epoch
+type EpochStartEvent struct {
+	Epoch   uint32 `json:"epoch"`
+	Nonce   uint64 `json:"nonce"`
+	Round   uint64 `json:"round"`
+	ShardID uint32 `json:"shard"`
+}
@@ -0,0 +1,398 @@
+package events
+
+import (
+	"context"
+	"encoding/hex"
+	"sort"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ Notifier = (*eventsNotifier)(nil)
+var _ process.BlockEventsNotifier = (*eventsNotifier)(nil)
+
+var log = logger.GetOrCreate("process/events")
+
+// notificationsQueueSize is the number of committed blocks which can wait to be turned into events
+const notificationsQueueSize = 100
+
+// ArgsEventsNotifier defines the arguments needed for creating the events notifier
+type ArgsEventsNotifier struct {
+	Marshalizer     marshal.Marshalizer
+	PubkeyConverter core.PubkeyConverter
+	TxLogsStorer    storage.Storer
+	// MaxSubscribers limits the number of subscriptions active at the same time
+	MaxSubscribers int
+	// SubscriberQueueSize is the number of events buffered for each subscriber before it is dropped as too slow
+	SubscriberQueueSize int
+	// MaxFilterEntries limits the number of addresses, contracts and topics a filter can hold
+	MaxFilterEntries int
+}
+
+type notification struct {
+	header       data.HeaderHandler
+	headerHash   []byte
+	txs          map[string]data.TransactionHandler
+	isEpochStart bool
+}
+
+type eventsNotifier struct {
+	marshalizer         marshal.Marshalizer
+	pubkeyConverter     core.PubkeyConverter
+	txLogsStorer        storage.Storer
+	maxSubscribers      int
+	subscriberQueueSize int
+	maxFilterEntries    int
+
+	chNotifications chan *notification
+	cancelFunc      context.CancelFunc
+
+	mutSubscribers sync.RWMutex
+	subscribers    map[uint64]*subscription
+	lastID         uint64
+	closed         bool
+}
+
+// NewEventsNotifier creates a component which turns the committed blocks and the start of epoch notifications into
+// events and streams them, without ever blocking the caller, to the subscribers whose filter selects them
+func NewEventsNotifier(args ArgsEventsNotifier) (*eventsNotifier, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.PubkeyConverter) {
+		return nil, process.ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.TxLogsStorer) {
+		return nil, process.ErrNilStore
+	}
+	if args.MaxSubscribers < 1 {
+		return nil, process.ErrInvalidValue
+	}
+	if args.SubscriberQueueSize < 1 {
+		return nil, process.ErrInvalidValue
+	}
+	if args.MaxFilterEntries < 0 {
+		return nil, process.ErrInvalidValue
+	}
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	en := &eventsNotifier{
+		marshalizer:         args.Marshalizer,
+		pubkeyConverter:     args.PubkeyConverter,
+		txLogsStorer:        args.TxLogsStorer,
+		maxSubscribers:      args.MaxSubscribers,
+		subscriberQueueSize: args.SubscriberQueueSize,
+		maxFilterEntries:    args.MaxFilterEntries,
+		chNotifications:     make(chan *notification, notificationsQueueSize),
+		cancelFunc:          cancelFunc,
+		subscribers:         make(map[uint64]*subscription),
+	}
+
+	go en.dispatch(ctx)
+
+	return en, nil
+}
+
+// NotifyBlockCommitted queues the block for streaming. The call does not block: if the queue is full the block is
+// dropped and a warning is logged
+func (en *eventsNotifier) NotifyBlockCommitted(header data.HeaderHandler, headerHash []byte, txs map[string]data.TransactionHandler) {
+	if check.IfNil(header) {
+		return
+	}
+
+	en.enqueue(&notification{
+		header:     header,
+		headerHash: headerHash,
+		txs:        txs,
+	})
+}
+
+// EpochStartAction queues the start of epoch event
+func (en *eventsNotifier) EpochStartAction(hdr data.HeaderHandler) {
+	if check.IfNil(hdr) {
+		return
+	}
+
+	en.enqueue(&notification{
+		header:       hdr,
+		isEpochStart: true,
+	})
+}
+
+// EpochStartPrepare does nothing
+func (en *eventsNotifier) EpochStartPrepare(_ data.HeaderHandler, _ data.BodyHandler) {
+}
+
+// NotifyOrder returns the order in which the events notifier is told about the start of epoch
+func (en *eventsNotifier) NotifyOrder() uint32 {
+	return core.EventsNotifierOrder
+}
+
+func (en *eventsNotifier) enqueue(n *notification) {
+	if en.numSubscribers() == 0 {
+		return
+	}
+
+	select {
+	case en.chNotifications <- n:
+	default:
+		log.Warn("events notifier queue is full, block dropped",
+			"nonce", n.header.GetNonce(),
+			"round", n.header.GetRound(),
+		)
+	}
+}
+
+func (en *eventsNotifier) numSubscribers() int {
+	en.mutSubscribers.RLock()
+	defer en.mutSubscribers.RUnlock()
+
+	return len(en.subscribers)
+}
+
+// Subscribe creates a new subscription for the events selected by the filter
+func (en *eventsNotifier) Subscribe(filter Filter) (Subscription, error) {
+	compiled, err := compileFilter(filter, en.pubkeyConverter, en.maxFilterEntries)
+	if err != nil {
+		return nil, err
+	}
+
+	en.mutSubscribers.Lock()
+	defer en.mutSubscribers.Unlock()
+
+	if en.closed {
+		return nil, process.ErrEventsNotifierClosed
+	}
+	if len(en.subscribers) >= en.maxSubscribers {
+		return nil, process.ErrTooManySubscribers
+	}
+
+	en.lastID++
+	sub := newSubscription(en.lastID, compiled, en.subscriberQueueSize, en.removeSubscriber)
+	en.subscribers[sub.id] = sub
+
+	return sub, nil
+}
+
+func (en *eventsNotifier) removeSubscriber(id uint64) {
+	en.mutSubscribers.Lock()
+	delete(en.subscribers, id)
+	en.mutSubscribers.Unlock()
+}
+
+func (en *eventsNotifier) getSubscribers() []*subscription {
+	en.mutSubscribers.RLock()
+	defer en.mutSubscribers.RUnlock()
+
+	subscribers := make([]*subscription, 0, len(en.subscribers))
+	for _, sub := range en.subscribers {
+		subscribers = append(subscribers, sub)
+	}
+
+	return subscribers
+}
+
+func (en *eventsNotifier) dispatch(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case n := <-en.chNotifications:
+			en.fanOut(n)
+		}
+	}
+}
+
+func (en *eventsNotifier) fanOut(n *notification) {
+	subscribers := en.getSubscribers()
+	if len(subscribers) == 0 {
+		return
+	}
+
+	wantsLogs := false
+	for _, sub := range subscribers {
+		wantsLogs = wantsLogs || sub.filter.wantsLogs()
+	}
+
+	for _, re := range en.createEvents(n, wantsLogs) {
+		for _, sub := range subscribers {
+			if sub.filter.matches(re) {
+				sub.push(re.event)
+			}
+		}
+	}
+}
+
+func (en *eventsNotifier) createEvents(n *notification, wantsLogs bool) []*routedEvent {
+	if n.isEpochStart {
+		return []*routedEvent{{
+			event: &Event{
+				Type: EpochStartEventType,
+				EpochStart: &EpochStartEvent{
+					Epoch:   n.header.GetEpoch(),
+					Nonce:   n.header.GetNonce(),
+					Round:   n.header.GetRound(),
+					ShardID: n.header.GetShardID(),
+				},
+			},
+		}}
+	}
+
+	blockHash := hex.EncodeToString(n.headerHash)
+	routed := []*routedEvent{{
+		event: &Event{
+			Type: BlockEventType,
+			Block: &BlockEvent{
+				Hash:      blockHash,
+				Nonce:     n.header.GetNonce(),
+				Round:     n.header.GetRound(),
+				Epoch:     n.header.GetEpoch(),
+				ShardID:   n.header.GetShardID(),
+				Timestamp: n.header.GetTimeStamp(),
+				NumTxs:    len(n.txs),
+			},
+		},
+	}}
+
+	hashes := make([]string, 0, len(n.txs))
+	for hash := range n.txs {
+		hashes = append(hashes, hash)
+	}
+	sort.Strings(hashes)
+
+	for _, hash := range hashes {
+		tx := n.txs[hash]
+		if check.IfNil(tx) {
+			continue
+		}
+
+		routed = append(routed, en.createTransactionEvent([]byte(hash), tx, blockHash, n.header.GetNonce()))
+		if wantsLogs {
+			routed = append(routed, en.createLogEvents([]byte(hash), blockHash, n.header.GetNonce())...)
+		}
+	}
+
+	return routed
+}
+
+func (en *eventsNotifier) createTransactionEvent(
+	txHash []byte,
+	tx data.TransactionHandler,
+	blockHash string,
+	blockNonce uint64,
+) *routedEvent {
+	value := "0"
+	if tx.GetValue() != nil {
+		value = tx.GetValue().String()
+	}
+
+	return &routedEvent{
+		event: &Event{
+			Type: TransactionEventType,
+			Transaction: &TransactionEvent{
+				Hash:       hex.EncodeToString(txHash),
+				Type:       getTxType(tx),
+				Nonce:      tx.GetNonce(),
+				Value:      value,
+				Sender:     en.encodeAddress(tx.GetSndAddr()),
+				Receiver:   en.encodeAddress(tx.GetRcvAddr()),
+				Data:       tx.GetData(),
+				BlockHash:  blockHash,
+				BlockNonce: blockNonce,
+			},
+		},
+		addresses: [][]byte{tx.GetSndAddr(), tx.GetRcvAddr()},
+	}
+}
+
+// createLogEvents reads the log saved for the transaction while it was processed. Most transactions have no log
+func (en *eventsNotifier) createLogEvents(txHash []byte, blockHash string, blockNonce uint64) []*routedEvent {
+	buff, err := en.txLogsStorer.Get(txHash)
+	if err != nil {
+		return nil
+	}
+
+	txLog := &transaction.Log{}
+	err = en.marshalizer.Unmarshal(txLog, buff)
+	if err != nil {
+		log.Debug("events notifier: can not unmarshal transaction log", "hash", txHash, "error", err.Error())
+		return nil
+	}
+
+	routed := make([]*routedEvent, 0, len(txLog.Events))
+	for _, event := range txLog.Events {
+		if event == nil {
+			continue
+		}
+
+		topics := hexTopics(event.Topics)
+		routed = append(routed, &routedEvent{
+			event: &Event{
+				Type: LogEventType,
+				Log: &LogEvent{
+					TxHash:     hex.EncodeToString(txHash),
+					Address:    en.encodeAddress(event.Address),
+					Identifier: string(event.Identifier),
+					Topics:     topics,
+					Data:       hex.EncodeToString(event.Data),
+					BlockHash:  blockHash,
+					BlockNonce: blockNonce,
+				},
+			},
+			addresses:  [][]byte{event.Address},
+			identifier: string(event.Identifier),
+			topics:     topics,
+		})
+	}
+
+	return routed
+}
+
+func (en *eventsNotifier) encodeAddress(address []byte) string {
+	if len(address) != en.pubkeyConverter.Len() {
+		return hex.EncodeToString(address)
+	}
+
+	return en.pubkeyConverter.Encode(address)
+}
+
+func getTxType(tx data.TransactionHandler) string {
+	switch tx.(type) {
+	case *smartContractResult.SmartContractResult:
+		return unsignedTxType
+	case *rewardTx.RewardTx:
+		return rewardTxType
+	default:
+		return normalTxType
+	}
+}
+
+// Close stops the streaming and ends all the active subscriptions with ErrEventsNotifierClosed
+func (en *eventsNotifier) Close() error {
+	en.mutSubscribers.Lock()
+	en.closed = true
+	en.mutSubscribers.Unlock()
+
+	en.cancelFunc()
+
+	for _, sub := range en.getSubscribers() {
+		sub.closeWithError(process.ErrEventsNotifierClosed)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (en *eventsNotifier) IsInterfaceNil() bool {
+	return en == nil
+}
@@ -0,0 +1,276 @@
+package events_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/events"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const waitTimeout = time.Second
+
+var (
+	addrA    = []byte("addressA________________________")
+	addrB    = []byte("addressB________________________")
+	contract = []byte("contract________________________")
+)
+
+func createMockArgsEventsNotifier() events.ArgsEventsNotifier {
+	return events.ArgsEventsNotifier{
+		Marshalizer:         &mock.MarshalizerMock{},
+		PubkeyConverter:     mock.NewPubkeyConverterMock(32),
+		TxLogsStorer:        mock.NewStorerMock(),
+		MaxSubscribers:      10,
+		SubscriberQueueSize: 10,
+		MaxFilterEntries:    10,
+	}
+}
+
+func readEvent(t *testing.T, sub events.Subscription) *events.Event {
+	select {
+	case event, ok := <-sub.Events():
+		require.True(t, ok, "subscription closed")
+		return event
+	case <-time.After(waitTimeout):
+		require.Fail(t, "timeout waiting for event")
+		return nil
+	}
+}
+
+func requireNoEvent(t *testing.T, sub events.Subscription) {
+	select {
+	case event := <-sub.Events():
+		require.Fail(t, "unexpected event", "%v", event)
+	case <-time.After(time.Millisecond * 50):
+	}
+}
+
+func TestNewEventsNotifier_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEventsNotifier()
+	args.Marshalizer = nil
+	en, err := events.NewEventsNotifier(args)
+
+	assert.True(t, check.IfNil(en))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewEventsNotifier_NilPubkeyConverterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEventsNotifier()
+	args.PubkeyConverter = nil
+	en, err := events.NewEventsNotifier(args)
+
+	assert.True(t, check.IfNil(en))
+	assert.Equal(t, process.ErrNilPubkeyConverter, err)
+}
+
+func TestNewEventsNotifier_NilTxLogsStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEventsNotifier()
+	args.TxLogsStorer = nil
+	en, err := events.NewEventsNotifier(args)
+
+	assert.True(t, check.IfNil(en))
+	assert.Equal(t, process.ErrNilStore, err)
+}
+
+func TestNewEventsNotifier_InvalidLimitsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEventsNotifier()
+	args.MaxSubscribers = 0
+	en, err := events.NewEventsNotifier(args)
+	assert.True(t, check.IfNil(en))
+	assert.Equal(t, process.ErrInvalidValue, err)
+
+	args = createMockArgsEventsNotifier()
+	args.SubscriberQueueSize = 0
+	en, err = events.NewEventsNotifier(args)
+	assert.True(t, check.IfNil(en))
+	assert.Equal(t, process.ErrInvalidValue, err)
+}
+
+func TestNewEventsNotifier_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	en, err := events.NewEventsNotifier(createMockArgsEventsNotifier())
+
+	assert.False(t, check.IfNil(en))
+	assert.Nil(t, err)
+	assert.Nil(t, en.Close())
+}
+
+func TestEventsNotifier_SubscribeShouldCheckFilter(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEventsNotifier()
+	args.MaxFilterEntries = 1
+	en, _ := events.NewEventsNotifier(args)
+	defer func() {
+		_ = en.Close()
+	}()
+
+	sub, err := en.Subscribe(events.Filter{})
+	assert.Nil(t, sub)
+	assert.Equal(t, process.ErrEmptyEventsFilter, err)
+
+	sub, err = en.Subscribe(events.Filter{Addresses: []string{hex.EncodeToString(addrA), hex.EncodeToString(addrB)}})
+	assert.Nil(t, sub)
+	assert.Equal(t, process.ErrTooManyFilterEntries, err)
+
+	sub, err = en.Subscribe(events.Filter{Addresses: []string{"not hex"}})
+	assert.Nil(t, sub)
+	assert.NotNil(t, err)
+}
+
+func TestEventsNotifier_SubscribeShouldLimitSubscribers(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEventsNotifier()
+	args.MaxSubscribers = 1
+	en, _ := events.NewEventsNotifier(args)
+	defer func() {
+		_ = en.Close()
+	}()
+
+	sub, err := en.Subscribe(events.Filter{Blocks: true})
+	require.Nil(t, err)
+
+	_, err = en.Subscribe(events.Filter{Blocks: true})
+	assert.Equal(t, process.ErrTooManySubscribers, err)
+
+	sub.Close()
+	_, err = en.Subscribe(events.Filter{Blocks: true})
+	assert.Nil(t, err)
+}
+
+func TestEventsNotifier_NotifyBlockCommittedShouldStreamSelectedEvents(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEventsNotifier()
+	txLog := &transaction.Log{
+		Address: contract,
+		Events: []*transaction.Event{
+			{Address: contract, Identifier: []byte("transfer"), Topics: [][]byte{[]byte("topic")}, Data: []byte("data")},
+			{Address: contract, Identifier: []byte("other")},
+		},
+	}
+	buff, _ := args.Marshalizer.Marshal(txLog)
+	_ = args.TxLogsStorer.Put([]byte("txHash2"), buff)
+
+	en, _ := events.NewEventsNotifier(args)
+	defer func() {
+		_ = en.Close()
+	}()
+
+	blocksSub, _ := en.Subscribe(events.Filter{Blocks: true})
+	addressSub, _ := en.Subscribe(events.Filter{Addresses: []string{hex.EncodeToString(addrA)}})
+	logsSub, _ := en.Subscribe(events.Filter{
+		Contracts: []string{hex.EncodeToString(contract)},
+		Topics:    []string{hex.EncodeToString([]byte("topic"))},
+	})
+
+	header := &block.Header{Nonce: 5, Round: 6, Epoch: 1}
+	txs := map[string]data.TransactionHandler{
+		"txHash1": &transaction.Transaction{Nonce: 1, Value: big.NewInt(10), SndAddr: addrA, RcvAddr: addrB},
+		"txHash2": &transaction.Transaction{Nonce: 2, Value: big.NewInt(0), SndAddr: addrB, RcvAddr: contract},
+		"txHash3": &rewardTx.RewardTx{Value: big.NewInt(1), RcvAddr: addrA},
+	}
+	en.NotifyBlockCommitted(header, []byte("hdrHash"), txs)
+
+	event := readEvent(t, blocksSub)
+	require.Equal(t, events.BlockEventType, event.Type)
+	assert.Equal(t, uint64(5), event.Block.Nonce)
+	assert.Equal(t, hex.EncodeToString([]byte("hdrHash")), event.Block.Hash)
+	assert.Equal(t, 3, event.Block.NumTxs)
+	requireNoEvent(t, blocksSub)
+
+	event = readEvent(t, addressSub)
+	require.Equal(t, events.TransactionEventType, event.Type)
+	assert.Equal(t, hex.EncodeToString([]byte("txHash1")), event.Transaction.Hash)
+	assert.Equal(t, "10", event.Transaction.Value)
+	assert.Equal(t, "normal", event.Transaction.Type)
+	event = readEvent(t, addressSub)
+	assert.Equal(t, hex.EncodeToString([]byte("txHash3")), event.Transaction.Hash)
+	assert.Equal(t, "reward", event.Transaction.Type)
+	requireNoEvent(t, addressSub)
+
+	event = readEvent(t, logsSub)
+	require.Equal(t, events.LogEventType, event.Type)
+	assert.Equal(t, "transfer", event.Log.Identifier)
+	assert.Equal(t, hex.EncodeToString(contract), event.Log.Address)
+	assert.Equal(t, hex.EncodeToString([]byte("data")), event.Log.Data)
+	requireNoEvent(t, logsSub)
+}
+
+func TestEventsNotifier_EpochStartActionShouldStreamEpochEvent(t *testing.T) {
+	t.Parallel()
+
+	en, _ := events.NewEventsNotifier(createMockArgsEventsNotifier())
+	defer func() {
+		_ = en.Close()
+	}()
+
+	sub, _ := en.Subscribe(events.Filter{EpochStart: true})
+	en.NotifyBlockCommitted(&block.Header{Nonce: 1}, []byte("hash"), nil)
+	en.EpochStartAction(&block.MetaBlock{Epoch: 3, Nonce: 10})
+
+	event := readEvent(t, sub)
+	require.Equal(t, events.EpochStartEventType, event.Type)
+	assert.Equal(t, uint32(3), event.EpochStart.Epoch)
+	assert.Equal(t, uint64(10), event.EpochStart.Nonce)
+}
+
+func TestEventsNotifier_SlowSubscriberShouldBeDropped(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEventsNotifier()
+	args.SubscriberQueueSize = 1
+	en, _ := events.NewEventsNotifier(args)
+	defer func() {
+		_ = en.Close()
+	}()
+
+	sub, _ := en.Subscribe(events.Filter{Blocks: true})
+	en.NotifyBlockCommitted(&block.Header{Nonce: 1}, []byte("hash1"), nil)
+	en.NotifyBlockCommitted(&block.Header{Nonce: 2}, []byte("hash2"), nil)
+
+	require.Eventually(t, func() bool {
+		return sub.Err() == process.ErrSubscriberTooSlow
+	}, waitTimeout, time.Millisecond*10)
+
+	_, err := en.Subscribe(events.Filter{Blocks: true})
+	assert.Nil(t, err)
+}
+
+func TestEventsNotifier_CloseShouldEndSubscriptions(t *testing.T) {
+	t.Parallel()
+
+	en, _ := events.NewEventsNotifier(createMockArgsEventsNotifier())
+	sub, _ := en.Subscribe(events.Filter{Blocks: true})
+
+	err := en.Close()
+	assert.Nil(t, err)
+
+	_, ok := <-sub.Events()
+	assert.False(t, ok)
+	assert.Equal(t, process.ErrEventsNotifierClosed, sub.Err())
+
+	_, err = en.Subscribe(events.Filter{Blocks: true})
+	assert.Equal(t, process.ErrEventsNotifierClosed, err)
+}
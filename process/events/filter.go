@@ -0,0 +1,144 @@
+package events
+
+import (
+	"encoding/hex"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// Filter selects the events a subscriber receives. Transactions are selected by their sender or receiver address,
+// log events by the contract which emitted them and by their identifier or one of their hex encoded topics
+type Filter struct {
+	Blocks     bool     `json:"blocks"`
+	EpochStart bool     `json:"epochStart"`
+	Addresses  []string `json:"addresses"`
+	Contracts  []string `json:"contracts"`
+	Topics     []string `json:"topics"`
+}
+
+// routedEvent is an event together with the raw values it is matched on
+type routedEvent struct {
+	event      *Event
+	addresses  [][]byte
+	identifier string
+	topics     []string
+}
+
+type compiledFilter struct {
+	blocks     bool
+	epochStart bool
+	addresses  map[string]struct{}
+	contracts  map[string]struct{}
+	topics     map[string]struct{}
+}
+
+func compileFilter(filter Filter, pubkeyConverter core.PubkeyConverter, maxEntries int) (*compiledFilter, error) {
+	numEntries := len(filter.Addresses) + len(filter.Contracts) + len(filter.Topics)
+	if numEntries > maxEntries {
+		return nil, process.ErrTooManyFilterEntries
+	}
+	if numEntries == 0 && !filter.Blocks && !filter.EpochStart {
+		return nil, process.ErrEmptyEventsFilter
+	}
+
+	addresses, err := decodeAddresses(filter.Addresses, pubkeyConverter)
+	if err != nil {
+		return nil, err
+	}
+	contracts, err := decodeAddresses(filter.Contracts, pubkeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	topics := make(map[string]struct{}, len(filter.Topics))
+	for _, topic := range filter.Topics {
+		topics[topic] = struct{}{}
+	}
+
+	return &compiledFilter{
+		blocks:     filter.Blocks,
+		epochStart: filter.EpochStart,
+		addresses:  addresses,
+		contracts:  contracts,
+		topics:     topics,
+	}, nil
+}
+
+func decodeAddresses(addresses []string, pubkeyConverter core.PubkeyConverter) (map[string]struct{}, error) {
+	decoded := make(map[string]struct{}, len(addresses))
+	for _, address := range addresses {
+		buff, err := pubkeyConverter.Decode(address)
+		if err != nil {
+			return nil, err
+		}
+
+		decoded[string(buff)] = struct{}{}
+	}
+
+	return decoded, nil
+}
+
+func (cf *compiledFilter) wantsLogs() bool {
+	return len(cf.contracts) > 0 || len(cf.topics) > 0
+}
+
+func (cf *compiledFilter) matches(re *routedEvent) bool {
+	switch re.event.Type {
+	case BlockEventType:
+		return cf.blocks
+	case EpochStartEventType:
+		return cf.epochStart
+	case TransactionEventType:
+		return containsAny(cf.addresses, re.addresses)
+	case LogEventType:
+		return cf.matchesLog(re)
+	default:
+		return false
+	}
+}
+
+func (cf *compiledFilter) matchesLog(re *routedEvent) bool {
+	if !cf.wantsLogs() {
+		return false
+	}
+	if len(cf.contracts) > 0 && !containsAny(cf.contracts, re.addresses) {
+		return false
+	}
+	if len(cf.topics) == 0 {
+		return true
+	}
+
+	_, ok := cf.topics[re.identifier]
+	if ok {
+		return true
+	}
+	for _, topic := range re.topics {
+		_, ok = cf.topics[topic]
+		if ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsAny(set map[string]struct{}, values [][]byte) bool {
+	for _, value := range values {
+		_, ok := set[string(value)]
+		if ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hexTopics(topics [][]byte) []string {
+	encoded := make([]string, 0, len(topics))
+	for _, topic := range topics {
+		encoded = append(encoded, hex.EncodeToString(topic))
+	}
+
+	return encoded
+}
@@ -0,0 +1,25 @@
+package events
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// Subscription defines a stream of events selected by a filter
+type Subscription interface {
+	// Events returns the channel on which the selected events are delivered. It is closed when the subscription ends
+	Events() <-chan *Event
+	// Err returns the reason the subscription ended, nil while it is active or if it was closed by its owner
+	Err() error
+	Close()
+}
+
+// Notifier defines the component which turns the committed blocks into events and streams them to subscribers
+type Notifier interface {
+	NotifyBlockCommitted(header data.HeaderHandler, headerHash []byte, txs map[string]data.TransactionHandler)
+	EpochStartAction(hdr data.HeaderHandler)
+	EpochStartPrepare(metaHdr data.HeaderHandler, body data.BodyHandler)
+	NotifyOrder() uint32
+	Subscribe(filter Filter) (Subscription, error)
+	Close() error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,78 @@
+package events
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ Subscription = (*subscription)(nil)
+
+type subscription struct {
+	id      uint64
+	filter  *compiledFilter
+	chEvent chan *Event
+	onClose func(id uint64)
+
+	mutState sync.RWMutex
+	closed   bool
+	err      error
+}
+
+func newSubscription(id uint64, filter *compiledFilter, queueSize int, onClose func(id uint64)) *subscription {
+	return &subscription{
+		id:      id,
+		filter:  filter,
+		chEvent: make(chan *Event, queueSize),
+		onClose: onClose,
+	}
+}
+
+// push delivers the event without blocking. A subscriber whose queue is full is dropped with ErrSubscriberTooSlow
+func (s *subscription) push(event *Event) {
+	s.mutState.RLock()
+	if s.closed {
+		s.mutState.RUnlock()
+		return
+	}
+
+	select {
+	case s.chEvent <- event:
+		s.mutState.RUnlock()
+	default:
+		s.mutState.RUnlock()
+		s.closeWithError(process.ErrSubscriberTooSlow)
+	}
+}
+
+// Events returns the channel on which the selected events are delivered
+func (s *subscription) Events() <-chan *Event {
+	return s.chEvent
+}
+
+// Err returns the reason the subscription ended
+func (s *subscription) Err() error {
+	s.mutState.RLock()
+	defer s.mutState.RUnlock()
+
+	return s.err
+}
+
+// Close ends the subscription
+func (s *subscription) Close() {
+	s.closeWithError(nil)
+}
+
+func (s *subscription) closeWithError(err error) {
+	s.mutState.Lock()
+	if s.closed {
+		s.mutState.Unlock()
+		return
+	}
+	s.closed = true
+	s.err = err
+	close(s.chEvent)
+	s.mutState.Unlock()
+
+	s.onClose(s.id)
+}
@@ -169,6 +169,7 @@ func (bicf *baseInterceptorsContainerFactory) createOneTxInterceptor(topic strin
 	argProcessor := &processor.ArgTxInterceptorProcessor{
 		ShardedDataCache: bicf.dataPool.Transactions(),
 		TxValidator:      txValidator,
+		WhiteListHandler: bicf.whiteListHandler,
 	}
 	txProcessor, err := processor.NewTxInterceptorProcessor(argProcessor)
 	if err != nil {
@@ -206,6 +207,7 @@ func (bicf *baseInterceptorsContainerFactory) createOneUnsignedTxInterceptor(top
 	argProcessor := &processor.ArgTxInterceptorProcessor{
 		ShardedDataCache: bicf.dataPool.UnsignedTransactions(),
 		TxValidator:      txValidator,
+		WhiteListHandler: bicf.whiteListHandler,
 	}
 	txProcessor, err := processor.NewTxInterceptorProcessor(argProcessor)
 	if err != nil {
@@ -243,6 +245,7 @@ func (bicf *baseInterceptorsContainerFactory) createOneRewardTxInterceptor(topic
 	argProcessor := &processor.ArgTxInterceptorProcessor{
 		ShardedDataCache: bicf.dataPool.RewardTransactions(),
 		TxValidator:      txValidator,
+		WhiteListHandler: bicf.whiteListHandler,
 	}
 	txProcessor, err := processor.NewTxInterceptorProcessor(argProcessor)
 	if err != nil {
@@ -32,6 +32,7 @@ type vmContainerFactory struct {
 	hasher              hashing.Hasher
 	marshalizer         marshal.Marshalizer
 	systemSCConfig      *config.SystemSmartContractsConfig
+	numOfShards         uint32
 }
 
 // NewVMContainerFactory is responsible for creating a new virtual machine factory object
@@ -85,6 +86,7 @@ func NewVMContainerFactory(
 		marshalizer:         marshalizer,
 		systemSCConfig:      systemSCConfig,
 		validatorAccountsDB: validatorAccountsDB,
+		numOfShards:         argBlockChainHook.ShardCoordinator.NumberOfShards(),
 	}, nil
 }
 
@@ -127,6 +129,7 @@ func (vmf *vmContainerFactory) createSystemVM() (vmcommon.VMExecutionHandler, er
 		Hasher:              vmf.hasher,
 		Marshalizer:         vmf.marshalizer,
 		SystemSCConfig:      vmf.systemSCConfig,
+		NumOfShards:         vmf.numOfShards,
 	}
 	scFactory, err := systemVMFactory.NewSystemSCFactory(argsNewSystemScFactory)
 	if err != nil {
@@ -20,4 +20,5 @@ type InterceptedTransactionHandler interface {
 // ShardedPool is a perspective of the sharded data pool
 type ShardedPool interface {
 	AddData(key []byte, data interface{}, sizeInBytes int, cacheID string)
+	AddRequestedData(key []byte, data interface{}, sizeInBytes int, cacheID string)
 }
@@ -11,8 +11,9 @@ var _ process.InterceptorProcessor = (*TxInterceptorProcessor)(nil)
 // TxInterceptorProcessor is the processor used when intercepting transactions
 // (smart contract results, receipts, transaction) structs which satisfy TransactionHandler interface.
 type TxInterceptorProcessor struct {
-	shardedPool ShardedPool
-	txValidator process.TxValidator
+	shardedPool      ShardedPool
+	txValidator      process.TxValidator
+	whiteListHandler process.WhiteListHandler
 }
 
 // NewTxInterceptorProcessor creates a new TxInterceptorProcessor instance
@@ -26,10 +27,14 @@ func NewTxInterceptorProcessor(argument *ArgTxInterceptorProcessor) (*TxIntercep
 	if check.IfNil(argument.TxValidator) {
 		return nil, process.ErrNilTxValidator
 	}
+	if check.IfNil(argument.WhiteListHandler) {
+		return nil, process.ErrNilWhiteListHandler
+	}
 
 	return &TxInterceptorProcessor{
-		shardedPool: argument.ShardedDataCache,
-		txValidator: argument.TxValidator,
+		shardedPool:      argument.ShardedDataCache,
+		txValidator:      argument.TxValidator,
+		whiteListHandler: argument.WhiteListHandler,
 	}, nil
 }
 
@@ -44,6 +49,8 @@ func (txip *TxInterceptorProcessor) Validate(data process.InterceptedData, _ cor
 }
 
 // Save will save the received data into the cacher
+// A transaction having the same sender and nonce as one in the pool only replaces it if it pays sufficiently more,
+// unless it was requested by the block processing, case in which both are kept
 func (txip *TxInterceptorProcessor) Save(data process.InterceptedData, _ core.PeerID) error {
 	interceptedTx, ok := data.(InterceptedTransactionHandler)
 	if !ok {
@@ -51,7 +58,12 @@ func (txip *TxInterceptorProcessor) Save(data process.InterceptedData, _ core.Pe
 	}
 
 	cacherIdentifier := process.ShardCacherIdentifier(interceptedTx.SenderShardId(), interceptedTx.ReceiverShardId())
-	txip.shardedPool.AddData(
+	addData := txip.shardedPool.AddData
+	if txip.whiteListHandler.IsWhiteListed(data) {
+		addData = txip.shardedPool.AddRequestedData
+	}
+
+	addData(
 		data.Hash(),
 		interceptedTx.Transaction(),
 		interceptedTx.Transaction().Size(),
@@ -18,6 +18,7 @@ func createMockTxArgument() *processor.ArgTxInterceptorProcessor {
 	return &processor.ArgTxInterceptorProcessor{
 		ShardedDataCache: &mock.ShardedDataStub{},
 		TxValidator:      &mock.TxValidatorStub{},
+		WhiteListHandler: &mock.WhiteListHandlerStub{},
 	}
 }
 
@@ -52,6 +53,17 @@ func TestNewTxInterceptorProcessor_NilTxValidatorShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilTxValidator, err)
 }
 
+func TestNewTxInterceptorProcessor_NilWhiteListHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockTxArgument()
+	arg.WhiteListHandler = nil
+	txip, err := processor.NewTxInterceptorProcessor(arg)
+
+	assert.Nil(t, txip)
+	assert.Equal(t, process.ErrNilWhiteListHandler, err)
+}
+
 func TestNewTxInterceptorProcessor_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -166,6 +178,55 @@ func TestTxInterceptorProcessor_SaveShouldWork(t *testing.T) {
 	assert.True(t, addedWasCalled)
 }
 
+func TestTxInterceptorProcessor_SaveWhiteListedShouldAddRequestedData(t *testing.T) {
+	t.Parallel()
+
+	addedWasCalled := false
+	addedRequestedWasCalled := false
+	txInterceptedData := &struct {
+		mock.InterceptedDataStub
+		mock.InterceptedTxHandlerStub
+	}{
+		InterceptedDataStub: mock.InterceptedDataStub{
+			HashCalled: func() []byte {
+				return make([]byte, 0)
+			},
+		},
+		InterceptedTxHandlerStub: mock.InterceptedTxHandlerStub{
+			SenderShardIdCalled: func() uint32 {
+				return 0
+			},
+			ReceiverShardIdCalled: func() uint32 {
+				return 0
+			},
+			TransactionCalled: func() data.TransactionHandler {
+				return &transaction.Transaction{}
+			},
+		},
+	}
+	arg := createMockTxArgument()
+	shardedDataCache := arg.ShardedDataCache.(*mock.ShardedDataStub)
+	shardedDataCache.AddDataCalled = func(key []byte, data interface{}, sizeInBytes int, cacheId string) {
+		addedWasCalled = true
+	}
+	shardedDataCache.AddRequestedDataCalled = func(key []byte, data interface{}, sizeInBytes int, cacheId string) {
+		addedRequestedWasCalled = true
+	}
+	arg.WhiteListHandler = &mock.WhiteListHandlerStub{
+		IsWhiteListedCalled: func(interceptedData process.InterceptedData) bool {
+			return true
+		},
+	}
+
+	txip, _ := processor.NewTxInterceptorProcessor(arg)
+
+	err := txip.Save(txInterceptedData, "")
+
+	assert.Nil(t, err)
+	assert.False(t, addedWasCalled)
+	assert.True(t, addedRequestedWasCalled)
+}
+
 //------- IsInterfaceNil
 
 func TestTxInterceptorProcessor_IsInterfaceNil(t *testing.T) {
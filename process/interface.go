@@ -249,6 +249,22 @@ type TransactionLogProcessorDatabase interface {
 	IsInterfaceNil() bool
 }
 
+// AccountHistoryHandler defines the actions needed for indexing and querying the transactions which touched an account
+type AccountHistoryHandler interface {
+	SaveTransactions(header data.HeaderHandler, headerHash []byte, txs map[string]data.TransactionHandler) error
+	RevertBlock(header data.HeaderHandler, headerHash []byte) error
+	GetTransactions(address []byte, from uint32, size uint32) ([]*transaction.AccountHistoryEntry, bool, error)
+	IsEnabled() bool
+	IsInterfaceNil() bool
+}
+
+// BlockEventsNotifier defines the component which is told about every committed block, so that it can stream the
+// block, its transactions and their logs to the subscribers
+type BlockEventsNotifier interface {
+	NotifyBlockCommitted(header data.HeaderHandler, headerHash []byte, txs map[string]data.TransactionHandler)
+	IsInterfaceNil() bool
+}
+
 // ValidatorsProvider is the main interface for validators' provider
 type ValidatorsProvider interface {
 	GetLatestValidators() map[string]*state.ValidatorApiResponse
@@ -429,6 +445,8 @@ type BlockChainHookHandler interface {
 	SetCurrentHeader(hdr data.HeaderHandler)
 	GetBuiltInFunctions() BuiltInFunctionContainer
 	NewAddress(creatorAddress []byte, creatorNonce uint64, vmType []byte) ([]byte, error)
+	GetAccountsAdapter() state.AccountsAdapter
+	SetAccountsAdapter(accounts state.AccountsAdapter) error
 }
 
 // Interceptor defines what a data interceptor should do
@@ -562,6 +580,8 @@ type EconomicsAddressesHandler interface {
 // SmartContractToProtocolHandler is able to translate data from smart contract state into protocol changes
 type SmartContractToProtocolHandler interface {
 	UpdateProtocol(body *block.Body, nonce uint64) error
+	UpdateProtocolForKeyRotations(epoch uint32) error
+	UpdateProtocolForKeys(keys [][]byte, nonce uint64) error
 	IsInterfaceNil() bool
 }
 
@@ -572,6 +592,14 @@ type PeerChangesHandler interface {
 	IsInterfaceNil() bool
 }
 
+// EquivocationSlasher creates the slashings of a metachain block from the pooled equivocation proofs and applies the
+// slashings of a processed metachain block
+type EquivocationSlasher interface {
+	CreateSlashings(haveTime func() bool) ([]block.Slashing, error)
+	ProcessSlashings(slashings []block.Slashing) error
+	IsInterfaceNil() bool
+}
+
 // BlackListHandler can determine if a certain key is or not blacklisted
 type BlackListHandler interface {
 	Add(key string) error
@@ -597,11 +625,30 @@ type NetworkConnectionWatcher interface {
 	IsInterfaceNil() bool
 }
 
-// SCQuery represents a prepared query for executing a function of the smart contract
+// SCQuery represents a prepared query for executing a function of the smart contract. If RootHash is set, the
+// query is executed against the state with that root hash instead of the current one
 type SCQuery struct {
 	ScAddress []byte
 	FuncName  string
+	// CallerAddr is the caller seen by the smart contract, the smart contract itself when not set
+	CallerAddr []byte
+	// CallValue is the value seen by the smart contract as transferred by the caller, zero when not set
+	CallValue *big.Int
 	Arguments [][]byte
+	RootHash  []byte
+	// GasLimit is the gas provided to the VM. The query service uses the maximum gas limit per block when it is not set
+	// or exceeds it
+	GasLimit uint64
+	// KeepFailedOutput makes the query service return the VM output of a call ended with a return code other than ok,
+	// instead of an error
+	KeepFailedOutput bool
+}
+
+// AccountsAdapterHolder holds the accounts adapter through which the VMs access the state and allows replacing it
+type AccountsAdapterHolder interface {
+	GetAccountsAdapter() state.AccountsAdapter
+	SetAccountsAdapter(accounts state.AccountsAdapter) error
+	IsInterfaceNil() bool
 }
 
 // GasHandler is able to perform some gas calculation
@@ -780,6 +827,12 @@ type BuiltInFunctionContainer interface {
 	IsInterfaceNil() bool
 }
 
+// ESDTPauseHandler provides the paused state of the esdt tokens
+type ESDTPauseHandler interface {
+	IsPaused(token []byte) bool
+	IsInterfaceNil() bool
+}
+
 // RoundTimeDurationHandler defines the methods to get the time duration of a round
 type RoundTimeDurationHandler interface {
 	TimeDuration() time.Duration
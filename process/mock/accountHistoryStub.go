@@ -0,0 +1,55 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+// AccountHistoryStub -
+type AccountHistoryStub struct {
+	SaveTransactionsCalled func(header data.HeaderHandler, headerHash []byte, txs map[string]data.TransactionHandler) error
+	RevertBlockCalled      func(header data.HeaderHandler, headerHash []byte) error
+	GetTransactionsCalled  func(address []byte, from uint32, size uint32) ([]*transaction.AccountHistoryEntry, bool, error)
+	IsEnabledCalled        func() bool
+}
+
+// SaveTransactions -
+func (ahs *AccountHistoryStub) SaveTransactions(header data.HeaderHandler, headerHash []byte, txs map[string]data.TransactionHandler) error {
+	if ahs.SaveTransactionsCalled != nil {
+		return ahs.SaveTransactionsCalled(header, headerHash, txs)
+	}
+
+	return nil
+}
+
+// RevertBlock -
+func (ahs *AccountHistoryStub) RevertBlock(header data.HeaderHandler, headerHash []byte) error {
+	if ahs.RevertBlockCalled != nil {
+		return ahs.RevertBlockCalled(header, headerHash)
+	}
+
+	return nil
+}
+
+// GetTransactions -
+func (ahs *AccountHistoryStub) GetTransactions(address []byte, from uint32, size uint32) ([]*transaction.AccountHistoryEntry, bool, error) {
+	if ahs.GetTransactionsCalled != nil {
+		return ahs.GetTransactionsCalled(address, from, size)
+	}
+
+	return nil, false, nil
+}
+
+// IsEnabled -
+func (ahs *AccountHistoryStub) IsEnabled() bool {
+	if ahs.IsEnabledCalled != nil {
+		return ahs.IsEnabledCalled()
+	}
+
+	return false
+}
+
+// IsInterfaceNil -
+func (ahs *AccountHistoryStub) IsInterfaceNil() bool {
+	return ahs == nil
+}
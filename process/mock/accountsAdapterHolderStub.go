@@ -0,0 +1,34 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+// AccountsAdapterHolderStub -
+type AccountsAdapterHolderStub struct {
+	GetAccountsAdapterCalled func() state.AccountsAdapter
+	SetAccountsAdapterCalled func(accounts state.AccountsAdapter) error
+}
+
+// GetAccountsAdapter -
+func (aahs *AccountsAdapterHolderStub) GetAccountsAdapter() state.AccountsAdapter {
+	if aahs.GetAccountsAdapterCalled != nil {
+		return aahs.GetAccountsAdapterCalled()
+	}
+
+	return nil
+}
+
+// SetAccountsAdapter -
+func (aahs *AccountsAdapterHolderStub) SetAccountsAdapter(accounts state.AccountsAdapter) error {
+	if aahs.SetAccountsAdapterCalled != nil {
+		return aahs.SetAccountsAdapterCalled(accounts)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (aahs *AccountsAdapterHolderStub) IsInterfaceNil() bool {
+	return aahs == nil
+}
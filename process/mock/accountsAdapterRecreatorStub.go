@@ -0,0 +1,24 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+// AccountsAdapterRecreatorStub -
+type AccountsAdapterRecreatorStub struct {
+	RecreateAccountsAdapterCalled func(rootHash []byte) (state.AccountsAdapter, error)
+}
+
+// RecreateAccountsAdapter -
+func (aars *AccountsAdapterRecreatorStub) RecreateAccountsAdapter(rootHash []byte) (state.AccountsAdapter, error) {
+	if aars.RecreateAccountsAdapterCalled != nil {
+		return aars.RecreateAccountsAdapterCalled(rootHash)
+	}
+
+	return nil, state.ErrStateNotAvailable
+}
+
+// IsInterfaceNil -
+func (aars *AccountsAdapterRecreatorStub) IsInterfaceNil() bool {
+	return aars == nil
+}
@@ -10,15 +10,22 @@ import (
 
 // BlockChainHookHandlerMock -
 type BlockChainHookHandlerMock struct {
-	AddTempAccountCalled    func(address []byte, balance *big.Int, nonce uint64)
-	CleanTempAccountsCalled func()
-	TempAccountCalled       func(address []byte) state.AccountHandler
-	SetCurrentHeaderCalled  func(hdr data.HeaderHandler)
-	NewAddressCalled        func(creatorAddress []byte, creatorNonce uint64, vmType []byte) ([]byte, error)
+	AddTempAccountCalled      func(address []byte, balance *big.Int, nonce uint64)
+	CleanTempAccountsCalled   func()
+	TempAccountCalled         func(address []byte) state.AccountHandler
+	SetCurrentHeaderCalled    func(hdr data.HeaderHandler)
+	NewAddressCalled          func(creatorAddress []byte, creatorNonce uint64, vmType []byte) ([]byte, error)
+	GetAccountsAdapterCalled  func() state.AccountsAdapter
+	SetAccountsAdapterCalled  func(accounts state.AccountsAdapter) error
+	GetBuiltInFunctionsCalled func() process.BuiltInFunctionContainer
 }
 
 // GetBuiltInFunctions -
 func (e *BlockChainHookHandlerMock) GetBuiltInFunctions() process.BuiltInFunctionContainer {
+	if e.GetBuiltInFunctionsCalled != nil {
+		return e.GetBuiltInFunctionsCalled()
+	}
+
 	return nil
 }
 
@@ -64,3 +71,21 @@ func (e *BlockChainHookHandlerMock) NewAddress(creatorAddress []byte, creatorNon
 
 	return make([]byte, 0), nil
 }
+
+// GetAccountsAdapter -
+func (e *BlockChainHookHandlerMock) GetAccountsAdapter() state.AccountsAdapter {
+	if e.GetAccountsAdapterCalled != nil {
+		return e.GetAccountsAdapterCalled()
+	}
+
+	return nil
+}
+
+// SetAccountsAdapter -
+func (e *BlockChainHookHandlerMock) SetAccountsAdapter(accounts state.AccountsAdapter) error {
+	if e.SetAccountsAdapterCalled != nil {
+		return e.SetAccountsAdapterCalled(accounts)
+	}
+
+	return nil
+}
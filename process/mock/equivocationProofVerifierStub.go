@@ -0,0 +1,19 @@
+package mock
+
+// EquivocationProofVerifierStub -
+type EquivocationProofVerifierStub struct {
+	VerifyProofCalled func(proof []byte) ([]byte, uint64, error)
+}
+
+// VerifyProof -
+func (e *EquivocationProofVerifierStub) VerifyProof(proof []byte) ([]byte, uint64, error) {
+	if e.VerifyProofCalled != nil {
+		return e.VerifyProofCalled(proof)
+	}
+	return nil, 0, nil
+}
+
+// IsInterfaceNil -
+func (e *EquivocationProofVerifierStub) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,20 @@
+package mock
+
+// ESDTPauseHandlerStub -
+type ESDTPauseHandlerStub struct {
+	IsPausedCalled func(token []byte) bool
+}
+
+// IsPaused -
+func (e *ESDTPauseHandlerStub) IsPaused(token []byte) bool {
+	if e.IsPausedCalled != nil {
+		return e.IsPausedCalled(token)
+	}
+
+	return false
+}
+
+// IsInterfaceNil -
+func (e *ESDTPauseHandlerStub) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,244 @@
+package scToProtocol
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/factory"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var _ process.EquivocationSlasher = (*equivocationSlasher)(nil)
+
+const slashEquivocationFunction = "slashEquivocation"
+
+// ArgsEquivocationSlasher holds the arguments needed to create an equivocation slasher
+type ArgsEquivocationSlasher struct {
+	ProofsPool             storage.Cacher
+	ProofVerifier          vm.EquivocationProofVerifier
+	SystemVM               vmcommon.VMExecutionHandler
+	Accounts               state.AccountsAdapter
+	MaxSlashingsInOneBlock uint32
+}
+
+// equivocationSlasher slashes the keys proven to have signed two different blocks in the same round. Each slashing is
+// carried in the Slashings of a metachain block along with its proof
+type equivocationSlasher struct {
+	proofsPool             storage.Cacher
+	proofVerifier          vm.EquivocationProofVerifier
+	systemVM               vmcommon.VMExecutionHandler
+	accounts               state.AccountsAdapter
+	maxSlashingsInOneBlock int
+}
+
+// NewEquivocationSlasher creates the component which moves the pooled equivocation proofs into the metachain blocks
+// and executes them on the staking smart contract
+func NewEquivocationSlasher(args ArgsEquivocationSlasher) (*equivocationSlasher, error) {
+	if check.IfNil(args.ProofsPool) {
+		return nil, process.ErrNilCacher
+	}
+	if check.IfNil(args.ProofVerifier) {
+		return nil, process.ErrNilEquivocationProofVerifier
+	}
+	if args.SystemVM == nil {
+		return nil, process.ErrNilSystemVM
+	}
+	if check.IfNil(args.Accounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if args.MaxSlashingsInOneBlock == 0 {
+		return nil, fmt.Errorf("%w for MaxSlashingsInOneBlock", process.ErrInvalidValue)
+	}
+
+	return &equivocationSlasher{
+		proofsPool:             args.ProofsPool,
+		proofVerifier:          args.ProofVerifier,
+		systemVM:               args.SystemVM,
+		accounts:               args.Accounts,
+		maxSlashingsInOneBlock: int(args.MaxSlashingsInOneBlock),
+	}, nil
+}
+
+// CreateSlashings executes the pooled equivocation proofs on the staking smart contract and returns the slashings of
+// the accepted ones. The proofs rejected by or failing on the staking smart contract are removed from the pool, while
+// the accepted ones are kept until the slashing is committed and the contract starts rejecting them as already slashed
+func (es *equivocationSlasher) CreateSlashings(haveTime func() bool) ([]block.Slashing, error) {
+	slashings := make([]block.Slashing, 0)
+
+	for _, key := range es.proofsPool.Keys() {
+		if len(slashings) >= es.maxSlashingsInOneBlock || !haveTime() {
+			break
+		}
+
+		proof, ok := es.getProofFromPool(key)
+		if !ok {
+			continue
+		}
+
+		pubKey, round, err := es.proofVerifier.VerifyProof(proof)
+		if err != nil {
+			log.Debug("equivocationSlasher.CreateSlashings: invalid proof", "error", err.Error())
+			es.proofsPool.Remove(key)
+			continue
+		}
+
+		vmOutput, err := es.executeSlashing(proof)
+		if err != nil {
+			log.Debug("equivocationSlasher.CreateSlashings: slashing failed",
+				"pk", pubKey,
+				"round", round,
+				"error", err.Error(),
+			)
+			es.proofsPool.Remove(key)
+			continue
+		}
+		if vmOutput.ReturnCode != vmcommon.Ok {
+			log.Debug("equivocationSlasher.CreateSlashings: slashing rejected",
+				"pk", pubKey,
+				"round", round,
+				"return code", vmOutput.ReturnCode.String(),
+				"return message", vmOutput.ReturnMessage,
+			)
+			es.proofsPool.Remove(key)
+			continue
+		}
+
+		err = es.applyVMOutput(vmOutput)
+		if err != nil {
+			return nil, err
+		}
+
+		slashings = append(slashings, block.Slashing{
+			PublicKey: pubKey,
+			Round:     round,
+			Proof:     proof,
+		})
+	}
+
+	return slashings, nil
+}
+
+// ProcessSlashings verifies the slashings of a metachain block and executes them on the staking smart contract. It
+// errors if any of them is not a valid slashing or if the staking smart contract rejects it
+func (es *equivocationSlasher) ProcessSlashings(slashings []block.Slashing) error {
+	if len(slashings) > es.maxSlashingsInOneBlock {
+		return fmt.Errorf("%w: %d slashings in block, maximum %d", process.ErrInvalidSlashing, len(slashings), es.maxSlashingsInOneBlock)
+	}
+
+	for i := range slashings {
+		err := es.processSlashing(&slashings[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (es *equivocationSlasher) processSlashing(slashing *block.Slashing) error {
+	pubKey, round, err := es.proofVerifier.VerifyProof(slashing.Proof)
+	if err != nil {
+		return fmt.Errorf("%w: %s", process.ErrInvalidSlashing, err.Error())
+	}
+	if !bytes.Equal(pubKey, slashing.PublicKey) || round != slashing.Round {
+		return fmt.Errorf("%w: the proof is for another key or round", process.ErrInvalidSlashing)
+	}
+
+	vmOutput, err := es.executeSlashing(slashing.Proof)
+	if err != nil {
+		return err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return fmt.Errorf("%w: %s", process.ErrInvalidSlashing, vmOutput.ReturnMessage)
+	}
+
+	return es.applyVMOutput(vmOutput)
+}
+
+func (es *equivocationSlasher) getProofFromPool(key []byte) ([]byte, bool) {
+	value, ok := es.proofsPool.Peek(key)
+	if !ok {
+		return nil, false
+	}
+
+	proof, ok := value.([]byte)
+	if !ok {
+		es.proofsPool.Remove(key)
+		return nil, false
+	}
+
+	return proof, true
+}
+
+func (es *equivocationSlasher) executeSlashing(proof []byte) (*vmcommon.VMOutput, error) {
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  factory.StakingSCAddress,
+			Arguments:   [][]byte{proof},
+			CallValue:   big.NewInt(0),
+			GasProvided: math.MaxUint64,
+		},
+		RecipientAddr: factory.StakingSCAddress,
+		Function:      slashEquivocationFunction,
+	}
+
+	vmOutput, err := es.systemVM.RunSmartContractCall(vmInput)
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput == nil {
+		return nil, process.ErrNilVMOutput
+	}
+
+	return vmOutput, nil
+}
+
+// applyVMOutput saves the storage updates done by the slashing. A slashing only changes the staking data, so an
+// output moving funds is an error
+func (es *equivocationSlasher) applyVMOutput(vmOutput *vmcommon.VMOutput) error {
+	for _, outAcc := range vmOutput.OutputAccounts {
+		if outAcc.BalanceDelta != nil && outAcc.BalanceDelta.Sign() != 0 {
+			return fmt.Errorf("%w: the slashing changes a balance", process.ErrInvalidSlashing)
+		}
+	}
+
+	for _, outAcc := range vmOutput.OutputAccounts {
+		if len(outAcc.StorageUpdates) == 0 {
+			continue
+		}
+
+		account, err := es.accounts.LoadAccount(outAcc.Address)
+		if err != nil {
+			return err
+		}
+
+		userAccount, ok := account.(state.UserAccountHandler)
+		if !ok {
+			return process.ErrWrongTypeAssertion
+		}
+
+		for _, storageUpdate := range outAcc.StorageUpdates {
+			userAccount.DataTrieTracker().SaveKeyValue(storageUpdate.Offset, storageUpdate.Data)
+		}
+
+		err = es.accounts.SaveAccount(userAccount)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (es *equivocationSlasher) IsInterfaceNil() bool {
+	return es == nil
+}
@@ -0,0 +1,356 @@
+package scToProtocol
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/vm/factory"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testProof   = []byte("proof")
+	testBlsKey  = []byte("bls key")
+	testRound   = uint64(37)
+	testSlotKey = []byte("staking key")
+)
+
+func createMockArgumentsEquivocationSlasher() ArgsEquivocationSlasher {
+	return ArgsEquivocationSlasher{
+		ProofsPool: mock.NewCacherMock(),
+		ProofVerifier: &mock.EquivocationProofVerifierStub{
+			VerifyProofCalled: func(proof []byte) ([]byte, uint64, error) {
+				return testBlsKey, testRound, nil
+			},
+		},
+		SystemVM: &mock.VMExecutionHandlerStub{
+			RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				return createSlashingVMOutput(), nil
+			},
+		},
+		Accounts:               &mock.AccountsStub{},
+		MaxSlashingsInOneBlock: 10,
+	}
+}
+
+func createSlashingVMOutput() *vmcommon.VMOutput {
+	return &vmcommon.VMOutput{
+		ReturnCode: vmcommon.Ok,
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			string(factory.StakingSCAddress): {
+				Address:      factory.StakingSCAddress,
+				BalanceDelta: big.NewInt(0),
+				StorageUpdates: map[string]*vmcommon.StorageUpdate{
+					string(testSlotKey): {Offset: testSlotKey, Data: []byte("slashed")},
+				},
+			},
+		},
+	}
+}
+
+func createSavingAccountsStub(savedAccounts map[string]state.UserAccountHandler) *mock.AccountsStub {
+	return &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			return state.NewUserAccount(address)
+		},
+		SaveAccountCalled: func(account state.AccountHandler) error {
+			savedAccounts[string(account.AddressBytes())] = account.(state.UserAccountHandler)
+			return nil
+		},
+	}
+}
+
+func haveTimeTrue() bool {
+	return true
+}
+
+func TestNewEquivocationSlasher_NilProofsPoolShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsEquivocationSlasher()
+	args.ProofsPool = nil
+
+	es, err := NewEquivocationSlasher(args)
+	assert.Nil(t, es)
+	assert.Equal(t, process.ErrNilCacher, err)
+}
+
+func TestNewEquivocationSlasher_NilProofVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsEquivocationSlasher()
+	args.ProofVerifier = nil
+
+	es, err := NewEquivocationSlasher(args)
+	assert.Nil(t, es)
+	assert.Equal(t, process.ErrNilEquivocationProofVerifier, err)
+}
+
+func TestNewEquivocationSlasher_NilSystemVMShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsEquivocationSlasher()
+	args.SystemVM = nil
+
+	es, err := NewEquivocationSlasher(args)
+	assert.Nil(t, es)
+	assert.Equal(t, process.ErrNilSystemVM, err)
+}
+
+func TestNewEquivocationSlasher_NilAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsEquivocationSlasher()
+	args.Accounts = nil
+
+	es, err := NewEquivocationSlasher(args)
+	assert.Nil(t, es)
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+}
+
+func TestNewEquivocationSlasher_ZeroMaxSlashingsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsEquivocationSlasher()
+	args.MaxSlashingsInOneBlock = 0
+
+	es, err := NewEquivocationSlasher(args)
+	assert.Nil(t, es)
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+}
+
+func TestNewEquivocationSlasher_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	es, err := NewEquivocationSlasher(createMockArgumentsEquivocationSlasher())
+	assert.Nil(t, err)
+	assert.False(t, es.IsInterfaceNil())
+}
+
+func TestEquivocationSlasher_CreateSlashingsShouldIncludeThePooledProofs(t *testing.T) {
+	t.Parallel()
+
+	savedAccounts := make(map[string]state.UserAccountHandler)
+	args := createMockArgumentsEquivocationSlasher()
+	args.Accounts = createSavingAccountsStub(savedAccounts)
+	var vmInput *vmcommon.ContractCallInput
+	args.SystemVM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			vmInput = input
+			return createSlashingVMOutput(), nil
+		},
+	}
+	args.ProofsPool.Put([]byte("key"), testProof, len(testProof))
+	es, _ := NewEquivocationSlasher(args)
+
+	slashings, err := es.CreateSlashings(haveTimeTrue)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(slashings))
+	assert.Equal(t, testBlsKey, slashings[0].PublicKey)
+	assert.Equal(t, testRound, slashings[0].Round)
+	assert.Equal(t, testProof, slashings[0].Proof)
+
+	assert.Equal(t, factory.StakingSCAddress, vmInput.RecipientAddr)
+	assert.Equal(t, slashEquivocationFunction, vmInput.Function)
+	assert.Equal(t, [][]byte{testProof}, vmInput.Arguments)
+
+	stakingAccount := savedAccounts[string(factory.StakingSCAddress)]
+	require.NotNil(t, stakingAccount)
+	value, _ := stakingAccount.DataTrieTracker().RetrieveValue(testSlotKey)
+	assert.Equal(t, []byte("slashed"), value)
+
+	_, stillPooled := args.ProofsPool.Peek([]byte("key"))
+	assert.True(t, stillPooled)
+}
+
+func TestEquivocationSlasher_CreateSlashingsShouldRemoveTheRejectedProofs(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsEquivocationSlasher()
+	args.Accounts = &mock.AccountsStub{
+		SaveAccountCalled: func(account state.AccountHandler) error {
+			assert.Fail(t, "should have not saved the state of a rejected slashing")
+			return nil
+		},
+	}
+	args.SystemVM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			vmOutput := createSlashingVMOutput()
+			vmOutput.ReturnCode = vmcommon.UserError
+			return vmOutput, nil
+		},
+	}
+	args.ProofsPool.Put([]byte("rejected"), testProof, len(testProof))
+	args.ProofsPool.Put([]byte("not a proof"), "not a proof", 0)
+	es, _ := NewEquivocationSlasher(args)
+
+	slashings, err := es.CreateSlashings(haveTimeTrue)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(slashings))
+	assert.Equal(t, 0, args.ProofsPool.Len())
+}
+
+func TestEquivocationSlasher_CreateSlashingsShouldSkipTheFailingProofs(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsEquivocationSlasher()
+	args.Accounts = createSavingAccountsStub(make(map[string]state.UserAccountHandler))
+	args.ProofVerifier = &mock.EquivocationProofVerifierStub{
+		VerifyProofCalled: func(proof []byte) ([]byte, uint64, error) {
+			return proof, testRound, nil
+		},
+	}
+	args.SystemVM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			if string(input.Arguments[0]) == "failing" {
+				return nil, errors.New("execution failed")
+			}
+			return createSlashingVMOutput(), nil
+		},
+	}
+	args.ProofsPool.Put([]byte("failing"), []byte("failing"), len("failing"))
+	args.ProofsPool.Put([]byte("key"), testProof, len(testProof))
+	es, _ := NewEquivocationSlasher(args)
+
+	slashings, err := es.CreateSlashings(haveTimeTrue)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(slashings))
+	assert.Equal(t, testProof, slashings[0].Proof)
+
+	_, failingStillPooled := args.ProofsPool.Peek([]byte("failing"))
+	assert.False(t, failingStillPooled)
+	_, stillPooled := args.ProofsPool.Peek([]byte("key"))
+	assert.True(t, stillPooled)
+}
+
+func TestEquivocationSlasher_CreateSlashingsShouldRemoveTheInvalidProofs(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsEquivocationSlasher()
+	args.ProofVerifier = &mock.EquivocationProofVerifierStub{
+		VerifyProofCalled: func(proof []byte) ([]byte, uint64, error) {
+			return nil, 0, errors.New("invalid proof")
+		},
+	}
+	args.SystemVM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			assert.Fail(t, "should have not executed an invalid proof")
+			return nil, nil
+		},
+	}
+	args.ProofsPool.Put([]byte("key"), testProof, len(testProof))
+	es, _ := NewEquivocationSlasher(args)
+
+	slashings, err := es.CreateSlashings(haveTimeTrue)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(slashings))
+	assert.Equal(t, 0, args.ProofsPool.Len())
+}
+
+func TestEquivocationSlasher_CreateSlashingsShouldStopAtTheMaximumNumberOfSlashings(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsEquivocationSlasher()
+	args.Accounts = createSavingAccountsStub(make(map[string]state.UserAccountHandler))
+	args.MaxSlashingsInOneBlock = 2
+	for _, key := range []string{"key1", "key2", "key3"} {
+		args.ProofsPool.Put([]byte(key), testProof, len(testProof))
+	}
+	es, _ := NewEquivocationSlasher(args)
+
+	slashings, err := es.CreateSlashings(haveTimeTrue)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(slashings))
+}
+
+func TestEquivocationSlasher_CreateSlashingsShouldErrIfTheSlashingMovesFunds(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsEquivocationSlasher()
+	args.SystemVM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			vmOutput := createSlashingVMOutput()
+			vmOutput.OutputAccounts[string(factory.StakingSCAddress)].BalanceDelta = big.NewInt(10)
+			return vmOutput, nil
+		},
+	}
+	args.ProofsPool.Put([]byte("key"), testProof, len(testProof))
+	es, _ := NewEquivocationSlasher(args)
+
+	slashings, err := es.CreateSlashings(haveTimeTrue)
+	assert.Nil(t, slashings)
+	assert.True(t, errors.Is(err, process.ErrInvalidSlashing))
+}
+
+func TestEquivocationSlasher_ProcessSlashingsShouldApplyTheSlashings(t *testing.T) {
+	t.Parallel()
+
+	savedAccounts := make(map[string]state.UserAccountHandler)
+	args := createMockArgumentsEquivocationSlasher()
+	args.Accounts = createSavingAccountsStub(savedAccounts)
+	es, _ := NewEquivocationSlasher(args)
+
+	slashings := []block.Slashing{
+		{PublicKey: testBlsKey, Round: testRound, Proof: testProof},
+	}
+	err := es.ProcessSlashings(slashings)
+	require.Nil(t, err)
+
+	value, _ := savedAccounts[string(factory.StakingSCAddress)].DataTrieTracker().RetrieveValue(testSlotKey)
+	assert.Equal(t, []byte("slashed"), value)
+}
+
+func TestEquivocationSlasher_ProcessSlashingsInvalidSlashingsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsEquivocationSlasher()
+	args.Accounts = createSavingAccountsStub(make(map[string]state.UserAccountHandler))
+	args.MaxSlashingsInOneBlock = 1
+	args.ProofVerifier = &mock.EquivocationProofVerifierStub{
+		VerifyProofCalled: func(proof []byte) ([]byte, uint64, error) {
+			if string(proof) == "invalid" {
+				return nil, 0, errors.New("invalid proof")
+			}
+			return testBlsKey, testRound, nil
+		},
+	}
+	args.SystemVM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			vmOutput := createSlashingVMOutput()
+			if string(input.Arguments[0]) == "rejected" {
+				vmOutput.ReturnCode = vmcommon.UserError
+			}
+			return vmOutput, nil
+		},
+	}
+	es, _ := NewEquivocationSlasher(args)
+
+	valid := block.Slashing{PublicKey: testBlsKey, Round: testRound, Proof: testProof}
+
+	wrongKey := valid
+	wrongKey.PublicKey = []byte("another key")
+	wrongRound := valid
+	wrongRound.Round = testRound + 1
+	invalidProof := valid
+	invalidProof.Proof = []byte("invalid")
+	rejected := valid
+	rejected.Proof = []byte("rejected")
+
+	for _, slashings := range [][]block.Slashing{
+		{wrongKey},
+		{wrongRound},
+		{invalidProof},
+		{rejected},
+		{valid, valid},
+	} {
+		err := es.ProcessSlashings(slashings)
+		assert.True(t, errors.Is(err, process.ErrInvalidSlashing))
+	}
+}
@@ -3,6 +3,7 @@ package scToProtocol
 import (
 	"bytes"
 	"math"
+	"strings"
 
 	"github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -17,7 +18,6 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/vm/factory"
 	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
-	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
 var _ process.SmartContractToProtocolHandler = (*stakingToPeer)(nil)
@@ -138,50 +138,217 @@ func (stp *stakingToPeer) UpdateProtocol(body *block.Body, nonce uint64) error {
 		return err
 	}
 
+	replacedKeys, err := stp.getReplacedKeys(affectedStates)
+	if err != nil {
+		return err
+	}
+
 	for _, key := range affectedStates {
-		if len(key) != stp.pubkeyConv.Len() {
-			continue
+		err = stp.updateProtocolForKey(key, replacedKeys, nonce)
+		if err != nil {
+			return err
 		}
+	}
 
-		blsPubKey := []byte(key)
-		log.Trace("get on StakingScAddress called", "blsKey", blsPubKey)
+	return nil
+}
 
-		query := process.SCQuery{
-			ScAddress: factory.StakingSCAddress,
-			FuncName:  "get",
-			Arguments: [][]byte{blsPubKey},
-		}
-		var vmOutput *vmcommon.VMOutput
-		vmOutput, err = stp.scQuery.ExecuteQuery(&query)
+// UpdateProtocolForKeys applies to the peer state the changes made in the staking smart contract for the given keys
+// outside of the block transactions, such as the equivocation slashings
+func (stp *stakingToPeer) UpdateProtocolForKeys(keys [][]byte, nonce uint64) error {
+	for _, key := range keys {
+		err := stp.updateProtocolForKey(string(key), nil, nonce)
 		if err != nil {
 			return err
 		}
+	}
+
+	return nil
+}
 
-		var data []byte
-		if len(vmOutput.ReturnData) > 0 {
-			data = vmOutput.ReturnData[0]
+func (stp *stakingToPeer) updateProtocolForKey(key string, replacedKeys map[string]bool, nonce uint64) error {
+	if len(key) != stp.pubkeyConv.Len() {
+		return nil
+	}
+
+	blsPubKey := []byte(key)
+	log.Trace("get on StakingScAddress called", "blsKey", blsPubKey)
+
+	data, err := stp.getStorageFromStakingSC(blsPubKey)
+	if err != nil {
+		return err
+	}
+	// no data under key -> peer can be deleted from trie
+	if len(data) == 0 {
+		// the peer of a replaced key is moved to the new key at the start of the next epoch
+		if replacedKeys[key] {
+			return nil
 		}
-		// no data under key -> peer can be deleted from trie
-		if len(data) == 0 {
-			err = stp.peerState.RemoveAccount(blsPubKey)
-			log.LogIfError(err, "staking to protocol RemoveAccount blsPubKey", blsPubKey)
 
-			continue
+		err = stp.peerState.RemoveAccount(blsPubKey)
+		log.LogIfError(err, "staking to protocol RemoveAccount blsPubKey", blsPubKey)
+
+		return nil
+	}
+
+	var stakingData systemSmartContracts.StakedData
+	err = stp.vmMarshalizer.Unmarshal(&stakingData, data)
+	if err != nil {
+		return err
+	}
+
+	return stp.updatePeerState(stakingData, blsPubKey, nonce)
+}
+
+// UpdateProtocolForKeyRotations moves the peers of the validator keys changed in the given epoch to the new keys, so
+// that the rating, the list and the position in the list are kept. It should be called at the start of the next
+// epoch, before the validators info is computed, so that the nodes coordinator picks up the new keys. The old keys
+// remain in the inactive list, as they can still get the statistics of the last blocks of the epoch
+func (stp *stakingToPeer) UpdateProtocolForKeyRotations(epoch uint32) error {
+	keyRotations, err := stp.getKeyRotations(systemSmartContracts.CreateKeyRotationsKey(epoch))
+	if err != nil {
+		return err
+	}
+
+	for _, keyRotation := range keyRotations {
+		err = stp.movePeerToNewKey(keyRotation.OldKey, keyRotation.NewKey)
+		if err != nil {
+			return err
 		}
+	}
+
+	return nil
+}
+
+func (stp *stakingToPeer) movePeerToNewKey(oldKey []byte, newKey []byte) error {
+	oldAccount, err := stp.getPeerAccount(oldKey)
+	if err != nil {
+		return err
+	}
+	if len(oldAccount.GetBLSPublicKey()) == 0 {
+		log.Debug("no peer to move for the changed validator key", "old key", oldKey, "new key", newKey)
+		return nil
+	}
+
+	peerData, err := stp.protoMarshalizer.Marshal(oldAccount)
+	if err != nil {
+		return err
+	}
+
+	newAccount, err := stp.getPeerAccount(newKey)
+	if err != nil {
+		return err
+	}
+	// the reward address of the new key could have been changed after the key rotation
+	rewardAddress := newAccount.GetRewardAddress()
 
-		var stakingData systemSmartContracts.StakedData
-		err = stp.vmMarshalizer.Unmarshal(&stakingData, data)
+	err = stp.protoMarshalizer.Unmarshal(newAccount, peerData)
+	if err != nil {
+		return err
+	}
+	err = newAccount.SetBLSPublicKey(newKey)
+	if err != nil {
+		return err
+	}
+	if len(rewardAddress) > 0 {
+		err = newAccount.SetRewardAddress(rewardAddress)
 		if err != nil {
 			return err
 		}
+	}
+
+	err = stp.peerState.SaveAccount(newAccount)
+	if err != nil {
+		return err
+	}
+
+	err = stp.peerState.RemoveAccount(oldKey)
+	if err != nil {
+		return err
+	}
 
-		err = stp.updatePeerState(stakingData, blsPubKey, nonce)
+	inactiveAccount, err := stp.getPeerAccount(oldKey)
+	if err != nil {
+		return err
+	}
+	err = inactiveAccount.SetBLSPublicKey(oldKey)
+	if err != nil {
+		return err
+	}
+	if len(oldAccount.GetRewardAddress()) > 0 {
+		err = inactiveAccount.SetRewardAddress(oldAccount.GetRewardAddress())
 		if err != nil {
 			return err
 		}
 	}
+	inactiveAccount.SetListAndIndex(oldAccount.GetShardId(), string(core.InactiveList), 0)
 
-	return nil
+	log.Debug("moved peer to the changed validator key",
+		"old key", oldKey,
+		"new key", newKey,
+		"list", newAccount.GetList(),
+		"shard", newAccount.GetShardId(),
+	)
+
+	return stp.peerState.SaveAccount(inactiveAccount)
+}
+
+// getReplacedKeys returns the keys replaced by the key rotations found among the modified states
+func (stp *stakingToPeer) getReplacedKeys(affectedStates []string) (map[string]bool, error) {
+	replacedKeys := make(map[string]bool)
+	for _, key := range affectedStates {
+		if !strings.HasPrefix(key, systemSmartContracts.KeyRotationsKeyPrefix) {
+			continue
+		}
+
+		keyRotations, err := stp.getKeyRotations([]byte(key))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, keyRotation := range keyRotations {
+			replacedKeys[string(keyRotation.OldKey)] = true
+		}
+	}
+
+	return replacedKeys, nil
+}
+
+func (stp *stakingToPeer) getKeyRotations(key []byte) ([]systemSmartContracts.KeyRotation, error) {
+	keyRotations := make([]systemSmartContracts.KeyRotation, 0)
+
+	data, err := stp.getStorageFromStakingSC(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return keyRotations, nil
+	}
+
+	err = stp.vmMarshalizer.Unmarshal(&keyRotations, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return keyRotations, nil
+}
+
+func (stp *stakingToPeer) getStorageFromStakingSC(key []byte) ([]byte, error) {
+	query := process.SCQuery{
+		ScAddress: factory.StakingSCAddress,
+		FuncName:  "get",
+		Arguments: [][]byte{key},
+	}
+	vmOutput, err := stp.scQuery.ExecuteQuery(&query)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(vmOutput.ReturnData) == 0 {
+		return nil, nil
+	}
+
+	return vmOutput.ReturnData[0], nil
 }
 
 func (stp *stakingToPeer) updatePeerState(
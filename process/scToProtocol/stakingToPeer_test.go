@@ -14,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/vm/factory"
@@ -629,3 +630,170 @@ func TestStakingToPeer_UpdatePeerState(t *testing.T) {
 	_ = stp.updatePeerState(stakingData, blsPubKey, stakingData.UnStakedNonce)
 	assert.Equal(t, string(core.LeavingList), peerAccount.GetList())
 }
+
+func TestStakingToPeer_UpdateProtocolForKeysShouldJailTheSlashedKey(t *testing.T) {
+	t.Parallel()
+
+	blsPubKey := bytes.Repeat([]byte("k"), 32)
+	nonce := uint64(12)
+	peerAccount := state.NewEmptyPeerAccount()
+	peerAccount.SetListAndIndex(0, string(core.EligibleList), 5)
+	peerAccountsDB := &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			return peerAccount, nil
+		},
+	}
+
+	stakingData := systemSmartContracts.StakedData{
+		Staked:        true,
+		RewardAddress: []byte("rwd"),
+		StakeValue:    big.NewInt(90),
+		JailedNonce:   nonce,
+	}
+	marshalizer := &mock.MarshalizerMock{}
+	scDataGetter := &mock.ScQueryStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			assert.Equal(t, blsPubKey, query.Arguments[0])
+			retData, _ := marshalizer.Marshal(&stakingData)
+			return &vmcommon.VMOutput{ReturnData: [][]byte{retData}}, nil
+		},
+	}
+
+	arguments := createMockArgumentsNewStakingToPeer()
+	arguments.PeerState = peerAccountsDB
+	arguments.VmMarshalizer = marshalizer
+	arguments.ScQuery = scDataGetter
+	stp, _ := NewStakingToPeer(arguments)
+
+	err := stp.UpdateProtocolForKeys([][]byte{blsPubKey}, nonce)
+	assert.Nil(t, err)
+	assert.Equal(t, string(core.LeavingList), peerAccount.GetList())
+	assert.Equal(t, blsPubKey, peerAccount.GetBLSPublicKey())
+}
+
+func TestStakingToPeer_UpdateProtocolShouldNotRemoveTheReplacedKey(t *testing.T) {
+	t.Parallel()
+
+	oldKey := bytes.Repeat([]byte("o"), 32)
+	newKey := bytes.Repeat([]byte("n"), 32)
+	keyRotationsKey := systemSmartContracts.CreateKeyRotationsKey(2)
+
+	currTx := &mock.TxForCurrentBlockStub{}
+	currTx.GetTxCalled = func(txHash []byte) (handler data.TransactionHandler, e error) {
+		return &smartContractResult.SmartContractResult{
+			RcvAddr: factory.StakingSCAddress,
+		}, nil
+	}
+
+	argParser := &mock.ArgumentParserMock{}
+	argParser.GetStorageUpdatesCalled = func(data string) (updates []*vmcommon.StorageUpdate, e error) {
+		return []*vmcommon.StorageUpdate{
+			{Offset: oldKey, Data: nil},
+			{Offset: keyRotationsKey, Data: []byte("rotations")},
+		}, nil
+	}
+
+	keyRotations, _ := json.Marshal([]systemSmartContracts.KeyRotation{{OldKey: oldKey, NewKey: newKey}})
+	scQuery := &mock.ScQueryStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			if bytes.Equal(query.Arguments[0], keyRotationsKey) {
+				return &vmcommon.VMOutput{ReturnData: [][]byte{keyRotations}}, nil
+			}
+			return &vmcommon.VMOutput{ReturnData: [][]byte{nil}}, nil
+		},
+	}
+
+	peerState := &mock.AccountsStub{}
+	peerState.RemoveAccountCalled = func(address []byte) error {
+		assert.Fail(t, "the peer of a replaced key should not be removed")
+		return nil
+	}
+
+	arguments := createMockArgumentsNewStakingToPeer()
+	arguments.ArgParser = argParser
+	arguments.CurrTxs = currTx
+	arguments.PeerState = peerState
+	arguments.ScQuery = scQuery
+	arguments.VmMarshalizer = &mock.MarshalizerMock{}
+	stp, _ := NewStakingToPeer(arguments)
+
+	err := stp.UpdateProtocol(createBlockBody(), 10)
+	assert.Nil(t, err)
+}
+
+func TestStakingToPeer_UpdateProtocolForKeyRotationsShouldMoveThePeer(t *testing.T) {
+	t.Parallel()
+
+	oldKey := []byte("oldKey")
+	newKey := []byte("newKey")
+	newRewardAddress := []byte("newRewardAddress")
+
+	accounts := make(map[string]state.PeerAccountHandler)
+	peerState := &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			account, ok := accounts[string(address)]
+			if ok {
+				return account, nil
+			}
+			return state.NewPeerAccount(address)
+		},
+		SaveAccountCalled: func(account state.AccountHandler) error {
+			accounts[string(account.AddressBytes())] = account.(state.PeerAccountHandler)
+			return nil
+		},
+		RemoveAccountCalled: func(address []byte) error {
+			delete(accounts, string(address))
+			return nil
+		},
+	}
+
+	oldAccount, _ := state.NewPeerAccount(oldKey)
+	_ = oldAccount.SetBLSPublicKey(oldKey)
+	_ = oldAccount.SetRewardAddress([]byte("rewardAddress"))
+	oldAccount.SetListAndIndex(1, string(core.EligibleList), 7)
+	oldAccount.SetRating(70)
+	oldAccount.SetTempRating(75)
+	oldAccount.IncreaseLeaderSuccessRate(3)
+	accounts[string(oldKey)] = oldAccount
+
+	// the reward address was changed after the key rotation
+	newAccount, _ := state.NewPeerAccount(newKey)
+	_ = newAccount.SetBLSPublicKey(newKey)
+	_ = newAccount.SetRewardAddress(newRewardAddress)
+	accounts[string(newKey)] = newAccount
+
+	keyRotations, _ := json.Marshal([]systemSmartContracts.KeyRotation{{OldKey: oldKey, NewKey: newKey}})
+	scQuery := &mock.ScQueryStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			assert.Equal(t, systemSmartContracts.CreateKeyRotationsKey(4), query.Arguments[0])
+			return &vmcommon.VMOutput{ReturnData: [][]byte{keyRotations}}, nil
+		},
+	}
+
+	arguments := createMockArgumentsNewStakingToPeer()
+	arguments.PeerState = peerState
+	arguments.ScQuery = scQuery
+	arguments.VmMarshalizer = &mock.MarshalizerMock{}
+	arguments.ProtoMarshalizer = &marshal.GogoProtoMarshalizer{}
+	stp, _ := NewStakingToPeer(arguments)
+
+	err := stp.UpdateProtocolForKeyRotations(4)
+	assert.Nil(t, err)
+
+	movedAccount := accounts[string(newKey)]
+	assert.Equal(t, newKey, movedAccount.GetBLSPublicKey())
+	assert.Equal(t, newRewardAddress, movedAccount.GetRewardAddress())
+	assert.Equal(t, string(core.EligibleList), movedAccount.GetList())
+	assert.Equal(t, uint32(7), movedAccount.GetIndexInList())
+	assert.Equal(t, uint32(1), movedAccount.GetShardId())
+	assert.Equal(t, uint32(70), movedAccount.GetRating())
+	assert.Equal(t, uint32(75), movedAccount.GetTempRating())
+	assert.Equal(t, uint32(3), movedAccount.GetLeaderSuccessRate().NumSuccess)
+
+	inactiveAccount := accounts[string(oldKey)]
+	assert.Equal(t, oldKey, inactiveAccount.GetBLSPublicKey())
+	assert.Equal(t, []byte("rewardAddress"), inactiveAccount.GetRewardAddress())
+	assert.Equal(t, string(core.InactiveList), inactiveAccount.GetList())
+	assert.Equal(t, uint32(0), inactiveAccount.GetRating())
+	assert.Equal(t, uint32(0), inactiveAccount.GetLeaderSuccessRate().NumSuccess)
+}
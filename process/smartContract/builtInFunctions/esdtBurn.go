@@ -0,0 +1,92 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	vmFactory "github.com/ElrondNetwork/elrond-go/vm/factory"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var _ process.BuiltinFunction = (*esdtBurn)(nil)
+
+type esdtBurn struct {
+	marshalizer marshal.Marshalizer
+	keyPrefix   []byte
+}
+
+// NewESDTBurnFunc returns the esdt burn built-in function component
+func NewESDTBurnFunc(marshalizer marshal.Marshalizer) (*esdtBurn, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+
+	e := &esdtBurn{
+		marshalizer: marshalizer,
+		keyPrefix:   []byte(core.ElrondProtectedKeyPrefix + esdtKeyIdentifier),
+	}
+
+	return e, nil
+}
+
+// ProcessBuiltinFunction removes the burnt value from the esdt balance of the destination account. The call is
+// accepted only from the esdt system smart contract, which updates the token supply from the returned data
+func (e *esdtBurn) ProcessBuiltinFunction(
+	_, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if len(vmInput.Arguments) != 2 {
+		return nil, process.ErrInvalidArguments
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, process.ErrBuiltInFunctionCalledWithValue
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vmFactory.ESDTSCAddress) {
+		return nil, process.ErrAddressIsNotESDTSystemSC
+	}
+	if check.IfNil(acntDst) {
+		return nil, process.ErrNilUserAccount
+	}
+
+	value := big.NewInt(0).SetBytes(vmInput.Arguments[1])
+	if value.Cmp(zero) <= 0 {
+		return nil, process.ErrNegativeValue
+	}
+
+	esdtTokenKey := append(e.keyPrefix, vmInput.Arguments[0]...)
+	esdtData, err := getESDTDataFromKey(acntDst, esdtTokenKey, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	esdtData.Value.Sub(esdtData.Value, value)
+	if esdtData.Value.Cmp(zero) < 0 {
+		return nil, process.ErrInsufficientFunds
+	}
+
+	log.Trace("esdt burn", "addr", acntDst.AddressBytes(), "value", value, "tokenKey", esdtTokenKey)
+	err = saveESDTData(acntDst, esdtTokenKey, esdtData, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode: vmcommon.Ok,
+		ReturnData: [][]byte{[]byte(core.BuiltInFunctionESDTBurn), vmInput.Arguments[0], value.Bytes()},
+	}
+
+	return vmOutput, nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtBurn) IsInterfaceNil() bool {
+	return e == nil
+}
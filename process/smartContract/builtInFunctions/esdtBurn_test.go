@@ -0,0 +1,85 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	vmFactory "github.com/ElrondNetwork/elrond-go/vm/factory"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewESDTBurnFunc_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	burn, err := NewESDTBurnFunc(nil)
+	assert.Nil(t, burn)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestESDTBurn_ProcessBuiltInFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	burn, _ := NewESDTBurnFunc(&mock.MarshalizerMock{})
+	_, err := burn.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue: big.NewInt(0),
+		},
+	}
+	_, err = burn.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input.Arguments = [][]byte{[]byte("key"), big.NewInt(10).Bytes()}
+	input.CallerAddr = []byte("not the esdt sc")
+	_, err = burn.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrAddressIsNotESDTSystemSC, err)
+
+	input.CallerAddr = vmFactory.ESDTSCAddress
+	_, err = burn.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrNilUserAccount, err)
+
+	acnt, _ := state.NewUserAccount([]byte("dst"))
+	input.Arguments[1] = big.NewInt(0).Bytes()
+	_, err = burn.ProcessBuiltinFunction(nil, acnt, input)
+	assert.Equal(t, process.ErrNegativeValue, err)
+
+	input.Arguments[1] = big.NewInt(10).Bytes()
+	_, err = burn.ProcessBuiltinFunction(nil, acnt, input)
+	assert.Equal(t, process.ErrInsufficientFunds, err)
+}
+
+func TestESDTBurn_ProcessBuiltInFunctionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	burn, _ := NewESDTBurnFunc(marshalizer)
+
+	key := []byte("key")
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: vmFactory.ESDTSCAddress,
+			Arguments:  [][]byte{key, big.NewInt(10).Bytes()},
+		},
+	}
+	acnt, _ := state.NewUserAccount([]byte("dst"))
+	esdtKey := append(burn.keyPrefix, key...)
+	esdtToken := &ESDigitalToken{Value: big.NewInt(100)}
+	marshalledData, _ := marshalizer.Marshal(esdtToken)
+	acnt.DataTrieTracker().SaveKeyValue(esdtKey, marshalledData)
+
+	vmOutput, err := burn.ProcessBuiltinFunction(nil, acnt, input)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte(core.BuiltInFunctionESDTBurn), key, big.NewInt(10).Bytes()}, vmOutput.ReturnData)
+
+	marshalledData, _ = acnt.DataTrieTracker().RetrieveValue(esdtKey)
+	_ = marshalizer.Unmarshal(esdtToken, marshalledData)
+	assert.True(t, esdtToken.Value.Cmp(big.NewInt(90)) == 0)
+}
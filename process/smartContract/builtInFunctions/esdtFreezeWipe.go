@@ -0,0 +1,97 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	vmFactory "github.com/ElrondNetwork/elrond-go/vm/factory"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var _ process.BuiltinFunction = (*esdtFreezeWipe)(nil)
+
+type esdtFreezeWipe struct {
+	marshalizer marshal.Marshalizer
+	keyPrefix   []byte
+	wipe        bool
+	freeze      bool
+}
+
+// NewESDTFreezeWipeFunc returns the esdt freeze/un-freeze/wipe built-in function component
+func NewESDTFreezeWipeFunc(
+	marshalizer marshal.Marshalizer,
+	freeze bool,
+	wipe bool,
+) (*esdtFreezeWipe, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+
+	e := &esdtFreezeWipe{
+		marshalizer: marshalizer,
+		keyPrefix:   []byte(core.ElrondProtectedKeyPrefix + esdtKeyIdentifier),
+		freeze:      freeze,
+		wipe:        wipe,
+	}
+
+	return e, nil
+}
+
+// ProcessBuiltinFunction resolves ESDT freeze, un-freeze and wipe function calls
+func (e *esdtFreezeWipe) ProcessBuiltinFunction(
+	_, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if len(vmInput.Arguments) != 1 {
+		return nil, process.ErrInvalidArguments
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, process.ErrBuiltInFunctionCalledWithValue
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vmFactory.ESDTSCAddress) {
+		return nil, process.ErrAddressIsNotESDTSystemSC
+	}
+	if check.IfNil(acntDst) {
+		return nil, process.ErrNilUserAccount
+	}
+
+	esdtTokenKey := append(e.keyPrefix, vmInput.Arguments[0]...)
+	esdtData, err := getESDTDataFromKey(acntDst, esdtTokenKey, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}
+	if e.wipe {
+		if !esdtData.Frozen {
+			return nil, process.ErrCannotWipeAccountNotFrozen
+		}
+
+		log.Trace("esdt wipe", "addr", acntDst.AddressBytes(), "value", esdtData.Value, "tokenKey", esdtTokenKey)
+		// the wiped value is returned to the esdt system smart contract, which removes it from the token supply
+		vmOutput.ReturnData = [][]byte{[]byte(core.BuiltInFunctionESDTWipe), vmInput.Arguments[0], esdtData.Value.Bytes()}
+		esdtData.Value = big.NewInt(0)
+	} else {
+		esdtData.Frozen = e.freeze
+	}
+
+	err = saveESDTData(acntDst, esdtTokenKey, esdtData, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	return vmOutput, nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtFreezeWipe) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,123 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	vmFactory "github.com/ElrondNetwork/elrond-go/vm/factory"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewESDTFreezeWipeFunc_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	freeze, err := NewESDTFreezeWipeFunc(nil, true, false)
+	assert.Nil(t, freeze)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestESDTFreezeWipe_ProcessBuiltInFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	freeze, _ := NewESDTFreezeWipeFunc(&mock.MarshalizerMock{}, true, false)
+	_, err := freeze.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue: big.NewInt(0),
+		},
+	}
+	_, err = freeze.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input.Arguments = [][]byte{[]byte("key")}
+	input.CallValue = big.NewInt(1)
+	_, err = freeze.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrBuiltInFunctionCalledWithValue, err)
+
+	input.CallValue = big.NewInt(0)
+	input.CallerAddr = []byte("not the esdt sc")
+	_, err = freeze.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrAddressIsNotESDTSystemSC, err)
+
+	input.CallerAddr = vmFactory.ESDTSCAddress
+	_, err = freeze.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrNilUserAccount, err)
+}
+
+func TestESDTFreezeWipe_ProcessBuiltInFunctionFreezeAndUnFreeze(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	freeze, _ := NewESDTFreezeWipeFunc(marshalizer, true, false)
+	unFreeze, _ := NewESDTFreezeWipeFunc(marshalizer, false, false)
+
+	key := []byte("key")
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: vmFactory.ESDTSCAddress,
+			Arguments:  [][]byte{key},
+		},
+	}
+	acnt, _ := state.NewUserAccount([]byte("dst"))
+
+	_, err := freeze.ProcessBuiltinFunction(nil, acnt, input)
+	assert.Nil(t, err)
+
+	esdtKey := append(freeze.keyPrefix, key...)
+	esdtToken := &ESDigitalToken{}
+	marshalledData, _ := acnt.DataTrieTracker().RetrieveValue(esdtKey)
+	_ = marshalizer.Unmarshal(esdtToken, marshalledData)
+	assert.True(t, esdtToken.Frozen)
+
+	_, err = unFreeze.ProcessBuiltinFunction(nil, acnt, input)
+	assert.Nil(t, err)
+
+	marshalledData, _ = acnt.DataTrieTracker().RetrieveValue(esdtKey)
+	_ = marshalizer.Unmarshal(esdtToken, marshalledData)
+	assert.False(t, esdtToken.Frozen)
+}
+
+func TestESDTFreezeWipe_ProcessBuiltInFunctionWipe(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	wipe, _ := NewESDTFreezeWipeFunc(marshalizer, false, true)
+
+	key := []byte("key")
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: vmFactory.ESDTSCAddress,
+			Arguments:  [][]byte{key},
+		},
+	}
+	acnt, _ := state.NewUserAccount([]byte("dst"))
+	esdtKey := append(wipe.keyPrefix, key...)
+	esdtToken := &ESDigitalToken{Value: big.NewInt(100)}
+	marshalledData, _ := marshalizer.Marshal(esdtToken)
+	acnt.DataTrieTracker().SaveKeyValue(esdtKey, marshalledData)
+
+	_, err := wipe.ProcessBuiltinFunction(nil, acnt, input)
+	assert.Equal(t, process.ErrCannotWipeAccountNotFrozen, err)
+
+	esdtToken.Frozen = true
+	marshalledData, _ = marshalizer.Marshal(esdtToken)
+	acnt.DataTrieTracker().SaveKeyValue(esdtKey, marshalledData)
+
+	vmOutput, err := wipe.ProcessBuiltinFunction(nil, acnt, input)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte(core.BuiltInFunctionESDTWipe), key, big.NewInt(100).Bytes()}, vmOutput.ReturnData)
+
+	marshalledData, _ = acnt.DataTrieTracker().RetrieveValue(esdtKey)
+	_ = marshalizer.Unmarshal(esdtToken, marshalledData)
+	assert.True(t, esdtToken.Value.Cmp(big.NewInt(0)) == 0)
+	assert.True(t, esdtToken.Frozen)
+}
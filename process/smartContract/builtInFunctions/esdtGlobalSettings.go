@@ -0,0 +1,65 @@
+package builtInFunctions
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+var _ process.ESDTPauseHandler = (*esdtGlobalSettings)(nil)
+
+type esdtGlobalSettings struct {
+	accounts  state.AccountsAdapter
+	address   []byte
+	keyPrefix []byte
+}
+
+// NewESDTGlobalSettings returns the component which reads the esdt settings saved in the global settings account of
+// the current shard by the ESDTPause and ESDTUnPause built-in functions
+func NewESDTGlobalSettings(
+	accounts state.AccountsAdapter,
+	shardCoordinator sharding.Coordinator,
+) (*esdtGlobalSettings, error) {
+	if check.IfNil(accounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(shardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+
+	return &esdtGlobalSettings{
+		accounts:  accounts,
+		address:   core.ESDTGlobalSettingsAddress(shardCoordinator.SelfId()),
+		keyPrefix: []byte(core.ElrondProtectedKeyPrefix + esdtKeyIdentifier),
+	}, nil
+}
+
+// IsPaused returns true if the provided token is paused in the current shard
+func (e *esdtGlobalSettings) IsPaused(token []byte) bool {
+	account, err := e.accounts.GetExistingAccount(e.address)
+	if err != nil {
+		return false
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return false
+	}
+
+	esdtTokenKey := append(e.keyPrefix, token...)
+	value, err := userAccount.DataTrieTracker().RetrieveValue(esdtTokenKey)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(value, pausedValue)
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtGlobalSettings) IsInterfaceNil() bool {
+	return e == nil
+}
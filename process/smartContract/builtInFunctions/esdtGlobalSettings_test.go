@@ -0,0 +1,40 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewESDTGlobalSettings(t *testing.T) {
+	t.Parallel()
+
+	globalSettings, err := NewESDTGlobalSettings(nil, mock.NewMultiShardsCoordinatorMock(2))
+	assert.Nil(t, globalSettings)
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+
+	globalSettings, err = NewESDTGlobalSettings(&mock.AccountsStub{}, nil)
+	assert.Nil(t, globalSettings)
+	assert.Equal(t, process.ErrNilShardCoordinator, err)
+
+	globalSettings, err = NewESDTGlobalSettings(&mock.AccountsStub{}, mock.NewMultiShardsCoordinatorMock(2))
+	assert.Nil(t, err)
+	assert.False(t, globalSettings.IsInterfaceNil())
+}
+
+func TestESDTGlobalSettings_IsPausedMissingAccountShouldReturnFalse(t *testing.T) {
+	t.Parallel()
+
+	accounts := &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			return nil, errors.New("account not found")
+		},
+	}
+	globalSettings, _ := NewESDTGlobalSettings(accounts, mock.NewMultiShardsCoordinatorMock(2))
+
+	assert.False(t, globalSettings.IsPaused([]byte("key")))
+}
@@ -0,0 +1,71 @@
+package builtInFunctions
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	vmFactory "github.com/ElrondNetwork/elrond-go/vm/factory"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var _ process.BuiltinFunction = (*esdtPause)(nil)
+
+var pausedValue = []byte{1}
+
+type esdtPause struct {
+	keyPrefix []byte
+	pause     bool
+}
+
+// NewESDTPauseFunc returns the esdt pause/un-pause built-in function component
+func NewESDTPauseFunc(pause bool) *esdtPause {
+	return &esdtPause{
+		keyPrefix: []byte(core.ElrondProtectedKeyPrefix + esdtKeyIdentifier),
+		pause:     pause,
+	}
+}
+
+// ProcessBuiltinFunction resolves ESDT pause and un-pause function calls by saving the paused state of the token
+// in the esdt global settings account of the shard
+func (e *esdtPause) ProcessBuiltinFunction(
+	_, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if len(vmInput.Arguments) != 1 {
+		return nil, process.ErrInvalidArguments
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, process.ErrBuiltInFunctionCalledWithValue
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vmFactory.ESDTSCAddress) {
+		return nil, process.ErrAddressIsNotESDTSystemSC
+	}
+	if check.IfNil(acntDst) {
+		return nil, process.ErrNilUserAccount
+	}
+	if !core.IsESDTGlobalSettingsAddress(acntDst.AddressBytes()) {
+		return nil, process.ErrAddressIsNotESDTGlobalSettings
+	}
+
+	esdtTokenKey := append(e.keyPrefix, vmInput.Arguments[0]...)
+	log.Trace("esdt pause", "paused", e.pause, "tokenKey", esdtTokenKey)
+
+	var value []byte
+	if e.pause {
+		value = pausedValue
+	}
+	acntDst.DataTrieTracker().SaveKeyValue(esdtTokenKey, value)
+
+	return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtPause) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,101 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	vmFactory "github.com/ElrondNetwork/elrond-go/vm/factory"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestESDTPause_ProcessBuiltInFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	pause := NewESDTPauseFunc(true)
+	_, err := pause.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue: big.NewInt(0),
+		},
+	}
+	_, err = pause.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input.Arguments = [][]byte{[]byte("key")}
+	input.CallValue = big.NewInt(1)
+	_, err = pause.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrBuiltInFunctionCalledWithValue, err)
+
+	input.CallValue = big.NewInt(0)
+	input.CallerAddr = []byte("not the esdt sc")
+	_, err = pause.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrAddressIsNotESDTSystemSC, err)
+
+	input.CallerAddr = vmFactory.ESDTSCAddress
+	_, err = pause.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrNilUserAccount, err)
+
+	acnt, _ := state.NewUserAccount([]byte("dst"))
+	_, err = pause.ProcessBuiltinFunction(nil, acnt, input)
+	assert.Equal(t, process.ErrAddressIsNotESDTGlobalSettings, err)
+}
+
+func TestESDTPause_TransferIsRefusedWhilePaused(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	globalSettingsAcnt, _ := state.NewUserAccount(core.ESDTGlobalSettingsAddress(shardCoordinator.SelfId()))
+	accounts := &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			return globalSettingsAcnt, nil
+		},
+	}
+	globalSettings, _ := NewESDTGlobalSettings(accounts, shardCoordinator)
+	transfer, _ := NewESDTTransferFunc(10, marshalizer, globalSettings)
+	pause := NewESDTPauseFunc(true)
+	unPause := NewESDTPauseFunc(false)
+
+	key := []byte("key")
+	pauseInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: vmFactory.ESDTSCAddress,
+			Arguments:  [][]byte{key},
+		},
+	}
+	transferInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			GasProvided: 50,
+			CallValue:   big.NewInt(0),
+			Arguments:   [][]byte{key, big.NewInt(10).Bytes()},
+		},
+	}
+	accSnd, _ := state.NewUserAccount([]byte("snd"))
+	accDst, _ := state.NewUserAccount([]byte("dst"))
+	esdtKey := append(transfer.keyPrefix, key...)
+	marshalledData, _ := marshalizer.Marshal(&ESDigitalToken{Value: big.NewInt(100)})
+	accSnd.DataTrieTracker().SaveKeyValue(esdtKey, marshalledData)
+
+	_, err := pause.ProcessBuiltinFunction(nil, globalSettingsAcnt, pauseInput)
+	assert.Nil(t, err)
+	assert.True(t, globalSettings.IsPaused(key))
+	assert.False(t, globalSettings.IsPaused([]byte("other token")))
+
+	_, err = transfer.ProcessBuiltinFunction(accSnd, accDst, transferInput)
+	assert.Equal(t, process.ErrESDTTokenIsPaused, err)
+
+	_, err = unPause.ProcessBuiltinFunction(nil, globalSettingsAcnt, pauseInput)
+	assert.Nil(t, err)
+	assert.False(t, globalSettings.IsPaused(key))
+
+	_, err = transfer.ProcessBuiltinFunction(accSnd, accDst, transferInput)
+	assert.Nil(t, err)
+}
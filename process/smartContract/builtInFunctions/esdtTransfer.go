@@ -20,24 +20,30 @@ var _ process.BuiltinFunction = (*esdtTransfer)(nil)
 var zero = big.NewInt(0)
 
 type esdtTransfer struct {
-	funcGasCost uint64
-	marshalizer marshal.Marshalizer
-	keyPrefix   []byte
+	funcGasCost  uint64
+	marshalizer  marshal.Marshalizer
+	keyPrefix    []byte
+	pauseHandler process.ESDTPauseHandler
 }
 
 // NewESDTTransferFunc returns the esdt transfer built-in function component
 func NewESDTTransferFunc(
 	funcGasCost uint64,
 	marshalizer marshal.Marshalizer,
+	pauseHandler process.ESDTPauseHandler,
 ) (*esdtTransfer, error) {
 	if check.IfNil(marshalizer) {
 		return nil, process.ErrNilMarshalizer
 	}
+	if check.IfNil(pauseHandler) {
+		return nil, process.ErrNilESDTPauseHandler
+	}
 
 	e := &esdtTransfer{
-		funcGasCost: funcGasCost,
-		marshalizer: marshalizer,
-		keyPrefix:   []byte(core.ElrondProtectedKeyPrefix + esdtKeyIdentifier),
+		funcGasCost:  funcGasCost,
+		marshalizer:  marshalizer,
+		keyPrefix:    []byte(core.ElrondProtectedKeyPrefix + esdtKeyIdentifier),
+		pauseHandler: pauseHandler,
 	}
 
 	return e, nil
@@ -72,6 +78,9 @@ func (e *esdtTransfer) ProcessBuiltinFunction(
 		if vmInput.GasProvided < e.funcGasCost {
 			return nil, process.ErrNotEnoughGas
 		}
+		if e.pauseHandler.IsPaused(vmInput.Arguments[0]) {
+			return nil, process.ErrESDTTokenIsPaused
+		}
 
 		gasRemaining = vmInput.GasProvided - e.funcGasCost
 		err := e.addToESDTBalance(acntSnd, esdtTokenKey, big.NewInt(0).Neg(value))
@@ -104,35 +113,33 @@ func (e *esdtTransfer) ProcessBuiltinFunction(
 }
 
 func (e *esdtTransfer) addToESDTBalance(userAcnt state.UserAccountHandler, key []byte, value *big.Int) error {
-	esdtData, err := e.getESDTDataFromKey(userAcnt, key)
+	esdtData, err := getESDTDataFromKey(userAcnt, key, e.marshalizer)
 	if err != nil {
 		return err
 	}
+	if esdtData.Frozen && value.Cmp(zero) < 0 {
+		// a frozen account can still receive tokens, but it can not send them
+		return process.ErrESDTIsFrozenForAccount
+	}
 
 	esdtData.Value.Add(esdtData.Value, value)
 	if esdtData.Value.Cmp(zero) < 0 {
 		return process.ErrInsufficientFunds
 	}
 
-	marshalledData, err := e.marshalizer.Marshal(esdtData)
-	if err != nil {
-		return err
-	}
-
 	log.Trace("esdt after transfer", "addr", userAcnt.AddressBytes(), "value", esdtData.Value, "tokenKey", key)
-	userAcnt.DataTrieTracker().SaveKeyValue(key, marshalledData)
 
-	return nil
+	return saveESDTData(userAcnt, key, esdtData, e.marshalizer)
 }
 
-func (e *esdtTransfer) getESDTDataFromKey(userAcnt state.UserAccountHandler, key []byte) (*ESDigitalToken, error) {
+func getESDTDataFromKey(userAcnt state.UserAccountHandler, key []byte, marshalizer marshal.Marshalizer) (*ESDigitalToken, error) {
 	esdtData := &ESDigitalToken{Value: big.NewInt(0)}
 	marshalledData, err := userAcnt.DataTrieTracker().RetrieveValue(key)
 	if err != nil {
 		return esdtData, nil
 	}
 
-	err = e.marshalizer.Unmarshal(esdtData, marshalledData)
+	err = marshalizer.Unmarshal(esdtData, marshalledData)
 	if err != nil {
 		return nil, err
 	}
@@ -140,6 +147,17 @@ func (e *esdtTransfer) getESDTDataFromKey(userAcnt state.UserAccountHandler, key
 	return esdtData, nil
 }
 
+func saveESDTData(userAcnt state.UserAccountHandler, key []byte, esdtData *ESDigitalToken, marshalizer marshal.Marshalizer) error {
+	marshalledData, err := marshalizer.Marshal(esdtData)
+	if err != nil {
+		return err
+	}
+
+	userAcnt.DataTrieTracker().SaveKeyValue(key, marshalledData)
+
+	return nil
+}
+
 // IsInterfaceNil returns true if underlying object in nil
 func (e *esdtTransfer) IsInterfaceNil() bool {
 	return e == nil
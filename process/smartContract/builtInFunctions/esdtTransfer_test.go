@@ -1,6 +1,7 @@
 package builtInFunctions
 
 import (
+	"bytes"
 	"math/big"
 	"testing"
 
@@ -11,10 +12,18 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+func TestNewESDTTransferFunc_NilPauseHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	esdt, err := NewESDTTransferFunc(10, &mock.MarshalizerMock{}, nil)
+	assert.Nil(t, esdt)
+	assert.Equal(t, process.ErrNilESDTPauseHandler, err)
+}
+
 func TestESDTTransfer_ProcessBuiltInFunctionErrors(t *testing.T) {
 	t.Parallel()
 
-	esdt, _ := NewESDTTransferFunc(10, &mock.MarshalizerMock{})
+	esdt, _ := NewESDTTransferFunc(10, &mock.MarshalizerMock{}, &mock.ESDTPauseHandlerStub{})
 	_, err := esdt.ProcessBuiltinFunction(nil, nil, nil)
 	assert.Equal(t, err, process.ErrNilVmInput)
 
@@ -44,7 +53,7 @@ func TestESDTTransfer_ProcessBuiltInFunctionSingleShard(t *testing.T) {
 	t.Parallel()
 
 	marshalizer := &mock.MarshalizerMock{}
-	esdt, _ := NewESDTTransferFunc(10, marshalizer)
+	esdt, _ := NewESDTTransferFunc(10, marshalizer, &mock.ESDTPauseHandlerStub{})
 
 	input := &vmcommon.ContractCallInput{
 		VMInput: vmcommon.VMInput{
@@ -81,7 +90,7 @@ func TestESDTTransfer_ProcessBuiltInFunctionSenderInShard(t *testing.T) {
 	t.Parallel()
 
 	marshalizer := &mock.MarshalizerMock{}
-	esdt, _ := NewESDTTransferFunc(10, marshalizer)
+	esdt, _ := NewESDTTransferFunc(10, marshalizer, &mock.ESDTPauseHandlerStub{})
 
 	input := &vmcommon.ContractCallInput{
 		VMInput: vmcommon.VMInput{
@@ -110,7 +119,7 @@ func TestESDTTransfer_ProcessBuiltInFunctionDestInShard(t *testing.T) {
 	t.Parallel()
 
 	marshalizer := &mock.MarshalizerMock{}
-	esdt, _ := NewESDTTransferFunc(10, marshalizer)
+	esdt, _ := NewESDTTransferFunc(10, marshalizer, &mock.ESDTPauseHandlerStub{})
 
 	input := &vmcommon.ContractCallInput{
 		VMInput: vmcommon.VMInput{
@@ -131,3 +140,71 @@ func TestESDTTransfer_ProcessBuiltInFunctionDestInShard(t *testing.T) {
 	_ = marshalizer.Unmarshal(esdtToken, marshalledData)
 	assert.True(t, esdtToken.Value.Cmp(big.NewInt(10)) == 0)
 }
+
+func TestESDTTransfer_ProcessBuiltInFunctionFrozenSenderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	esdt, _ := NewESDTTransferFunc(10, marshalizer, &mock.ESDTPauseHandlerStub{})
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			GasProvided: 50,
+			CallValue:   big.NewInt(0),
+		},
+	}
+	key := []byte("key")
+	input.Arguments = [][]byte{key, big.NewInt(10).Bytes()}
+	accSnd, _ := state.NewUserAccount([]byte("snd"))
+	accDst, _ := state.NewUserAccount([]byte("dst"))
+
+	esdtKey := append(esdt.keyPrefix, key...)
+	esdtToken := &ESDigitalToken{Value: big.NewInt(100), Frozen: true}
+	marshalledData, _ := marshalizer.Marshal(esdtToken)
+	accSnd.DataTrieTracker().SaveKeyValue(esdtKey, marshalledData)
+
+	_, err := esdt.ProcessBuiltinFunction(accSnd, accDst, input)
+	assert.Equal(t, process.ErrESDTIsFrozenForAccount, err)
+
+	// a frozen account can receive tokens
+	_, err = esdt.ProcessBuiltinFunction(nil, accSnd, input)
+	assert.Nil(t, err)
+	marshalledData, _ = accSnd.DataTrieTracker().RetrieveValue(esdtKey)
+	_ = marshalizer.Unmarshal(esdtToken, marshalledData)
+	assert.True(t, esdtToken.Value.Cmp(big.NewInt(110)) == 0)
+}
+
+func TestESDTTransfer_ProcessBuiltInFunctionPausedTokenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	key := []byte("key")
+	pauseHandler := &mock.ESDTPauseHandlerStub{
+		IsPausedCalled: func(token []byte) bool {
+			return bytes.Equal(token, key)
+		},
+	}
+	esdt, _ := NewESDTTransferFunc(10, marshalizer, pauseHandler)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			GasProvided: 50,
+			CallValue:   big.NewInt(0),
+		},
+	}
+	input.Arguments = [][]byte{key, big.NewInt(10).Bytes()}
+	accSnd, _ := state.NewUserAccount([]byte("snd"))
+	accDst, _ := state.NewUserAccount([]byte("dst"))
+
+	esdtKey := append(esdt.keyPrefix, key...)
+	esdtToken := &ESDigitalToken{Value: big.NewInt(100)}
+	marshalledData, _ := marshalizer.Marshal(esdtToken)
+	accSnd.DataTrieTracker().SaveKeyValue(esdtKey, marshalledData)
+
+	_, err := esdt.ProcessBuiltinFunction(accSnd, accDst, input)
+	assert.Equal(t, process.ErrESDTTokenIsPaused, err)
+
+	marshalledData, _ = accSnd.DataTrieTracker().RetrieveValue(esdtKey)
+	_ = marshalizer.Unmarshal(esdtToken, marshalledData)
+	assert.True(t, esdtToken.Value.Cmp(big.NewInt(100)) == 0)
+}
@@ -4,8 +4,10 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/mitchellh/mapstructure"
 )
 
@@ -17,6 +19,8 @@ type ArgsCreateBuiltInFunctionContainer struct {
 	MapDNSAddresses      map[string]struct{}
 	EnableUserNameChange bool
 	Marshalizer          marshal.Marshalizer
+	Accounts             state.AccountsAdapter
+	ShardCoordinator     sharding.Coordinator
 }
 
 // CreateBuiltInFunctionContainer will create the list of built-in functions
@@ -58,7 +62,12 @@ func CreateBuiltInFunctionContainer(args ArgsCreateBuiltInFunctionContainer) (pr
 		return nil, err
 	}
 
-	newFunc, err = NewESDTTransferFunc(gasConfig.BuiltInCost.ESDTTransfer, args.Marshalizer)
+	esdtPauseHandler, err := NewESDTGlobalSettings(args.Accounts, args.ShardCoordinator)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTTransferFunc(gasConfig.BuiltInCost.ESDTTransfer, args.Marshalizer, esdtPauseHandler)
 	if err != nil {
 		return nil, err
 	}
@@ -67,6 +76,54 @@ func CreateBuiltInFunctionContainer(args ArgsCreateBuiltInFunctionContainer) (pr
 		return nil, err
 	}
 
+	newFunc, err = NewESDTBurnFunc(args.Marshalizer)
+	if err != nil {
+		return nil, err
+	}
+	err = container.Add(core.BuiltInFunctionESDTBurn, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTFreezeWipeFunc(args.Marshalizer, true, false)
+	if err != nil {
+		return nil, err
+	}
+	err = container.Add(core.BuiltInFunctionESDTFreeze, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTFreezeWipeFunc(args.Marshalizer, false, false)
+	if err != nil {
+		return nil, err
+	}
+	err = container.Add(core.BuiltInFunctionESDTUnFreeze, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTFreezeWipeFunc(args.Marshalizer, false, true)
+	if err != nil {
+		return nil, err
+	}
+	err = container.Add(core.BuiltInFunctionESDTWipe, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc = NewESDTPauseFunc(true)
+	err = container.Add(core.BuiltInFunctionESDTPause, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc = NewESDTPauseFunc(false)
+	err = container.Add(core.BuiltInFunctionESDTUnPause, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
 	return container, nil
 }
 
@@ -18,6 +18,8 @@ func createMockArguments() ArgsCreateBuiltInFunctionContainer {
 		MapDNSAddresses:      make(map[string]struct{}),
 		EnableUserNameChange: false,
 		Marshalizer:          &mock.MarshalizerMock{},
+		Accounts:             &mock.AccountsStub{},
+		ShardCoordinator:     mock.NewMultiShardsCoordinatorMock(2),
 	}
 
 	return args
@@ -67,8 +69,14 @@ func TestCreateBuiltInFunctionContainer_Errors(t *testing.T) {
 	assert.Equal(t, process.ErrNilDnsAddresses, err)
 	assert.Nil(t, container)
 
+	args = createMockArguments()
+	args.Accounts = nil
+	container, err = CreateBuiltInFunctionContainer(args)
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+	assert.Nil(t, container)
+
 	args = createMockArguments()
 	container, err = CreateBuiltInFunctionContainer(args)
 	assert.Nil(t, err)
-	assert.Equal(t, container.Len(), 5)
+	assert.Equal(t, container.Len(), 11)
 }
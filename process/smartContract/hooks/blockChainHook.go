@@ -574,6 +574,23 @@ func (bh *BlockChainHookImpl) SetCurrentHeader(hdr data.HeaderHandler) {
 	bh.mutCurrentHdr.Unlock()
 }
 
+// GetAccountsAdapter returns the accounts adapter through which the smart contracts access the state
+func (bh *BlockChainHookImpl) GetAccountsAdapter() state.AccountsAdapter {
+	return bh.accounts
+}
+
+// SetAccountsAdapter replaces the accounts adapter through which the smart contracts access the state. It must not
+// be called while a VM call is in progress
+func (bh *BlockChainHookImpl) SetAccountsAdapter(accounts state.AccountsAdapter) error {
+	if check.IfNil(accounts) {
+		return process.ErrNilAccountsAdapter
+	}
+
+	bh.accounts = accounts
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (bh *BlockChainHookImpl) IsInterfaceNil() bool {
 	return bh == nil
@@ -301,7 +301,7 @@ func (sc *scProcessor) deleteSCRsWithValueZeroGoingToMeta(scrs []data.Transactio
 	cleanSCRs := make([]data.TransactionHandler, 0, len(scrs))
 	for _, scr := range scrs {
 		shardID := sc.shardCoordinator.ComputeId(scr.GetRcvAddr())
-		if shardID == core.MetachainShardId && scr.GetGasLimit() == 0 && scr.GetValue().Cmp(zero) == 0 {
+		if shardID == core.MetachainShardId && scr.GetGasLimit() == 0 && scr.GetValue().Cmp(zero) == 0 && !isCallBack(scr) {
 			continue
 		}
 		cleanSCRs = append(cleanSCRs, scr)
@@ -310,6 +310,10 @@ func (sc *scProcessor) deleteSCRsWithValueZeroGoingToMeta(scrs []data.Transactio
 	return cleanSCRs
 }
 
+func isCallBack(tx data.TransactionHandler) bool {
+	return determineCallType(tx) == vmcommon.AsynchronousCallBack
+}
+
 func (sc *scProcessor) saveAccounts(acntSnd, acntDst state.AccountHandler) error {
 	if !check.IfNil(acntSnd) {
 		err := sc.accounts.SaveAccount(acntSnd)
@@ -373,7 +377,7 @@ func (sc *scProcessor) resolveBuiltInFunctions(
 		tx,
 		txHash,
 		acntSnd,
-		vmcommon.DirectCall,
+		vmInput.CallType,
 	)
 	if !check.IfNil(acntSnd) {
 		err = acntSnd.AddToBalance(scrForSender.Value)
@@ -827,6 +831,10 @@ func (sc *scProcessor) createSmartContractResult(
 	result.GasLimit = outAcc.GasLimit
 	result.GasPrice = tx.GetGasPrice()
 	result.PrevTxHash = txHash
+	if outAcc.CallType == vmcommon.AsynchronousCall {
+		// the callbacks are only created by the protocol from the results of the asynchronous calls
+		result.CallType = vmcommon.AsynchronousCall
+	}
 	setOriginalTxHash(result, txHash, tx)
 
 	return result
@@ -2,6 +2,7 @@ package smartContract
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"testing"
@@ -565,6 +566,47 @@ func TestScProcessor_CreateVMCallInput(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestScProcessor_CreateVMCallInputAsyncCallBack(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.ArgsParser = vmcommon.NewAtArgumentParser()
+	sc, _ := NewSmartContractProcessor(arguments)
+
+	scr := &smartContractResult.SmartContractResult{
+		SndAddr:  []byte("SRC"),
+		RcvAddr:  []byte("DST"),
+		Data:     []byte("@6f6b@01"),
+		Value:    big.NewInt(0),
+		CallType: vmcommon.AsynchronousCallBack,
+	}
+
+	input, err := sc.createVMCallInput(scr)
+	require.Nil(t, err)
+	require.Equal(t, "callBack", input.Function)
+	require.Equal(t, vmcommon.AsynchronousCallBack, input.CallType)
+	require.Equal(t, [][]byte{[]byte("ok"), {1}}, input.Arguments)
+}
+
+func TestScProcessor_DeleteSCRsWithValueZeroGoingToMetaShouldKeepCallBacks(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockSmartContractProcessorArguments()
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		return core.MetachainShardId
+	}
+	arguments.Coordinator = shardCoordinator
+	sc, _ := NewSmartContractProcessor(arguments)
+
+	scrWithoutValue := &smartContractResult.SmartContractResult{Value: big.NewInt(0)}
+	scrWithValue := &smartContractResult.SmartContractResult{Value: big.NewInt(1)}
+	callBack := &smartContractResult.SmartContractResult{Value: big.NewInt(0), CallType: vmcommon.AsynchronousCallBack}
+
+	scrs := sc.deleteSCRsWithValueZeroGoingToMeta([]data.TransactionHandler{scrWithoutValue, scrWithValue, callBack})
+	require.Equal(t, []data.TransactionHandler{scrWithValue, callBack}, scrs)
+}
+
 func TestScProcessor_CreateVMDeployBadCode(t *testing.T) {
 	t.Parallel()
 
@@ -1762,3 +1804,66 @@ func TestScProcessor_ProcessSmartContractResultExecuteSC(t *testing.T) {
 	require.Nil(t, err)
 	require.True(t, executeCalled)
 }
+
+func TestScProcessor_ExecuteSmartContractTransactionAsyncBuiltInCallShouldSendCallBack(t *testing.T) {
+	t.Parallel()
+
+	esdtSCAddress := []byte("esdtSCAddress")
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.ArgsParser = vmcommon.NewAtArgumentParser()
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if bytes.Equal(address, esdtSCAddress) {
+			return core.MetachainShardId
+		}
+		return 0
+	}
+	arguments.Coordinator = shardCoordinator
+	arguments.AccountsDB = &mock.AccountsStub{
+		SaveAccountCalled: func(account state.AccountHandler) error {
+			return nil
+		},
+		JournalLenCalled: func() int {
+			return 0
+		},
+	}
+	arguments.EconomicsFee = &mock.FeeHandlerStub{
+		ComputeFeeCalled: func(tx process.TransactionWithFeeHandler) *big.Int {
+			return big.NewInt(0)
+		},
+	}
+	builtInFuncs := builtInFunctions.NewBuiltInFunctionContainer()
+	_ = builtInFuncs.Add(core.BuiltInFunctionESDTBurn, &mock.BuiltInFunctionStub{
+		ProcessBuiltinFunctionCalled: func(acntSnd, acntDst state.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			return nil, process.ErrInsufficientFunds
+		},
+	})
+	arguments.BuiltInFunctions = builtInFuncs
+	var createdSCRs []data.TransactionHandler
+	arguments.ScrForwarder = &mock.IntermediateTransactionHandlerMock{
+		AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+			createdSCRs = append(createdSCRs, txs...)
+			return nil
+		},
+	}
+	sc, _ := NewSmartContractProcessor(arguments)
+
+	holder := []byte("holder")
+	scr := &smartContractResult.SmartContractResult{
+		SndAddr:  esdtSCAddress,
+		RcvAddr:  holder,
+		Data:     []byte(core.BuiltInFunctionESDTBurn + "@746f6b656e@0a"),
+		Value:    big.NewInt(0),
+		CallType: vmcommon.AsynchronousCall,
+	}
+	acntDst, _ := state.NewUserAccount(holder)
+
+	err := sc.ExecuteSmartContractTransaction(scr, nil, acntDst)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(createdSCRs))
+
+	callBack := createdSCRs[0].(*smartContractResult.SmartContractResult)
+	require.Equal(t, esdtSCAddress, callBack.RcvAddr)
+	require.Equal(t, vmcommon.AsynchronousCallBack, callBack.CallType)
+	require.Equal(t, []byte("@"+hex.EncodeToString([]byte(vmcommon.UserError.String()))), callBack.Data)
+}
@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
@@ -14,28 +15,43 @@ import (
 
 var _ process.SCQueryService = (*SCQueryService)(nil)
 
+// ArgsNewSCQueryService defines the arguments needed to create a new SCQueryService
+type ArgsNewSCQueryService struct {
+	VmContainer       process.VirtualMachinesContainer
+	EconomicsFee      process.FeeHandler
+	AccountsHolder    process.AccountsAdapterHolder
+	AccountsRecreator state.AccountsAdapterRecreator
+}
+
 // SCQueryService can execute Get functions over SC to fetch stored values
 type SCQueryService struct {
-	vmContainer  process.VirtualMachinesContainer
-	economicsFee process.FeeHandler
-	mutRunSc     sync.Mutex
+	vmContainer       process.VirtualMachinesContainer
+	economicsFee      process.FeeHandler
+	accountsHolder    process.AccountsAdapterHolder
+	accountsRecreator state.AccountsAdapterRecreator
+	mutRunSc          sync.Mutex
 }
 
 // NewSCQueryService returns a new instance of SCQueryService
-func NewSCQueryService(
-	vmContainer process.VirtualMachinesContainer,
-	economicsFee process.FeeHandler,
-) (*SCQueryService, error) {
-	if check.IfNil(vmContainer) {
+func NewSCQueryService(args ArgsNewSCQueryService) (*SCQueryService, error) {
+	if check.IfNil(args.VmContainer) {
 		return nil, process.ErrNoVM
 	}
-	if check.IfNil(economicsFee) {
+	if check.IfNil(args.EconomicsFee) {
 		return nil, process.ErrNilEconomicsFeeHandler
 	}
+	if check.IfNil(args.AccountsHolder) {
+		return nil, process.ErrNilAccountsAdapterHolder
+	}
+	if check.IfNil(args.AccountsRecreator) {
+		return nil, process.ErrNilAccountsAdapterRecreator
+	}
 
 	return &SCQueryService{
-		vmContainer:  vmContainer,
-		economicsFee: economicsFee,
+		vmContainer:       args.VmContainer,
+		economicsFee:      args.EconomicsFee,
+		accountsHolder:    args.AccountsHolder,
+		accountsRecreator: args.AccountsRecreator,
 	}, nil
 }
 
@@ -51,9 +67,41 @@ func (service *SCQueryService) ExecuteQuery(query *process.SCQuery) (*vmcommon.V
 	service.mutRunSc.Lock()
 	defer service.mutRunSc.Unlock()
 
+	if len(query.RootHash) > 0 {
+		restoreAccounts, err := service.useStateAt(query.RootHash)
+		if err != nil {
+			return nil, err
+		}
+		defer restoreAccounts()
+	}
+
 	return service.executeScCall(query, 0)
 }
 
+// useStateAt points the VMs to the state with the given root hash and returns the function which points
+// them back to the state they used before. Both should be called while holding mutRunSc
+func (service *SCQueryService) useStateAt(rootHash []byte) (func(), error) {
+	accounts, err := service.accountsRecreator.RecreateAccountsAdapter(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	currentAccounts := service.accountsHolder.GetAccountsAdapter()
+	err = service.accountsHolder.SetAccountsAdapter(accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	restoreAccounts := func() {
+		errSet := service.accountsHolder.SetAccountsAdapter(currentAccounts)
+		if errSet != nil {
+			log.Error("SCQueryService: could not restore the current state", "error", errSet.Error())
+		}
+	}
+
+	return restoreAccounts, nil
+}
+
 func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice uint64) (*vmcommon.VMOutput, error) {
 	vm, err := findVMByScAddress(service.vmContainer, query.ScAddress)
 	if err != nil {
@@ -66,6 +114,10 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 		return nil, err
 	}
 
+	if query.KeepFailedOutput {
+		return vmOutput, nil
+	}
+
 	err = service.checkVMOutput(vmOutput)
 	if err != nil {
 		return nil, err
@@ -75,11 +127,25 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 }
 
 func (service *SCQueryService) createVMCallInput(query *process.SCQuery, gasPrice uint64) *vmcommon.ContractCallInput {
+	gasLimit := service.economicsFee.MaxGasLimitPerBlock(0)
+	if query.GasLimit > 0 && query.GasLimit < gasLimit {
+		gasLimit = query.GasLimit
+	}
+
+	callerAddr := query.ScAddress
+	if len(query.CallerAddr) > 0 {
+		callerAddr = query.CallerAddr
+	}
+	callValue := big.NewInt(0)
+	if query.CallValue != nil {
+		callValue = big.NewInt(0).Set(query.CallValue)
+	}
+
 	vmInput := vmcommon.VMInput{
-		CallerAddr:  query.ScAddress,
-		CallValue:   big.NewInt(0),
+		CallerAddr:  callerAddr,
+		CallValue:   callValue,
 		GasPrice:    gasPrice,
-		GasProvided: service.economicsFee.MaxGasLimitPerBlock(0),
+		GasProvided: gasLimit,
 		Arguments:   query.Arguments,
 		CallType:    vmcommon.DirectCall,
 	}
@@ -1,6 +1,7 @@
 package smartContract
 
 import (
+	"errors"
 	"math"
 	"math/big"
 	"sync"
@@ -8,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
@@ -18,10 +20,24 @@ import (
 
 const DummyScAddress = "00000000000000000500fabd9501b7e5353de57a4e319857c2fb99089770720a"
 
+func createMockArgumentsForSCQuery(
+	vmContainer process.VirtualMachinesContainer,
+	economicsFee process.FeeHandler,
+) ArgsNewSCQueryService {
+	return ArgsNewSCQueryService{
+		VmContainer:       vmContainer,
+		EconomicsFee:      economicsFee,
+		AccountsHolder:    &mock.AccountsAdapterHolderStub{},
+		AccountsRecreator: &mock.AccountsAdapterRecreatorStub{},
+	}
+}
+
 func TestNewSCQueryService_NilVmShouldErr(t *testing.T) {
 	t.Parallel()
 
-	target, err := NewSCQueryService(nil, &mock.FeeHandlerStub{})
+	args := createMockArgumentsForSCQuery(&mock.VMContainerMock{}, &mock.FeeHandlerStub{})
+	args.VmContainer = nil
+	target, err := NewSCQueryService(args)
 
 	assert.Nil(t, target)
 	assert.Equal(t, process.ErrNoVM, err)
@@ -30,16 +46,40 @@ func TestNewSCQueryService_NilVmShouldErr(t *testing.T) {
 func TestNewSCQueryService_NilFeeHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
-	target, err := NewSCQueryService(&mock.VMContainerMock{}, nil)
+	args := createMockArgumentsForSCQuery(&mock.VMContainerMock{}, &mock.FeeHandlerStub{})
+	args.EconomicsFee = nil
+	target, err := NewSCQueryService(args)
 
 	assert.Nil(t, target)
 	assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
 }
 
+func TestNewSCQueryService_NilAccountsHolderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForSCQuery(&mock.VMContainerMock{}, &mock.FeeHandlerStub{})
+	args.AccountsHolder = nil
+	target, err := NewSCQueryService(args)
+
+	assert.Nil(t, target)
+	assert.Equal(t, process.ErrNilAccountsAdapterHolder, err)
+}
+
+func TestNewSCQueryService_NilAccountsRecreatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForSCQuery(&mock.VMContainerMock{}, &mock.FeeHandlerStub{})
+	args.AccountsRecreator = nil
+	target, err := NewSCQueryService(args)
+
+	assert.Nil(t, target)
+	assert.Equal(t, process.ErrNilAccountsAdapterRecreator, err)
+}
+
 func TestNewSCQueryService_ShouldWork(t *testing.T) {
 	t.Parallel()
 
-	target, err := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{})
+	target, err := NewSCQueryService(createMockArgumentsForSCQuery(&mock.VMContainerMock{}, &mock.FeeHandlerStub{}))
 
 	assert.NotNil(t, target)
 	assert.Nil(t, err)
@@ -49,7 +89,7 @@ func TestNewSCQueryService_ShouldWork(t *testing.T) {
 func TestExecuteQuery_GetNilAddressShouldErr(t *testing.T) {
 	t.Parallel()
 
-	target, _ := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{})
+	target, _ := NewSCQueryService(createMockArgumentsForSCQuery(&mock.VMContainerMock{}, &mock.FeeHandlerStub{}))
 
 	query := process.SCQuery{
 		ScAddress: nil,
@@ -66,7 +106,7 @@ func TestExecuteQuery_GetNilAddressShouldErr(t *testing.T) {
 func TestExecuteQuery_EmptyFunctionShouldErr(t *testing.T) {
 	t.Parallel()
 
-	target, _ := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{})
+	target, _ := NewSCQueryService(createMockArgumentsForSCQuery(&mock.VMContainerMock{}, &mock.FeeHandlerStub{}))
 
 	query := process.SCQuery{
 		ScAddress: []byte{0},
@@ -102,7 +142,7 @@ func TestExecuteQuery_ShouldReceiveQueryCorrectly(t *testing.T) {
 		},
 	}
 
-	target, _ := NewSCQueryService(
+	target, _ := NewSCQueryService(createMockArgumentsForSCQuery(
 		&mock.VMContainerMock{
 			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
 				return mockVM, nil
@@ -113,7 +153,7 @@ func TestExecuteQuery_ShouldReceiveQueryCorrectly(t *testing.T) {
 				return uint64(math.MaxUint64)
 			},
 		},
-	)
+	))
 
 	dataArgs := make([][]byte, len(args))
 	for i, arg := range args {
@@ -129,6 +169,62 @@ func TestExecuteQuery_ShouldReceiveQueryCorrectly(t *testing.T) {
 	assert.True(t, runWasCalled)
 }
 
+func TestExecuteQuery_ShouldUseCallerValueAndGasLimit(t *testing.T) {
+	t.Parallel()
+
+	maxGasLimit := uint64(10000)
+	callerAddr := []byte("caller")
+	var receivedInput *vmcommon.ContractCallInput
+	mockVM := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+			receivedInput = input
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.Ok,
+			}, nil
+		},
+	}
+
+	target, _ := NewSCQueryService(createMockArgumentsForSCQuery(
+		&mock.VMContainerMock{
+			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+				return mockVM, nil
+			},
+		},
+		&mock.FeeHandlerStub{
+			MaxGasLimitPerBlockCalled: func() uint64 {
+				return maxGasLimit
+			},
+		},
+	))
+
+	query := process.SCQuery{
+		ScAddress:  []byte(DummyScAddress),
+		FuncName:   "function",
+		CallerAddr: callerAddr,
+		CallValue:  big.NewInt(37),
+		GasLimit:   500,
+	}
+	_, err := target.ExecuteQuery(&query)
+
+	assert.Nil(t, err)
+	assert.Equal(t, callerAddr, receivedInput.CallerAddr)
+	assert.Equal(t, big.NewInt(37), receivedInput.CallValue)
+	assert.Equal(t, uint64(500), receivedInput.GasProvided)
+
+	query.GasLimit = maxGasLimit + 1
+	_, err = target.ExecuteQuery(&query)
+
+	assert.Nil(t, err)
+	assert.Equal(t, maxGasLimit, receivedInput.GasProvided)
+	assert.Equal(t, maxGasLimit+1, query.GasLimit)
+
+	query.GasLimit = 0
+	_, err = target.ExecuteQuery(&query)
+
+	assert.Nil(t, err)
+	assert.Equal(t, maxGasLimit, receivedInput.GasProvided)
+}
+
 func TestExecuteQuery_ReturnsCorrectly(t *testing.T) {
 	t.Parallel()
 
@@ -143,7 +239,7 @@ func TestExecuteQuery_ReturnsCorrectly(t *testing.T) {
 		},
 	}
 
-	target, _ := NewSCQueryService(
+	target, _ := NewSCQueryService(createMockArgumentsForSCQuery(
 		&mock.VMContainerMock{
 			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
 				return mockVM, nil
@@ -154,7 +250,7 @@ func TestExecuteQuery_ReturnsCorrectly(t *testing.T) {
 				return uint64(math.MaxUint64)
 			},
 		},
-	)
+	))
 
 	query := process.SCQuery{
 		ScAddress: []byte(DummyScAddress),
@@ -179,7 +275,7 @@ func TestExecuteQuery_WhenNotOkCodeShouldErr(t *testing.T) {
 			}, nil
 		},
 	}
-	target, _ := NewSCQueryService(
+	target, _ := NewSCQueryService(createMockArgumentsForSCQuery(
 		&mock.VMContainerMock{
 			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
 				return mockVM, nil
@@ -190,7 +286,7 @@ func TestExecuteQuery_WhenNotOkCodeShouldErr(t *testing.T) {
 				return uint64(math.MaxUint64)
 			},
 		},
-	)
+	))
 
 	query := process.SCQuery{
 		ScAddress: []byte(DummyScAddress),
@@ -205,6 +301,44 @@ func TestExecuteQuery_WhenNotOkCodeShouldErr(t *testing.T) {
 	assert.Nil(t, returnedData)
 }
 
+func TestExecuteQuery_WhenNotOkCodeAndKeepFailedOutputShouldReturnOutput(t *testing.T) {
+	t.Parallel()
+
+	mockVM := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+			return &vmcommon.VMOutput{
+				ReturnCode:    vmcommon.UserError,
+				ReturnMessage: "user error",
+			}, nil
+		},
+	}
+	target, _ := NewSCQueryService(createMockArgumentsForSCQuery(
+		&mock.VMContainerMock{
+			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+				return mockVM, nil
+			},
+		},
+		&mock.FeeHandlerStub{
+			MaxGasLimitPerBlockCalled: func() uint64 {
+				return uint64(math.MaxUint64)
+			},
+		},
+	))
+
+	query := process.SCQuery{
+		ScAddress:        []byte(DummyScAddress),
+		FuncName:         "function",
+		Arguments:        [][]byte{},
+		KeepFailedOutput: true,
+	}
+
+	returnedData, err := target.ExecuteQuery(&query)
+
+	assert.Nil(t, err)
+	assert.Equal(t, vmcommon.UserError, returnedData.ReturnCode)
+	assert.Equal(t, "user error", returnedData.ReturnMessage)
+}
+
 func TestExecuteQuery_ShouldCallRunScSequentially(t *testing.T) {
 	t.Parallel()
 
@@ -226,7 +360,7 @@ func TestExecuteQuery_ShouldCallRunScSequentially(t *testing.T) {
 		},
 	}
 
-	target, _ := NewSCQueryService(
+	target, _ := NewSCQueryService(createMockArgumentsForSCQuery(
 		&mock.VMContainerMock{
 			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
 				return mockVM, nil
@@ -237,7 +371,7 @@ func TestExecuteQuery_ShouldCallRunScSequentially(t *testing.T) {
 				return uint64(math.MaxUint64)
 			},
 		},
-	)
+	))
 
 	noOfGoRoutines := 50
 	wg := sync.WaitGroup{}
@@ -271,7 +405,7 @@ func TestSCQueryService_ComputeTxCostScCall(t *testing.T) {
 		},
 	}
 
-	target, _ := NewSCQueryService(
+	target, _ := NewSCQueryService(createMockArgumentsForSCQuery(
 		&mock.VMContainerMock{
 			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
 				return mockVM, nil
@@ -282,7 +416,7 @@ func TestSCQueryService_ComputeTxCostScCall(t *testing.T) {
 				return uint64(math.MaxUint64)
 			},
 		},
-	)
+	))
 
 	tx := &transaction.Transaction{
 		RcvAddr: []byte(DummyScAddress),
@@ -292,3 +426,93 @@ func TestSCQueryService_ComputeTxCostScCall(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, consumedGas, cost)
 }
+
+func TestExecuteQuery_WithRootHashShouldRunOnPastStateAndRestoreCurrentState(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	currentAccounts := &mock.AccountsStub{}
+	pastAccounts := &mock.AccountsStub{}
+	var usedAccounts state.AccountsAdapter = currentAccounts
+	accountsHolder := &mock.AccountsAdapterHolderStub{
+		GetAccountsAdapterCalled: func() state.AccountsAdapter {
+			return usedAccounts
+		},
+		SetAccountsAdapterCalled: func(accounts state.AccountsAdapter) error {
+			usedAccounts = accounts
+			return nil
+		},
+	}
+
+	mockVM := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+			assert.True(t, usedAccounts == pastAccounts)
+
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.Ok,
+			}, nil
+		},
+	}
+	args := createMockArgumentsForSCQuery(
+		&mock.VMContainerMock{
+			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+				return mockVM, nil
+			},
+		},
+		&mock.FeeHandlerStub{
+			MaxGasLimitPerBlockCalled: func() uint64 {
+				return uint64(math.MaxUint64)
+			},
+		},
+	)
+	args.AccountsHolder = accountsHolder
+	args.AccountsRecreator = &mock.AccountsAdapterRecreatorStub{
+		RecreateAccountsAdapterCalled: func(hash []byte) (state.AccountsAdapter, error) {
+			assert.Equal(t, rootHash, hash)
+			return pastAccounts, nil
+		},
+	}
+	target, _ := NewSCQueryService(args)
+
+	query := process.SCQuery{
+		ScAddress: []byte(DummyScAddress),
+		FuncName:  "function",
+		RootHash:  rootHash,
+	}
+	_, err := target.ExecuteQuery(&query)
+
+	assert.Nil(t, err)
+	assert.True(t, usedAccounts == currentAccounts)
+}
+
+func TestExecuteQuery_WithRootHashStateNotAvailableShouldErr(t *testing.T) {
+	t.Parallel()
+
+	runWasCalled := false
+	mockVM := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+			runWasCalled = true
+			return &vmcommon.VMOutput{}, nil
+		},
+	}
+	args := createMockArgumentsForSCQuery(
+		&mock.VMContainerMock{
+			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+				return mockVM, nil
+			},
+		},
+		&mock.FeeHandlerStub{},
+	)
+	target, _ := NewSCQueryService(args)
+
+	query := process.SCQuery{
+		ScAddress: []byte(DummyScAddress),
+		FuncName:  "function",
+		RootHash:  []byte("root hash"),
+	}
+	output, err := target.ExecuteQuery(&query)
+
+	assert.Nil(t, output)
+	assert.True(t, errors.Is(err, state.ErrStateNotAvailable))
+	assert.False(t, runWasCalled)
+}
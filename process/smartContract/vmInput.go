@@ -73,6 +73,12 @@ func (sc *scProcessor) prepareGasProvided(tx data.TransactionHandler) (uint64, e
 	if sc.shardCoordinator.ComputeId(tx.GetSndAddr()) == core.MetachainShardId {
 		return tx.GetGasLimit(), nil
 	}
+	isCallBackToSystemSC := sc.shardCoordinator.SelfId() == core.MetachainShardId &&
+		determineCallType(tx) == vmcommon.AsynchronousCallBack
+	if isCallBackToSystemSC {
+		// the system smart contracts do not provide gas to their asynchronous calls, so the callbacks come without it
+		return tx.GetGasLimit(), nil
+	}
 
 	gasForTxData := sc.economicsFee.ComputeGasLimit(tx)
 	if tx.GetGasLimit() < gasForTxData {
@@ -105,7 +111,6 @@ func (sc *scProcessor) createVMCallInput(tx data.TransactionHandler) (*vmcommon.
 	}
 
 	vmCallInput := &vmcommon.ContractCallInput{}
-	vmCallInput.CallType = callType
 	vmCallInput.RecipientAddr = tx.GetRcvAddr()
 	vmCallInput.Function, err = sc.argsParser.GetFunction()
 	if err != nil {
@@ -117,6 +122,7 @@ func (sc *scProcessor) createVMCallInput(tx data.TransactionHandler) (*vmcommon.
 	if err != nil {
 		return nil, err
 	}
+	vmCallInput.CallType = callType
 
 	vmCallInput.VMInput.Arguments, err = sc.argsParser.GetFunctionArguments()
 	if err != nil {
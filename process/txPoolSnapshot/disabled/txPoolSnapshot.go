@@ -0,0 +1,34 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go/process/txPoolSnapshot"
+)
+
+var _ txPoolSnapshot.Snapshotter = (*txPoolSnapshotter)(nil)
+
+type txPoolSnapshotter struct {
+}
+
+// NewDisabledTxPoolSnapshot returns a snapshotter used when the transactions pool snapshot is disabled
+func NewDisabledTxPoolSnapshot() *txPoolSnapshotter {
+	return new(txPoolSnapshotter)
+}
+
+// LoadSnapshot does nothing
+func (tps *txPoolSnapshotter) LoadSnapshot() error {
+	return nil
+}
+
+// StartSnapshotting does nothing
+func (tps *txPoolSnapshotter) StartSnapshotting() {
+}
+
+// Close does nothing
+func (tps *txPoolSnapshotter) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tps *txPoolSnapshotter) IsInterfaceNil() bool {
+	return tps == nil
+}
@@ -0,0 +1,20 @@
+package txPoolSnapshot
+
+import (
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+)
+
+// Snapshotter defines the component which persists the pending transactions across restarts
+type Snapshotter interface {
+	LoadSnapshot() error
+	StartSnapshotting()
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// TxPool defines the transactions pool whose content is saved in the snapshots
+type TxPool interface {
+	AddData(key []byte, data interface{}, sizeInBytes int, cacheId string)
+	ForEachTransaction(function txcache.ForEachTransaction)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,302 @@
+package txPoolSnapshot
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/close"
+	"github.com/ElrondNetwork/elrond-go/data/batch"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+)
+
+var _ Snapshotter = (*txPoolSnapshot)(nil)
+var _ close.Closer = (*txPoolSnapshot)(nil)
+
+var log = logger.GetOrCreate("process/txPoolSnapshot")
+
+// snapshotIndexKey is the key holding the number of chunks of the last snapshot
+var snapshotIndexKey = []byte("txPoolSnapshotIndex")
+
+const chunkKeyPrefix = "txPoolSnapshotChunk_"
+
+// numTxsPerChunk is the number of transactions saved under a single key of the storer
+const numTxsPerChunk = 1000
+
+// ArgsTxPoolSnapshot defines the arguments needed for creating the transactions pool snapshot
+type ArgsTxPoolSnapshot struct {
+	TxPool           TxPool
+	Storer           storage.Storer
+	Marshalizer      marshal.Marshalizer
+	Hasher           hashing.Hasher
+	ShardCoordinator sharding.Coordinator
+	// TxValidator revalidates the loaded transactions against the current state of the accounts
+	TxValidator      process.TxValidator
+	FeeHandler       process.FeeHandler
+	SnapshotInterval time.Duration
+}
+
+type txPoolSnapshot struct {
+	txPool           TxPool
+	storer           storage.Storer
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+	shardCoordinator sharding.Coordinator
+	txValidator      process.TxValidator
+	feeHandler       process.FeeHandler
+	snapshotInterval time.Duration
+
+	mutSnapshot sync.Mutex
+	numChunks   int
+	cancelFunc  func()
+}
+
+// NewTxPoolSnapshot creates a component which saves the transactions of the pool in a storer, periodically and on close,
+// and loads them back, dropping the ones which are not valid anymore
+func NewTxPoolSnapshot(args ArgsTxPoolSnapshot) (*txPoolSnapshot, error) {
+	if check.IfNil(args.TxPool) {
+		return nil, process.ErrNilTransactionPool
+	}
+	if check.IfNil(args.Storer) {
+		return nil, process.ErrNilStore
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, process.ErrNilHasher
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.TxValidator) {
+		return nil, process.ErrNilTxValidator
+	}
+	if check.IfNil(args.FeeHandler) {
+		return nil, process.ErrNilEconomicsFeeHandler
+	}
+	if args.SnapshotInterval <= 0 {
+		return nil, fmt.Errorf("%w for SnapshotInterval", process.ErrInvalidValue)
+	}
+
+	return &txPoolSnapshot{
+		txPool:           args.TxPool,
+		storer:           args.Storer,
+		marshalizer:      args.Marshalizer,
+		hasher:           args.Hasher,
+		shardCoordinator: args.ShardCoordinator,
+		txValidator:      args.TxValidator,
+		feeHandler:       args.FeeHandler,
+		snapshotInterval: args.SnapshotInterval,
+	}, nil
+}
+
+// LoadSnapshot adds the transactions of the last snapshot to the pool. It should be called once the accounts reflect
+// the last committed block, since the transactions which are not valid anymore (e.g. already executed, or whose
+// sender cannot pay the fee) are dropped.
+func (tps *txPoolSnapshot) LoadSnapshot() error {
+	tps.mutSnapshot.Lock()
+	defer tps.mutSnapshot.Unlock()
+
+	indexBuff, err := tps.storer.Get(snapshotIndexKey)
+	if err != nil {
+		log.Debug("txPoolSnapshot.LoadSnapshot: no snapshot to load")
+		return nil
+	}
+
+	numChunks, err := strconv.Atoi(string(indexBuff))
+	if err != nil {
+		return err
+	}
+
+	tps.numChunks = numChunks
+	numLoaded := 0
+	numDropped := 0
+
+	for i := 0; i < numChunks; i++ {
+		chunk, errGet := tps.getChunk(i)
+		if errGet != nil {
+			log.Debug("txPoolSnapshot.LoadSnapshot", "chunk", i, "error", errGet.Error())
+			continue
+		}
+
+		for _, txBuff := range chunk.Data {
+			errRestore := tps.restoreTransaction(txBuff)
+			if errRestore != nil {
+				log.Trace("txPoolSnapshot.LoadSnapshot: transaction dropped", "error", errRestore.Error())
+				numDropped++
+				continue
+			}
+
+			numLoaded++
+		}
+	}
+
+	log.Info("transactions pool snapshot loaded", "num txs", numLoaded, "num dropped", numDropped)
+
+	return nil
+}
+
+func (tps *txPoolSnapshot) getChunk(index int) (*batch.Batch, error) {
+	buff, err := tps.storer.Get(chunkKey(index))
+	if err != nil {
+		return nil, err
+	}
+
+	chunk := &batch.Batch{}
+	err = tps.marshalizer.Unmarshal(chunk, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return chunk, nil
+}
+
+func (tps *txPoolSnapshot) restoreTransaction(txBuff []byte) error {
+	tx := &transaction.Transaction{}
+	err := tps.marshalizer.Unmarshal(tx, txBuff)
+	if err != nil {
+		return err
+	}
+
+	senderShardID := tps.shardCoordinator.ComputeId(tx.SndAddr)
+	receiverShardID := tps.shardCoordinator.ComputeId(tx.RcvAddr)
+	selfShardID := tps.shardCoordinator.SelfId()
+	if senderShardID != selfShardID && receiverShardID != selfShardID {
+		return process.ErrTxNotForCurrentShard
+	}
+
+	err = tps.txValidator.CheckTxValidity(&txToValidate{
+		tx:              tx,
+		senderShardID:   senderShardID,
+		receiverShardID: receiverShardID,
+		fee:             tps.feeHandler.ComputeFee(tx),
+	})
+	if err != nil {
+		return err
+	}
+
+	txHash := tps.hasher.Compute(string(txBuff))
+	cacheID := process.ShardCacherIdentifier(senderShardID, receiverShardID)
+	tps.txPool.AddData(txHash, tx, tx.Size(), cacheID)
+
+	return nil
+}
+
+// StartSnapshotting starts saving the transactions of the pool periodically
+func (tps *txPoolSnapshot) StartSnapshotting() {
+	var ctx context.Context
+	ctx, tps.cancelFunc = context.WithCancel(context.Background())
+	go tps.snapshotPeriodically(ctx)
+}
+
+func (tps *txPoolSnapshot) snapshotPeriodically(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("txPoolSnapshot's go routine is stopping...")
+			return
+		case <-time.After(tps.snapshotInterval):
+		}
+
+		err := tps.TakeSnapshot()
+		if err != nil {
+			log.Warn("txPoolSnapshot.TakeSnapshot", "error", err.Error())
+		}
+	}
+}
+
+// TakeSnapshot saves the transactions of the pool, replacing the previous snapshot
+func (tps *txPoolSnapshot) TakeSnapshot() error {
+	tps.mutSnapshot.Lock()
+	defer tps.mutSnapshot.Unlock()
+
+	chunks, numTxs, err := tps.createChunks()
+	if err != nil {
+		return err
+	}
+
+	for i, chunk := range chunks {
+		buff, errMarshal := tps.marshalizer.Marshal(chunk)
+		if errMarshal != nil {
+			return errMarshal
+		}
+
+		err = tps.storer.Put(chunkKey(i), buff)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tps.storer.Put(snapshotIndexKey, []byte(strconv.Itoa(len(chunks))))
+	if err != nil {
+		return err
+	}
+
+	for i := len(chunks); i < tps.numChunks; i++ {
+		errRemove := tps.storer.Remove(chunkKey(i))
+		log.LogIfError(errRemove)
+	}
+	tps.numChunks = len(chunks)
+
+	log.Debug("txPoolSnapshot.TakeSnapshot", "num txs", numTxs, "num chunks", len(chunks))
+
+	return nil
+}
+
+// createChunks marshals the transactions of the pool and groups them in chunks. Only the regular transactions are
+// saved, the smart contract results and the rewards being produced again by the protocol.
+func (tps *txPoolSnapshot) createChunks() ([]*batch.Batch, int, error) {
+	txs := make([]*transaction.Transaction, 0)
+	tps.txPool.ForEachTransaction(func(_ []byte, wrappedTx *txcache.WrappedTransaction) {
+		tx, ok := wrappedTx.Tx.(*transaction.Transaction)
+		if ok {
+			txs = append(txs, tx)
+		}
+	})
+
+	chunks := make([]*batch.Batch, 0, len(txs)/numTxsPerChunk+1)
+	for i, tx := range txs {
+		if i%numTxsPerChunk == 0 {
+			chunks = append(chunks, &batch.Batch{Data: make([][]byte, 0, numTxsPerChunk)})
+		}
+
+		txBuff, err := tps.marshalizer.Marshal(tx)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		lastChunk := chunks[len(chunks)-1]
+		lastChunk.Data = append(lastChunk.Data, txBuff)
+	}
+
+	return chunks, len(txs), nil
+}
+
+// Close stops the periodic snapshots and saves a last snapshot of the pool
+func (tps *txPoolSnapshot) Close() error {
+	if tps.cancelFunc != nil {
+		tps.cancelFunc()
+	}
+
+	return tps.TakeSnapshot()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tps *txPoolSnapshot) IsInterfaceNil() bool {
+	return tps == nil
+}
+
+func chunkKey(index int) []byte {
+	return []byte(chunkKeyPrefix + strconv.Itoa(index))
+}
@@ -0,0 +1,269 @@
+package txPoolSnapshot_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/txpool"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/txPoolSnapshot"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgs(pool txPoolSnapshot.TxPool, storer *mock.StorerMock) txPoolSnapshot.ArgsTxPoolSnapshot {
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if bytes.HasSuffix(address, []byte("-shard1")) {
+			return 1
+		}
+		return 0
+	}
+
+	return txPoolSnapshot.ArgsTxPoolSnapshot{
+		TxPool:           pool,
+		Storer:           storer,
+		Marshalizer:      &mock.MarshalizerMock{},
+		Hasher:           &mock.HasherMock{},
+		ShardCoordinator: shardCoordinator,
+		TxValidator: &mock.TxValidatorStub{
+			CheckTxValidityCalled: func(_ process.TxValidatorHandler) error {
+				return nil
+			},
+		},
+		FeeHandler:       &mock.FeeHandlerStub{},
+		SnapshotInterval: time.Minute,
+	}
+}
+
+type txPoolToTest interface {
+	dataRetriever.ShardedDataCacherNotifier
+	ForEachTransaction(function txcache.ForEachTransaction)
+	GetCachesStatistics() []txcache.CacheStatistics
+}
+
+func createTxPool(t *testing.T) txPoolToTest {
+	pool, err := txpool.NewShardedTxPool(txpool.ArgShardedTxPool{
+		Config: storageUnit.CacheConfig{
+			Capacity:             10000,
+			SizePerSender:        10000,
+			SizeInBytes:          10485760,
+			SizeInBytesPerSender: 10485760,
+			Shards:               1,
+		},
+		MinGasPrice:    200000000000,
+		NumberOfShards: 2,
+		SelfShardID:    0,
+	})
+	require.Nil(t, err)
+
+	return pool.(txPoolToTest)
+}
+
+func addTx(pool txPoolToTest, sender string, receiver string, nonce uint64, cacheID string) {
+	tx := &transaction.Transaction{
+		Nonce:    nonce,
+		SndAddr:  []byte(sender),
+		RcvAddr:  []byte(receiver),
+		GasPrice: 200000000000,
+		GasLimit: 50000,
+	}
+	txHash := []byte(fmt.Sprintf("%s-%d", sender, nonce))
+	pool.AddData(txHash, tx, tx.Size(), cacheID)
+}
+
+func countTxs(pool txPoolSnapshot.TxPool) int {
+	numTxs := 0
+	pool.ForEachTransaction(func(_ []byte, _ *txcache.WrappedTransaction) {
+		numTxs++
+	})
+
+	return numTxs
+}
+
+func TestNewTxPoolSnapshot_NilTxPoolShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(nil, mock.NewStorerMock())
+	tps, err := txPoolSnapshot.NewTxPoolSnapshot(args)
+
+	assert.Nil(t, tps)
+	assert.Equal(t, process.ErrNilTransactionPool, err)
+}
+
+func TestNewTxPoolSnapshot_NilStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(createTxPool(t), nil)
+	args.Storer = nil
+	tps, err := txPoolSnapshot.NewTxPoolSnapshot(args)
+
+	assert.Nil(t, tps)
+	assert.Equal(t, process.ErrNilStore, err)
+}
+
+func TestNewTxPoolSnapshot_NilTxValidatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(createTxPool(t), mock.NewStorerMock())
+	args.TxValidator = nil
+	tps, err := txPoolSnapshot.NewTxPoolSnapshot(args)
+
+	assert.Nil(t, tps)
+	assert.Equal(t, process.ErrNilTxValidator, err)
+}
+
+func TestNewTxPoolSnapshot_InvalidSnapshotIntervalShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(createTxPool(t), mock.NewStorerMock())
+	args.SnapshotInterval = 0
+	tps, err := txPoolSnapshot.NewTxPoolSnapshot(args)
+
+	assert.Nil(t, tps)
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+}
+
+func TestNewTxPoolSnapshot_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	tps, err := txPoolSnapshot.NewTxPoolSnapshot(createMockArgs(createTxPool(t), mock.NewStorerMock()))
+
+	assert.Nil(t, err)
+	assert.False(t, tps.IsInterfaceNil())
+}
+
+func TestTxPoolSnapshot_LoadSnapshotWithoutSnapshotShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pool := createTxPool(t)
+	tps, _ := txPoolSnapshot.NewTxPoolSnapshot(createMockArgs(pool, mock.NewStorerMock()))
+
+	err := tps.LoadSnapshot()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 0, countTxs(pool))
+}
+
+func TestTxPoolSnapshot_LoadSnapshotShouldRestoreTheValidTransactions(t *testing.T) {
+	t.Parallel()
+
+	storer := mock.NewStorerMock()
+	pool := createTxPool(t)
+	addTx(pool, "alice", "bob", 1, "0")
+	addTx(pool, "alice", "bob", 2, "0")
+	addTx(pool, "alice", "carol-shard1", 3, "0_1")
+	addTx(pool, "dave-shard1", "bob", 7, "1_0")
+
+	tps, _ := txPoolSnapshot.NewTxPoolSnapshot(createMockArgs(pool, storer))
+	err := tps.TakeSnapshot()
+	require.Nil(t, err)
+
+	errStale := errors.New("stale transaction")
+	restoredPool := createTxPool(t)
+	args := createMockArgs(restoredPool, storer)
+	args.TxValidator = &mock.TxValidatorStub{
+		CheckTxValidityCalled: func(txValidatorHandler process.TxValidatorHandler) error {
+			if bytes.Equal(txValidatorHandler.SenderAddress(), []byte("alice")) && txValidatorHandler.Nonce() == 1 {
+				return errStale
+			}
+			return nil
+		},
+	}
+	restoredTps, _ := txPoolSnapshot.NewTxPoolSnapshot(args)
+	err = restoredTps.LoadSnapshot()
+	require.Nil(t, err)
+
+	assert.Equal(t, 3, countTxs(restoredPool))
+	_, ok := restoredPool.SearchFirstData([]byte("alice-1"))
+	assert.False(t, ok)
+	// the hashes are recomputed from the saved transactions
+	restoredPool.ForEachTransaction(func(txHash []byte, tx *txcache.WrappedTransaction) {
+		buff, _ := args.Marshalizer.Marshal(tx.Tx)
+		assert.Equal(t, args.Hasher.Compute(string(buff)), txHash)
+	})
+
+	statistics := restoredPool.GetCachesStatistics()
+	require.Len(t, statistics, 2)
+	assert.Equal(t, "0", statistics[0].Name)
+	assert.Equal(t, uint64(2), statistics[0].NumTxs)
+	assert.Equal(t, "1_0", statistics[1].Name)
+	assert.Equal(t, uint64(1), statistics[1].NumTxs)
+}
+
+func TestTxPoolSnapshot_LoadSnapshotShouldDropTransactionsNotRelatedToTheShard(t *testing.T) {
+	t.Parallel()
+
+	storer := mock.NewStorerMock()
+	pool := createTxPool(t)
+	addTx(pool, "dave-shard1", "erin-shard1", 1, "1")
+
+	tps, _ := txPoolSnapshot.NewTxPoolSnapshot(createMockArgs(pool, storer))
+	err := tps.TakeSnapshot()
+	require.Nil(t, err)
+
+	restoredPool := createTxPool(t)
+	restoredTps, _ := txPoolSnapshot.NewTxPoolSnapshot(createMockArgs(restoredPool, storer))
+	err = restoredTps.LoadSnapshot()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 0, countTxs(restoredPool))
+}
+
+func TestTxPoolSnapshot_TakeSnapshotShouldReplaceThePreviousOne(t *testing.T) {
+	t.Parallel()
+
+	storer := mock.NewStorerMock()
+	pool := createTxPool(t)
+	for nonce := uint64(0); nonce < 2500; nonce++ {
+		addTx(pool, "alice", "bob", nonce, "0")
+	}
+
+	tps, _ := txPoolSnapshot.NewTxPoolSnapshot(createMockArgs(pool, storer))
+	err := tps.TakeSnapshot()
+	require.Nil(t, err)
+	_, err = storer.Get([]byte("txPoolSnapshotChunk_2"))
+	assert.Nil(t, err)
+
+	pool.Clear()
+	addTx(pool, "alice", "bob", 2500, "0")
+	err = tps.TakeSnapshot()
+	require.Nil(t, err)
+	_, err = storer.Get([]byte("txPoolSnapshotChunk_1"))
+	assert.NotNil(t, err)
+	_, err = storer.Get([]byte("txPoolSnapshotChunk_2"))
+	assert.NotNil(t, err)
+
+	restoredPool := createTxPool(t)
+	restoredTps, _ := txPoolSnapshot.NewTxPoolSnapshot(createMockArgs(restoredPool, storer))
+	err = restoredTps.LoadSnapshot()
+	require.Nil(t, err)
+	assert.Equal(t, 1, countTxs(restoredPool))
+}
+
+func TestTxPoolSnapshot_CloseShouldTakeSnapshot(t *testing.T) {
+	t.Parallel()
+
+	storer := mock.NewStorerMock()
+	pool := createTxPool(t)
+	addTx(pool, "alice", "bob", 1, "0")
+
+	tps, _ := txPoolSnapshot.NewTxPoolSnapshot(createMockArgs(pool, storer))
+	tps.StartSnapshotting()
+	err := tps.Close()
+	require.Nil(t, err)
+
+	restoredPool := createTxPool(t)
+	restoredTps, _ := txPoolSnapshot.NewTxPoolSnapshot(createMockArgs(restoredPool, storer))
+	err = restoredTps.LoadSnapshot()
+	require.Nil(t, err)
+	assert.Equal(t, 1, countTxs(restoredPool))
+}
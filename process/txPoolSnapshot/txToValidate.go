@@ -0,0 +1,44 @@
+package txPoolSnapshot
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.TxValidatorHandler = (*txToValidate)(nil)
+
+// txToValidate exposes a transaction read from the snapshot the same way an intercepted transaction is exposed,
+// so that it can be checked by the transactions validator used by the interceptors
+type txToValidate struct {
+	tx              *transaction.Transaction
+	senderShardID   uint32
+	receiverShardID uint32
+	fee             *big.Int
+}
+
+// SenderShardId returns the shard of the sender
+func (ttv *txToValidate) SenderShardId() uint32 {
+	return ttv.senderShardID
+}
+
+// ReceiverShardId returns the shard of the receiver
+func (ttv *txToValidate) ReceiverShardId() uint32 {
+	return ttv.receiverShardID
+}
+
+// Nonce returns the nonce of the transaction
+func (ttv *txToValidate) Nonce() uint64 {
+	return ttv.tx.Nonce
+}
+
+// SenderAddress returns the address of the sender
+func (ttv *txToValidate) SenderAddress() []byte {
+	return ttv.tx.SndAddr
+}
+
+// Fee returns the fee of the transaction
+func (ttv *txToValidate) Fee() *big.Int {
+	return ttv.fee
+}
@@ -0,0 +1,82 @@
+package txsimulator
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// accountsTracker wraps the accounts adapter a simulation runs on. It remembers, in order, the addresses of the
+// saved or removed accounts and refuses to commit, so the simulated changes never reach the trie storage
+type accountsTracker struct {
+	state.AccountsAdapter
+	mutAddresses sync.Mutex
+	addresses    [][]byte
+	seen         map[string]struct{}
+}
+
+func newAccountsTracker(accounts state.AccountsAdapter) *accountsTracker {
+	return &accountsTracker{
+		AccountsAdapter: accounts,
+		addresses:       make([][]byte, 0),
+		seen:            make(map[string]struct{}),
+	}
+}
+
+// SaveAccount saves the account in the wrapped accounts adapter and marks its address as touched
+func (at *accountsTracker) SaveAccount(account state.AccountHandler) error {
+	err := at.AccountsAdapter.SaveAccount(account)
+	if err != nil {
+		return err
+	}
+
+	at.addAddress(account.AddressBytes())
+
+	return nil
+}
+
+// RemoveAccount removes the account from the wrapped accounts adapter and marks its address as touched
+func (at *accountsTracker) RemoveAccount(address []byte) error {
+	err := at.AccountsAdapter.RemoveAccount(address)
+	if err != nil {
+		return err
+	}
+
+	at.addAddress(address)
+
+	return nil
+}
+
+// Commit returns ErrCommitNotAllowed as a simulated state is always discarded
+func (at *accountsTracker) Commit() ([]byte, error) {
+	return nil, process.ErrCommitNotAllowed
+}
+
+func (at *accountsTracker) addAddress(address []byte) {
+	at.mutAddresses.Lock()
+	defer at.mutAddresses.Unlock()
+
+	_, found := at.seen[string(address)]
+	if found {
+		return
+	}
+
+	at.seen[string(address)] = struct{}{}
+	at.addresses = append(at.addresses, address)
+}
+
+func (at *accountsTracker) touchedAddresses() [][]byte {
+	at.mutAddresses.Lock()
+	defer at.mutAddresses.Unlock()
+
+	addresses := make([][]byte, len(at.addresses))
+	copy(addresses, at.addresses)
+
+	return addresses
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (at *accountsTracker) IsInterfaceNil() bool {
+	return at == nil
+}
@@ -0,0 +1,25 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+type txSimulator struct {
+}
+
+// NewTxSimulator returns a transaction simulator for nodes which can not simulate transactions, like the
+// metachain nodes
+func NewTxSimulator() *txSimulator {
+	return &txSimulator{}
+}
+
+// SimulateTransaction returns ErrTransactionSimulationNotSupported
+func (ts *txSimulator) SimulateTransaction(_ *transaction.Transaction) (*transaction.SimulationResults, error) {
+	return nil, process.ErrTransactionSimulationNotSupported
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ts *txSimulator) IsInterfaceNil() bool {
+	return ts == nil
+}
@@ -0,0 +1,84 @@
+package txsimulator
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.IntermediateTransactionHandler = (*resultsCollector)(nil)
+
+// resultsCollector replaces the intermediate results processors during a simulation. It only keeps, in order,
+// the results it receives, as no miniblock is ever created out of them
+type resultsCollector struct {
+	mutResults sync.Mutex
+	results    []data.TransactionHandler
+}
+
+func newResultsCollector() *resultsCollector {
+	return &resultsCollector{
+		results: make([]data.TransactionHandler, 0),
+	}
+}
+
+// AddIntermediateTransactions keeps the provided results
+func (rc *resultsCollector) AddIntermediateTransactions(txs []data.TransactionHandler) error {
+	rc.mutResults.Lock()
+	rc.results = append(rc.results, txs...)
+	rc.mutResults.Unlock()
+
+	return nil
+}
+
+// CreateAllInterMiniBlocks returns nil as no miniblock is created during a simulation
+func (rc *resultsCollector) CreateAllInterMiniBlocks() []*block.MiniBlock {
+	return nil
+}
+
+// VerifyInterMiniBlocks returns nil as no miniblock is verified during a simulation
+func (rc *resultsCollector) VerifyInterMiniBlocks(_ *block.Body) error {
+	return nil
+}
+
+// SaveCurrentIntermediateTxToStorage returns nil as the results of a simulation are never saved
+func (rc *resultsCollector) SaveCurrentIntermediateTxToStorage() error {
+	return nil
+}
+
+// GetAllCurrentFinishedTxs returns an empty map as no result is finished during a simulation
+func (rc *resultsCollector) GetAllCurrentFinishedTxs() map[string]data.TransactionHandler {
+	return make(map[string]data.TransactionHandler)
+}
+
+// CreateBlockStarted removes the kept results
+func (rc *resultsCollector) CreateBlockStarted() {
+	rc.mutResults.Lock()
+	rc.results = make([]data.TransactionHandler, 0)
+	rc.mutResults.Unlock()
+}
+
+// GetCreatedInShardMiniBlock returns nil as no miniblock is created during a simulation
+func (rc *resultsCollector) GetCreatedInShardMiniBlock() *block.MiniBlock {
+	return nil
+}
+
+// RemoveProcessedResultsFor does nothing as the results are kept for the whole simulation
+func (rc *resultsCollector) RemoveProcessedResultsFor(_ [][]byte) {
+}
+
+func (rc *resultsCollector) getResults() []data.TransactionHandler {
+	rc.mutResults.Lock()
+	defer rc.mutResults.Unlock()
+
+	results := make([]data.TransactionHandler, len(rc.results))
+	copy(results, rc.results)
+
+	return results
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rc *resultsCollector) IsInterfaceNil() bool {
+	return rc == nil
+}
@@ -0,0 +1,529 @@
+package txsimulator
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/block/postprocess"
+	"github.com/ElrondNetwork/elrond-go/process/block/preprocess"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract"
+	processTransaction "github.com/ElrondNetwork/elrond-go/process/transaction"
+	"github.com/ElrondNetwork/elrond-go/process/transactionLog"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+)
+
+var log = logger.GetOrCreate("process/txsimulator")
+
+const okReturnCode = "ok"
+
+// ArgsTxSimulator holds the arguments needed to create a new transaction simulator
+type ArgsTxSimulator struct {
+	VmContainer       process.VirtualMachinesContainer
+	BlockChainHook    process.BlockChainHookHandler
+	AccountsRecreator state.AccountsAdapterRecreator
+	BlockChain        data.ChainHandler
+	ShardCoordinator  sharding.Coordinator
+	PubkeyConverter   core.PubkeyConverter
+	Hasher            hashing.Hasher
+	Marshalizer       marshal.Marshalizer
+	EconomicsFee      process.FeeHandler
+	TxTypeHandler     process.TxTypeHandler
+	ArgsParser        process.ArgumentsParser
+}
+
+type txSimulator struct {
+	vmContainer       process.VirtualMachinesContainer
+	blockChainHook    process.BlockChainHookHandler
+	accountsRecreator state.AccountsAdapterRecreator
+	blockChain        data.ChainHandler
+	shardCoordinator  sharding.Coordinator
+	pubkeyConverter   core.PubkeyConverter
+	hasher            hashing.Hasher
+	marshalizer       marshal.Marshalizer
+	economicsFee      process.FeeHandler
+	txTypeHandler     process.TxTypeHandler
+	argsParser        process.ArgumentsParser
+	mutSimulation     sync.Mutex
+}
+
+// simulationPipeline holds the processors a single simulation runs through, all working on the simulated state
+type simulationPipeline struct {
+	txProcessor      process.TransactionProcessor
+	scrCollector     *resultsCollector
+	receiptCollector *resultsCollector
+	txFeeHandler     process.TransactionFeeHandler
+	gasHandler       process.GasHandler
+	txLogProcessor   process.TransactionLogProcessorDatabase
+}
+
+// NewTxSimulator creates a component which executes transactions through the same processors used on block
+// processing, on a throwaway copy of the current state of the node's shard
+func NewTxSimulator(args ArgsTxSimulator) (*txSimulator, error) {
+	if check.IfNil(args.VmContainer) {
+		return nil, process.ErrNoVM
+	}
+	if check.IfNil(args.BlockChainHook) {
+		return nil, process.ErrNilBlockChainHook
+	}
+	if check.IfNil(args.AccountsRecreator) {
+		return nil, process.ErrNilAccountsAdapterRecreator
+	}
+	if check.IfNil(args.BlockChain) {
+		return nil, process.ErrNilBlockChain
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.PubkeyConverter) {
+		return nil, process.ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, process.ErrNilHasher
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.EconomicsFee) {
+		return nil, process.ErrNilEconomicsFeeHandler
+	}
+	if check.IfNil(args.TxTypeHandler) {
+		return nil, process.ErrNilTxTypeHandler
+	}
+	if check.IfNil(args.ArgsParser) {
+		return nil, process.ErrNilArgumentParser
+	}
+
+	return &txSimulator{
+		vmContainer:       args.VmContainer,
+		blockChainHook:    args.BlockChainHook,
+		accountsRecreator: args.AccountsRecreator,
+		blockChain:        args.BlockChain,
+		shardCoordinator:  args.ShardCoordinator,
+		pubkeyConverter:   args.PubkeyConverter,
+		hasher:            args.Hasher,
+		marshalizer:       args.Marshalizer,
+		economicsFee:      args.EconomicsFee,
+		txTypeHandler:     args.TxTypeHandler,
+		argsParser:        args.ArgsParser,
+	}, nil
+}
+
+// SimulateTransaction executes the transaction on the state of the last block and returns its outcome: the
+// status, the gas used, the fee, the generated results and logs and the changes made on the accounts of this
+// shard. All the changes are discarded afterwards. Only the part of the execution taking place in this shard
+// is simulated, the generated cross shard results are returned without being executed
+func (ts *txSimulator) SimulateTransaction(tx *transaction.Transaction) (*transaction.SimulationResults, error) {
+	if check.IfNil(tx) {
+		return nil, process.ErrNilTransaction
+	}
+
+	txHash, err := core.CalculateHash(ts.marshalizer, ts.hasher, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	ts.mutSimulation.Lock()
+	defer ts.mutSimulation.Unlock()
+
+	rootHash, err := ts.getCurrentRootHash()
+	if err != nil {
+		return nil, err
+	}
+	accounts, err := ts.accountsRecreator.RecreateAccountsAdapter(rootHash)
+	if err != nil {
+		return nil, err
+	}
+	tracker := newAccountsTracker(accounts)
+
+	restoreAccounts, err := ts.useAccounts(tracker)
+	if err != nil {
+		return nil, err
+	}
+	defer restoreAccounts()
+
+	pipeline, err := ts.createPipeline(tracker)
+	if err != nil {
+		return nil, err
+	}
+
+	errProcess := pipeline.txProcessor.ProcessTransaction(tx)
+
+	results := &transaction.SimulationResults{
+		Hash: hex.EncodeToString(txHash),
+		Fee:  pipeline.txFeeHandler.GetAccumulatedFees().String(),
+	}
+	scrs := pipeline.scrCollector.getResults()
+	ts.setStatus(results, txHash, scrs, errProcess)
+	results.GasUsed = ts.computeGasUsed(tx, txHash, results.Status, pipeline.gasHandler)
+	results.ScResults = ts.createApiSmartContractResults(scrs)
+	results.Receipts = ts.createApiReceipts(pipeline.receiptCollector.getResults())
+	results.Logs = ts.createApiLogs(pipeline.txLogProcessor, txHash)
+
+	results.AccountDeltas, err = ts.computeAccountDeltas(tracker)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (ts *txSimulator) getCurrentRootHash() ([]byte, error) {
+	header := ts.blockChain.GetCurrentBlockHeader()
+	if check.IfNil(header) {
+		header = ts.blockChain.GetGenesisHeader()
+	}
+	if check.IfNil(header) {
+		return nil, fmt.Errorf("%w: no block header available", state.ErrStateNotAvailable)
+	}
+
+	return header.GetRootHash(), nil
+}
+
+// useAccounts points the VMs to the simulated state and returns the function which points them back to the state
+// they used before. Both should be called while holding mutSimulation
+func (ts *txSimulator) useAccounts(accounts state.AccountsAdapter) (func(), error) {
+	currentAccounts := ts.blockChainHook.GetAccountsAdapter()
+	err := ts.blockChainHook.SetAccountsAdapter(accounts)
+	if err != nil {
+		return nil, err
+	}
+	ts.blockChainHook.CleanTempAccounts()
+
+	restoreAccounts := func() {
+		ts.blockChainHook.CleanTempAccounts()
+		errSet := ts.blockChainHook.SetAccountsAdapter(currentAccounts)
+		if errSet != nil {
+			log.Error("txSimulator: could not restore the accounts adapter", "error", errSet.Error())
+		}
+	}
+
+	return restoreAccounts, nil
+}
+
+func (ts *txSimulator) createPipeline(accounts state.AccountsAdapter) (*simulationPipeline, error) {
+	scrCollector := newResultsCollector()
+	receiptCollector := newResultsCollector()
+	badTxCollector := newResultsCollector()
+
+	txFeeHandler, err := postprocess.NewFeeAccumulator()
+	if err != nil {
+		return nil, err
+	}
+
+	gasHandler, err := preprocess.NewGasComputation(ts.economicsFee, ts.txTypeHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	txLogProcessor, err := transactionLog.NewTxLogProcessor(transactionLog.ArgTxLogProcessor{
+		Storer:      storageUnit.NewNilStorer(),
+		Marshalizer: ts.marshalizer,
+	})
+	if err != nil {
+		return nil, err
+	}
+	txLogProcessor.EnableLogToBeSavedInCache()
+
+	argsScProcessor := smartContract.ArgsNewSmartContractProcessor{
+		VmContainer:      ts.vmContainer,
+		ArgsParser:       ts.argsParser,
+		Hasher:           ts.hasher,
+		Marshalizer:      ts.marshalizer,
+		AccountsDB:       accounts,
+		TempAccounts:     ts.blockChainHook,
+		PubkeyConv:       ts.pubkeyConverter,
+		Coordinator:      ts.shardCoordinator,
+		ScrForwarder:     scrCollector,
+		TxFeeHandler:     txFeeHandler,
+		EconomicsFee:     ts.economicsFee,
+		TxTypeHandler:    ts.txTypeHandler,
+		GasHandler:       gasHandler,
+		BuiltInFunctions: ts.blockChainHook.GetBuiltInFunctions(),
+		TxLogsProcessor:  txLogProcessor,
+	}
+	scProcessor, err := smartContract.NewSmartContractProcessor(argsScProcessor)
+	if err != nil {
+		return nil, err
+	}
+
+	txProcessor, err := processTransaction.NewTxProcessor(
+		accounts,
+		ts.hasher,
+		ts.pubkeyConverter,
+		ts.marshalizer,
+		ts.shardCoordinator,
+		scProcessor,
+		txFeeHandler,
+		ts.txTypeHandler,
+		ts.economicsFee,
+		receiptCollector,
+		badTxCollector,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &simulationPipeline{
+		txProcessor:      txProcessor,
+		scrCollector:     scrCollector,
+		receiptCollector: receiptCollector,
+		txFeeHandler:     txFeeHandler,
+		gasHandler:       gasHandler,
+		txLogProcessor:   txLogProcessor,
+	}, nil
+}
+
+// setStatus sets the status of the simulated transaction. A failed smart contract execution does not return an
+// error, as the transaction is still included in a block, so its return code is taken from the result sent back
+// to the caller
+func (ts *txSimulator) setStatus(
+	results *transaction.SimulationResults,
+	txHash []byte,
+	scrs []data.TransactionHandler,
+	errProcess error,
+) {
+	if errProcess != nil {
+		results.Status = string(core.TxStatusInvalid)
+		if errors.Is(errProcess, process.ErrFailedTransaction) {
+			results.Status = string(core.TxStatusFail)
+		}
+		results.FailReason = errProcess.Error()
+		return
+	}
+
+	results.Status = string(core.TxStatusSuccess)
+	for _, scr := range scrs {
+		returnCode, ok := getReturnCode(scr, txHash)
+		if !ok || returnCode == okReturnCode {
+			continue
+		}
+
+		results.Status = string(core.TxStatusFail)
+		results.FailReason = returnCode
+		returnMessage := string(scr.(*smartContractResult.SmartContractResult).ReturnMessage)
+		if len(returnMessage) > 0 {
+			results.FailReason = fmt.Sprintf("%s: %s", returnCode, returnMessage)
+		}
+		return
+	}
+}
+
+// getReturnCode extracts the return code from a result sent back to the caller of the given transaction
+func getReturnCode(result data.TransactionHandler, txHash []byte) (string, bool) {
+	scr, ok := result.(*smartContractResult.SmartContractResult)
+	if !ok || string(scr.PrevTxHash) != string(txHash) {
+		return "", false
+	}
+	if !strings.HasPrefix(string(scr.Data), "@") {
+		return "", false
+	}
+
+	encodedReturnCode := strings.Split(string(scr.Data[1:]), "@")[0]
+	returnCode, err := hex.DecodeString(encodedReturnCode)
+	if err != nil {
+		return "", false
+	}
+
+	return string(returnCode), true
+}
+
+func (ts *txSimulator) computeGasUsed(
+	tx *transaction.Transaction,
+	txHash []byte,
+	status string,
+	gasHandler process.GasHandler,
+) uint64 {
+	if status == string(core.TxStatusInvalid) {
+		return 0
+	}
+	if ts.txTypeHandler.ComputeTransactionType(tx) == process.MoveBalance {
+		return ts.economicsFee.ComputeGasLimit(tx)
+	}
+
+	gasRefunded := gasHandler.GasRefunded(txHash)
+	if gasRefunded > tx.GasLimit {
+		return 0
+	}
+
+	return tx.GasLimit - gasRefunded
+}
+
+func (ts *txSimulator) createApiSmartContractResults(scrs []data.TransactionHandler) []*transaction.ApiSmartContractResult {
+	apiResults := make([]*transaction.ApiSmartContractResult, 0, len(scrs))
+	for _, handler := range scrs {
+		scr, ok := handler.(*smartContractResult.SmartContractResult)
+		if !ok {
+			continue
+		}
+
+		scrHash, err := core.CalculateHash(ts.marshalizer, ts.hasher, scr)
+		if err != nil {
+			log.Debug("txSimulator: could not compute the smart contract result hash", "error", err.Error())
+		}
+
+		apiResults = append(apiResults, &transaction.ApiSmartContractResult{
+			Hash:           hex.EncodeToString(scrHash),
+			Nonce:          scr.Nonce,
+			Value:          bigIntToString(scr.Value),
+			Receiver:       ts.pubkeyConverter.Encode(scr.RcvAddr),
+			Sender:         ts.pubkeyConverter.Encode(scr.SndAddr),
+			Data:           string(scr.Data),
+			PrevTxHash:     hex.EncodeToString(scr.PrevTxHash),
+			OriginalTxHash: hex.EncodeToString(scr.OriginalTxHash),
+			GasLimit:       scr.GasLimit,
+			GasPrice:       scr.GasPrice,
+			CallType:       int(scr.CallType),
+			ReturnMessage:  string(scr.ReturnMessage),
+			ReceiverShard:  ts.shardCoordinator.ComputeId(scr.RcvAddr),
+		})
+	}
+
+	return apiResults
+}
+
+func (ts *txSimulator) createApiReceipts(receipts []data.TransactionHandler) []*transaction.ApiReceipt {
+	apiReceipts := make([]*transaction.ApiReceipt, 0, len(receipts))
+	for _, handler := range receipts {
+		rpt, ok := handler.(*receipt.Receipt)
+		if !ok {
+			continue
+		}
+
+		apiReceipts = append(apiReceipts, &transaction.ApiReceipt{
+			Value:  bigIntToString(rpt.Value),
+			Sender: ts.pubkeyConverter.Encode(rpt.SndAddr),
+			Data:   string(rpt.Data),
+			TxHash: hex.EncodeToString(rpt.TxHash),
+		})
+	}
+
+	return apiReceipts
+}
+
+func (ts *txSimulator) createApiLogs(txLogProcessor process.TransactionLogProcessorDatabase, txHash []byte) *transaction.ApiLogs {
+	logHandler, found := txLogProcessor.GetLogFromCache(txHash)
+	if !found {
+		return nil
+	}
+	txLog, ok := logHandler.(*transaction.Log)
+	if !ok {
+		return nil
+	}
+
+	apiLogs := &transaction.ApiLogs{
+		Address: ts.pubkeyConverter.Encode(txLog.Address),
+		Events:  make([]*transaction.ApiEvent, 0, len(txLog.Events)),
+	}
+	for _, event := range txLog.Events {
+		topics := make([]string, 0, len(event.Topics))
+		for _, topic := range event.Topics {
+			topics = append(topics, hex.EncodeToString(topic))
+		}
+
+		apiLogs.Events = append(apiLogs.Events, &transaction.ApiEvent{
+			Address:    ts.pubkeyConverter.Encode(event.Address),
+			Identifier: string(event.Identifier),
+			Topics:     topics,
+			Data:       hex.EncodeToString(event.Data),
+		})
+	}
+
+	return apiLogs
+}
+
+// computeAccountDeltas reads the touched accounts from the simulated state, reverts all the simulated changes and
+// reads them once more, for the values they had before the simulation
+func (ts *txSimulator) computeAccountDeltas(tracker *accountsTracker) ([]*transaction.ApiAccountDelta, error) {
+	addresses := tracker.touchedAddresses()
+	accountsAfter := make([]state.UserAccountHandler, 0, len(addresses))
+	for _, address := range addresses {
+		accountsAfter = append(accountsAfter, getUserAccount(tracker, address))
+	}
+
+	err := tracker.RevertToSnapshot(0)
+	if err != nil {
+		return nil, err
+	}
+
+	deltas := make([]*transaction.ApiAccountDelta, 0, len(addresses))
+	for i, address := range addresses {
+		accountBefore := getUserAccount(tracker, address)
+		deltas = append(deltas, &transaction.ApiAccountDelta{
+			Address:        ts.pubkeyConverter.Encode(address),
+			BalanceBefore:  getBalance(accountBefore).String(),
+			BalanceAfter:   getBalance(accountsAfter[i]).String(),
+			NonceBefore:    getNonce(accountBefore),
+			NonceAfter:     getNonce(accountsAfter[i]),
+			StorageChanged: string(getRootHash(accountBefore)) != string(getRootHash(accountsAfter[i])),
+		})
+	}
+
+	return deltas, nil
+}
+
+func getUserAccount(accounts state.AccountsAdapter, address []byte) state.UserAccountHandler {
+	account, err := accounts.GetExistingAccount(address)
+	if err != nil {
+		return nil
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil
+	}
+
+	return userAccount
+}
+
+func getBalance(account state.UserAccountHandler) *big.Int {
+	if check.IfNil(account) || account.GetBalance() == nil {
+		return big.NewInt(0)
+	}
+
+	return account.GetBalance()
+}
+
+func getNonce(account state.UserAccountHandler) uint64 {
+	if check.IfNil(account) {
+		return 0
+	}
+
+	return account.GetNonce()
+}
+
+func getRootHash(account state.UserAccountHandler) []byte {
+	if check.IfNil(account) {
+		return nil
+	}
+
+	return account.GetRootHash()
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ts *txSimulator) IsInterfaceNil() bool {
+	return ts == nil
+}
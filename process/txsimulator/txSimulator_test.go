@@ -0,0 +1,303 @@
+package txsimulator_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
+	"github.com/ElrondNetwork/elrond-go/process/txsimulator"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const gasPrice = 10
+const moveBalanceGas = 5
+
+var senderAddress = []byte("sender-address-of-32-bytes-long.")
+var receiverAddress = []byte("receiver-address-of-32-bytes-lon")
+
+func createMockArgsTxSimulator() txsimulator.ArgsTxSimulator {
+	return txsimulator.ArgsTxSimulator{
+		VmContainer: &mock.VMContainerMock{},
+		BlockChainHook: &mock.BlockChainHookHandlerMock{
+			GetBuiltInFunctionsCalled: func() process.BuiltInFunctionContainer {
+				return builtInFunctions.NewBuiltInFunctionContainer()
+			},
+		},
+		AccountsRecreator: &mock.AccountsAdapterRecreatorStub{},
+		BlockChain: &mock.BlockChainMock{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{RootHash: []byte("root hash")}
+			},
+		},
+		ShardCoordinator: mock.NewOneShardCoordinatorMock(),
+		PubkeyConverter:  mock.NewPubkeyConverterMock(32),
+		Hasher:           &mock.HasherMock{},
+		Marshalizer:      &mock.MarshalizerMock{},
+		EconomicsFee: &mock.FeeHandlerStub{
+			ComputeGasLimitCalled: func(tx process.TransactionWithFeeHandler) uint64 {
+				return moveBalanceGas
+			},
+			ComputeFeeCalled: func(tx process.TransactionWithFeeHandler) *big.Int {
+				return big.NewInt(gasPrice * moveBalanceGas)
+			},
+		},
+		TxTypeHandler: &mock.TxTypeHandlerMock{},
+		ArgsParser:    &mock.ArgumentParserMock{},
+	}
+}
+
+// createAccountsWithSender returns an accounts DB over an in memory trie, with the sender's balance committed
+func createAccountsWithSender(t *testing.T, balance int64) (state.AccountsAdapterRecreator, []byte) {
+	marshalizer := &mock.MarshalizerMock{}
+	hasher := &mock.HasherMock{}
+	accountFactory := factory.NewAccountCreator()
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(memorydb.New())
+	tr, _ := trie.NewTrie(storageManager, marshalizer, hasher, 5)
+	adb, _ := state.NewAccountsDB(tr, hasher, marshalizer, accountFactory)
+
+	account, _ := adb.LoadAccount(senderAddress)
+	_ = account.(state.UserAccountHandler).AddToBalance(big.NewInt(balance))
+	_ = adb.SaveAccount(account)
+	rootHash, err := adb.Commit()
+	require.Nil(t, err)
+
+	recreator, err := state.NewAccountsDBRecreator(tr, hasher, marshalizer, accountFactory)
+	require.Nil(t, err)
+
+	return recreator, rootHash
+}
+
+func createMoveBalanceTx(nonce uint64, value int64) *transaction.Transaction {
+	return &transaction.Transaction{
+		Nonce:    nonce,
+		Value:    big.NewInt(value),
+		RcvAddr:  receiverAddress,
+		SndAddr:  senderAddress,
+		GasPrice: gasPrice,
+		GasLimit: moveBalanceGas,
+	}
+}
+
+func getBalance(t *testing.T, recreator state.AccountsAdapterRecreator, rootHash []byte, address []byte) *big.Int {
+	accounts, err := recreator.RecreateAccountsAdapter(rootHash)
+	require.Nil(t, err)
+	account, err := accounts.GetExistingAccount(address)
+	require.Nil(t, err)
+
+	return account.(state.UserAccountHandler).GetBalance()
+}
+
+func TestNewTxSimulator_NilVmContainerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTxSimulator()
+	args.VmContainer = nil
+	ts, err := txsimulator.NewTxSimulator(args)
+
+	assert.True(t, check.IfNil(ts))
+	assert.Equal(t, process.ErrNoVM, err)
+}
+
+func TestNewTxSimulator_NilBlockChainHookShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTxSimulator()
+	args.BlockChainHook = nil
+	ts, err := txsimulator.NewTxSimulator(args)
+
+	assert.True(t, check.IfNil(ts))
+	assert.Equal(t, process.ErrNilBlockChainHook, err)
+}
+
+func TestNewTxSimulator_NilAccountsRecreatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTxSimulator()
+	args.AccountsRecreator = nil
+	ts, err := txsimulator.NewTxSimulator(args)
+
+	assert.True(t, check.IfNil(ts))
+	assert.Equal(t, process.ErrNilAccountsAdapterRecreator, err)
+}
+
+func TestNewTxSimulator_NilBlockChainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTxSimulator()
+	args.BlockChain = nil
+	ts, err := txsimulator.NewTxSimulator(args)
+
+	assert.True(t, check.IfNil(ts))
+	assert.Equal(t, process.ErrNilBlockChain, err)
+}
+
+func TestNewTxSimulator_NilEconomicsFeeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTxSimulator()
+	args.EconomicsFee = nil
+	ts, err := txsimulator.NewTxSimulator(args)
+
+	assert.True(t, check.IfNil(ts))
+	assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
+}
+
+func TestNewTxSimulator_NilArgsParserShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTxSimulator()
+	args.ArgsParser = nil
+	ts, err := txsimulator.NewTxSimulator(args)
+
+	assert.True(t, check.IfNil(ts))
+	assert.Equal(t, process.ErrNilArgumentParser, err)
+}
+
+func TestNewTxSimulator_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ts, err := txsimulator.NewTxSimulator(createMockArgsTxSimulator())
+
+	assert.False(t, check.IfNil(ts))
+	assert.Nil(t, err)
+}
+
+func TestTxSimulator_SimulateTransactionNilTxShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts, _ := txsimulator.NewTxSimulator(createMockArgsTxSimulator())
+	results, err := ts.SimulateTransaction(nil)
+
+	assert.Nil(t, results)
+	assert.Equal(t, process.ErrNilTransaction, err)
+}
+
+func TestTxSimulator_SimulateTransactionStateNotAvailableShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTxSimulator()
+	setAccountsWasCalled := false
+	args.BlockChainHook = &mock.BlockChainHookHandlerMock{
+		SetAccountsAdapterCalled: func(accounts state.AccountsAdapter) error {
+			setAccountsWasCalled = true
+			return nil
+		},
+	}
+	ts, _ := txsimulator.NewTxSimulator(args)
+	results, err := ts.SimulateTransaction(createMoveBalanceTx(0, 1))
+
+	assert.Nil(t, results)
+	assert.True(t, errors.Is(err, state.ErrStateNotAvailable))
+	assert.False(t, setAccountsWasCalled)
+}
+
+func TestTxSimulator_SimulateTransactionMoveBalanceShouldNotAlterState(t *testing.T) {
+	t.Parallel()
+
+	recreator, rootHash := createAccountsWithSender(t, 1000)
+	args := createMockArgsTxSimulator()
+	args.AccountsRecreator = recreator
+	args.BlockChain = &mock.BlockChainMock{
+		GetGenesisHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{RootHash: rootHash}
+		},
+	}
+	originalAccounts := &mock.AccountsStub{}
+	hookAccounts := make([]state.AccountsAdapter, 0)
+	hook := args.BlockChainHook.(*mock.BlockChainHookHandlerMock)
+	hook.GetAccountsAdapterCalled = func() state.AccountsAdapter {
+		return originalAccounts
+	}
+	hook.SetAccountsAdapterCalled = func(accounts state.AccountsAdapter) error {
+		hookAccounts = append(hookAccounts, accounts)
+		return nil
+	}
+	ts, _ := txsimulator.NewTxSimulator(args)
+
+	results, err := ts.SimulateTransaction(createMoveBalanceTx(0, 100))
+	require.Nil(t, err)
+
+	assert.Equal(t, string(core.TxStatusSuccess), results.Status)
+	assert.Empty(t, results.FailReason)
+	assert.Equal(t, uint64(moveBalanceGas), results.GasUsed)
+	assert.Equal(t, "50", results.Fee)
+	assert.Empty(t, results.ScResults)
+	assert.Nil(t, results.Logs)
+	require.Equal(t, 2, len(results.AccountDeltas))
+	senderDelta := results.AccountDeltas[0]
+	assert.Equal(t, mock.NewPubkeyConverterMock(32).Encode(senderAddress), senderDelta.Address)
+	assert.Equal(t, "1000", senderDelta.BalanceBefore)
+	assert.Equal(t, "850", senderDelta.BalanceAfter)
+	assert.Equal(t, uint64(0), senderDelta.NonceBefore)
+	assert.Equal(t, uint64(1), senderDelta.NonceAfter)
+	receiverDelta := results.AccountDeltas[1]
+	assert.Equal(t, "0", receiverDelta.BalanceBefore)
+	assert.Equal(t, "100", receiverDelta.BalanceAfter)
+
+	assert.Equal(t, big.NewInt(1000), getBalance(t, recreator, rootHash, senderAddress))
+	require.Equal(t, 2, len(hookAccounts))
+	assert.Equal(t, originalAccounts, hookAccounts[1])
+}
+
+func TestTxSimulator_SimulateTransactionInsufficientFundsShouldFail(t *testing.T) {
+	t.Parallel()
+
+	recreator, rootHash := createAccountsWithSender(t, 1000)
+	args := createMockArgsTxSimulator()
+	args.AccountsRecreator = recreator
+	args.BlockChain = &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{RootHash: rootHash}
+		},
+	}
+	ts, _ := txsimulator.NewTxSimulator(args)
+
+	results, err := ts.SimulateTransaction(createMoveBalanceTx(0, 2000))
+	require.Nil(t, err)
+
+	assert.Equal(t, string(core.TxStatusFail), results.Status)
+	assert.Contains(t, results.FailReason, process.ErrFailedTransaction.Error())
+	assert.Equal(t, "50", results.Fee)
+	require.Equal(t, 1, len(results.Receipts))
+	assert.Equal(t, "50", results.Receipts[0].Value)
+	require.Equal(t, 1, len(results.AccountDeltas))
+	assert.Equal(t, "950", results.AccountDeltas[0].BalanceAfter)
+
+	assert.Equal(t, big.NewInt(1000), getBalance(t, recreator, rootHash, senderAddress))
+}
+
+func TestTxSimulator_SimulateTransactionWrongNonceShouldBeInvalid(t *testing.T) {
+	t.Parallel()
+
+	recreator, rootHash := createAccountsWithSender(t, 1000)
+	args := createMockArgsTxSimulator()
+	args.AccountsRecreator = recreator
+	args.BlockChain = &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{RootHash: rootHash}
+		},
+	}
+	ts, _ := txsimulator.NewTxSimulator(args)
+
+	results, err := ts.SimulateTransaction(createMoveBalanceTx(5, 100))
+	require.Nil(t, err)
+
+	assert.Equal(t, string(core.TxStatusInvalid), results.Status)
+	assert.Equal(t, process.ErrHigherNonceInTransaction.Error(), results.FailReason)
+	assert.Equal(t, uint64(0), results.GasUsed)
+	assert.Equal(t, "0", results.Fee)
+	assert.Empty(t, results.AccountDeltas)
+}
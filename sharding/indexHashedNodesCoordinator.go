@@ -84,6 +84,7 @@ type indexHashedNodesCoordinator struct {
 	consensusGroupCacher          Cacher
 	loadingFromDisk               atomic.Value
 	shuffledOutHandler            ShuffledOutHandler
+	roundRobinConsensus           bool
 }
 
 // NewIndexHashedNodesCoordinator creates a new index hashed group selector
@@ -122,6 +123,7 @@ func NewIndexHashedNodesCoordinator(arguments ArgNodesCoordinator) (*indexHashed
 		consensusGroupCacher:          arguments.ConsensusGroupCache,
 		shardIDAsObserver:             arguments.ShardIDAsObserver,
 		shuffledOutHandler:            arguments.ShuffledOutHandler,
+		roundRobinConsensus:           arguments.RoundRobinConsensus,
 	}
 
 	ihgs.loadingFromDisk.Store(false)
@@ -309,7 +311,12 @@ func (ihgs *indexHashedNodesCoordinator) ComputeConsensusGroup(
 		"eligible list length", len(eligibleList),
 		"epoch", epoch)
 
-	tempList, err := selectValidators(selector, randomness, uint32(consensusSize), eligibleList)
+	var tempList []Validator
+	if ihgs.roundRobinConsensus {
+		tempList, err = selectValidatorsRoundRobin(round, uint32(consensusSize), eligibleList)
+	} else {
+		tempList, err = selectValidators(selector, randomness, uint32(consensusSize), eligibleList)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -1040,6 +1047,26 @@ func selectValidators(
 	return consensusGroup, nil
 }
 
+// selectValidatorsRoundRobin selects the validators following the order of the eligible list, starting with the one
+// at the round index, so that each eligible validator leads the consensus in its turn
+func selectValidatorsRoundRobin(
+	round uint64,
+	consensusSize uint32,
+	eligibleList []Validator,
+) ([]Validator, error) {
+	if consensusSize == 0 || int(consensusSize) > len(eligibleList) {
+		return nil, ErrInvalidConsensusGroupSize
+	}
+
+	numEligible := uint64(len(eligibleList))
+	consensusGroup := make([]Validator, consensusSize)
+	for i := range consensusGroup {
+		consensusGroup[i] = eligibleList[(round+uint64(i))%numEligible]
+	}
+
+	return consensusGroup, nil
+}
+
 // createValidatorInfoFromBody unmarshalls body data to create validator info
 func createValidatorInfoFromBody(
 	body data.BodyHandler,
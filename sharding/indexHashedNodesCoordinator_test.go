@@ -339,6 +339,48 @@ func TestIndexHashedNodesCoordinator_ComputeValidatorsGroup1ValidatorShouldRetur
 	require.Nil(t, err)
 }
 
+func TestIndexHashedNodesCoordinator_ComputeValidatorsGroupRoundRobinShouldTakeTheEligibleInTurns(t *testing.T) {
+	t.Parallel()
+
+	arguments := createArguments()
+	arguments.RoundRobinConsensus = true
+	ihgs, _ := NewIndexHashedNodesCoordinator(arguments)
+
+	eligibleList := arguments.EligibleNodes[0]
+	numEligible := uint64(len(eligibleList))
+	for round := uint64(0); round < 2*numEligible; round++ {
+		consensusGroup, err := ihgs.ComputeConsensusGroup([]byte("randomness"), round, 0, 0)
+
+		require.Nil(t, err)
+		require.Equal(t, 1, len(consensusGroup))
+		require.Equal(t, eligibleList[round%numEligible], consensusGroup[0])
+	}
+}
+
+func TestSelectValidatorsRoundRobin_InvalidConsensusSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	eligibleList := createDummyNodesList(3, "eligible")
+
+	consensusGroup, err := selectValidatorsRoundRobin(0, 0, eligibleList)
+	require.Nil(t, consensusGroup)
+	require.Equal(t, ErrInvalidConsensusGroupSize, err)
+
+	consensusGroup, err = selectValidatorsRoundRobin(0, 4, eligibleList)
+	require.Nil(t, consensusGroup)
+	require.Equal(t, ErrInvalidConsensusGroupSize, err)
+}
+
+func TestSelectValidatorsRoundRobin_ShouldWrapAroundTheEligibleList(t *testing.T) {
+	t.Parallel()
+
+	eligibleList := createDummyNodesList(3, "eligible")
+
+	consensusGroup, err := selectValidatorsRoundRobin(5, 2, eligibleList)
+	require.Nil(t, err)
+	require.Equal(t, []Validator{eligibleList[2], eligibleList[0]}, consensusGroup)
+}
+
 func TestIndexHashedNodesCoordinator_ComputeValidatorsGroup400of400For10locksNoMemoization(t *testing.T) {
 	consensusGroupSize := 400
 	nodesPerShard := uint32(400)
@@ -23,4 +23,5 @@ type ArgNodesCoordinator struct {
 	Epoch                   uint32
 	ConsensusGroupCache     Cacher
 	ShuffledOutHandler      ShuffledOutHandler
+	RoundRobinConsensus     bool
 }
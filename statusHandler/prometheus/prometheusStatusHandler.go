@@ -0,0 +1,232 @@
+package prometheus
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+var _ core.AppStatusHandler = (*prometheusStatusHandler)(nil)
+
+const (
+	gaugeType   = "gauge"
+	counterType = "counter"
+	// infoSuffix is appended to the name of the metrics holding a text value, exposed as a label of a constant gauge
+	infoSuffix = "_info"
+	// maxLabelValueLength limits the text values exposed. Longer ones, like the lists of connected peers, are skipped
+	maxLabelValueLength = 256
+)
+
+// counterMetrics holds the metrics which only go up. All the other metrics are exposed as gauges
+var counterMetrics = map[string]struct{}{
+	core.MetricCountLeader:                  {},
+	core.MetricCountAcceptedBlocks:          {},
+	core.MetricCountConsensusAcceptedBlocks: {},
+	core.MetricNumProcessedTxs:              {},
+	core.MetricNumTimesInForkChoice:         {},
+}
+
+type metric struct {
+	metricType  string
+	uintValue   uint64
+	intValue    int64
+	stringValue string
+	isInt       bool
+	isString    bool
+}
+
+func newMetric(key string) *metric {
+	_, isCounter := counterMetrics[key]
+	if isCounter {
+		return &metric{metricType: counterType}
+	}
+
+	return &metric{metricType: gaugeType}
+}
+
+func (m *metric) isCounter() bool {
+	return m.metricType == counterType
+}
+
+// prometheusStatusHandler keeps the last value of every metric and exposes all of them in the Prometheus text
+// format. The type of a metric is fixed when it is registered: the known monotonic metrics are counters, all the
+// others are gauges. A text value is exposed as a number if it can be parsed as one, otherwise as the value label
+// of an _info gauge
+type prometheusStatusHandler struct {
+	mutMetrics sync.RWMutex
+	metrics    map[string]*metric
+}
+
+// NewPrometheusStatusHandler returns a status handler able to export the node metrics to Prometheus
+func NewPrometheusStatusHandler() *prometheusStatusHandler {
+	return &prometheusStatusHandler{
+		metrics: make(map[string]*metric),
+	}
+}
+
+func (psh *prometheusStatusHandler) getOrCreate(key string) *metric {
+	m, ok := psh.metrics[key]
+	if !ok {
+		m = newMetric(key)
+		psh.metrics[key] = m
+	}
+
+	return m
+}
+
+// Increment increments the value of a metric
+func (psh *prometheusStatusHandler) Increment(key string) {
+	psh.AddUint64(key, 1)
+}
+
+// AddUint64 increases the value of a metric
+func (psh *prometheusStatusHandler) AddUint64(key string, val uint64) {
+	psh.mutMetrics.Lock()
+	defer psh.mutMetrics.Unlock()
+
+	m := psh.getOrCreate(key)
+	if m.isInt || m.isString {
+		return
+	}
+
+	m.uintValue += val
+}
+
+// Decrement decrements the value of a gauge. Counters can not be decremented
+func (psh *prometheusStatusHandler) Decrement(key string) {
+	psh.mutMetrics.Lock()
+	defer psh.mutMetrics.Unlock()
+
+	m := psh.getOrCreate(key)
+	if m.isInt || m.isString || m.isCounter() {
+		return
+	}
+
+	if m.uintValue > 0 {
+		m.uintValue--
+	}
+}
+
+// SetInt64Value sets the value of a gauge
+func (psh *prometheusStatusHandler) SetInt64Value(key string, value int64) {
+	psh.mutMetrics.Lock()
+	defer psh.mutMetrics.Unlock()
+
+	m := psh.getOrCreate(key)
+	if m.isCounter() {
+		return
+	}
+
+	m.isInt = true
+	m.isString = false
+	m.intValue = value
+}
+
+// SetUInt64Value sets the value of a metric
+func (psh *prometheusStatusHandler) SetUInt64Value(key string, value uint64) {
+	psh.mutMetrics.Lock()
+	defer psh.mutMetrics.Unlock()
+
+	m := psh.getOrCreate(key)
+	m.isInt = false
+	m.isString = false
+	m.uintValue = value
+}
+
+// SetStringValue sets the text value of a gauge
+func (psh *prometheusStatusHandler) SetStringValue(key string, value string) {
+	psh.mutMetrics.Lock()
+	defer psh.mutMetrics.Unlock()
+
+	m := psh.getOrCreate(key)
+	if m.isCounter() {
+		return
+	}
+
+	m.isInt = false
+	m.isString = true
+	m.stringValue = value
+}
+
+// Metrics returns all the metrics in the Prometheus text exposition format, sorted by name
+func (psh *prometheusStatusHandler) Metrics() string {
+	psh.mutMetrics.RLock()
+	defer psh.mutMetrics.RUnlock()
+
+	keys := make([]string, 0, len(psh.metrics))
+	for key := range psh.metrics {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	builder := strings.Builder{}
+	for _, key := range keys {
+		writeMetric(&builder, sanitizeName(key), psh.metrics[key])
+	}
+
+	return builder.String()
+}
+
+func writeMetric(builder *strings.Builder, name string, m *metric) {
+	if !m.isString {
+		value := strconv.FormatUint(m.uintValue, 10)
+		if m.isInt {
+			value = strconv.FormatInt(m.intValue, 10)
+		}
+
+		writeSample(builder, name, m.metricType, "", value)
+		return
+	}
+
+	_, err := strconv.ParseFloat(m.stringValue, 64)
+	if err == nil {
+		writeSample(builder, name, gaugeType, "", m.stringValue)
+		return
+	}
+	if len(m.stringValue) > maxLabelValueLength {
+		return
+	}
+
+	label := fmt.Sprintf(`{value="%s"}`, escapeLabelValue(m.stringValue))
+	writeSample(builder, name+infoSuffix, gaugeType, label, "1")
+}
+
+func writeSample(builder *strings.Builder, name string, metricType string, labels string, value string) {
+	_, _ = fmt.Fprintf(builder, "# TYPE %s %s\n%s%s %s\n", name, metricType, name, labels, value)
+}
+
+// sanitizeName replaces the characters not allowed in a Prometheus metric name with underscores
+func sanitizeName(key string) string {
+	sanitized := []byte(key)
+	for i, c := range sanitized {
+		isLetter := (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+		isDigit := c >= '0' && c <= '9'
+		if isLetter || c == '_' || c == ':' || (isDigit && i > 0) {
+			continue
+		}
+
+		sanitized[i] = '_'
+	}
+
+	return string(sanitized)
+}
+
+func escapeLabelValue(value string) string {
+	value = strings.Replace(value, `\`, `\\`, -1)
+	value = strings.Replace(value, `"`, `\"`, -1)
+
+	return strings.Replace(value, "\n", `\n`, -1)
+}
+
+// Close does nothing
+func (psh *prometheusStatusHandler) Close() {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (psh *prometheusStatusHandler) IsInterfaceNil() bool {
+	return psh == nil
+}
@@ -0,0 +1,117 @@
+package prometheus_test
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/prometheus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPrometheusStatusHandler(t *testing.T) {
+	t.Parallel()
+
+	psh := prometheus.NewPrometheusStatusHandler()
+
+	assert.False(t, check.IfNil(psh))
+	assert.Equal(t, "", psh.Metrics())
+}
+
+func TestPrometheusStatusHandler_CounterMetricShouldBeCounter(t *testing.T) {
+	t.Parallel()
+
+	psh := prometheus.NewPrometheusStatusHandler()
+	psh.SetUInt64Value(core.MetricCountLeader, 0)
+	psh.Increment(core.MetricCountLeader)
+	psh.AddUint64(core.MetricCountLeader, 5)
+	psh.Decrement(core.MetricCountLeader)
+
+	assert.Equal(t, "# TYPE erd_count_leader counter\nerd_count_leader 6\n", psh.Metrics())
+}
+
+func TestPrometheusStatusHandler_IncrementedMetricShouldBeGauge(t *testing.T) {
+	t.Parallel()
+
+	psh := prometheus.NewPrometheusStatusHandler()
+	psh.Increment("erd_metric")
+	psh.Increment("erd_metric")
+	psh.Decrement("erd_metric")
+
+	assert.Equal(t, "# TYPE erd_metric gauge\nerd_metric 1\n", psh.Metrics())
+}
+
+func TestPrometheusStatusHandler_MetricTypeShouldNotDependOnTheCallsOrder(t *testing.T) {
+	t.Parallel()
+
+	psh := prometheus.NewPrometheusStatusHandler()
+	psh.Increment(core.MetricCountConsensus)
+	expected := "# TYPE erd_count_consensus gauge\nerd_count_consensus 1\n"
+	assert.Equal(t, expected, psh.Metrics())
+
+	psh.Increment(core.MetricCountConsensus)
+	psh.Decrement(core.MetricCountConsensus)
+	assert.Equal(t, expected, psh.Metrics())
+}
+
+func TestPrometheusStatusHandler_SetValuesShouldBeGauges(t *testing.T) {
+	t.Parallel()
+
+	psh := prometheus.NewPrometheusStatusHandler()
+	psh.SetUInt64Value("erd_nonce", 37)
+	psh.SetInt64Value("erd_signed", -4)
+	psh.SetStringValue("erd_average_block_tx_count", "12.5")
+
+	expected := "# TYPE erd_average_block_tx_count gauge\nerd_average_block_tx_count 12.5\n" +
+		"# TYPE erd_nonce gauge\nerd_nonce 37\n" +
+		"# TYPE erd_signed gauge\nerd_signed -4\n"
+	assert.Equal(t, expected, psh.Metrics())
+}
+
+func TestPrometheusStatusHandler_TextValueShouldBeInfoLabel(t *testing.T) {
+	t.Parallel()
+
+	psh := prometheus.NewPrometheusStatusHandler()
+	psh.SetStringValue("erd_app_version", `v1.0 "tag"`)
+	psh.SetStringValue("erd_p2p_peers", strings.Repeat("peer,", 100))
+
+	assert.Equal(t, "# TYPE erd_app_version_info gauge\nerd_app_version_info{value=\"v1.0 \\\"tag\\\"\"} 1\n", psh.Metrics())
+}
+
+func TestPrometheusStatusHandler_NameShouldBeSanitized(t *testing.T) {
+	t.Parallel()
+
+	psh := prometheus.NewPrometheusStatusHandler()
+	psh.SetUInt64Value("erd_p2p_num_peers_/p2p/antiflood-1", 3)
+
+	assert.Equal(t, "# TYPE erd_p2p_num_peers__p2p_antiflood_1 gauge\nerd_p2p_num_peers__p2p_antiflood_1 3\n", psh.Metrics())
+}
+
+func TestPrometheusStatusHandler_ConcurrentAccessShouldWork(t *testing.T) {
+	t.Parallel()
+
+	psh := prometheus.NewPrometheusStatusHandler()
+	numCalls := 100
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			switch idx % 4 {
+			case 0:
+				psh.Increment("counter")
+			case 1:
+				psh.SetUInt64Value("gauge", uint64(idx))
+			case 2:
+				psh.SetStringValue("text", "value")
+			default:
+				_ = psh.Metrics()
+			}
+			wg.Done()
+		}(i)
+	}
+	wg.Wait()
+
+	assert.True(t, strings.Contains(psh.Metrics(), "counter 25\n"))
+}
@@ -0,0 +1,319 @@
+package boltdb
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	bolt "go.etcd.io/bbolt"
+)
+
+var _ storage.Persister = (*DB)(nil)
+var _ storage.KeysRanger = (*DB)(nil)
+
+// read + write + execute for owner only
+const rwxOwner = 0700
+
+// read + write for owner only
+const rwOwner = 0600
+
+const dbFileName = "data.db"
+
+// openTimeout bounds the wait for the file lock held by another process using the same database
+const openTimeout = time.Second
+
+var bucketName = []byte("data")
+
+var log = logger.GetOrCreate("storage/boltdb")
+
+// DB holds a pointer to the bolt database and the path to where it is stored. Bolt keeps the data in a single
+// memory mapped B+tree file, so, unlike leveldb, it does not run background compactions that can stall writes
+type DB struct {
+	db                *bolt.DB
+	path              string
+	maxBatchSize      int
+	batchDelaySeconds int
+	sizeBatch         int
+	batch             storage.Batcher
+	mutBatch          sync.RWMutex
+	dbClosed          chan struct{}
+}
+
+// NewDB is a constructor for the bolt persister
+// It creates the files in the location given as parameter
+func NewDB(path string, batchDelaySeconds int, maxBatchSize int) (s *DB, err error) {
+	err = os.MkdirAll(path, rwxOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &bolt.Options{
+		Timeout: openTimeout,
+		// the free list is rebuilt on open instead of being written on each commit, which speeds up the writes
+		NoFreelistSync: true,
+		FreelistType:   bolt.FreelistMapType,
+	}
+
+	db, err := bolt.Open(filepath.Join(path, dbFileName), rwOwner, options)
+	if err != nil {
+		return nil, fmt.Errorf("%w for path %s", err, path)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, errCreate := tx.CreateBucketIfNotExists(bucketName)
+		return errCreate
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("%w for path %s", err, path)
+	}
+
+	dbStore := &DB{
+		db:                db,
+		path:              path,
+		maxBatchSize:      maxBatchSize,
+		batchDelaySeconds: batchDelaySeconds,
+		sizeBatch:         0,
+		dbClosed:          make(chan struct{}),
+	}
+
+	dbStore.batch = NewBatch()
+
+	go dbStore.batchTimeoutHandle()
+
+	runtime.SetFinalizer(dbStore, func(db *DB) {
+		_ = db.Close()
+	})
+
+	return dbStore, nil
+}
+
+func (s *DB) batchTimeoutHandle() {
+	for {
+		select {
+		case <-time.After(time.Duration(s.batchDelaySeconds) * time.Second):
+			s.mutBatch.Lock()
+			err := s.putBatch(s.batch)
+			if err != nil {
+				log.Warn("boltdb putBatch", "error", err.Error())
+				s.mutBatch.Unlock()
+				continue
+			}
+
+			s.batch.Reset()
+			s.sizeBatch = 0
+			s.mutBatch.Unlock()
+		case <-s.dbClosed:
+			log.Debug("closing the timed batch handler", "path", s.path)
+			return
+		}
+	}
+}
+
+func (s *DB) updateBatchWithIncrement() error {
+	s.mutBatch.Lock()
+	defer s.mutBatch.Unlock()
+
+	s.sizeBatch++
+	if s.sizeBatch < s.maxBatchSize {
+		return nil
+	}
+
+	err := s.putBatch(s.batch)
+	if err != nil {
+		log.Warn("boltdb putBatch", "error", err.Error())
+		return err
+	}
+
+	s.batch.Reset()
+	s.sizeBatch = 0
+
+	return nil
+}
+
+// Put adds the value to the (key, val) storage medium
+func (s *DB) Put(key, val []byte) error {
+	err := s.batch.Put(key, val)
+	if err != nil {
+		return err
+	}
+
+	return s.updateBatchWithIncrement()
+}
+
+// Get returns the value associated to the key
+func (s *DB) Get(key []byte) ([]byte, error) {
+	data := s.batch.Get(key)
+	if data != nil {
+		if bytes.Equal(data, []byte(removed)) {
+			return nil, storage.ErrKeyNotFound
+		}
+		return data, nil
+	}
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		value := tx.Bucket(bucketName).Get(key)
+		if value == nil {
+			return storage.ErrKeyNotFound
+		}
+
+		// the slice returned by bolt is only valid while the transaction is open
+		data = make([]byte, len(value))
+		copy(data, value)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// Has returns nil if the given key is present in the persistence medium
+func (s *DB) Has(key []byte) error {
+	data := s.batch.Get(key)
+	if data != nil {
+		if bytes.Equal(data, []byte(removed)) {
+			return storage.ErrKeyNotFound
+		}
+		return nil
+	}
+
+	return s.db.View(func(tx *bolt.Tx) error {
+		if tx.Bucket(bucketName).Get(key) == nil {
+			return storage.ErrKeyNotFound
+		}
+
+		return nil
+	})
+}
+
+// RangeKeys writes the pending batch and then calls the handler for each stored (key, value) pair until the
+// handler returns false
+func (s *DB) RangeKeys(handler func(key []byte, value []byte) bool) error {
+	s.mutBatch.Lock()
+	err := s.putBatch(s.batch)
+	if err != nil {
+		s.mutBatch.Unlock()
+		return err
+	}
+	s.batch.Reset()
+	s.sizeBatch = 0
+	s.mutBatch.Unlock()
+
+	return s.db.View(func(tx *bolt.Tx) error {
+		cursor := tx.Bucket(bucketName).Cursor()
+		for key, value := cursor.First(); key != nil; key, value = cursor.Next() {
+			// the slices returned by bolt are only valid while the transaction is open
+			keyCopy := make([]byte, len(key))
+			copy(keyCopy, key)
+			valueCopy := make([]byte, len(value))
+			copy(valueCopy, value)
+
+			if !handler(keyCopy, valueCopy) {
+				return nil
+			}
+		}
+
+		return nil
+	})
+}
+
+// Init initializes the storage medium and prepares it for usage
+func (s *DB) Init() error {
+	// no special initialization needed
+	return nil
+}
+
+// putBatch writes the Batch data into the database in a single transaction
+func (s *DB) putBatch(b storage.Batcher) error {
+	dbBatch, ok := b.(*batch)
+	if !ok {
+		return storage.ErrInvalidBatch
+	}
+
+	dbBatch.mutBatch.RLock()
+	defer dbBatch.mutBatch.RUnlock()
+
+	if len(dbBatch.cachedData) == 0 {
+		return nil
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(bucketName)
+		for key, val := range dbBatch.cachedData {
+			var err error
+			if bytes.Equal(val, []byte(removed)) {
+				err = bucket.Delete([]byte(key))
+			} else {
+				err = bucket.Put([]byte(key), val)
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// Close closes the files/resources associated to the storage medium
+func (s *DB) Close() error {
+	s.mutBatch.Lock()
+	_ = s.putBatch(s.batch)
+	s.sizeBatch = 0
+	s.mutBatch.Unlock()
+
+	select {
+	case s.dbClosed <- struct{}{}:
+	default:
+	}
+
+	return s.db.Close()
+}
+
+// Remove removes the data associated to the given key
+func (s *DB) Remove(key []byte) error {
+	s.mutBatch.Lock()
+	_ = s.batch.Delete(key)
+	s.mutBatch.Unlock()
+
+	return s.updateBatchWithIncrement()
+}
+
+// Destroy removes the storage medium stored data
+func (s *DB) Destroy() error {
+	s.mutBatch.Lock()
+	s.batch.Reset()
+	s.sizeBatch = 0
+	s.mutBatch.Unlock()
+
+	select {
+	case s.dbClosed <- struct{}{}:
+	default:
+	}
+
+	err := s.db.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.RemoveAll(s.path)
+}
+
+// DestroyClosed removes the already closed storage medium stored data
+func (s *DB) DestroyClosed() error {
+	return os.RemoveAll(s.path)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *DB) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -0,0 +1,212 @@
+package boltdb_test
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/boltdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createBoltDb(t *testing.T, batchDelaySeconds int, maxBatchSize int) *boltdb.DB {
+	dir, _ := ioutil.TempDir("", "boltdb_temp")
+	bdb, err := boltdb.NewDB(dir, batchDelaySeconds, maxBatchSize)
+	require.Nil(t, err, "Failed creating boltdb database file")
+
+	return bdb
+}
+
+func TestDB_InitNoError(t *testing.T) {
+	bdb := createBoltDb(t, 10, 1)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	err := bdb.Init()
+
+	assert.Nil(t, err, "error initializing db")
+}
+
+func TestDB_DoubleOpenShouldError(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "boltdb_temp")
+	bdb1, err := boltdb.NewDB(dir, 10, 1)
+	require.Nil(t, err)
+
+	defer func() {
+		_ = bdb1.Close()
+		_ = os.RemoveAll(dir)
+	}()
+
+	_, err = boltdb.NewDB(dir, 10, 1)
+	assert.NotNil(t, err)
+}
+
+func TestDB_ReopenShouldKeepData(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "boltdb_temp")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	key, val := []byte("key"), []byte("value")
+	bdb, err := boltdb.NewDB(dir, 10, 100)
+	require.Nil(t, err)
+	err = bdb.Put(key, val)
+	require.Nil(t, err)
+	err = bdb.Close()
+	require.Nil(t, err)
+
+	bdb, err = boltdb.NewDB(dir, 10, 100)
+	require.Nil(t, err)
+	defer func() {
+		_ = bdb.Close()
+	}()
+
+	v, err := bdb.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, v)
+}
+
+func TestDB_GetOKAfterPutBeforeTimeout(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	bdb := createBoltDb(t, 1, 100)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	err := bdb.Put(key, val)
+	assert.Nil(t, err)
+	v, err := bdb.Get(key)
+	assert.Equal(t, val, v)
+	assert.Nil(t, err)
+}
+
+func TestDB_GetOKAfterPutWithTimeout(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	bdb := createBoltDb(t, 1, 100)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	err := bdb.Put(key, val)
+	assert.Nil(t, err)
+	time.Sleep(time.Second * 2)
+
+	v, err := bdb.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, v)
+}
+
+func TestDB_GetErrorOnClosed(t *testing.T) {
+	bdb := createBoltDb(t, 1, 100)
+	_ = bdb.Close()
+	defer func() {
+		_ = bdb.DestroyClosed()
+	}()
+
+	v, err := bdb.Get([]byte("key"))
+	assert.Nil(t, v)
+	assert.NotNil(t, err)
+}
+
+func TestDB_RemoveAfterTimeoutOK(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	bdb := createBoltDb(t, 1, 100)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	err := bdb.Put(key, val)
+	assert.Nil(t, err)
+	time.Sleep(time.Second * 2)
+
+	_ = bdb.Remove(key)
+
+	v, err := bdb.Get(key)
+	assert.Nil(t, v)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+}
+
+func TestDB_GetNotPresent(t *testing.T) {
+	bdb := createBoltDb(t, 10, 1)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	v, err := bdb.Get([]byte("key"))
+
+	assert.Nil(t, v)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+}
+
+func TestDB_HasPresent(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	bdb := createBoltDb(t, 10, 1)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	err := bdb.Put(key, val)
+	assert.Nil(t, err)
+
+	err = bdb.Has(key)
+	assert.Nil(t, err)
+}
+
+func TestDB_HasNotPresent(t *testing.T) {
+	bdb := createBoltDb(t, 10, 1)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	err := bdb.Has([]byte("key"))
+
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+}
+
+func TestDB_RemovePresent(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	bdb := createBoltDb(t, 10, 1)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	err := bdb.Put(key, val)
+	assert.Nil(t, err)
+
+	err = bdb.Remove(key)
+	assert.Nil(t, err)
+
+	err = bdb.Has(key)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+}
+
+func TestDB_Destroy(t *testing.T) {
+	bdb := createBoltDb(t, 10, 1)
+
+	err := bdb.Destroy()
+
+	assert.Nil(t, err, "no error expected but got %s", err)
+}
+
+func TestDB_RangeKeysShouldIncludePendingBatch(t *testing.T) {
+	bdb := createBoltDb(t, 10, 100)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	_ = bdb.Put([]byte("key1"), []byte("value1"))
+	_ = bdb.Put([]byte("key2"), []byte("value2"))
+
+	recovered := make(map[string]string)
+	err := bdb.RangeKeys(func(key []byte, value []byte) bool {
+		recovered[string(key)] = string(value)
+		return true
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"key1": "value1", "key2": "value2"}, recovered)
+}
@@ -100,6 +100,10 @@ var ErrFailedCacheEviction = errors.New("failed eviction within cache")
 // ErrItemAlreadyInCache signals that an item is already in cache
 var ErrItemAlreadyInCache = errors.New("item already in cache")
 
+// ErrInsufficientGasPriceBump signals that a transaction cannot replace the one having the same sender and nonce,
+// since its gas price is not sufficiently higher
+var ErrInsufficientGasPriceBump = errors.New("insufficient gas price bump for replacing the transaction with the same nonce")
+
 // ErrCacheSizeInvalid signals that size of cache is less than 1
 var ErrCacheSizeInvalid = errors.New("cache size is less than 1")
 
@@ -117,3 +121,12 @@ var ErrNegativeSizeInBytes = errors.New("negative size in bytes")
 
 // ErrNilTimeCache signals that a nil time cache has been provided
 var ErrNilTimeCache = errors.New("nil time cache")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNotIterablePersister signals that the persister can not iterate over its stored data
+var ErrNotIterablePersister = errors.New("persister can not iterate over its data")
+
+// ErrMigrationVerificationFailed signals that the migrated data does not match the source data
+var ErrMigrationVerificationFailed = errors.New("migration verification failed")
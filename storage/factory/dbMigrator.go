@@ -0,0 +1,494 @@
+package factory
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+)
+
+const migratingSuffix = ".migrating"
+
+// BackupSuffix is appended to the path of each migrated database for keeping the source data
+const BackupSuffix = ".backup"
+
+// ArgsDBMigrator holds the arguments needed for creating a dbMigrator
+type ArgsDBMigrator struct {
+	GeneralConfig         config.Config
+	Hasher                hashing.Hasher
+	DestinationDBType     storageUnit.DBType
+	WorkingDir            string
+	ChainID               string
+	DefaultDBPath         string
+	DefaultEpochString    string
+	DefaultStaticDbString string
+	DefaultShardString    string
+	NumSamples            int
+	KeepBackup            bool
+}
+
+// MigratedUnit holds the result of migrating one database
+type MigratedUnit struct {
+	Path        string
+	SourceType  string
+	NumEntries  uint64
+	NumVerified int
+}
+
+type unitToMigrate struct {
+	path     string
+	dbConfig config.DBConfig
+}
+
+type sampledEntry struct {
+	key       []byte
+	valueHash []byte
+}
+
+type dbMigrator struct {
+	storersConfig     []config.DBConfig
+	trieStoragePaths  []string
+	evictionDBConfig  config.DBConfig
+	snapshotDBConfig  config.DBConfig
+	hasher            hashing.Hasher
+	destinationDBType storageUnit.DBType
+	chainDir          string
+	epochPrefix       string
+	staticDirName     string
+	shardPrefix       string
+	numSamples        int
+	keepBackup        bool
+}
+
+// NewDBMigrator creates a component able to copy all the databases of a stopped node to another persister type
+func NewDBMigrator(args ArgsDBMigrator) (*dbMigrator, error) {
+	if check.IfNil(args.Hasher) {
+		return nil, storage.ErrNilHasher
+	}
+	if len(args.ChainID) == 0 {
+		return nil, fmt.Errorf("%w: empty chain ID", storage.ErrInvalidConfig)
+	}
+	if len(dataFileForDBType(args.DestinationDBType)) == 0 {
+		return nil, fmt.Errorf("%w: %s", storage.ErrNotSupportedDBType, args.DestinationDBType)
+	}
+
+	generalConfig := args.GeneralConfig
+
+	return &dbMigrator{
+		storersConfig: []config.DBConfig{
+			generalConfig.MiniBlocksStorage.DB,
+			generalConfig.PeerBlockBodyStorage.DB,
+			generalConfig.BlockHeaderStorage.DB,
+			generalConfig.TxStorage.DB,
+			generalConfig.UnsignedTransactionStorage.DB,
+			generalConfig.RewardTxStorage.DB,
+			generalConfig.ShardHdrNonceHashStorage.DB,
+			generalConfig.MetaHdrNonceHashStorage.DB,
+			generalConfig.StatusMetricsStorage.DB,
+			generalConfig.BootstrapStorage.DB,
+			generalConfig.MetaBlockStorage.DB,
+			generalConfig.AccountsTrieStorage.DB,
+			generalConfig.PeerAccountsTrieStorage.DB,
+			generalConfig.TxLogsStorage.DB,
+			generalConfig.Heartbeat.HeartbeatStorage.DB,
+			generalConfig.AccountHistory.AccountHistoryStorage.DB,
+			generalConfig.TxPoolSnapshot.TxPoolSnapshotStorage.DB,
+		},
+		trieStoragePaths: []string{
+			filepath.Dir(generalConfig.AccountsTrieStorage.DB.FilePath),
+			filepath.Dir(generalConfig.PeerAccountsTrieStorage.DB.FilePath),
+		},
+		evictionDBConfig:  generalConfig.EvictionWaitingList.DB,
+		snapshotDBConfig:  generalConfig.TrieSnapshotDB,
+		hasher:            args.Hasher,
+		destinationDBType: args.DestinationDBType,
+		chainDir:          filepath.Join(args.WorkingDir, args.DefaultDBPath, args.ChainID),
+		epochPrefix:       args.DefaultEpochString + "_",
+		staticDirName:     args.DefaultStaticDbString,
+		shardPrefix:       args.DefaultShardString + "_",
+		numSamples:        args.NumSamples,
+		keepBackup:        args.KeepBackup,
+	}, nil
+}
+
+// Migrate copies every database of every epoch and shard, and of the static storage, to the destination persister
+// type. Each copy is verified against the source by the number of entries and by the hashes of a sample of values
+// before it replaces the source database in the pathmanager directory layout. The units already using the
+// destination type or an in-memory type are left untouched
+func (dm *dbMigrator) Migrate() ([]*MigratedUnit, error) {
+	units, err := dm.getUnitsToMigrate()
+	if err != nil {
+		return nil, err
+	}
+
+	migrated := make([]*MigratedUnit, 0, len(units))
+	for _, unit := range units {
+		log.Info("migrating database", "path", unit.path, "from", unit.dbConfig.Type, "to", dm.destinationDBType)
+
+		result, errMigrate := dm.migrateUnit(unit)
+		if errMigrate != nil {
+			return migrated, fmt.Errorf("%w while migrating %s", errMigrate, unit.path)
+		}
+
+		log.Info("database migrated", "path", unit.path, "entries", result.NumEntries, "verified", result.NumVerified)
+		migrated = append(migrated, result)
+	}
+
+	return migrated, nil
+}
+
+func (dm *dbMigrator) getUnitsToMigrate() ([]*unitToMigrate, error) {
+	rootDirs, err := dm.getShardDirectories()
+	if err != nil {
+		return nil, err
+	}
+
+	units := make([]*unitToMigrate, 0)
+	for _, rootDir := range rootDirs {
+		for _, dbConfig := range dm.storersConfig {
+			if len(dbConfig.FilePath) == 0 {
+				continue
+			}
+			paths, errGlob := dm.getStorerPaths(rootDir, dbConfig.FilePath)
+			if errGlob != nil {
+				return nil, errGlob
+			}
+
+			units = dm.appendUnits(units, paths, dbConfig)
+		}
+
+		for _, trieStoragePath := range dm.trieStoragePaths {
+			if len(dm.evictionDBConfig.FilePath) == 0 || len(dm.snapshotDBConfig.FilePath) == 0 {
+				break
+			}
+			evictionPath := filepath.Join(rootDir, trieStoragePath, dm.evictionDBConfig.FilePath)
+			units = dm.appendUnits(units, []string{evictionPath}, dm.evictionDBConfig)
+
+			snapshotsPath := filepath.Join(rootDir, trieStoragePath, dm.snapshotDBConfig.FilePath)
+			snapshots, errList := listSubDirectories(snapshotsPath)
+			if errList != nil {
+				return nil, errList
+			}
+
+			// each snapshot is a database in a directory named by the snapshot's index
+			snapshots = filterNumericNames(snapshots)
+			units = dm.appendUnits(units, snapshots, dm.snapshotDBConfig)
+		}
+	}
+
+	sort.Slice(units, func(i, j int) bool {
+		return units[i].path < units[j].path
+	})
+
+	return units, nil
+}
+
+func (dm *dbMigrator) appendUnits(units []*unitToMigrate, paths []string, dbConfig config.DBConfig) []*unitToMigrate {
+	dbType := storageUnit.DBType(dbConfig.Type)
+	if dbType == dm.destinationDBType || dbType == storageUnit.MemoryDB {
+		return units
+	}
+
+	for _, path := range paths {
+		if !directoryExists(path) {
+			continue
+		}
+		if !dataFileExists(path, dbType) {
+			// this happens when a previous run has already migrated the unit but the config was not updated
+			log.Warn("skipping database not written by its configured persister type",
+				"path", path, "type", dbType)
+			continue
+		}
+
+		units = append(units, &unitToMigrate{
+			path:     path,
+			dbConfig: dbConfig,
+		})
+	}
+
+	return units
+}
+
+// getShardDirectories returns the Epoch_<e>/Shard_<s> and Static/Shard_<s> directories of the chain
+func (dm *dbMigrator) getShardDirectories() ([]string, error) {
+	chainSubDirs, err := listSubDirectories(dm.chainDir)
+	if err != nil {
+		return nil, err
+	}
+
+	shardDirs := make([]string, 0)
+	for _, dir := range chainSubDirs {
+		dirName := filepath.Base(dir)
+		if !strings.HasPrefix(dirName, dm.epochPrefix) && dirName != dm.staticDirName {
+			continue
+		}
+
+		subDirs, errList := listSubDirectories(dir)
+		if errList != nil {
+			return nil, errList
+		}
+
+		for _, subDir := range subDirs {
+			if strings.HasPrefix(filepath.Base(subDir), dm.shardPrefix) {
+				shardDirs = append(shardDirs, subDir)
+			}
+		}
+	}
+
+	return shardDirs, nil
+}
+
+// getStorerPaths returns the path of the storer together with the paths of the storers created with a numeric
+// suffix appended to the same identifier, as the shard header nonce storers are
+func (dm *dbMigrator) getStorerPaths(rootDir string, filePath string) ([]string, error) {
+	basePath := filepath.Join(rootDir, filePath)
+	matches, err := filepath.Glob(basePath + "*")
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(matches))
+	for _, match := range matches {
+		suffix := strings.TrimPrefix(match, basePath)
+		if isNumeric(suffix) {
+			paths = append(paths, match)
+		}
+	}
+
+	return paths, nil
+}
+
+func (dm *dbMigrator) migrateUnit(unit *unitToMigrate) (*MigratedUnit, error) {
+	migratingPath := unit.path + migratingSuffix
+	err := os.RemoveAll(migratingPath)
+	if err != nil {
+		return nil, err
+	}
+
+	numEntries, samples, err := dm.copyUnit(unit, migratingPath)
+	if err != nil {
+		_ = os.RemoveAll(migratingPath)
+		return nil, err
+	}
+
+	destinationConfig := unit.dbConfig
+	destinationConfig.Type = string(dm.destinationDBType)
+	err = dm.verifyCopy(destinationConfig, migratingPath, numEntries, samples)
+	if err != nil {
+		_ = os.RemoveAll(migratingPath)
+		return nil, err
+	}
+
+	backupPath := unit.path + BackupSuffix
+	err = os.Rename(unit.path, backupPath)
+	if err != nil {
+		return nil, err
+	}
+	err = os.Rename(migratingPath, unit.path)
+	if err != nil {
+		return nil, err
+	}
+	if !dm.keepBackup {
+		err = os.RemoveAll(backupPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &MigratedUnit{
+		Path:        unit.path,
+		SourceType:  unit.dbConfig.Type,
+		NumEntries:  numEntries,
+		NumVerified: len(samples),
+	}, nil
+}
+
+func (dm *dbMigrator) copyUnit(unit *unitToMigrate, destinationPath string) (uint64, []*sampledEntry, error) {
+	source, err := NewPersisterFactory(unit.dbConfig).Create(unit.path)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer closePersister(source)
+
+	sourceRanger, ok := source.(storage.KeysRanger)
+	if !ok {
+		return 0, nil, storage.ErrNotIterablePersister
+	}
+
+	destinationConfig := unit.dbConfig
+	destinationConfig.Type = string(dm.destinationDBType)
+	destination, err := NewPersisterFactory(destinationConfig).Create(destinationPath)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	// reservoir sampling keeps a uniformly distributed sample without knowing the number of entries in advance
+	random := rand.New(rand.NewSource(time.Now().UnixNano()))
+	samples := make([]*sampledEntry, 0, dm.numSamples)
+	numEntries := uint64(0)
+	var errPut error
+	err = sourceRanger.RangeKeys(func(key []byte, value []byte) bool {
+		errPut = destination.Put(key, value)
+		if errPut != nil {
+			return false
+		}
+
+		numEntries++
+		sample := &sampledEntry{
+			key:       key,
+			valueHash: dm.hasher.Compute(string(value)),
+		}
+		if len(samples) < dm.numSamples {
+			samples = append(samples, sample)
+			return true
+		}
+		index := random.Int63n(int64(numEntries))
+		if index < int64(dm.numSamples) {
+			samples[index] = sample
+		}
+
+		return true
+	})
+	errClose := destination.Close()
+	if err != nil {
+		return 0, nil, err
+	}
+	if errPut != nil {
+		return 0, nil, errPut
+	}
+	if errClose != nil {
+		return 0, nil, errClose
+	}
+
+	return numEntries, samples, nil
+}
+
+func (dm *dbMigrator) verifyCopy(dbConfig config.DBConfig, path string, numEntries uint64, samples []*sampledEntry) error {
+	persister, err := NewPersisterFactory(dbConfig).Create(path)
+	if err != nil {
+		return err
+	}
+	defer closePersister(persister)
+
+	ranger, ok := persister.(storage.KeysRanger)
+	if !ok {
+		return storage.ErrNotIterablePersister
+	}
+
+	numCopied := uint64(0)
+	err = ranger.RangeKeys(func(_ []byte, _ []byte) bool {
+		numCopied++
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if numCopied != numEntries {
+		return fmt.Errorf("%w: source has %d entries, destination has %d",
+			storage.ErrMigrationVerificationFailed, numEntries, numCopied)
+	}
+
+	for _, sample := range samples {
+		value, errGet := persister.Get(sample.key)
+		if errGet != nil {
+			return fmt.Errorf("%w: %s for key %x", storage.ErrMigrationVerificationFailed, errGet.Error(), sample.key)
+		}
+		if !bytes.Equal(dm.hasher.Compute(string(value)), sample.valueHash) {
+			return fmt.Errorf("%w: value hash mismatch for key %x", storage.ErrMigrationVerificationFailed, sample.key)
+		}
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dm *dbMigrator) IsInterfaceNil() bool {
+	return dm == nil
+}
+
+func closePersister(persister storage.Persister) {
+	err := persister.Close()
+	if err != nil {
+		log.Warn("cannot close persister", "error", err)
+	}
+}
+
+func listSubDirectories(path string) ([]string, error) {
+	if !directoryExists(path) {
+		return make([]string, 0), nil
+	}
+
+	infos, err := ioutil.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	dirs := make([]string, 0, len(infos))
+	for _, info := range infos {
+		if info.IsDir() {
+			dirs = append(dirs, filepath.Join(path, info.Name()))
+		}
+	}
+
+	return dirs, nil
+}
+
+func directoryExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
+
+// dataFileForDBType returns a file that each on-disk persister type creates in its directory
+func dataFileForDBType(dbType storageUnit.DBType) string {
+	switch dbType {
+	case storageUnit.LvlDB, storageUnit.LvlDBSerial:
+		return "CURRENT"
+	case storageUnit.BoltDB:
+		return "data.db"
+	default:
+		return ""
+	}
+}
+
+func dataFileExists(path string, dbType storageUnit.DBType) bool {
+	dataFile := dataFileForDBType(dbType)
+	if len(dataFile) == 0 {
+		return false
+	}
+
+	_, err := os.Stat(filepath.Join(path, dataFile))
+
+	return err == nil
+}
+
+func filterNumericNames(paths []string) []string {
+	filtered := make([]string, 0, len(paths))
+	for _, path := range paths {
+		if isNumeric(filepath.Base(path)) {
+			filtered = append(filtered, path)
+		}
+	}
+
+	return filtered
+}
+
+func isNumeric(value string) bool {
+	for _, c := range value {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,180 @@
+package factory
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMigratorDBConfig(filePath string, dbType storageUnit.DBType) config.DBConfig {
+	return config.DBConfig{
+		FilePath:          filePath,
+		Type:              string(dbType),
+		BatchDelaySeconds: 1,
+		MaxBatchSize:      100,
+		MaxOpenFiles:      10,
+	}
+}
+
+func getDBMigratorArgs(workingDir string) ArgsDBMigrator {
+	generalConfig := config.Config{}
+	generalConfig.MiniBlocksStorage.DB = createMigratorDBConfig("MiniBlocks", storageUnit.LvlDBSerial)
+	generalConfig.ShardHdrNonceHashStorage.DB = createMigratorDBConfig("ShardHdrHashNonce", storageUnit.LvlDBSerial)
+	generalConfig.StatusMetricsStorage.DB = createMigratorDBConfig("StatusMetricsStorageDB", storageUnit.MemoryDB)
+	generalConfig.AccountsTrieStorage.DB = createMigratorDBConfig("AccountsTrie/MainDB", storageUnit.LvlDB)
+	generalConfig.EvictionWaitingList.DB = createMigratorDBConfig("EvictionWaitingList", storageUnit.LvlDBSerial)
+	generalConfig.TrieSnapshotDB = createMigratorDBConfig("TrieSnapshot", storageUnit.LvlDBSerial)
+
+	return ArgsDBMigrator{
+		GeneralConfig:         generalConfig,
+		Hasher:                &blake2b.Blake2b{},
+		DestinationDBType:     storageUnit.BoltDB,
+		WorkingDir:            workingDir,
+		ChainID:               "chain",
+		DefaultDBPath:         "db",
+		DefaultEpochString:    "Epoch",
+		DefaultStaticDbString: "Static",
+		DefaultShardString:    "Shard",
+		NumSamples:            5,
+	}
+}
+
+func createUnitWithData(t *testing.T, dbConfig config.DBConfig, path string, numEntries int) {
+	persister, err := NewPersisterFactory(dbConfig).Create(path)
+	require.Nil(t, err)
+
+	for i := 0; i < numEntries; i++ {
+		err = persister.Put([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+		require.Nil(t, err)
+	}
+
+	require.Nil(t, persister.Close())
+}
+
+func checkUnitData(t *testing.T, dbConfig config.DBConfig, path string, numEntries int) {
+	persister, err := NewPersisterFactory(dbConfig).Create(path)
+	require.Nil(t, err)
+	defer func() {
+		_ = persister.Close()
+	}()
+
+	numFound := 0
+	err = persister.(storage.KeysRanger).RangeKeys(func(_ []byte, _ []byte) bool {
+		numFound++
+		return true
+	})
+	require.Nil(t, err)
+	assert.Equal(t, numEntries, numFound)
+
+	for i := 0; i < numEntries; i++ {
+		value, errGet := persister.Get([]byte(fmt.Sprintf("key%d", i)))
+		require.Nil(t, errGet)
+		assert.Equal(t, []byte(fmt.Sprintf("value%d", i)), value)
+	}
+}
+
+func TestNewDBMigrator_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getDBMigratorArgs("")
+	args.Hasher = nil
+	dm, err := NewDBMigrator(args)
+
+	assert.True(t, check.IfNil(dm))
+	assert.Equal(t, storage.ErrNilHasher, err)
+}
+
+func TestNewDBMigrator_InvalidDestinationShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getDBMigratorArgs("")
+	args.DestinationDBType = storageUnit.MemoryDB
+	dm, err := NewDBMigrator(args)
+
+	assert.True(t, check.IfNil(dm))
+	assert.True(t, errors.Is(err, storage.ErrNotSupportedDBType))
+}
+
+func TestDbMigrator_MigrateShouldCopyAllUnits(t *testing.T) {
+	t.Parallel()
+
+	workingDir, err := ioutil.TempDir("", "dbmigrator")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(workingDir)
+	}()
+
+	args := getDBMigratorArgs(workingDir)
+	chainDir := filepath.Join(workingDir, "db", "chain")
+	generalConfig := args.GeneralConfig
+
+	miniBlocksPath := filepath.Join(chainDir, "Epoch_0", "Shard_0", "MiniBlocks")
+	createUnitWithData(t, generalConfig.MiniBlocksStorage.DB, miniBlocksPath, 20)
+	nonceHashPath := filepath.Join(chainDir, "Epoch_1", "Shard_metachain", "ShardHdrHashNonce1")
+	createUnitWithData(t, generalConfig.ShardHdrNonceHashStorage.DB, nonceHashPath, 7)
+	trieDir := filepath.Join(chainDir, "Static", "Shard_0", "AccountsTrie")
+	createUnitWithData(t, generalConfig.AccountsTrieStorage.DB, filepath.Join(trieDir, "MainDB"), 30)
+	createUnitWithData(t, generalConfig.TrieSnapshotDB, filepath.Join(trieDir, "TrieSnapshot", "0"), 3)
+	createUnitWithData(t, generalConfig.EvictionWaitingList.DB, filepath.Join(trieDir, "EvictionWaitingList"), 0)
+
+	dm, err := NewDBMigrator(args)
+	require.Nil(t, err)
+
+	migrated, err := dm.Migrate()
+	require.Nil(t, err)
+	require.Equal(t, 5, len(migrated))
+
+	destinationConfig := func(dbConfig config.DBConfig) config.DBConfig {
+		dbConfig.Type = string(storageUnit.BoltDB)
+		return dbConfig
+	}
+	checkUnitData(t, destinationConfig(generalConfig.MiniBlocksStorage.DB), miniBlocksPath, 20)
+	checkUnitData(t, destinationConfig(generalConfig.ShardHdrNonceHashStorage.DB), nonceHashPath, 7)
+	checkUnitData(t, destinationConfig(generalConfig.AccountsTrieStorage.DB), filepath.Join(trieDir, "MainDB"), 30)
+	checkUnitData(t, destinationConfig(generalConfig.TrieSnapshotDB), filepath.Join(trieDir, "TrieSnapshot", "0"), 3)
+
+	assert.False(t, directoryExists(miniBlocksPath+BackupSuffix))
+	assert.False(t, directoryExists(miniBlocksPath+migratingSuffix))
+
+	// a second run finds the units already migrated and skips them
+	migrated, err = dm.Migrate()
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(migrated))
+}
+
+func TestDbMigrator_MigrateShouldKeepBackup(t *testing.T) {
+	t.Parallel()
+
+	workingDir, err := ioutil.TempDir("", "dbmigrator")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(workingDir)
+	}()
+
+	args := getDBMigratorArgs(workingDir)
+	args.KeepBackup = true
+	miniBlocksPath := filepath.Join(workingDir, "db", "chain", "Epoch_0", "Shard_0", "MiniBlocks")
+	createUnitWithData(t, args.GeneralConfig.MiniBlocksStorage.DB, miniBlocksPath, 4)
+
+	dm, err := NewDBMigrator(args)
+	require.Nil(t, err)
+
+	migrated, err := dm.Migrate()
+	require.Nil(t, err)
+	require.Equal(t, 1, len(migrated))
+	assert.Equal(t, uint64(4), migrated[0].NumEntries)
+	assert.Equal(t, 4, migrated[0].NumVerified)
+
+	checkUnitData(t, args.GeneralConfig.MiniBlocksStorage.DB, miniBlocksPath+BackupSuffix, 4)
+}
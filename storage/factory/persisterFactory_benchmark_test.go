@@ -0,0 +1,169 @@
+package factory_test
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/require"
+)
+
+// the benchmarks below run the same workloads against each of the on-disk persisters, so their results can be
+// compared directly: go test -run=^$ -bench=BenchmarkPersister ./storage/factory/
+
+const benchKeySize = 32
+const benchValueSize = 256
+const benchNumPrefilledKeys = 10000
+
+var benchDBTypes = []storageUnit.DBType{
+	storageUnit.LvlDB,
+	storageUnit.LvlDBSerial,
+	storageUnit.BoltDB,
+}
+
+func createBenchPersister(b *testing.B, dbType storageUnit.DBType, maxBatchSize int) (storage.Persister, func()) {
+	dir, err := ioutil.TempDir("", "persister_bench")
+	require.Nil(b, err)
+
+	persister, err := factory.NewPersisterFactory(config.DBConfig{
+		Type:              string(dbType),
+		BatchDelaySeconds: 2,
+		MaxBatchSize:      maxBatchSize,
+		MaxOpenFiles:      10,
+	}).Create(dir)
+	require.Nil(b, err)
+
+	return persister, func() {
+		_ = persister.Close()
+		_ = os.RemoveAll(dir)
+	}
+}
+
+func generateBenchData(b *testing.B, numEntries int) ([][]byte, [][]byte) {
+	keys := make([][]byte, numEntries)
+	values := make([][]byte, numEntries)
+	for i := 0; i < numEntries; i++ {
+		keys[i] = make([]byte, benchKeySize)
+		values[i] = make([]byte, benchValueSize)
+		_, err := rand.Read(keys[i])
+		require.Nil(b, err)
+		_, err = rand.Read(values[i])
+		require.Nil(b, err)
+	}
+
+	return keys, values
+}
+
+func prefillPersister(b *testing.B, persister storage.Persister, keys [][]byte, values [][]byte) {
+	for i := range keys {
+		err := persister.Put(keys[i], values[i])
+		require.Nil(b, err)
+	}
+}
+
+func benchmarkPut(b *testing.B, maxBatchSize int) {
+	for _, dbType := range benchDBTypes {
+		b.Run(string(dbType), func(b *testing.B) {
+			persister, cleanup := createBenchPersister(b, dbType, maxBatchSize)
+			defer cleanup()
+
+			keys, values := generateBenchData(b, b.N)
+
+			b.SetBytes(benchKeySize + benchValueSize)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				err := persister.Put(keys[i], values[i])
+				require.Nil(b, err)
+			}
+		})
+	}
+}
+
+// BenchmarkPersister_PutEachEntryCommitted measures the writes when every Put is flushed to disk
+func BenchmarkPersister_PutEachEntryCommitted(b *testing.B) {
+	benchmarkPut(b, 1)
+}
+
+// BenchmarkPersister_PutBatched measures the writes when the entries are flushed in batches of the size used
+// by the node's storers
+func BenchmarkPersister_PutBatched(b *testing.B) {
+	benchmarkPut(b, 100)
+}
+
+// BenchmarkPersister_PutLargeBatches measures the writes when the entries are flushed in large batches, as
+// it happens while syncing an archive node
+func BenchmarkPersister_PutLargeBatches(b *testing.B) {
+	benchmarkPut(b, 10000)
+}
+
+// BenchmarkPersister_GetPresent measures the reads of existing keys that are no longer held in the write batch
+func BenchmarkPersister_GetPresent(b *testing.B) {
+	for _, dbType := range benchDBTypes {
+		b.Run(string(dbType), func(b *testing.B) {
+			persister, cleanup := createBenchPersister(b, dbType, benchNumPrefilledKeys)
+			defer cleanup()
+
+			keys, values := generateBenchData(b, benchNumPrefilledKeys)
+			prefillPersister(b, persister, keys, values)
+
+			b.SetBytes(benchValueSize)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_, err := persister.Get(keys[i%benchNumPrefilledKeys])
+				require.Nil(b, err)
+			}
+		})
+	}
+}
+
+// BenchmarkPersister_GetNotPresent measures the reads of missing keys
+func BenchmarkPersister_GetNotPresent(b *testing.B) {
+	for _, dbType := range benchDBTypes {
+		b.Run(string(dbType), func(b *testing.B) {
+			persister, cleanup := createBenchPersister(b, dbType, benchNumPrefilledKeys)
+			defer cleanup()
+
+			keys, values := generateBenchData(b, benchNumPrefilledKeys)
+			prefillPersister(b, persister, keys, values)
+			missingKeys, _ := generateBenchData(b, benchNumPrefilledKeys)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				err := persister.Has(missingKeys[i%benchNumPrefilledKeys])
+				require.Equal(b, storage.ErrKeyNotFound, err)
+			}
+		})
+	}
+}
+
+// BenchmarkPersister_MixedReadWrite measures a workload with one write for every four reads
+func BenchmarkPersister_MixedReadWrite(b *testing.B) {
+	for _, dbType := range benchDBTypes {
+		b.Run(string(dbType), func(b *testing.B) {
+			persister, cleanup := createBenchPersister(b, dbType, 100)
+			defer cleanup()
+
+			keys, values := generateBenchData(b, benchNumPrefilledKeys)
+			prefillPersister(b, persister, keys, values)
+			newKeys, newValues := generateBenchData(b, b.N/5+1)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if i%5 == 0 {
+					err := persister.Put(newKeys[i/5], newValues[i/5])
+					require.Nil(b, err)
+					continue
+				}
+
+				_, err := persister.Get(keys[i%benchNumPrefilledKeys])
+				require.Nil(b, err, fmt.Sprintf("iteration %d", i))
+			}
+		})
+	}
+}
@@ -78,6 +78,8 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 	var shardHdrHashNonceUnit *pruning.PruningStorer
 	var bootstrapUnit *pruning.PruningStorer
 	var txLogsUnit *pruning.PruningStorer
+	var accountHistoryUnit *pruning.PruningStorer
+	var txPoolSnapshotUnit storage.Storer
 	var err error
 
 	successfullyCreatedStorers := make([]storage.Storer, 0)
@@ -193,6 +195,23 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, txLogsUnit)
 
+	if psf.generalConfig.AccountHistory.Enabled {
+		accountHistoryUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.AccountHistory.AccountHistoryStorage)
+		accountHistoryUnit, err = pruning.NewPruningStorer(accountHistoryUnitArgs)
+		if err != nil {
+			return nil, err
+		}
+		successfullyCreatedStorers = append(successfullyCreatedStorers, accountHistoryUnit)
+	}
+
+	if psf.generalConfig.TxPoolSnapshot.Enabled {
+		txPoolSnapshotUnit, err = psf.createTxPoolSnapshotUnit()
+		if err != nil {
+			return nil, err
+		}
+		successfullyCreatedStorers = append(successfullyCreatedStorers, txPoolSnapshotUnit)
+	}
+
 	store := dataRetriever.NewChainStorer()
 	store.AddStorer(dataRetriever.TransactionUnit, txUnit)
 	store.AddStorer(dataRetriever.MiniBlockUnit, miniBlockUnit)
@@ -208,6 +227,12 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 	store.AddStorer(dataRetriever.BootstrapUnit, bootstrapUnit)
 	store.AddStorer(dataRetriever.StatusMetricsUnit, statusMetricsStorageUnit)
 	store.AddStorer(dataRetriever.TxLogsUnit, txLogsUnit)
+	if psf.generalConfig.AccountHistory.Enabled {
+		store.AddStorer(dataRetriever.AccountHistoryUnit, accountHistoryUnit)
+	}
+	if psf.generalConfig.TxPoolSnapshot.Enabled {
+		store.AddStorer(dataRetriever.TxPoolSnapshotUnit, txPoolSnapshotUnit)
+	}
 
 	return store, err
 }
@@ -224,6 +249,8 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 	var shardHdrHashNonceUnits []*pruning.PruningStorer
 	var bootstrapUnit *pruning.PruningStorer
 	var txLogsUnit *pruning.PruningStorer
+	var accountHistoryUnit *pruning.PruningStorer
+	var txPoolSnapshotUnit storage.Storer
 	var err error
 
 	successfullyCreatedStorers := make([]storage.Storer, 0)
@@ -337,6 +364,23 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, txLogsUnit)
 
+	if psf.generalConfig.AccountHistory.Enabled {
+		accountHistoryUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.AccountHistory.AccountHistoryStorage)
+		accountHistoryUnit, err = pruning.NewPruningStorer(accountHistoryUnitArgs)
+		if err != nil {
+			return nil, err
+		}
+		successfullyCreatedStorers = append(successfullyCreatedStorers, accountHistoryUnit)
+	}
+
+	if psf.generalConfig.TxPoolSnapshot.Enabled {
+		txPoolSnapshotUnit, err = psf.createTxPoolSnapshotUnit()
+		if err != nil {
+			return nil, err
+		}
+		successfullyCreatedStorers = append(successfullyCreatedStorers, txPoolSnapshotUnit)
+	}
+
 	store := dataRetriever.NewChainStorer()
 	store.AddStorer(dataRetriever.MetaBlockUnit, metaBlockUnit)
 	store.AddStorer(dataRetriever.BlockHeaderUnit, headerUnit)
@@ -353,10 +397,29 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 	store.AddStorer(dataRetriever.BootstrapUnit, bootstrapUnit)
 	store.AddStorer(dataRetriever.StatusMetricsUnit, statusMetricsStorageUnit)
 	store.AddStorer(dataRetriever.TxLogsUnit, txLogsUnit)
+	if psf.generalConfig.AccountHistory.Enabled {
+		store.AddStorer(dataRetriever.AccountHistoryUnit, accountHistoryUnit)
+	}
+	if psf.generalConfig.TxPoolSnapshot.Enabled {
+		store.AddStorer(dataRetriever.TxPoolSnapshotUnit, txPoolSnapshotUnit)
+	}
 
 	return store, err
 }
 
+// createTxPoolSnapshotUnit creates a static storer, since the snapshot of the transactions pool has to survive the epoch changes
+func (psf *StorageServiceFactory) createTxPoolSnapshotUnit() (storage.Storer, error) {
+	storageConfig := psf.generalConfig.TxPoolSnapshot.TxPoolSnapshotStorage
+	dbConfig := GetDBFromConfig(storageConfig.DB)
+	shardId := core.GetShardIdString(psf.shardCoordinator.SelfId())
+	dbConfig.FilePath = psf.pathManager.PathForStatic(shardId, storageConfig.DB.FilePath)
+
+	return storageUnit.NewStorageUnitFromConf(
+		GetCacherFromConfig(storageConfig.Cache),
+		dbConfig,
+		GetBloomFromConfig(storageConfig.Bloom))
+}
+
 func (psf *StorageServiceFactory) createPruningStorerArgs(storageConfig config.StorageConfig) *pruning.StorerArgs {
 	fullArchiveMode := psf.generalConfig.StoragePruning.FullArchive
 	numOfEpochsToKeep := uint32(psf.generalConfig.StoragePruning.NumEpochsToKeep)
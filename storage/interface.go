@@ -29,6 +29,13 @@ type Persister interface {
 	IsInterfaceNil() bool
 }
 
+// KeysRanger defines a persister able to iterate over all the (key, value) pairs it holds
+type KeysRanger interface {
+	// RangeKeys calls the handler for each stored (key, value) pair until the handler returns false. The
+	// provided slices can be kept by the handler
+	RangeKeys(handler func(key []byte, value []byte) bool) error
+}
+
 // Batcher allows to batch the data first then write the batch to the persister in one go
 type Batcher interface {
 	// Put inserts one entry - key, value pair - into the batch
@@ -67,3 +67,22 @@ func openOneTime(path string, options *opt.Options) (*leveldb.DB, error) {
 
 	return nil, errOpen
 }
+
+func rangeKeys(db *leveldb.DB, handler func(key []byte, value []byte) bool) error {
+	iterator := db.NewIterator(nil, nil)
+	defer iterator.Release()
+
+	for iterator.Next() {
+		// the iterator reuses its buffers between steps
+		key := make([]byte, len(iterator.Key()))
+		copy(key, iterator.Key())
+		value := make([]byte, len(iterator.Value()))
+		copy(value, iterator.Value())
+
+		if !handler(key, value) {
+			break
+		}
+	}
+
+	return iterator.Error()
+}
@@ -15,6 +15,7 @@ import (
 )
 
 var _ storage.Persister = (*DB)(nil)
+var _ storage.KeysRanger = (*DB)(nil)
 
 // read + write + execute for owner only
 const rwxOwner = 0700
@@ -172,6 +173,22 @@ func (s *DB) Has(key []byte) error {
 	return storage.ErrKeyNotFound
 }
 
+// RangeKeys writes the pending batch and then calls the handler for each stored (key, value) pair until the
+// handler returns false
+func (s *DB) RangeKeys(handler func(key []byte, value []byte) bool) error {
+	s.mutBatch.Lock()
+	err := s.putBatch(s.batch)
+	if err != nil {
+		s.mutBatch.Unlock()
+		return err
+	}
+	s.batch.Reset()
+	s.sizeBatch = 0
+	s.mutBatch.Unlock()
+
+	return rangeKeys(s.db, handler)
+}
+
 // Init initializes the storage medium and prepares it for usage
 func (s *DB) Init() error {
 	// no special initialization needed
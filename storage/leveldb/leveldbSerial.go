@@ -15,6 +15,7 @@ import (
 )
 
 var _ storage.Persister = (*SerialDB)(nil)
+var _ storage.KeysRanger = (*SerialDB)(nil)
 
 // SerialDB holds a pointer to the leveldb database and the path to where it is stored.
 type SerialDB struct {
@@ -191,6 +192,21 @@ func (s *SerialDB) Has(key []byte) error {
 	return result
 }
 
+// RangeKeys writes the pending batch and then calls the handler for each stored (key, value) pair until the
+// handler returns false. The iteration runs on a leveldb snapshot, outside the serialized access loop
+func (s *SerialDB) RangeKeys(handler func(key []byte, value []byte) bool) error {
+	if s.isClosed() {
+		return storage.ErrSerialDBIsClosed
+	}
+
+	err := s.putBatch()
+	if err != nil {
+		return err
+	}
+
+	return rangeKeys(s.db, handler)
+}
+
 // Init initializes the storage medium and prepares it for usage
 func (s *SerialDB) Init() error {
 	// no special initialization needed
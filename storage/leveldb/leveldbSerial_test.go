@@ -195,3 +195,22 @@ func TestSerialDB_Destroy(t *testing.T) {
 
 	assert.Nil(t, err, "no error expected but got %s", err)
 }
+
+func TestSerialDB_RangeKeysShouldIncludePendingBatch(t *testing.T) {
+	ldb := createSerialLevelDb(t, 10, 100, 10)
+	defer func() {
+		_ = ldb.Destroy()
+	}()
+
+	_ = ldb.Put([]byte("key1"), []byte("value1"))
+	_ = ldb.Put([]byte("key2"), []byte("value2"))
+
+	recovered := make(map[string]string)
+	err := ldb.RangeKeys(func(key []byte, value []byte) bool {
+		recovered[string(key)] = string(value)
+		return true
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"key1": "value1", "key2": "value2"}, recovered)
+}
@@ -260,3 +260,22 @@ func TestDB_Destroy(t *testing.T) {
 
 	assert.Nil(t, err, "no error expected but got %s", err)
 }
+
+func TestDB_RangeKeysShouldStopWhenHandlerReturnsFalse(t *testing.T) {
+	ldb := createLevelDb(t, 10, 100, 10)
+	defer func() {
+		_ = ldb.Destroy()
+	}()
+
+	_ = ldb.Put([]byte("key1"), []byte("value1"))
+	_ = ldb.Put([]byte("key2"), []byte("value2"))
+
+	numCalls := 0
+	err := ldb.RangeKeys(func(key []byte, value []byte) bool {
+		numCalls++
+		return false
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numCalls)
+}
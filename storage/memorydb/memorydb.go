@@ -10,6 +10,7 @@ import (
 )
 
 var _ storage.Persister = (*DB)(nil)
+var _ storage.KeysRanger = (*DB)(nil)
 
 // DB represents the memory database storage. It holds a map of key value pairs
 // and a mutex to handle concurrent accesses to the map
@@ -50,6 +51,20 @@ func (s *DB) Get(key []byte) ([]byte, error) {
 	return val, nil
 }
 
+// RangeKeys calls the handler for each stored (key, value) pair until the handler returns false
+func (s *DB) RangeKeys(handler func(key []byte, value []byte) bool) error {
+	s.mutx.RLock()
+	defer s.mutx.RUnlock()
+
+	for key, val := range s.db {
+		if !handler([]byte(key), val) {
+			break
+		}
+	}
+
+	return nil
+}
+
 // Has returns true if the given key is present in the persistence medium, false otherwise
 func (s *DB) Has(key []byte) error {
 	s.mutx.RLock()
@@ -98,3 +98,18 @@ func TestDestroy(t *testing.T) {
 	err := mdb.Destroy()
 	assert.Nil(t, err, "no error expected but got %s", err)
 }
+
+func TestRangeKeys(t *testing.T) {
+	mdb := memorydb.New()
+	_ = mdb.Put([]byte("key1"), []byte("value1"))
+	_ = mdb.Put([]byte("key2"), []byte("value2"))
+
+	recovered := make(map[string]string)
+	err := mdb.RangeKeys(func(key []byte, value []byte) bool {
+		recovered[string(key)] = string(value)
+		return true
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"key1": "value1", "key2": "value2"}, recovered)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/hashing/keccak"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/bloom"
+	"github.com/ElrondNetwork/elrond-go/storage/boltdb"
 	"github.com/ElrondNetwork/elrond-go/storage/fifocache"
 	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
@@ -47,6 +48,7 @@ var log = logger.GetOrCreate("storage/storageUnit")
 const (
 	LvlDB       DBType = "LvlDB"
 	LvlDBSerial DBType = "LvlDBSerial"
+	BoltDB      DBType = "BoltDB"
 	MemoryDB    DBType = "MemoryDB"
 )
 
@@ -70,12 +72,14 @@ type UnitConfig struct {
 
 // CacheConfig holds the configurable elements of a cache
 type CacheConfig struct {
-	Type                 CacheType
-	SizeInBytes          uint64
-	SizeInBytesPerSender uint32
-	Capacity             uint32
-	SizePerSender        uint32
-	Shards               uint32
+	Type                      CacheType
+	SizeInBytes               uint64
+	SizeInBytesPerSender      uint32
+	Capacity                  uint32
+	SizePerSender             uint32
+	Shards                    uint32
+	MinGasPriceBumpPercentage uint32
+	SelectionPolicy           string
 }
 
 // DBConfig holds the configurable elements of a database
@@ -393,6 +397,8 @@ func NewDB(argDB ArgDB) (storage.Persister, error) {
 			db, err = leveldb.NewDB(argDB.Path, argDB.BatchDelaySeconds, argDB.MaxBatchSize, argDB.MaxOpenFiles)
 		case LvlDBSerial:
 			db, err = leveldb.NewSerialDB(argDB.Path, argDB.BatchDelaySeconds, argDB.MaxBatchSize, argDB.MaxOpenFiles)
+		case BoltDB:
+			db, err = boltdb.NewDB(argDB.Path, argDB.BatchDelaySeconds, argDB.MaxBatchSize)
 		case MemoryDB:
 			db = memorydb.New()
 		default:
@@ -1,2 +1,3 @@
 #!/bin/bash
 go test -bench="BenchmarkSendersMap_GetSnapshotAscending$" -benchtime=1x
+go test -run=XXX -bench="BenchmarkSelection_" -benchtime=20x
@@ -31,11 +31,14 @@ type ConfigSourceMe struct {
 	CountPerSenderThreshold       uint32
 	NumSendersToPreemptivelyEvict uint32
 	MinGasPriceNanoErd            uint32
+	MinGasPriceBumpPercentage     uint32
+	SelectionPolicy               string
 }
 
 type senderConstraints struct {
-	maxNumTxs   uint32
-	maxNumBytes uint32
+	maxNumTxs                 uint32
+	maxNumBytes               uint32
+	minGasPriceBumpPercentage uint32
 }
 
 // TODO: Upon further analysis and brainstorming, add some sensible minimum accepted values for the appropriate fields.
@@ -55,6 +58,9 @@ func (config *ConfigSourceMe) verify() error {
 	if config.MinGasPriceNanoErd < minGasPriceNanoErdLowerBound {
 		return fmt.Errorf("%w: config.MinGasPriceNanoErd is invalid", storage.ErrInvalidConfig)
 	}
+	if !IsKnownSelectionPolicy(config.SelectionPolicy) {
+		return fmt.Errorf("%w: config.SelectionPolicy is invalid", storage.ErrInvalidConfig)
+	}
 	if config.EvictionEnabled {
 		if config.NumBytesThreshold < maxNumBytesLowerBound || config.NumBytesThreshold > maxNumBytesUpperBound {
 			return fmt.Errorf("%w: config.NumBytesThreshold is invalid", storage.ErrInvalidConfig)
@@ -72,8 +78,9 @@ func (config *ConfigSourceMe) verify() error {
 
 func (config *ConfigSourceMe) getSenderConstraints() senderConstraints {
 	return senderConstraints{
-		maxNumBytes: config.NumBytesPerSenderThreshold,
-		maxNumTxs:   config.CountPerSenderThreshold,
+		maxNumBytes:               config.NumBytesPerSenderThreshold,
+		maxNumTxs:                 config.CountPerSenderThreshold,
+		minGasPriceBumpPercentage: config.MinGasPriceBumpPercentage,
 	}
 }
 
@@ -73,6 +73,11 @@ func (cache *CrossTxCache) AddTx(tx *WrappedTransaction) (ok bool, added bool) {
 	return cache.Add(tx)
 }
 
+// AddRequestedTx adds a transaction in the cache, the same as AddTx, since this cache does not replace transactions
+func (cache *CrossTxCache) AddRequestedTx(tx *WrappedTransaction) (ok bool, added bool) {
+	return cache.Add(tx)
+}
+
 // GetByTxHash gets the transaction by hash
 func (cache *CrossTxCache) GetByTxHash(txHash []byte) (*WrappedTransaction, bool) {
 	item, ok := cache.GetItem(txHash)
@@ -121,6 +126,20 @@ func (cache *CrossTxCache) ForEachTransaction(function ForEachTransaction) {
 	})
 }
 
+// GetStatistics returns the number of transactions and bytes held in the cache. The senders are not tracked
+func (cache *CrossTxCache) GetStatistics() CacheStatistics {
+	return CacheStatistics{
+		Name:     cache.config.Name,
+		NumTxs:   uint64(cache.Count()),
+		NumBytes: uint64(cache.NumBytes()),
+	}
+}
+
+// CheckReplacement always accepts the transaction, since the transactions are not tracked by sender
+func (cache *CrossTxCache) CheckReplacement(_ *WrappedTransaction) error {
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (cache *CrossTxCache) IsInterfaceNil() bool {
 	return cache == nil
@@ -20,6 +20,11 @@ func (cache *DisabledCache) AddTx(_ *WrappedTransaction) (ok bool, added bool) {
 	return false, false
 }
 
+// AddRequestedTx does nothing
+func (cache *DisabledCache) AddRequestedTx(_ *WrappedTransaction) (ok bool, added bool) {
+	return false, false
+}
+
 // GetByTxHash returns no transaction
 func (cache *DisabledCache) GetByTxHash(_ []byte) (*WrappedTransaction, bool) {
 	return nil, false
@@ -44,6 +49,16 @@ func (cache *DisabledCache) Len() int {
 func (cache *DisabledCache) ForEachTransaction(_ ForEachTransaction) {
 }
 
+// GetStatistics returns empty statistics
+func (cache *DisabledCache) GetStatistics() CacheStatistics {
+	return CacheStatistics{}
+}
+
+// CheckReplacement does nothing
+func (cache *DisabledCache) CheckReplacement(_ *WrappedTransaction) error {
+	return nil
+}
+
 // Clear does nothing
 func (cache *DisabledCache) Clear() {
 }
@@ -0,0 +1,101 @@
+package txcache
+
+// CacheStatistics holds the size of a transactions cache
+type CacheStatistics struct {
+	Name       string
+	NumTxs     uint64
+	NumBytes   uint64
+	NumSenders uint64
+}
+
+// NonceGap is a range of nonces, both ends included, missing from the transactions of a sender
+type NonceGap struct {
+	From uint64
+	To   uint64
+}
+
+// SenderInfo holds a snapshot of the transactions of a sender, along with the state tracked by the cache for it
+type SenderInfo struct {
+	Sender              []byte
+	Transactions        []*WrappedTransaction
+	AccountNonce        uint64
+	AccountNonceKnown   bool
+	Score               uint32
+	NumBytes            uint64
+	TotalGas            uint64
+	TotalFee            uint64
+	NumFailedSelections int64
+	IsInGracePeriod     bool
+	NonceGaps           []NonceGap
+}
+
+// GetStatistics returns the number of transactions, bytes and senders held in the cache
+func (cache *TxCache) GetStatistics() CacheStatistics {
+	return CacheStatistics{
+		Name:       cache.name,
+		NumTxs:     cache.CountTx(),
+		NumBytes:   cache.NumBytes(),
+		NumSenders: cache.CountSenders(),
+	}
+}
+
+// GetSenderInfo returns a snapshot of the transactions held in the cache for the given sender
+func (cache *TxCache) GetSenderInfo(sender []byte) (*SenderInfo, bool) {
+	listForSender, ok := cache.txListBySender.getListForSender(string(sender))
+	if !ok {
+		return nil, false
+	}
+
+	return listForSender.getSenderInfo(), true
+}
+
+func (listForSender *txListForSender) getSenderInfo() *SenderInfo {
+	listForSender.mutex.RLock()
+	defer listForSender.mutex.RUnlock()
+
+	info := &SenderInfo{
+		Sender:              []byte(listForSender.sender),
+		Transactions:        make([]*WrappedTransaction, 0, listForSender.countTx()),
+		AccountNonce:        listForSender.accountNonce.Get(),
+		AccountNonceKnown:   listForSender.accountNonceKnown.IsSet(),
+		Score:               listForSender.getLastComputedScore(),
+		NumBytes:            listForSender.totalBytes.GetUint64(),
+		TotalGas:            listForSender.totalGas.GetUint64(),
+		TotalFee:            listForSender.totalFee.GetUint64(),
+		NumFailedSelections: listForSender.numFailedSelections.Get(),
+		IsInGracePeriod:     listForSender.isInGracePeriod(),
+	}
+
+	for element := listForSender.items.Front(); element != nil; element = element.Next() {
+		info.Transactions = append(info.Transactions, element.Value.(*WrappedTransaction))
+	}
+
+	info.NonceGaps = findNonceGaps(info.Transactions, info.AccountNonce, info.AccountNonceKnown)
+
+	return info
+}
+
+// findNonceGaps returns the missing nonces, given the transactions sorted by nonce. The gap before the first
+// transaction is only known if the account nonce was notified
+func findNonceGaps(txs []*WrappedTransaction, accountNonce uint64, accountNonceKnown bool) []NonceGap {
+	gaps := make([]NonceGap, 0)
+	if len(txs) == 0 {
+		return gaps
+	}
+
+	previousNonce := txs[0].Tx.GetNonce()
+	if accountNonceKnown && previousNonce > accountNonce {
+		gaps = append(gaps, NonceGap{From: accountNonce, To: previousNonce - 1})
+	}
+
+	for _, tx := range txs[1:] {
+		nonce := tx.Tx.GetNonce()
+		if nonce > previousNonce+1 {
+			gaps = append(gaps, NonceGap{From: previousNonce + 1, To: nonce - 1})
+		}
+
+		previousNonce = nonce
+	}
+
+	return gaps
+}
@@ -0,0 +1,48 @@
+package txcache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetSenderInfo_UnknownSender(t *testing.T) {
+	cache := newUnconstrainedCacheToTest()
+
+	info, ok := cache.GetSenderInfo([]byte("alice"))
+	require.False(t, ok)
+	require.Nil(t, info)
+}
+
+func Test_GetSenderInfo(t *testing.T) {
+	cache := newUnconstrainedCacheToTest()
+	cache.AddTx(createTx([]byte("hash-alice-6"), "alice", 6))
+	cache.AddTx(createTx([]byte("hash-alice-3"), "alice", 3))
+	cache.AddTx(createTx([]byte("hash-alice-4"), "alice", 4))
+	cache.AddTx(createTx([]byte("hash-bob-1"), "bob", 1))
+	cache.NotifyAccountNonce([]byte("alice"), 1)
+
+	info, ok := cache.GetSenderInfo([]byte("alice"))
+	require.True(t, ok)
+	require.Equal(t, []byte("alice"), info.Sender)
+	require.Len(t, info.Transactions, 3)
+	require.Equal(t, []byte("hash-alice-3"), info.Transactions[0].TxHash)
+	require.Equal(t, []byte("hash-alice-6"), info.Transactions[2].TxHash)
+	require.True(t, info.AccountNonceKnown)
+	require.Equal(t, uint64(1), info.AccountNonce)
+	require.Equal(t, []NonceGap{{From: 1, To: 2}, {From: 5, To: 5}}, info.NonceGaps)
+}
+
+func Test_FindNonceGaps(t *testing.T) {
+	txs := []*WrappedTransaction{
+		createTx([]byte("a"), "alice", 5),
+		createTx([]byte("b"), "alice", 5),
+		createTx([]byte("c"), "alice", 6),
+		createTx([]byte("d"), "alice", 9),
+	}
+
+	require.Equal(t, []NonceGap{{From: 7, To: 8}}, findNonceGaps(txs, 0, false))
+	require.Equal(t, []NonceGap{{From: 3, To: 4}, {From: 7, To: 8}}, findNonceGaps(txs, 3, true))
+	require.Equal(t, []NonceGap{{From: 7, To: 8}}, findNonceGaps(txs, 5, true))
+	require.Empty(t, findNonceGaps(nil, 0, true))
+}
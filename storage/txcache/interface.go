@@ -4,5 +4,9 @@ type scoreComputer interface {
 	computeScore(scoreParams senderScoreParams) uint32
 }
 
+type selectionPolicy interface {
+	selectTransactions(cache *TxCache, senders []*txListForSender, numRequested int, batchSizePerSender int) []*WrappedTransaction
+}
+
 // ForEachTransaction is an iterator callback
 type ForEachTransaction func(txHash []byte, value *WrappedTransaction)
@@ -37,8 +37,8 @@ func TestDefaultScoreComputer_computeRawScoreOfTxListForSender(t *testing.T) {
 	list := newUnconstrainedListToTest()
 
 	list.AddTx(createTxWithParams([]byte("a"), ".", 1, 1000, 200000, 100*oneBillion))
-	list.AddTx(createTxWithParams([]byte("b"), ".", 1, 500, 100000, 100*oneBillion))
-	list.AddTx(createTxWithParams([]byte("c"), ".", 1, 500, 100000, 100*oneBillion))
+	list.AddTx(createTxWithParams([]byte("b"), ".", 2, 500, 100000, 100*oneBillion))
+	list.AddTx(createTxWithParams([]byte("c"), ".", 3, 500, 100000, 100*oneBillion))
 
 	require.Equal(t, uint64(3), list.countTx())
 	require.Equal(t, int64(2000), list.totalBytes.Get())
@@ -55,8 +55,8 @@ func TestDefaultScoreComputer_scoreFluctuatesDeterministicallyWhileTxListForSend
 	list := newUnconstrainedListToTest()
 
 	A := createTxWithParams([]byte("A"), ".", 1, 1000, 200000, 100*oneBillion)
-	B := createTxWithParams([]byte("b"), ".", 1, 500, 100000, 100*oneBillion)
-	C := createTxWithParams([]byte("c"), ".", 1, 500, 100000, 100*oneBillion)
+	B := createTxWithParams([]byte("b"), ".", 2, 500, 100000, 100*oneBillion)
+	C := createTxWithParams([]byte("c"), ".", 3, 500, 100000, 100*oneBillion)
 
 	scoreNone := int(computer.computeScore(list.getScoreParams()))
 	list.AddTx(A)
@@ -0,0 +1,240 @@
+package txcache
+
+import (
+	"container/heap"
+	"fmt"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// SelectionPolicyScore selects the transactions in batches, sender by sender, in the descending order of the senders' score.
+// Senders with a higher score are given larger batches. This is the default policy.
+const SelectionPolicyScore = "Score"
+
+// SelectionPolicyFIFO selects the transactions strictly in the order of their arrival in the cache
+const SelectionPolicyFIFO = "FIFO"
+
+// SelectionPolicyGasPrice selects the transactions paying the highest gas price first
+const SelectionPolicyGasPrice = "GasPrice"
+
+// SelectionPolicyRoundRobin selects "batchSizePerSender" transactions from each sender, in turn, regardless of their score
+const SelectionPolicyRoundRobin = "RoundRobin"
+
+var _ selectionPolicy = (*scoreSelectionPolicy)(nil)
+var _ selectionPolicy = (*fifoSelectionPolicy)(nil)
+var _ selectionPolicy = (*gasPriceSelectionPolicy)(nil)
+var _ selectionPolicy = (*roundRobinSelectionPolicy)(nil)
+
+// IsKnownSelectionPolicy returns whether the given name identifies a selection policy. An empty name stands for the default policy.
+func IsKnownSelectionPolicy(name string) bool {
+	_, err := newSelectionPolicy(name)
+	return err == nil
+}
+
+func newSelectionPolicy(name string) (selectionPolicy, error) {
+	switch name {
+	case "", SelectionPolicyScore:
+		return &scoreSelectionPolicy{}, nil
+	case SelectionPolicyFIFO:
+		return &fifoSelectionPolicy{}, nil
+	case SelectionPolicyGasPrice:
+		return &gasPriceSelectionPolicy{}, nil
+	case SelectionPolicyRoundRobin:
+		return &roundRobinSelectionPolicy{}, nil
+	default:
+		return nil, fmt.Errorf("%w: unknown selection policy %s", storage.ErrInvalidConfig, name)
+	}
+}
+
+type scoreSelectionPolicy struct {
+}
+
+// selectTransactions makes multiple passes over the senders (sorted by score, descending). In each pass, a sender gives
+// a batch of transactions, proportional to its score
+func (policy *scoreSelectionPolicy) selectTransactions(cache *TxCache, senders []*txListForSender, numRequested int, batchSizePerSender int) []*WrappedTransaction {
+	result := make([]*WrappedTransaction, numRequested)
+	resultFillIndex := 0
+	resultIsFull := false
+
+	for pass := 0; !resultIsFull; pass++ {
+		copiedInThisPass := 0
+
+		for _, txList := range senders {
+			batchSizeWithScoreCoefficient := batchSizePerSender * int(txList.getLastComputedScore()+1)
+			// Reset happens on first pass only
+			isFirstBatch := pass == 0
+			journal := txList.selectBatchTo(isFirstBatch, result[resultFillIndex:], batchSizeWithScoreCoefficient)
+			cache.onBatchSelected(txList, journal)
+
+			resultFillIndex += journal.copied
+			copiedInThisPass += journal.copied
+			resultIsFull = resultFillIndex == numRequested
+			if resultIsFull {
+				break
+			}
+		}
+
+		nothingCopiedThisPass := copiedInThisPass == 0
+
+		// No more passes needed
+		if nothingCopiedThisPass {
+			break
+		}
+	}
+
+	return result[:resultFillIndex]
+}
+
+type fifoSelectionPolicy struct {
+}
+
+// selectTransactions merges the transactions of all senders, in the order of their arrival.
+// The transactions of a sender are still selected in the order of their nonce.
+func (policy *fifoSelectionPolicy) selectTransactions(cache *TxCache, senders []*txListForSender, numRequested int, _ int) []*WrappedTransaction {
+	sequences := cache.selectSequencesOfSenders(senders, numRequested)
+	return mergeSequences(sequences, numRequested, isArrivedEarlier)
+}
+
+type gasPriceSelectionPolicy struct {
+}
+
+// selectTransactions merges the transactions of all senders, in the descending order of their gas price (ties are
+// broken by arrival). The transactions of a sender are still selected in the order of their nonce.
+func (policy *gasPriceSelectionPolicy) selectTransactions(cache *TxCache, senders []*txListForSender, numRequested int, _ int) []*WrappedTransaction {
+	sequences := cache.selectSequencesOfSenders(senders, numRequested)
+	return mergeSequences(sequences, numRequested, isPayingMore)
+}
+
+type roundRobinSelectionPolicy struct {
+}
+
+// selectTransactions makes multiple passes over the senders (sorted by the arrival of their first selectable transaction).
+// In each pass, each sender gives at most "batchSizePerSender" transactions.
+func (policy *roundRobinSelectionPolicy) selectTransactions(cache *TxCache, senders []*txListForSender, numRequested int, batchSizePerSender int) []*WrappedTransaction {
+	sequences := cache.selectSequencesOfSenders(senders, numRequested)
+	sort.SliceStable(sequences, func(i, j int) bool {
+		return isArrivedEarlier(sequences[i][0], sequences[j][0])
+	})
+
+	batchSizePerSender = core.MaxInt(batchSizePerSender, 1)
+	result := make([]*WrappedTransaction, 0, numRequested)
+
+	for offset := 0; len(result) < numRequested; offset += batchSizePerSender {
+		copiedInThisPass := 0
+
+		for _, sequence := range sequences {
+			if offset >= len(sequence) {
+				continue
+			}
+
+			end := core.MinInt(offset+batchSizePerSender, len(sequence))
+			end = core.MinInt(end, offset+numRequested-len(result))
+			result = append(result, sequence[offset:end]...)
+			copiedInThisPass += end - offset
+
+			if len(result) == numRequested {
+				break
+			}
+		}
+
+		if copiedInThisPass == 0 {
+			break
+		}
+	}
+
+	return result
+}
+
+// selectSequencesOfSenders returns, for each sender, its transactions that can be selected (sorted by nonce, without gaps),
+// at most "maxPerSender" of them. Senders without selectable transactions are omitted.
+func (cache *TxCache) selectSequencesOfSenders(senders []*txListForSender, maxPerSender int) [][]*WrappedTransaction {
+	sequences := make([][]*WrappedTransaction, 0, len(senders))
+
+	for _, txList := range senders {
+		size := core.MinInt(maxPerSender, int(txList.countTxWithLock()))
+		sequence := make([]*WrappedTransaction, size)
+		journal := txList.selectBatchTo(true, sequence, size)
+		cache.onBatchSelected(txList, journal)
+
+		if journal.copied > 0 {
+			sequences = append(sequences, sequence[:journal.copied])
+		}
+	}
+
+	return sequences
+}
+
+func isArrivedEarlier(a *WrappedTransaction, b *WrappedTransaction) bool {
+	return a.arrivalIndex < b.arrivalIndex
+}
+
+func isPayingMore(a *WrappedTransaction, b *WrappedTransaction) bool {
+	gasPriceA := a.Tx.GetGasPrice()
+	gasPriceB := b.Tx.GetGasPrice()
+	if gasPriceA != gasPriceB {
+		return gasPriceA > gasPriceB
+	}
+
+	return isArrivedEarlier(a, b)
+}
+
+// mergeSequences merges the sequences of transactions (one per sender), by repeatedly picking the best of their heads
+func mergeSequences(sequences [][]*WrappedTransaction, numRequested int, isBetter func(a, b *WrappedTransaction) bool) []*WrappedTransaction {
+	heads := &sequenceHeads{
+		sequences: sequences,
+		isBetter:  isBetter,
+	}
+	heap.Init(heads)
+
+	result := make([]*WrappedTransaction, 0, numRequested)
+	for len(result) < numRequested && heads.Len() > 0 {
+		best := heads.sequences[0]
+		result = append(result, best[0])
+
+		if len(best) == 1 {
+			heap.Pop(heads)
+			continue
+		}
+
+		heads.sequences[0] = best[1:]
+		heap.Fix(heads, 0)
+	}
+
+	return result
+}
+
+// sequenceHeads is a heap of sequences of transactions, ordered by their first transaction
+type sequenceHeads struct {
+	sequences [][]*WrappedTransaction
+	isBetter  func(a, b *WrappedTransaction) bool
+}
+
+// Len returns the number of sequences
+func (heads *sequenceHeads) Len() int {
+	return len(heads.sequences)
+}
+
+// Less compares the first transactions of two sequences
+func (heads *sequenceHeads) Less(i, j int) bool {
+	return heads.isBetter(heads.sequences[i][0], heads.sequences[j][0])
+}
+
+// Swap swaps two sequences
+func (heads *sequenceHeads) Swap(i, j int) {
+	heads.sequences[i], heads.sequences[j] = heads.sequences[j], heads.sequences[i]
+}
+
+// Push adds a sequence
+func (heads *sequenceHeads) Push(x interface{}) {
+	heads.sequences = append(heads.sequences, x.([]*WrappedTransaction))
+}
+
+// Pop removes the last sequence
+func (heads *sequenceHeads) Pop() interface{} {
+	last := len(heads.sequences) - 1
+	sequence := heads.sequences[last]
+	heads.sequences = heads.sequences[:last]
+	return sequence
+}
@@ -0,0 +1,193 @@
+package txcache
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewTxCache_UnknownSelectionPolicy(t *testing.T) {
+	require.True(t, IsKnownSelectionPolicy(""))
+	require.True(t, IsKnownSelectionPolicy(SelectionPolicyRoundRobin))
+	require.False(t, IsKnownSelectionPolicy("foo"))
+
+	cache, err := NewTxCache(ConfigSourceMe{
+		Name:                       "test",
+		NumChunks:                  16,
+		NumBytesPerSenderThreshold: maxNumBytesPerSenderUpperBound,
+		CountPerSenderThreshold:    math.MaxUint32,
+		MinGasPriceNanoErd:         100,
+		SelectionPolicy:            "foo",
+	})
+	require.Nil(t, cache)
+	require.True(t, errors.Is(err, storage.ErrInvalidConfig))
+}
+
+func Test_SelectTransactions_FIFO(t *testing.T) {
+	cache := newCacheWithSelectionPolicyToTest(SelectionPolicyFIFO)
+
+	cache.AddTx(createTx([]byte("hash-alice-2"), "alice", 2))
+	cache.AddTx(createTx([]byte("hash-bob-1"), "bob", 1))
+	cache.AddTx(createTx([]byte("hash-alice-1"), "alice", 1))
+	cache.AddTx(createTx([]byte("hash-carol-1"), "carol", 1))
+	cache.AddTx(createTx([]byte("hash-bob-2"), "bob", 2))
+	cache.AddTx(createTx([]byte("hash-dave-5"), "dave", 5))
+	cache.AddTx(createTx([]byte("hash-dave-7"), "dave", 7))
+
+	// Nonce order is preserved for "alice", though "hash-alice-2" arrived first
+	selected := cache.doSelectTransactions(10, 1)
+	require.Equal(t, []string{"hash-bob-1", "hash-alice-1", "hash-alice-2", "hash-carol-1", "hash-bob-2", "hash-dave-5"}, selectedHashesAsStrings(selected))
+
+	selected = cache.doSelectTransactions(3, 1)
+	require.Equal(t, []string{"hash-bob-1", "hash-alice-1", "hash-alice-2"}, selectedHashesAsStrings(selected))
+}
+
+func Test_SelectTransactions_GasPrice(t *testing.T) {
+	cache := newCacheWithSelectionPolicyToTest(SelectionPolicyGasPrice)
+
+	cache.AddTx(createTxWithParams([]byte("hash-alice-1"), "alice", 1, 128, 50000, 100))
+	cache.AddTx(createTxWithParams([]byte("hash-alice-2"), "alice", 2, 128, 50000, 500))
+	cache.AddTx(createTxWithParams([]byte("hash-bob-1"), "bob", 1, 128, 50000, 300))
+	cache.AddTx(createTxWithParams([]byte("hash-carol-1"), "carol", 1, 128, 50000, 300))
+	cache.AddTx(createTxWithParams([]byte("hash-carol-2"), "carol", 2, 128, 50000, 200))
+
+	// "hash-alice-2" pays the most, but it cannot be selected before "hash-alice-1"
+	selected := cache.doSelectTransactions(10, 1)
+	require.Equal(t, []string{"hash-bob-1", "hash-carol-1", "hash-carol-2", "hash-alice-1", "hash-alice-2"}, selectedHashesAsStrings(selected))
+
+	selected = cache.doSelectTransactions(2, 1)
+	require.Equal(t, []string{"hash-bob-1", "hash-carol-1"}, selectedHashesAsStrings(selected))
+}
+
+func Test_SelectTransactions_RoundRobin(t *testing.T) {
+	cache := newCacheWithSelectionPolicyToTest(SelectionPolicyRoundRobin)
+
+	for nonce := uint64(1); nonce <= 5; nonce++ {
+		cache.AddTx(createTx([]byte(fmt.Sprintf("hash-alice-%d", nonce)), "alice", nonce))
+	}
+	cache.AddTx(createTx([]byte("hash-bob-1"), "bob", 1))
+	cache.AddTx(createTx([]byte("hash-carol-1"), "carol", 1))
+	cache.AddTx(createTx([]byte("hash-carol-2"), "carol", 2))
+
+	selected := cache.doSelectTransactions(10, 2)
+	require.Equal(t, []string{
+		"hash-alice-1", "hash-alice-2", "hash-bob-1", "hash-carol-1", "hash-carol-2",
+		"hash-alice-3", "hash-alice-4",
+		"hash-alice-5",
+	}, selectedHashesAsStrings(selected))
+
+	selected = cache.doSelectTransactions(4, 1)
+	require.Equal(t, []string{"hash-alice-1", "hash-bob-1", "hash-carol-1", "hash-alice-2"}, selectedHashesAsStrings(selected))
+}
+
+func Test_SelectTransactions_PoliciesHandleInitialGaps(t *testing.T) {
+	policies := []string{SelectionPolicyFIFO, SelectionPolicyGasPrice, SelectionPolicyRoundRobin}
+
+	for _, policy := range policies {
+		cache := newCacheWithSelectionPolicyToTest(policy)
+		cache.AddTx(createTx([]byte("hash-alice-3"), "alice", 3))
+		cache.AddTx(createTx([]byte("hash-bob-1"), "bob", 1))
+		cache.NotifyAccountNonce([]byte("alice"), 1)
+
+		selected := cache.doSelectTransactions(10, 1)
+		require.Equal(t, []string{"hash-bob-1"}, selectedHashesAsStrings(selected), policy)
+		require.Equal(t, 1, cache.getNumFailedSelectionsOfSender("alice"), policy)
+	}
+}
+
+func BenchmarkSelection_Score(b *testing.B) {
+	benchmarkSelectionPolicy(b, SelectionPolicyScore)
+}
+
+func BenchmarkSelection_FIFO(b *testing.B) {
+	benchmarkSelectionPolicy(b, SelectionPolicyFIFO)
+}
+
+func BenchmarkSelection_GasPrice(b *testing.B) {
+	benchmarkSelectionPolicy(b, SelectionPolicyGasPrice)
+}
+
+func BenchmarkSelection_RoundRobin(b *testing.B) {
+	benchmarkSelectionPolicy(b, SelectionPolicyRoundRobin)
+}
+
+// benchmarkSelectionPolicy measures the throughput of a selection policy, along with its fairness:
+// Jain's fairness index (https://en.wikipedia.org/wiki/Fairness_measure) over the number of transactions selected from each sender,
+// ranging from 1/numSenders (one sender takes everything) to 1 (all senders are equally served)
+func benchmarkSelectionPolicy(b *testing.B, policy string) {
+	numSenders := 1000
+	numRequested := 10000
+	cache := newCacheWithSelectionPolicyToTest(policy)
+
+	// Senders are added one after another, each with a different number of transactions and gas price
+	for senderTag := 0; senderTag < numSenders; senderTag++ {
+		sender := string(createFakeSenderAddress(senderTag))
+		numTxs := 1 + senderTag%50
+		gasPrice := uint64(1+senderTag%5) * 100 * oneBillion
+
+		for nonce := 0; nonce < numTxs; nonce++ {
+			hash := createFakeTxHash([]byte(sender), nonce)
+			cache.AddTx(createTxWithParams(hash, sender, uint64(nonce), 128, 50000, gasPrice))
+		}
+	}
+
+	var selected []*WrappedTransaction
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		selected = cache.doSelectTransactions(numRequested, 10)
+	}
+	b.StopTimer()
+
+	b.ReportMetric(float64(len(selected)), "txs/selection")
+	b.ReportMetric(computeFairnessIndex(selected, numSenders), "fairness")
+}
+
+func computeFairnessIndex(selected []*WrappedTransaction, numSenders int) float64 {
+	countBySender := make(map[string]float64)
+	for _, tx := range selected {
+		countBySender[string(tx.Tx.GetSndAddr())]++
+	}
+
+	sum := float64(0)
+	sumOfSquares := float64(0)
+	for _, count := range countBySender {
+		sum += count
+		sumOfSquares += count * count
+	}
+
+	if sumOfSquares == 0 {
+		return 0
+	}
+
+	return sum * sum / (float64(numSenders) * sumOfSquares)
+}
+
+func selectedHashesAsStrings(selected []*WrappedTransaction) []string {
+	hashes := make([][]byte, len(selected))
+	for i, tx := range selected {
+		hashes[i] = tx.TxHash
+	}
+
+	return hashesAsStrings(hashes)
+}
+
+func newCacheWithSelectionPolicyToTest(policy string) *TxCache {
+	cache, err := NewTxCache(ConfigSourceMe{
+		Name:                       "test",
+		NumChunks:                  16,
+		NumBytesPerSenderThreshold: maxNumBytesPerSenderUpperBound,
+		CountPerSenderThreshold:    math.MaxUint32,
+		MinGasPriceNanoErd:         100,
+		SelectionPolicy:            policy,
+	})
+	if err != nil {
+		panic(fmt.Sprintf("newCacheWithSelectionPolicyToTest(): %s", err))
+	}
+
+	return cache
+}
@@ -1,6 +1,7 @@
 package txcache
 
 import (
+	"errors"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/core/atomic"
@@ -16,6 +17,8 @@ type TxCache struct {
 	txListBySender            *txListBySenderMap
 	txByHash                  *txByHashMap
 	config                    ConfigSourceMe
+	selectionPolicy           selectionPolicy
+	numArrivals               atomic.Counter
 	evictionMutex             sync.Mutex
 	evictionJournal           evictionJournal
 	evictionSnapshotOfSenders []*txListForSender
@@ -37,6 +40,11 @@ func NewTxCache(config ConfigSourceMe) (*TxCache, error) {
 		return nil, err
 	}
 
+	selectionPolicy, err := newSelectionPolicy(config.SelectionPolicy)
+	if err != nil {
+		return nil, err
+	}
+
 	// Note: for simplicity, we use the same "numChunks" for both internal concurrent maps
 	numChunks := config.NumChunks
 	senderConstraints := config.getSenderConstraints()
@@ -47,6 +55,7 @@ func NewTxCache(config ConfigSourceMe) (*TxCache, error) {
 		txListBySender:  newTxListBySenderMap(numChunks, senderConstraints, scoreComputer),
 		txByHash:        newTxByHashMap(numChunks),
 		config:          config,
+		selectionPolicy: selectionPolicy,
 		evictionJournal: evictionJournal{},
 	}
 
@@ -55,8 +64,22 @@ func NewTxCache(config ConfigSourceMe) (*TxCache, error) {
 }
 
 // AddTx adds a transaction in the cache
+// A transaction having the same sender and nonce as an existing one replaces it, as long as its gas price is
+// sufficiently higher, otherwise it is not added
 // Eviction happens if maximum capacity is reached
 func (cache *TxCache) AddTx(tx *WrappedTransaction) (ok bool, added bool) {
+	return cache.addTx(tx, false)
+}
+
+// AddRequestedTx adds a transaction requested by the block processing in the cache
+// Such a transaction might be already included in a block, so it is added even if there is a transaction having
+// the same sender and nonce, which is kept as well
+// Eviction happens if maximum capacity is reached
+func (cache *TxCache) AddRequestedTx(tx *WrappedTransaction) (ok bool, added bool) {
+	return cache.addTx(tx, true)
+}
+
+func (cache *TxCache) addTx(tx *WrappedTransaction, isRequested bool) (ok bool, added bool) {
 	if tx == nil || check.IfNil(tx.Tx) {
 		return false, false
 	}
@@ -65,8 +88,27 @@ func (cache *TxCache) AddTx(tx *WrappedTransaction) (ok bool, added bool) {
 		cache.doEviction()
 	}
 
+	tx.arrivalIndex = cache.numArrivals.Increment()
 	addedInByHash := cache.txByHash.addTx(tx)
-	addedInBySender, evicted := cache.txListBySender.addTx(tx)
+
+	var evicted [][]byte
+	var replaced []byte
+	var err error
+	if isRequested {
+		evicted, err = cache.txListBySender.addRequestedTx(tx)
+	} else {
+		evicted, replaced, err = cache.txListBySender.addTx(tx)
+	}
+	if errors.Is(err, storage.ErrInsufficientGasPriceBump) {
+		log.Trace("TxCache.AddTx(): transaction not added", "name", cache.name, "tx", tx.TxHash, "err", err)
+		if addedInByHash {
+			cache.txByHash.removeTx(string(tx.TxHash))
+		}
+
+		return true, false
+	}
+
+	addedInBySender := err == nil
 	if addedInByHash != addedInBySender {
 		// This can happen  when two go-routines concur to add the same transaction:
 		// - A adds to "txByHash"
@@ -81,20 +123,37 @@ func (cache *TxCache) AddTx(tx *WrappedTransaction) (ok bool, added bool) {
 		cache.txByHash.RemoveTxsBulk(evicted)
 	}
 
+	if len(replaced) > 0 {
+		log.Trace("TxCache.AddTx(): transaction replaced", "name", cache.name, "tx", tx.TxHash, "replaced", replaced)
+		cache.txByHash.removeTx(string(replaced))
+	}
+
 	// The return value "added" is true even if transaction added, but then removed due to limits be sender.
 	// This it to ensure that onAdded() notification is triggered.
 	return true, addedInByHash || addedInBySender
 }
 
+// CheckReplacement verifies whether the given transaction would be accepted by the cache, with respect to the
+// transaction having the same sender and nonce, if any. Such a transaction is only replaced if the gas price is
+// sufficiently higher, otherwise storage.ErrInsufficientGasPriceBump is returned
+func (cache *TxCache) CheckReplacement(tx *WrappedTransaction) error {
+	if tx == nil || check.IfNil(tx.Tx) {
+		return nil
+	}
+
+	return cache.txListBySender.checkReplacement(tx)
+}
+
 // GetByTxHash gets the transaction by hash
 func (cache *TxCache) GetByTxHash(txHash []byte) (*WrappedTransaction, bool) {
 	tx, ok := cache.txByHash.getTx(string(txHash))
 	return tx, ok
 }
 
-// SelectTransactions selects a reasonably fair list of transactions to be included in the next miniblock
+// SelectTransactions selects a list of transactions to be included in the next miniblock, according to the configured selection policy
 // It returns at most "numRequested" transactions
 // Each sender gets the chance to give at least "batchSizePerSender" transactions, unless "numRequested" limit is reached before iterating over all senders
+// (the "FIFO" and "GasPrice" policies ignore "batchSizePerSender")
 func (cache *TxCache) SelectTransactions(numRequested int, batchSizePerSender int) []*WrappedTransaction {
 	result := cache.doSelectTransactions(numRequested, batchSizePerSender)
 	go cache.doAfterSelection()
@@ -104,45 +163,19 @@ func (cache *TxCache) SelectTransactions(numRequested int, batchSizePerSender in
 func (cache *TxCache) doSelectTransactions(numRequested int, batchSizePerSender int) []*WrappedTransaction {
 	stopWatch := cache.monitorSelectionStart()
 
-	result := make([]*WrappedTransaction, numRequested)
-	resultFillIndex := 0
-	resultIsFull := false
-
 	snapshotOfSenders := cache.getSendersEligibleForSelection()
+	result := cache.selectionPolicy.selectTransactions(cache, snapshotOfSenders, numRequested, batchSizePerSender)
 
-	for pass := 0; !resultIsFull; pass++ {
-		copiedInThisPass := 0
-
-		for _, txList := range snapshotOfSenders {
-			batchSizeWithScoreCoefficient := batchSizePerSender * int(txList.getLastComputedScore()+1)
-			// Reset happens on first pass only
-			isFirstBatch := pass == 0
-			journal := txList.selectBatchTo(isFirstBatch, result[resultFillIndex:], batchSizeWithScoreCoefficient)
-			cache.monitorBatchSelectionEnd(journal)
-
-			if isFirstBatch {
-				cache.collectSweepable(txList)
-			}
-
-			resultFillIndex += journal.copied
-			copiedInThisPass += journal.copied
-			resultIsFull = resultFillIndex == numRequested
-			if resultIsFull {
-				break
-			}
-		}
+	cache.monitorSelectionEnd(result, stopWatch)
+	return result
+}
 
-		nothingCopiedThisPass := copiedInThisPass == 0
+func (cache *TxCache) onBatchSelected(txList *txListForSender, journal batchSelectionJournal) {
+	cache.monitorBatchSelectionEnd(journal)
 
-		// No more passes needed
-		if nothingCopiedThisPass {
-			break
-		}
+	if journal.isFirstBatch {
+		cache.collectSweepable(txList)
 	}
-
-	result = result[:resultFillIndex]
-	cache.monitorSelectionEnd(result, stopWatch)
-	return result
 }
 
 func (cache *TxCache) getSendersEligibleForSelection() []*txListForSender {
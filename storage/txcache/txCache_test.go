@@ -138,7 +138,7 @@ func Test_AddTx_AppliesSizeConstraintsPerSenderForNumBytes(t *testing.T) {
 
 	cache.AddTx(createTxWithParams([]byte("tx-alice-1"), "alice", 1, 128, 42, 42))
 	cache.AddTx(createTxWithParams([]byte("tx-alice-2"), "alice", 2, 512, 42, 42))
-	cache.AddTx(createTxWithParams([]byte("tx-alice-4"), "alice", 3, 256, 42, 42))
+	cache.AddTx(createTxWithParams([]byte("tx-alice-4"), "alice", 4, 256, 42, 42))
 	cache.AddTx(createTxWithParams([]byte("tx-bob-1"), "bob", 1, 512, 42, 42))
 	cache.AddTx(createTxWithParams([]byte("tx-bob-2"), "bob", 2, 513, 42, 42))
 
@@ -153,6 +153,66 @@ func Test_AddTx_AppliesSizeConstraintsPerSenderForNumBytes(t *testing.T) {
 	require.True(t, cache.areInternalMapsConsistent())
 }
 
+func Test_AddTx_ReplacesTransactionWithSameNonce(t *testing.T) {
+	cache, err := NewTxCache(ConfigSourceMe{
+		Name:                       "test",
+		NumChunks:                  16,
+		NumBytesPerSenderThreshold: maxNumBytesPerSenderUpperBound,
+		CountPerSenderThreshold:    math.MaxUint32,
+		MinGasPriceNanoErd:         100,
+		MinGasPriceBumpPercentage:  10,
+	})
+	require.Nil(t, err)
+
+	cache.AddTx(createTxWithParams([]byte("tx-alice-1"), "alice", 1, 128, 42, 1000))
+	cache.AddTx(createTxWithParams([]byte("tx-alice-2"), "alice", 2, 128, 42, 1000))
+
+	underpriced := createTxWithParams([]byte("tx-alice-2-"), "alice", 2, 128, 42, 1099)
+	err = cache.CheckReplacement(underpriced)
+	require.True(t, errors.Is(err, storage.ErrInsufficientGasPriceBump))
+	ok, added := cache.AddTx(underpriced)
+	require.True(t, ok)
+	require.False(t, added)
+	require.Equal(t, []string{"tx-alice-1", "tx-alice-2"}, cache.getHashesForSender("alice"))
+
+	replacement := createTxWithParams([]byte("tx-alice-2+"), "alice", 2, 128, 42, 1100)
+	require.Nil(t, cache.CheckReplacement(replacement))
+	ok, added = cache.AddTx(replacement)
+	require.True(t, ok)
+	require.True(t, added)
+	require.Equal(t, []string{"tx-alice-1", "tx-alice-2+"}, cache.getHashesForSender("alice"))
+
+	_, found := cache.GetByTxHash([]byte("tx-alice-2"))
+	require.False(t, found)
+	require.Equal(t, uint64(2), cache.CountTx())
+	require.True(t, cache.areInternalMapsConsistent())
+}
+
+func Test_AddRequestedTx_KeepsTransactionWithSameNonce(t *testing.T) {
+	cache, err := NewTxCache(ConfigSourceMe{
+		Name:                       "test",
+		NumChunks:                  16,
+		NumBytesPerSenderThreshold: maxNumBytesPerSenderUpperBound,
+		CountPerSenderThreshold:    math.MaxUint32,
+		MinGasPriceNanoErd:         100,
+		MinGasPriceBumpPercentage:  10,
+	})
+	require.Nil(t, err)
+
+	cache.AddTx(createTxWithParams([]byte("tx-alice-1"), "alice", 1, 128, 42, 1000))
+	cache.AddTx(createTxWithParams([]byte("tx-alice-1+"), "alice", 1, 128, 42, 1100))
+
+	ok, added := cache.AddRequestedTx(createTxWithParams([]byte("tx-alice-1"), "alice", 1, 128, 42, 1000))
+	require.True(t, ok)
+	require.True(t, added)
+	require.Equal(t, []string{"tx-alice-1+", "tx-alice-1"}, cache.getHashesForSender("alice"))
+
+	_, found := cache.GetByTxHash([]byte("tx-alice-1"))
+	require.True(t, found)
+	require.Equal(t, uint64(2), cache.CountTx())
+	require.True(t, cache.areInternalMapsConsistent())
+}
+
 func Test_RemoveByTxHash(t *testing.T) {
 	cache := newUnconstrainedCacheToTest()
 
@@ -30,12 +30,29 @@ func newTxListBySenderMap(nChunksHint uint32, senderConstraints senderConstraint
 }
 
 // addTx adds a transaction in the map, in the corresponding list (selected by its sender)
-func (txMap *txListBySenderMap) addTx(tx *WrappedTransaction) (bool, [][]byte) {
+func (txMap *txListBySenderMap) addTx(tx *WrappedTransaction) ([][]byte, []byte, error) {
 	sender := string(tx.Tx.GetSndAddr())
 	listForSender := txMap.getOrAddListForSender(sender)
 	return listForSender.AddTx(tx)
 }
 
+// addRequestedTx adds a transaction requested by the block processing in the map, in the corresponding list
+func (txMap *txListBySenderMap) addRequestedTx(tx *WrappedTransaction) ([][]byte, error) {
+	sender := string(tx.Tx.GetSndAddr())
+	listForSender := txMap.getOrAddListForSender(sender)
+	return listForSender.AddRequestedTx(tx)
+}
+
+// checkReplacement verifies whether the given transaction would be accepted in the list of its sender
+func (txMap *txListBySenderMap) checkReplacement(tx *WrappedTransaction) error {
+	listForSender, ok := txMap.getListForSender(string(tx.Tx.GetSndAddr()))
+	if !ok {
+		return nil
+	}
+
+	return listForSender.checkReplacement(tx)
+}
+
 // getOrAddListForSender gets or lazily creates a list (using double-checked locking pattern)
 func (txMap *txListBySenderMap) getOrAddListForSender(sender string) *txListForSender {
 	listForSender, ok := txMap.getListForSender(sender)
@@ -3,8 +3,10 @@ package txcache
 import (
 	"bytes"
 	"container/list"
+	"fmt"
 	"sync"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/atomic"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/txcache/maps"
@@ -48,15 +50,40 @@ func newTxListForSender(sender string, constraints *senderConstraints, onScoreCh
 }
 
 // AddTx adds a transaction in sender's list
-// This is a "sorted" insert
-func (listForSender *txListForSender) AddTx(tx *WrappedTransaction) (bool, [][]byte) {
+// This is a "sorted" insert. A transaction having the same nonce as an existing one replaces it,
+// as long as its gas price is sufficiently higher (see "verifyGasPriceBump"), otherwise it is rejected.
+// It returns the hashes of the evicted transactions and the hash of the replaced one, if any
+func (listForSender *txListForSender) AddTx(tx *WrappedTransaction) ([][]byte, []byte, error) {
+	return listForSender.addTx(tx, false)
+}
+
+// AddRequestedTx adds a transaction requested by the block processing in sender's list
+// A requested transaction might be already included in a block, so the transactions having the same nonce are all kept,
+// the ones with a higher gas price first (thus selected first). It returns the hashes of the evicted transactions
+func (listForSender *txListForSender) AddRequestedTx(tx *WrappedTransaction) ([][]byte, error) {
+	evicted, _, err := listForSender.addTx(tx, true)
+	return evicted, err
+}
+
+func (listForSender *txListForSender) addTx(tx *WrappedTransaction, keepSameNonce bool) ([][]byte, []byte, error) {
 	// We don't allow concurrent interceptor goroutines to mutate a given sender's list
 	listForSender.mutex.Lock()
 	defer listForSender.mutex.Unlock()
 
 	insertionPlace, err := listForSender.findInsertionPlace(tx)
 	if err != nil {
-		return false, nil
+		return nil, nil, err
+	}
+
+	var replacedElement *list.Element
+	if !keepSameNonce {
+		replacedElement = listForSender.findSameNonceElement(tx)
+	}
+	if replacedElement != nil {
+		err = listForSender.verifyGasPriceBump(tx, replacedElement.Value.(*WrappedTransaction))
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 
 	if insertionPlace == nil {
@@ -65,10 +92,17 @@ func (listForSender *txListForSender) AddTx(tx *WrappedTransaction) (bool, [][]b
 		listForSender.items.InsertAfter(tx, insertionPlace)
 	}
 
+	var replacedTxHash []byte
+	if replacedElement != nil {
+		listForSender.items.Remove(replacedElement)
+		listForSender.onRemovedListElement(replacedElement)
+		replacedTxHash = replacedElement.Value.(*WrappedTransaction).TxHash
+	}
+
 	listForSender.onAddedTransaction(tx)
 	evicted := listForSender.applySizeConstraints()
 	listForSender.triggerScoreChange()
-	return true, evicted
+	return evicted, replacedTxHash, nil
 }
 
 // This function should only be used in critical section (listForSender.mutex)
@@ -122,6 +156,8 @@ func (listForSender *txListForSender) getScoreParams() senderScoreParams {
 	return senderScoreParams{count: count, size: size, fee: fee, gas: gas}
 }
 
+// findInsertionPlace returns the element after which the incoming transaction should be inserted
+// Transactions having the same nonce are sorted by gas price, the ones with a higher gas price first
 // This function should only be used in critical section (listForSender.mutex)
 func (listForSender *txListForSender) findInsertionPlace(incomingTx *WrappedTransaction) (*list.Element, error) {
 	incomingNonce := incomingTx.Tx.GetNonce()
@@ -154,6 +190,62 @@ func (listForSender *txListForSender) findInsertionPlace(incomingTx *WrappedTran
 	return nil, nil
 }
 
+// findSameNonceElement returns the element holding the transaction with the same nonce as the given one, if any.
+// If there are more such transactions (requested by the block processing), the one with the highest gas price is returned
+// This function should only be used in critical section (listForSender.mutex)
+func (listForSender *txListForSender) findSameNonceElement(tx *WrappedTransaction) *list.Element {
+	nonce := tx.Tx.GetNonce()
+	for element := listForSender.items.Front(); element != nil; element = element.Next() {
+		value := element.Value.(*WrappedTransaction)
+		valueNonce := value.Tx.GetNonce()
+
+		if valueNonce == nonce && !tx.sameAs(value) {
+			return element
+		}
+
+		// Optimization: stop search at this point, since the list is sorted by nonce
+		if valueNonce > nonce {
+			break
+		}
+	}
+
+	return nil
+}
+
+// verifyGasPriceBump checks whether the incoming transaction is allowed to replace the existing one, having the same nonce
+func (listForSender *txListForSender) verifyGasPriceBump(incomingTx *WrappedTransaction, existingTx *WrappedTransaction) error {
+	minGasPrice := computeMinReplacementGasPrice(existingTx.Tx.GetGasPrice(), listForSender.constraints.minGasPriceBumpPercentage)
+	incomingGasPrice := incomingTx.Tx.GetGasPrice()
+	if incomingGasPrice < minGasPrice {
+		return fmt.Errorf("%w: gas price is %d, should be at least %d", storage.ErrInsufficientGasPriceBump, incomingGasPrice, minGasPrice)
+	}
+
+	return nil
+}
+
+// computeMinReplacementGasPrice returns the minimum gas price of a transaction replacing another one, with the given gas price
+// The replacement has to pay strictly more, even if the configured bump is 0
+func computeMinReplacementGasPrice(gasPrice uint64, bumpPercentage uint32) uint64 {
+	bump := gasPrice / 100 * uint64(bumpPercentage)
+	bump += gasPrice % 100 * uint64(bumpPercentage) / 100
+
+	return gasPrice + core.MaxUint64(bump, 1)
+}
+
+// checkReplacement verifies whether the given transaction would be accepted in the list, with respect to
+// the transaction having the same nonce, if any
+func (listForSender *txListForSender) checkReplacement(tx *WrappedTransaction) error {
+	listForSender.mutex.RLock()
+	defer listForSender.mutex.RUnlock()
+
+	sameNonceElement := listForSender.findSameNonceElement(tx)
+	if sameNonceElement == nil {
+		return nil
+	}
+
+	return listForSender.verifyGasPriceBump(tx, sameNonceElement.Value.(*WrappedTransaction))
+}
+
 // RemoveTx removes a transaction from the sender's list
 func (listForSender *txListForSender) RemoveTx(tx *WrappedTransaction) bool {
 	// We don't allow concurrent interceptor goroutines to mutate a given sender's list
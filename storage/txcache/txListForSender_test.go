@@ -1,9 +1,11 @@
 package txcache
 
 import (
+	"errors"
 	"math"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/stretchr/testify/require"
 )
 
@@ -18,44 +20,104 @@ func TestListForSender_AddTx_Sorts(t *testing.T) {
 	require.Equal(t, []string{"a", "b", "c", "d"}, list.getTxHashesAsStrings())
 }
 
-func TestListForSender_AddTx_GivesPriorityToHigherGas(t *testing.T) {
+func TestListForSender_AddTx_ReplacesSameNonceWhenHigherGasPrice(t *testing.T) {
 	list := newUnconstrainedListToTest()
 
 	list.AddTx(createTxWithParams([]byte("a"), ".", 1, 128, 42, 42))
 	list.AddTx(createTxWithParams([]byte("b"), ".", 3, 128, 42, 100))
-	list.AddTx(createTxWithParams([]byte("c"), ".", 3, 128, 42, 99))
 	list.AddTx(createTxWithParams([]byte("d"), ".", 2, 128, 42, 42))
-	list.AddTx(createTxWithParams([]byte("e"), ".", 3, 128, 42, 101))
 
-	require.Equal(t, []string{"a", "d", "e", "b", "c"}, list.getTxHashesAsStrings())
+	// Same price, not replaced
+	_, replaced, err := list.AddTx(createTxWithParams([]byte("c"), ".", 3, 128, 42, 100))
+	require.True(t, errors.Is(err, storage.ErrInsufficientGasPriceBump))
+	require.Nil(t, replaced)
+
+	// Lower price, not replaced
+	_, replaced, err = list.AddTx(createTxWithParams([]byte("c"), ".", 3, 128, 42, 99))
+	require.True(t, errors.Is(err, storage.ErrInsufficientGasPriceBump))
+	require.Nil(t, replaced)
+
+	_, replaced, err = list.AddTx(createTxWithParams([]byte("e"), ".", 3, 128, 42, 101))
+	require.Nil(t, err)
+	require.Equal(t, []byte("b"), replaced)
+	require.Equal(t, []string{"a", "d", "e"}, list.getTxHashesAsStrings())
+	require.Equal(t, uint64(3*128), list.totalBytes.GetUint64())
+	require.Equal(t, uint64(3*42), list.totalGas.GetUint64())
 }
 
-func TestListForSender_AddTx_SortsCorrectlyWhenSameNonceSamePrice(t *testing.T) {
-	list := newUnconstrainedListToTest()
+func TestListForSender_AddTx_RequiresGasPriceBump(t *testing.T) {
+	list := newListToTest(math.MaxUint32, math.MaxUint32)
+	list.constraints.minGasPriceBumpPercentage = 10
 
-	list.AddTx(createTxWithParams([]byte("a"), ".", 1, 128, 42, 42))
-	list.AddTx(createTxWithParams([]byte("b"), ".", 3, 128, 42, 100))
-	list.AddTx(createTxWithParams([]byte("c"), ".", 3, 128, 42, 100))
-	list.AddTx(createTxWithParams([]byte("d"), ".", 3, 128, 42, 98))
-	list.AddTx(createTxWithParams([]byte("e"), ".", 3, 128, 42, 101))
-	list.AddTx(createTxWithParams([]byte("f"), ".", 2, 128, 42, 42))
-	list.AddTx(createTxWithParams([]byte("g"), ".", 3, 128, 42, 99))
-
-	// In case of same-nonce, same-price transactions, the newer one has priority
-	require.Equal(t, []string{"a", "f", "e", "c", "b", "g", "d"}, list.getTxHashesAsStrings())
+	list.AddTx(createTxWithParams([]byte("a"), ".", 1, 128, 42, 1000))
+
+	_, _, err := list.AddTx(createTxWithParams([]byte("b"), ".", 1, 128, 42, 1099))
+	require.True(t, errors.Is(err, storage.ErrInsufficientGasPriceBump))
+	require.Equal(t, []string{"a"}, list.getTxHashesAsStrings())
+
+	_, replaced, err := list.AddTx(createTxWithParams([]byte("c"), ".", 1, 128, 42, 1100))
+	require.Nil(t, err)
+	require.Equal(t, []byte("a"), replaced)
+	require.Equal(t, []string{"c"}, list.getTxHashesAsStrings())
+}
+
+func TestListForSender_AddRequestedTx_KeepsSameNonce(t *testing.T) {
+	list := newListToTest(math.MaxUint32, math.MaxUint32)
+	list.constraints.minGasPriceBumpPercentage = 10
+
+	list.AddTx(createTxWithParams([]byte("a"), ".", 1, 128, 42, 1000))
+	list.AddTx(createTxWithParams([]byte("b"), ".", 2, 128, 42, 1000))
+
+	_, err := list.AddRequestedTx(createTxWithParams([]byte("c"), ".", 1, 128, 42, 900))
+	require.Nil(t, err)
+	_, err = list.AddRequestedTx(createTxWithParams([]byte("d"), ".", 1, 128, 42, 1050))
+	require.Nil(t, err)
+	_, err = list.AddRequestedTx(createTxWithParams([]byte("d"), ".", 1, 128, 42, 1050))
+	require.Equal(t, storage.ErrItemAlreadyInCache, err)
+	require.Equal(t, []string{"d", "a", "c", "b"}, list.getTxHashesAsStrings())
+
+	// A replacement has to pay sufficiently more than the best paid transaction having the same nonce, which it replaces
+	_, _, err = list.AddTx(createTxWithParams([]byte("e"), ".", 1, 128, 42, 1100))
+	require.True(t, errors.Is(err, storage.ErrInsufficientGasPriceBump))
+	_, replaced, err := list.AddTx(createTxWithParams([]byte("f"), ".", 1, 128, 42, 1200))
+	require.Nil(t, err)
+	require.Equal(t, []byte("d"), replaced)
+	require.Equal(t, []string{"f", "a", "c", "b"}, list.getTxHashesAsStrings())
+}
+
+func TestListForSender_checkReplacement(t *testing.T) {
+	list := newListToTest(math.MaxUint32, math.MaxUint32)
+	list.constraints.minGasPriceBumpPercentage = 10
+
+	txA := createTxWithParams([]byte("a"), ".", 1, 128, 42, 1000)
+	list.AddTx(txA)
+	list.AddTx(createTxWithParams([]byte("b"), ".", 3, 128, 42, 1000))
+
+	require.Nil(t, list.checkReplacement(txA))
+	require.Nil(t, list.checkReplacement(createTxWithParams([]byte("c"), ".", 2, 128, 42, 1)))
+	require.Nil(t, list.checkReplacement(createTxWithParams([]byte("d"), ".", 3, 128, 42, 1100)))
+	err := list.checkReplacement(createTxWithParams([]byte("e"), ".", 1, 128, 42, 1099))
+	require.True(t, errors.Is(err, storage.ErrInsufficientGasPriceBump))
+}
+
+func TestComputeMinReplacementGasPrice(t *testing.T) {
+	require.Equal(t, uint64(1), computeMinReplacementGasPrice(0, 10))
+	require.Equal(t, uint64(101), computeMinReplacementGasPrice(100, 0))
+	require.Equal(t, uint64(110), computeMinReplacementGasPrice(100, 10))
+	require.Equal(t, uint64(220000000000), computeMinReplacementGasPrice(200000000000, 10))
 }
 
 func TestListForSender_AddTx_IgnoresDuplicates(t *testing.T) {
 	list := newUnconstrainedListToTest()
 
-	added, _ := list.AddTx(createTx([]byte("tx1"), ".", 1))
-	require.True(t, added)
-	added, _ = list.AddTx(createTx([]byte("tx2"), ".", 2))
-	require.True(t, added)
-	added, _ = list.AddTx(createTx([]byte("tx3"), ".", 3))
-	require.True(t, added)
-	added, _ = list.AddTx(createTx([]byte("tx2"), ".", 2))
-	require.False(t, added)
+	_, _, err := list.AddTx(createTx([]byte("tx1"), ".", 1))
+	require.Nil(t, err)
+	_, _, err = list.AddTx(createTx([]byte("tx2"), ".", 2))
+	require.Nil(t, err)
+	_, _, err = list.AddTx(createTx([]byte("tx3"), ".", 3))
+	require.Nil(t, err)
+	_, _, err = list.AddTx(createTx([]byte("tx2"), ".", 2))
+	require.Equal(t, storage.ErrItemAlreadyInCache, err)
 }
 
 func TestListForSender_AddTx_AppliesSizeConstraintsForNumTransactions(t *testing.T) {
@@ -67,19 +129,20 @@ func TestListForSender_AddTx_AppliesSizeConstraintsForNumTransactions(t *testing
 	list.AddTx(createTx([]byte("tx2"), ".", 2))
 	require.Equal(t, []string{"tx1", "tx2", "tx4"}, list.getTxHashesAsStrings())
 
-	_, evicted := list.AddTx(createTx([]byte("tx3"), ".", 3))
+	evicted, _, _ := list.AddTx(createTx([]byte("tx3"), ".", 3))
 	require.Equal(t, []string{"tx1", "tx2", "tx3"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx4"}, hashesAsStrings(evicted))
 
-	// Gives priority to higher gas - though undesirably to some extent, "tx3" is evicted
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx2++"), ".", 2, 128, 42, 42))
-	require.Equal(t, []string{"tx1", "tx2++", "tx2"}, list.getTxHashesAsStrings())
-	require.Equal(t, []string{"tx3"}, hashesAsStrings(evicted))
+	// A replacement does not cause evictions
+	evicted, replaced, _ := list.AddTx(createTxWithParams([]byte("tx2++"), ".", 2, 128, 42, 42))
+	require.Equal(t, []string{"tx1", "tx2++", "tx3"}, list.getTxHashesAsStrings())
+	require.Equal(t, []string{}, hashesAsStrings(evicted))
+	require.Equal(t, []byte("tx2"), replaced)
 
-	// Though Undesirably to some extent, "tx3++"" is added, then evicted
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx3++"), ".", 3, 128, 42, 42))
-	require.Equal(t, []string{"tx1", "tx2++", "tx2"}, list.getTxHashesAsStrings())
-	require.Equal(t, []string{"tx3++"}, hashesAsStrings(evicted))
+	// Though Undesirably to some extent, "tx4++"" is added, then evicted
+	evicted, _, _ = list.AddTx(createTxWithParams([]byte("tx4++"), ".", 4, 128, 42, 42))
+	require.Equal(t, []string{"tx1", "tx2++", "tx3"}, list.getTxHashesAsStrings())
+	require.Equal(t, []string{"tx4++"}, hashesAsStrings(evicted))
 }
 
 func TestListForSender_AddTx_AppliesSizeConstraintsForNumBytes(t *testing.T) {
@@ -88,46 +151,43 @@ func TestListForSender_AddTx_AppliesSizeConstraintsForNumBytes(t *testing.T) {
 	list.AddTx(createTxWithParams([]byte("tx1"), ".", 1, 128, 42, 42))
 	list.AddTx(createTxWithParams([]byte("tx2"), ".", 2, 512, 42, 42))
 	list.AddTx(createTxWithParams([]byte("tx3"), ".", 3, 256, 42, 42))
-	_, evicted := list.AddTx(createTxWithParams([]byte("tx5"), ".", 4, 256, 42, 42))
+	evicted, _, _ := list.AddTx(createTxWithParams([]byte("tx5"), ".", 4, 256, 42, 42))
 	require.Equal(t, []string{"tx1", "tx2", "tx3"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx5"}, hashesAsStrings(evicted))
 
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx5--"), ".", 4, 128, 42, 42))
+	evicted, _, _ = list.AddTx(createTxWithParams([]byte("tx5--"), ".", 4, 128, 42, 42))
 	require.Equal(t, []string{"tx1", "tx2", "tx3", "tx5--"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{}, hashesAsStrings(evicted))
 
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx4"), ".", 4, 128, 42, 42))
-	require.Equal(t, []string{"tx1", "tx2", "tx3", "tx4"}, list.getTxHashesAsStrings())
+	// The replacement is larger, thus the transaction with the highest nonce is evicted
+	evicted, replaced, _ := list.AddTx(createTxWithParams([]byte("tx3++"), ".", 3, 384, 42, 100))
+	require.Equal(t, []string{"tx1", "tx2", "tx3++"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx5--"}, hashesAsStrings(evicted))
-
-	// Gives priority to higher gas - though undesirably to some extent, "tx4" is evicted
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx3++"), ".", 3, 256, 42, 100))
-	require.Equal(t, []string{"tx1", "tx2", "tx3++", "tx3"}, list.getTxHashesAsStrings())
-	require.Equal(t, []string{"tx4"}, hashesAsStrings(evicted))
+	require.Equal(t, []byte("tx3"), replaced)
 }
 
 func TestListForSender_findTx(t *testing.T) {
 	list := newUnconstrainedListToTest()
 
 	txA := createTx([]byte("A"), ".", 41)
-	txANewer := createTx([]byte("ANewer"), ".", 41)
+	txAPrevious := createTx([]byte("APrevious"), ".", 40)
 	txB := createTx([]byte("B"), ".", 42)
 	txD := createTx([]byte("none"), ".", 43)
 	list.AddTx(txA)
-	list.AddTx(txANewer)
+	list.AddTx(txAPrevious)
 	list.AddTx(txB)
 
 	elementWithA := list.findListElementWithTx(txA)
-	elementWithANewer := list.findListElementWithTx(txANewer)
+	elementWithAPrevious := list.findListElementWithTx(txAPrevious)
 	elementWithB := list.findListElementWithTx(txB)
 	noElementWithD := list.findListElementWithTx(txD)
 
 	require.NotNil(t, elementWithA)
-	require.NotNil(t, elementWithANewer)
+	require.NotNil(t, elementWithAPrevious)
 	require.NotNil(t, elementWithB)
 
 	require.Equal(t, txA, elementWithA.Value.(*WrappedTransaction))
-	require.Equal(t, txANewer, elementWithANewer.Value.(*WrappedTransaction))
+	require.Equal(t, txAPrevious, elementWithAPrevious.Value.(*WrappedTransaction))
 	require.Equal(t, txB, elementWithB.Value.(*WrappedTransaction))
 	require.Nil(t, noElementWithD)
 }
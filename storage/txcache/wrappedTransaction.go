@@ -16,6 +16,7 @@ type WrappedTransaction struct {
 	SenderShardID          uint32
 	ReceiverShardID        uint32
 	isImmuneToEvictionFlag atomic.Flag
+	arrivalIndex           int64
 }
 
 // GetKey gets the transaction hash
@@ -19,6 +19,8 @@ type TrieStub struct {
 	SnapshotCalled           func() error
 	GetSerializedNodesCalled func([]byte, uint64) ([][]byte, uint64, error)
 	DatabaseCalled           func() data.DBWriteCacher
+	GetProofCalled           func(key []byte) ([][]byte, error)
+	VerifyProofCalled        func(rootHash []byte, key []byte, proof [][]byte) (bool, error)
 }
 
 // EnterSnapshotMode -
@@ -178,3 +180,21 @@ func (ts *TrieStub) GetDirtyHashes() (data.ModifiedHashes, error) {
 // SetNewHashes -
 func (ts *TrieStub) SetNewHashes(_ data.ModifiedHashes) {
 }
+
+// GetProof -
+func (ts *TrieStub) GetProof(key []byte) ([][]byte, error) {
+	if ts.GetProofCalled != nil {
+		return ts.GetProofCalled(key)
+	}
+
+	return nil, nil
+}
+
+// VerifyProof -
+func (ts *TrieStub) VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error) {
+	if ts.VerifyProofCalled != nil {
+		return ts.VerifyProofCalled(rootHash, key, proof)
+	}
+
+	return false, nil
+}
@@ -92,6 +92,15 @@ var ErrInvalidStakingAccessAddress = errors.New("invalid staking access address"
 // ErrInvalidJailAccessAddress signals that invalid jailing access address was provided
 var ErrInvalidJailAccessAddress = errors.New("invalid jailing access address")
 
+// ErrInvalidSlashingAccessAddress signals that invalid slashing access address was provided
+var ErrInvalidSlashingAccessAddress = errors.New("invalid slashing access address")
+
+// ErrNilEquivocationProofVerifier signals that a nil equivocation proof verifier was provided
+var ErrNilEquivocationProofVerifier = errors.New("nil equivocation proof verifier")
+
+// ErrInvalidEquivocationSlashPercentage signals that the equivocation slash percentage is not between 0 and 1
+var ErrInvalidEquivocationSlashPercentage = errors.New("invalid equivocation slash percentage")
+
 // ErrNotEnoughGas signals that there is not enough gas for execution
 var ErrNotEnoughGas = errors.New("not enough gas")
 
@@ -124,3 +133,18 @@ var ErrNilValidatorAccountsDB = errors.New("nil validator accounts DB")
 
 // ErrNilPublicKey signals that nil public key has been provided
 var ErrNilPublicKey = errors.New("nil public key")
+
+// ErrNoTokenWithGivenName signals that there is no esdt token registered with the given name
+var ErrNoTokenWithGivenName = errors.New("no token with given name")
+
+// ErrNilDelegationSmartContractAddress signals that a nil delegation smart contract address was provided
+var ErrNilDelegationSmartContractAddress = errors.New("nil delegation smart contract address")
+
+// ErrDelegationPoolNotFound signals that the requested delegation pool does not exist
+var ErrDelegationPoolNotFound = errors.New("delegation pool not found")
+
+// ErrOnExecutionAtAuctionSC signals that there was an execution error at the auction smart contract
+var ErrOnExecutionAtAuctionSC = errors.New("execution error at auction sc")
+
+// ErrInvalidNumOfShards signals that an invalid number of shards was provided
+var ErrInvalidNumOfShards = errors.New("invalid number of shards")
@@ -2,6 +2,7 @@ package factory
 
 import (
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus/slashing"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/hashing"
@@ -20,6 +21,7 @@ type systemSCFactory struct {
 	marshalizer         marshal.Marshalizer
 	hasher              hashing.Hasher
 	systemSCConfig      *config.SystemSmartContractsConfig
+	numOfShards         uint32
 }
 
 // ArgsNewSystemSCFactory defines the arguments struct needed to create the system SCs
@@ -32,6 +34,7 @@ type ArgsNewSystemSCFactory struct {
 	Marshalizer         marshal.Marshalizer
 	Hasher              hashing.Hasher
 	SystemSCConfig      *config.SystemSmartContractsConfig
+	NumOfShards         uint32
 }
 
 // NewSystemSCFactory creates a factory which will instantiate the system smart contracts
@@ -66,6 +69,7 @@ func NewSystemSCFactory(args ArgsNewSystemSCFactory) (*systemSCFactory, error) {
 		marshalizer:         args.Marshalizer,
 		hasher:              args.Hasher,
 		systemSCConfig:      args.SystemSCConfig,
+		numOfShards:         args.NumOfShards,
 	}
 
 	err := scf.createGasConfig(args.GasMap)
@@ -118,6 +122,15 @@ func (scf *systemSCFactory) createGasConfig(gasMap map[string]map[string]uint64)
 func (scf *systemSCFactory) Create() (vm.SystemSCContainer, error) {
 	scContainer := NewSystemSCContainer()
 
+	equivocationVerifier, err := slashing.NewProofVerifier(slashing.ArgsProofVerifier{
+		Marshalizer:       scf.marshalizer,
+		Hasher:            scf.hasher,
+		SignatureVerifier: scf.sigVerifier,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	argsStaking := systemSmartContracts.ArgsNewStakingSmartContract{
 		MinNumNodes:              scf.nodesConfigProvider.MinNumberOfNodes(),
 		MinStakeValue:            scf.validatorSettings.GenesisNodePrice(),
@@ -125,10 +138,14 @@ func (scf *systemSCFactory) Create() (vm.SystemSCContainer, error) {
 		Eei:                      scf.systemEI,
 		StakingAccessAddr:        AuctionSCAddress,
 		JailAccessAddr:           JailingAddress,
+		SlashingAccessAddr:       StakingSCAddress,
 		NumRoundsWithoutBleed:    scf.validatorSettings.NumRoundsWithoutBleed(),
 		BleedPercentagePerRound:  scf.validatorSettings.BleedPercentagePerRound(),
 		MaximumPercentageToBleed: scf.validatorSettings.MaximumPercentageToBleed(),
 		GasCost:                  scf.gasCost,
+
+		EquivocationProofVerifier:   equivocationVerifier,
+		EquivocationSlashPercentage: scf.systemSCConfig.StakingSystemSCConfig.EquivocationSlashPercentage,
 	}
 	staking, err := systemSmartContracts.NewStakingSmartContract(argsStaking)
 	if err != nil {
@@ -166,6 +183,7 @@ func (scf *systemSCFactory) Create() (vm.SystemSCContainer, error) {
 		Marshalizer:   scf.marshalizer,
 		Hasher:        scf.hasher,
 		ESDTSCConfig:  scf.systemSCConfig.ESDTSystemSCConfig,
+		NumOfShards:   scf.numOfShards,
 	}
 	esdt, err := systemSmartContracts.NewESDTSmartContract(argsESDT)
 	if err != nil {
@@ -177,6 +195,25 @@ func (scf *systemSCFactory) Create() (vm.SystemSCContainer, error) {
 		return nil, err
 	}
 
+	argsDelegation := systemSmartContracts.ArgsNewDelegation{
+		Eei:                 scf.systemEI,
+		SigVerifier:         scf.sigVerifier,
+		ValidatorSettings:   scf.validatorSettings,
+		GasCost:             scf.gasCost,
+		DelegationSCAddress: DelegationSCAddress,
+		StakingSCAddress:    StakingSCAddress,
+		AuctionSCAddress:    AuctionSCAddress,
+	}
+	delegation, err := systemSmartContracts.NewDelegationSmartContract(argsDelegation)
+	if err != nil {
+		return nil, err
+	}
+
+	err = scContainer.Add(DelegationSCAddress, delegation)
+	if err != nil {
+		return nil, err
+	}
+
 	err = scf.systemEI.SetSystemSCContainer(scContainer)
 	if err != nil {
 		return nil, err
@@ -29,6 +29,7 @@ func createMockNewSystemScFactoryArgs() ArgsNewSystemSCFactory {
 				OwnerAddress:    "aaaaaa",
 			},
 		},
+		NumOfShards: 2,
 	}
 }
 
@@ -72,7 +73,7 @@ func TestSystemSCFactory_Create(t *testing.T) {
 
 	container, err := scFactory.Create()
 	assert.Nil(t, err)
-	assert.Equal(t, 3, container.Len())
+	assert.Equal(t, 4, container.Len())
 }
 
 func TestSystemSCFactory_IsInterfaceNil(t *testing.T) {
@@ -33,6 +33,7 @@ type SystemSCContainer interface {
 type SystemEI interface {
 	ExecuteOnDestContext(destination []byte, sender []byte, value *big.Int, input []byte) (*vmcommon.VMOutput, error)
 	Transfer(destination []byte, sender []byte, value *big.Int, input []byte, gasLimit uint64) error
+	AsyncCall(destination []byte, sender []byte, input []byte) error
 	GetBalance(addr []byte) *big.Int
 	SetStorage(key []byte, value []byte)
 	AddReturnMessage(msg string)
@@ -65,6 +66,13 @@ type MessageSignVerifier interface {
 	IsInterfaceNil() bool
 }
 
+// EquivocationProofVerifier verifies the proof of a key signing two different blocks in the same round, returning the
+// offending key and the round of the equivocation
+type EquivocationProofVerifier interface {
+	VerifyProof(proof []byte) ([]byte, uint64, error)
+	IsInterfaceNil() bool
+}
+
 // ValidatorSettingsHandler defines the functionality which is needed for validators' settings
 type ValidatorSettingsHandler interface {
 	UnBondPeriod() uint64
@@ -11,6 +11,7 @@ import (
 // SystemEIStub -
 type SystemEIStub struct {
 	TransferCalled                  func(destination []byte, sender []byte, value *big.Int, input []byte) error
+	AsyncCallCalled                 func(destination []byte, sender []byte, input []byte) error
 	GetBalanceCalled                func(addr []byte) *big.Int
 	SetStorageCalled                func(key []byte, value []byte)
 	SetReturnMessageCalled          func(msg string)
@@ -106,6 +107,14 @@ func (s *SystemEIStub) Transfer(destination []byte, sender []byte, value *big.In
 	return nil
 }
 
+// AsyncCall -
+func (s *SystemEIStub) AsyncCall(destination []byte, sender []byte, input []byte) error {
+	if s.AsyncCallCalled != nil {
+		return s.AsyncCallCalled(destination, sender, input)
+	}
+	return nil
+}
+
 // GetBalance -
 func (s *SystemEIStub) GetBalance(addr []byte) *big.Int {
 	if s.GetBalanceCalled != nil {
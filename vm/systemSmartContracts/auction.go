@@ -121,9 +121,8 @@ func (s *stakingAuctionSC) Execute(args *vmcommon.ContractCallInput) vmcommon.Re
 		return s.setConfig(args)
 	case "changeRewardAddress":
 		return s.changeRewardAddress(args)
-	// TODO: activate this after validation
-	//case "changeValidatorKeys":
-	//	return s.changeValidatorKeys(args)
+	case "changeValidatorKeys":
+		return s.changeValidatorKeys(args)
 	case "unJail":
 		return s.unJail(args)
 	}
@@ -236,6 +235,10 @@ func (s *stakingAuctionSC) changeValidatorKeys(args *vmcommon.ContractCallInput)
 	}
 
 	numNodesToChange := big.NewInt(0).SetBytes(args.Arguments[0]).Uint64()
+	if numNodesToChange == 0 || numNodesToChange > uint64(len(args.Arguments)) {
+		s.eei.AddReturnMessage("invalid number of nodes to change")
+		return vmcommon.UserError
+	}
 	expectedNumArguments := numNodesToChange*3 + 1
 	if uint64(len(args.Arguments)) < expectedNumArguments {
 		retMessage := fmt.Sprintf("invalid number of arguments: expected min %d, got %d", expectedNumArguments, len(args.Arguments))
@@ -259,7 +262,7 @@ func (s *stakingAuctionSC) changeValidatorKeys(args *vmcommon.ContractCallInput)
 		return vmcommon.UserError
 	}
 
-	for i := 1; i < len(args.Arguments); i += 3 {
+	for i := uint64(1); i < expectedNumArguments; i += 3 {
 		oldBlsKey := args.Arguments[i]
 		newBlsKey := args.Arguments[i+1]
 		signedWithNewKey := args.Arguments[i+2]
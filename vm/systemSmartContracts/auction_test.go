@@ -1770,7 +1770,6 @@ func TestAuctionStakingSC_ChangeRewardAddress(t *testing.T) {
 }
 
 func TestAuctionStakingSC_ChangeValidatorKeys(t *testing.T) {
-	t.Skip("function is disabled for now as it is not fully tested")
 	t.Parallel()
 
 	receiverAddr := []byte("receiverAddress")
@@ -1797,19 +1796,32 @@ func TestAuctionStakingSC_ChangeValidatorKeys(t *testing.T) {
 	changeValidatorKeys(t, sc, nodesToRunBytes, stakerAddress, stakerPubKey, newKey, []byte("signed"), vmcommon.UserError)
 	// changeValidatorKeys should error verify sig will return error
 	nodesToRunBytes = big.NewInt(1).Bytes()
-	args.SigVerifier = &mock.MessageSignVerifierMock{
+	newKey = []byte("newKey1")
+	sc.sigVerifier = &mock.MessageSignVerifierMock{
 		VerifyCalled: func(message []byte, signedMessage []byte, pubKey []byte) error {
 			return errors.New("new")
 		},
 	}
 	changeValidatorKeys(t, sc, nodesToRunBytes, stakerAddress, stakerPubKey, newKey, []byte("signed"), vmcommon.UserError)
 	// changeValidatorKeys should error wrong old key
-	args.SigVerifier = &mock.MessageSignVerifierMock{}
+	sc.sigVerifier = &mock.MessageSignVerifierMock{}
 	changeValidatorKeys(t, sc, nodesToRunBytes, stakerAddress, []byte("wrong"), newKey, []byte("signed"), vmcommon.UserError)
+	// changeValidatorKeys should error because the new key has a different length
+	changeValidatorKeys(t, sc, nodesToRunBytes, stakerAddress, stakerPubKey, []byte("newKey"), []byte("signed"), vmcommon.UserError)
 
 	// changeValidatorKeys should work
-	newKey = []byte("newKey1")
 	changeValidatorKeys(t, sc, nodesToRunBytes, stakerAddress, stakerPubKey, newKey, []byte("signed"), vmcommon.Ok)
+
+	registrationData, _ := sc.getOrCreateRegistrationData(stakerAddress)
+	assert.Equal(t, [][]byte{newKey}, registrationData.BlsPubKeys)
+
+	eei := args.Eei.(*vmContext)
+	eei.SetSCAddress([]byte("staking"))
+	assert.Equal(t, 0, len(eei.GetStorage(stakerPubKey)))
+	assert.True(t, len(eei.GetStorage(newKey)) > 0)
+
+	// changeValidatorKeys should error because the old key was already changed
+	changeValidatorKeys(t, sc, nodesToRunBytes, stakerAddress, stakerPubKey, []byte("newKey2"), []byte("signed"), vmcommon.UserError)
 }
 
 func createVmContextWithStakingSc(stakeValue *big.Int, unboundPeriod uint64, blockChainHook vmcommon.BlockchainHook) *vmContext {
@@ -0,0 +1,1025 @@
+package systemSmartContracts
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const delegationPoolKeyPrefix = "pool"
+const delegatorKeyPrefix = "delegator"
+const lastPoolIDKey = "lastPoolID"
+const poolIDLength = 4
+
+// maxServiceFee is the service fee representing 100%, the fee being expressed in hundredths of a percent
+const maxServiceFee = 10000
+
+// the pool id is written in the free bytes of the delegation contract address, between the bytes which mark a
+// metachain smart contract and the last bytes holding the contract index and the shard identifier
+const poolIDOffsetFromEnd = 7
+
+var rewardsPrecision = big.NewInt(0).Exp(big.NewInt(10), big.NewInt(18), nil)
+
+// DelegationNode is a node of a delegation pool along with the pool address signed with its BLS key
+type DelegationNode struct {
+	BlsKey        []byte
+	SignedMessage []byte
+	UnStakedNonce uint64
+}
+
+// DelegationPool holds the configuration and the funds accounting of a delegation pool. Waiting holds the delegated
+// funds which are not staked, including the ones reserved for the undelegated funds waiting to be withdrawn
+type DelegationPool struct {
+	Owner            []byte
+	ServiceFee       uint64
+	MaxDelegationCap *big.Int
+	TotalActive      *big.Int
+	TotalUnDelegated *big.Int
+	Waiting          *big.Int
+	RewardsPerShare  *big.Int
+	OwnerRewards     *big.Int
+	NotStakedNodes   []*DelegationNode
+	StakedNodes      []*DelegationNode
+	UnStakedNodes    []*DelegationNode
+}
+
+// UnDelegatedFunds are funds which do not generate rewards anymore and which can be withdrawn after the unbond period
+type UnDelegatedFunds struct {
+	Value *big.Int
+	Nonce uint64
+}
+
+// Delegator holds the funds and the rewards of an address in a delegation pool
+type Delegator struct {
+	ActiveStake       *big.Int
+	RewardsCheckpoint *big.Int
+	UnclaimedRewards  *big.Int
+	UnDelegated       []*UnDelegatedFunds
+}
+
+type delegation struct {
+	eei                 vm.SystemEI
+	sigVerifier         vm.MessageSignVerifier
+	gasCost             vm.GasCost
+	nodePrice           *big.Int
+	unBondPeriod        uint64
+	delegationSCAddress []byte
+	stakingSCAddress    []byte
+	auctionSCAddress    []byte
+}
+
+// ArgsNewDelegation defines the arguments needed for the delegation manager contract
+type ArgsNewDelegation struct {
+	Eei                 vm.SystemEI
+	SigVerifier         vm.MessageSignVerifier
+	ValidatorSettings   vm.ValidatorSettingsHandler
+	GasCost             vm.GasCost
+	DelegationSCAddress []byte
+	StakingSCAddress    []byte
+	AuctionSCAddress    []byte
+}
+
+// NewDelegationSmartContract creates the delegation manager smart contract. Each pool stakes its nodes through the
+// auction contract from its own address, derived from the delegation contract address, which also receives the
+// rewards of the pool's nodes
+func NewDelegationSmartContract(args ArgsNewDelegation) (*delegation, error) {
+	if check.IfNil(args.Eei) {
+		return nil, vm.ErrNilSystemEnvironmentInterface
+	}
+	if check.IfNil(args.SigVerifier) {
+		return nil, vm.ErrNilMessageSignVerifier
+	}
+	if check.IfNil(args.ValidatorSettings) {
+		return nil, vm.ErrNilValidatorSettings
+	}
+	if args.ValidatorSettings.GenesisNodePrice() == nil {
+		return nil, vm.ErrNilInitialStakeValue
+	}
+	if args.ValidatorSettings.GenesisNodePrice().Cmp(zero) <= 0 {
+		return nil, vm.ErrNegativeInitialStakeValue
+	}
+	if len(args.DelegationSCAddress) <= poolIDOffsetFromEnd {
+		return nil, vm.ErrNilDelegationSmartContractAddress
+	}
+	if len(args.StakingSCAddress) == 0 {
+		return nil, vm.ErrNilStakingSmartContractAddress
+	}
+	if len(args.AuctionSCAddress) == 0 {
+		return nil, vm.ErrNilAuctionSmartContractAddress
+	}
+
+	return &delegation{
+		eei:                 args.Eei,
+		sigVerifier:         args.SigVerifier,
+		gasCost:             args.GasCost,
+		nodePrice:           big.NewInt(0).Set(args.ValidatorSettings.GenesisNodePrice()),
+		unBondPeriod:        args.ValidatorSettings.UnBondPeriod(),
+		delegationSCAddress: args.DelegationSCAddress,
+		stakingSCAddress:    args.StakingSCAddress,
+		auctionSCAddress:    args.AuctionSCAddress,
+	}, nil
+}
+
+// Execute calls one of the functions from the delegation smart contract and runs the code according to the input
+func (d *delegation) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	err := CheckIfNil(args)
+	if err != nil {
+		d.eei.AddReturnMessage("nil arguments: " + err.Error())
+		return vmcommon.UserError
+	}
+
+	switch args.Function {
+	case core.SCDeployInitFunctionName:
+		return d.init(args)
+	case "createPool":
+		return d.createPool(args)
+	case "addNodes":
+		return d.addNodes(args)
+	case "removeNodes":
+		return d.removeNodes(args)
+	case "delegate":
+		return d.delegate(args)
+	case "unDelegate":
+		return d.unDelegate(args)
+	case "withdraw":
+		return d.withdraw(args)
+	case "claimRewards":
+		return d.claimRewards(args)
+	case "getPool":
+		return d.getPool(args)
+	case "getDelegator":
+		return d.getDelegator(args)
+	}
+
+	d.eei.AddReturnMessage("invalid method to call")
+	return vmcommon.UserError
+}
+
+func (d *delegation) init(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	ownerAddress := d.eei.GetStorage([]byte(ownerKey))
+	if ownerAddress != nil {
+		d.eei.AddReturnMessage("smart contract was already initialized")
+		return vmcommon.UserError
+	}
+
+	d.eei.SetStorage([]byte(ownerKey), args.CallerAddr)
+
+	return vmcommon.Ok
+}
+
+// createPool creates a pool owned by the caller. The arguments are the service fee, in hundredths of a percent, and
+// the maximum delegation cap, zero meaning no cap. The id of the new pool is returned
+func (d *delegation) createPool(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage("transaction value must be zero")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 2 {
+		d.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected %d, got %d", 2, len(args.Arguments)))
+		return vmcommon.UserError
+	}
+
+	serviceFee := big.NewInt(0).SetBytes(args.Arguments[0])
+	if serviceFee.Cmp(big.NewInt(maxServiceFee)) > 0 {
+		d.eei.AddReturnMessage(fmt.Sprintf("service fee must be at most %d", maxServiceFee))
+		return vmcommon.UserError
+	}
+
+	err := d.eei.UseGas(d.gasCost.MetaChainSystemSCsCost.Stake)
+	if err != nil {
+		d.eei.AddReturnMessage("insufficient gas limit")
+		return vmcommon.OutOfGas
+	}
+
+	poolID := uint32(big.NewInt(0).SetBytes(d.eei.GetStorage([]byte(lastPoolIDKey))).Uint64()) + 1
+	pool := &DelegationPool{
+		Owner:            args.CallerAddr,
+		ServiceFee:       serviceFee.Uint64(),
+		MaxDelegationCap: big.NewInt(0).SetBytes(args.Arguments[1]),
+		TotalActive:      big.NewInt(0),
+		TotalUnDelegated: big.NewInt(0),
+		Waiting:          big.NewInt(0),
+		RewardsPerShare:  big.NewInt(0),
+		OwnerRewards:     big.NewInt(0),
+		NotStakedNodes:   make([]*DelegationNode, 0),
+		StakedNodes:      make([]*DelegationNode, 0),
+		UnStakedNodes:    make([]*DelegationNode, 0),
+	}
+
+	err = d.savePool(poolID, pool)
+	if err != nil {
+		d.eei.AddReturnMessage("cannot save pool: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	poolIDBytes := big.NewInt(0).SetUint64(uint64(poolID)).Bytes()
+	d.eei.SetStorage([]byte(lastPoolIDKey), poolIDBytes)
+	d.eei.Finish(poolIDBytes)
+
+	return vmcommon.Ok
+}
+
+// addNodes registers nodes of a pool, the arguments being the pool id followed by pairs of BLS keys and signatures
+// of the pool address. The nodes are staked as soon as the pool has enough funds
+func (d *delegation) addNodes(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage("transaction value must be zero")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) < 3 || len(args.Arguments)%2 == 0 {
+		d.eei.AddReturnMessage("invalid number of arguments: expected the pool id followed by pairs of BLS key and signature")
+		return vmcommon.UserError
+	}
+
+	poolID, pool, returnCode := d.loadOwnedPool(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	numNodes := uint64(len(args.Arguments)-1) / 2
+	err := d.eei.UseGas(d.gasCost.MetaChainSystemSCsCost.Stake * numNodes)
+	if err != nil {
+		d.eei.AddReturnMessage("insufficient gas limit")
+		return vmcommon.OutOfGas
+	}
+
+	poolAddress := d.poolAddress(poolID)
+	for i := 1; i < len(args.Arguments); i += 2 {
+		blsKey := args.Arguments[i]
+		signedMessage := args.Arguments[i+1]
+		if isNodeInPool(pool, blsKey) {
+			d.eei.AddReturnMessage("node already added: " + hex.EncodeToString(blsKey))
+			return vmcommon.UserError
+		}
+
+		err = d.sigVerifier.Verify(poolAddress, signedMessage, blsKey)
+		if err != nil {
+			d.eei.AddReturnMessage("invalid signature for node " + hex.EncodeToString(blsKey))
+			return vmcommon.UserError
+		}
+
+		pool.NotStakedNodes = append(pool.NotStakedNodes, &DelegationNode{
+			BlsKey:        blsKey,
+			SignedMessage: signedMessage,
+		})
+	}
+
+	return d.rebalanceAndSavePool(poolID, pool)
+}
+
+// removeNodes removes nodes which are not staked from a pool, the arguments being the pool id followed by BLS keys
+func (d *delegation) removeNodes(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage("transaction value must be zero")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) < 2 {
+		d.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected min %d, got %d", 2, len(args.Arguments)))
+		return vmcommon.UserError
+	}
+
+	poolID, pool, returnCode := d.loadOwnedPool(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	err := d.eei.UseGas(d.gasCost.MetaChainSystemSCsCost.UnStake * uint64(len(args.Arguments)-1))
+	if err != nil {
+		d.eei.AddReturnMessage("insufficient gas limit")
+		return vmcommon.OutOfGas
+	}
+
+	for _, blsKey := range args.Arguments[1:] {
+		nodes, found := removeNode(pool.NotStakedNodes, blsKey)
+		if !found {
+			d.eei.AddReturnMessage("node is not in the not staked nodes list: " + hex.EncodeToString(blsKey))
+			return vmcommon.UserError
+		}
+		pool.NotStakedNodes = nodes
+	}
+
+	err = d.savePool(poolID, pool)
+	if err != nil {
+		d.eei.AddReturnMessage("cannot save pool: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// delegate adds the call value to the caller's active stake in the pool given as argument
+func (d *delegation) delegate(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) <= 0 {
+		d.eei.AddReturnMessage("transaction value must be greater than zero")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 {
+		d.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected %d, got %d", 1, len(args.Arguments)))
+		return vmcommon.UserError
+	}
+
+	err := d.eei.UseGas(d.gasCost.MetaChainSystemSCsCost.Stake)
+	if err != nil {
+		d.eei.AddReturnMessage("insufficient gas limit")
+		return vmcommon.OutOfGas
+	}
+
+	poolID, pool, returnCode := d.loadPoolAndDistributeRewards(args.Arguments[0])
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	newTotalActive := big.NewInt(0).Add(pool.TotalActive, args.CallValue)
+	if pool.MaxDelegationCap.Cmp(zero) > 0 && newTotalActive.Cmp(pool.MaxDelegationCap) > 0 {
+		d.eei.AddReturnMessage("delegation cap reached")
+		return vmcommon.UserError
+	}
+
+	delegator, err := d.getOrCreateDelegator(poolID, args.CallerAddr)
+	if err != nil {
+		d.eei.AddReturnMessage("cannot get delegator: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	computeDelegatorRewards(pool, delegator)
+	delegator.ActiveStake.Add(delegator.ActiveStake, args.CallValue)
+	pool.TotalActive = newTotalActive
+	pool.Waiting.Add(pool.Waiting, args.CallValue)
+
+	err = d.saveDelegator(poolID, args.CallerAddr, delegator)
+	if err != nil {
+		d.eei.AddReturnMessage("cannot save delegator: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return d.rebalanceAndSavePool(poolID, pool)
+}
+
+// unDelegate moves a part of the caller's active stake, given as the second argument, to the undelegated funds which
+// can be withdrawn after the unbond period. The pool unStakes as many nodes as needed to cover the undelegated funds
+func (d *delegation) unDelegate(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage("transaction value must be zero")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 2 {
+		d.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected %d, got %d", 2, len(args.Arguments)))
+		return vmcommon.UserError
+	}
+
+	value := big.NewInt(0).SetBytes(args.Arguments[1])
+	if value.Cmp(zero) <= 0 {
+		d.eei.AddReturnMessage("value to undelegate must be greater than zero")
+		return vmcommon.UserError
+	}
+
+	err := d.eei.UseGas(d.gasCost.MetaChainSystemSCsCost.UnStake)
+	if err != nil {
+		d.eei.AddReturnMessage("insufficient gas limit")
+		return vmcommon.OutOfGas
+	}
+
+	poolID, pool, returnCode := d.loadPoolAndDistributeRewards(args.Arguments[0])
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	delegator, err := d.getOrCreateDelegator(poolID, args.CallerAddr)
+	if err != nil {
+		d.eei.AddReturnMessage("cannot get delegator: error " + err.Error())
+		return vmcommon.UserError
+	}
+	if delegator.ActiveStake.Cmp(value) < 0 {
+		d.eei.AddReturnMessage("insufficient active stake")
+		return vmcommon.UserError
+	}
+
+	computeDelegatorRewards(pool, delegator)
+	delegator.ActiveStake.Sub(delegator.ActiveStake, value)
+	delegator.UnDelegated = append(delegator.UnDelegated, &UnDelegatedFunds{
+		Value: value,
+		Nonce: d.eei.BlockChainHook().CurrentNonce(),
+	})
+	pool.TotalActive.Sub(pool.TotalActive, value)
+	pool.TotalUnDelegated.Add(pool.TotalUnDelegated, value)
+
+	err = d.saveDelegator(poolID, args.CallerAddr, delegator)
+	if err != nil {
+		d.eei.AddReturnMessage("cannot save delegator: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return d.rebalanceAndSavePool(poolID, pool)
+}
+
+// withdraw sends to the caller the undelegated funds for which the unbond period passed, unBonding first the pool's
+// nodes which can be unBonded
+func (d *delegation) withdraw(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage("transaction value must be zero")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 {
+		d.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected %d, got %d", 1, len(args.Arguments)))
+		return vmcommon.UserError
+	}
+
+	err := d.eei.UseGas(d.gasCost.MetaChainSystemSCsCost.UnBond)
+	if err != nil {
+		d.eei.AddReturnMessage("insufficient gas limit")
+		return vmcommon.OutOfGas
+	}
+
+	poolID, pool, returnCode := d.loadPoolAndDistributeRewards(args.Arguments[0])
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	err = d.unBondNodes(poolID, pool)
+	if err != nil {
+		d.eei.AddReturnMessage("cannot unBond the nodes of the pool: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	delegator, err := d.getOrCreateDelegator(poolID, args.CallerAddr)
+	if err != nil {
+		d.eei.AddReturnMessage("cannot get delegator: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	currentNonce := d.eei.BlockChainHook().CurrentNonce()
+	toWithdraw := big.NewInt(0)
+	stillUnBonding := make([]*UnDelegatedFunds, 0, len(delegator.UnDelegated))
+	for _, funds := range delegator.UnDelegated {
+		if currentNonce-funds.Nonce < d.unBondPeriod {
+			stillUnBonding = append(stillUnBonding, funds)
+			continue
+		}
+
+		toWithdraw.Add(toWithdraw, funds.Value)
+	}
+	if toWithdraw.Cmp(zero) == 0 {
+		d.eei.AddReturnMessage("no undelegated funds passed the unbond period")
+		return vmcommon.UserError
+	}
+	if pool.Waiting.Cmp(toWithdraw) < 0 {
+		d.eei.AddReturnMessage("the undelegated funds are still locked in the auction contract, retry after the unbond period")
+		return vmcommon.UserError
+	}
+
+	delegator.UnDelegated = stillUnBonding
+	pool.Waiting.Sub(pool.Waiting, toWithdraw)
+	pool.TotalUnDelegated.Sub(pool.TotalUnDelegated, toWithdraw)
+
+	err = d.eei.Transfer(args.CallerAddr, d.delegationSCAddress, toWithdraw, nil, 0)
+	if err != nil {
+		d.eei.AddReturnMessage("transfer error on withdraw function: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	err = d.saveDelegator(poolID, args.CallerAddr, delegator)
+	if err != nil {
+		d.eei.AddReturnMessage("cannot save delegator: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return d.rebalanceAndSavePool(poolID, pool)
+}
+
+// claimRewards sends to the caller its rewards from the pool given as argument, along with the service fees when the
+// caller is the owner of the pool
+func (d *delegation) claimRewards(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage("transaction value must be zero")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 {
+		d.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected %d, got %d", 1, len(args.Arguments)))
+		return vmcommon.UserError
+	}
+
+	err := d.eei.UseGas(d.gasCost.MetaChainSystemSCsCost.Claim)
+	if err != nil {
+		d.eei.AddReturnMessage("insufficient gas limit")
+		return vmcommon.OutOfGas
+	}
+
+	poolID, pool, returnCode := d.loadPoolAndDistributeRewards(args.Arguments[0])
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	delegator, err := d.getOrCreateDelegator(poolID, args.CallerAddr)
+	if err != nil {
+		d.eei.AddReturnMessage("cannot get delegator: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	computeDelegatorRewards(pool, delegator)
+	claimable := big.NewInt(0).Set(delegator.UnclaimedRewards)
+	delegator.UnclaimedRewards.SetUint64(0)
+	if bytes.Equal(args.CallerAddr, pool.Owner) {
+		claimable.Add(claimable, pool.OwnerRewards)
+		pool.OwnerRewards.SetUint64(0)
+	}
+
+	if claimable.Cmp(zero) > 0 {
+		err = d.eei.Transfer(args.CallerAddr, d.delegationSCAddress, claimable, nil, 0)
+		if err != nil {
+			d.eei.AddReturnMessage("transfer error on claimRewards function: error " + err.Error())
+			return vmcommon.UserError
+		}
+	}
+
+	err = d.saveDelegator(poolID, args.CallerAddr, delegator)
+	if err != nil {
+		d.eei.AddReturnMessage("cannot save delegator: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	err = d.savePool(poolID, pool)
+	if err != nil {
+		d.eei.AddReturnMessage("cannot save pool: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// getPool returns the JSON encoded pool given as argument and the address of the pool
+func (d *delegation) getPool(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage("transaction value must be zero")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 {
+		d.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected %d, got %d", 1, len(args.Arguments)))
+		return vmcommon.UserError
+	}
+
+	err := d.eei.UseGas(d.gasCost.MetaChainSystemSCsCost.Get)
+	if err != nil {
+		d.eei.AddReturnMessage("insufficient gas limit")
+		return vmcommon.OutOfGas
+	}
+
+	poolID, pool, returnCode := d.loadPoolAndDistributeRewards(args.Arguments[0])
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	data, err := json.Marshal(pool)
+	if err != nil {
+		d.eei.AddReturnMessage("cannot marshal pool: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	d.eei.Finish(data)
+	d.eei.Finish(d.poolAddress(poolID))
+
+	return vmcommon.Ok
+}
+
+// getDelegator returns the JSON encoded delegator of the pool, the arguments being the pool id and the address of
+// the delegator. The unclaimed rewards include the rewards not yet distributed to the pool
+func (d *delegation) getDelegator(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage("transaction value must be zero")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 2 {
+		d.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected %d, got %d", 2, len(args.Arguments)))
+		return vmcommon.UserError
+	}
+
+	err := d.eei.UseGas(d.gasCost.MetaChainSystemSCsCost.Get)
+	if err != nil {
+		d.eei.AddReturnMessage("insufficient gas limit")
+		return vmcommon.OutOfGas
+	}
+
+	poolID, pool, returnCode := d.loadPoolAndDistributeRewards(args.Arguments[0])
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	delegator, err := d.getOrCreateDelegator(poolID, args.Arguments[1])
+	if err != nil {
+		d.eei.AddReturnMessage("cannot get delegator: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	computeDelegatorRewards(pool, delegator)
+	data, err := json.Marshal(delegator)
+	if err != nil {
+		d.eei.AddReturnMessage("cannot marshal delegator: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	d.eei.Finish(data)
+
+	return vmcommon.Ok
+}
+
+func (d *delegation) loadOwnedPool(args *vmcommon.ContractCallInput) (uint32, *DelegationPool, vmcommon.ReturnCode) {
+	poolID, pool, returnCode := d.loadPoolAndDistributeRewards(args.Arguments[0])
+	if returnCode != vmcommon.Ok {
+		return 0, nil, returnCode
+	}
+	if !bytes.Equal(args.CallerAddr, pool.Owner) {
+		d.eei.AddReturnMessage("only the pool owner can call this function")
+		return 0, nil, vmcommon.UserError
+	}
+
+	return poolID, pool, vmcommon.Ok
+}
+
+// loadPoolAndDistributeRewards loads the pool and distributes the rewards received on the pool address. This should
+// be the first operation on a pool as the balance of the pool address holds only the rewards at this point
+func (d *delegation) loadPoolAndDistributeRewards(poolIDBytes []byte) (uint32, *DelegationPool, vmcommon.ReturnCode) {
+	poolID := uint32(big.NewInt(0).SetBytes(poolIDBytes).Uint64())
+	pool, err := d.loadPool(poolID)
+	if len(poolIDBytes) > poolIDLength {
+		err = vm.ErrDelegationPoolNotFound
+	}
+	if err != nil {
+		d.eei.AddReturnMessage("cannot get pool: error " + err.Error())
+		return 0, nil, vmcommon.UserError
+	}
+
+	err = d.distributeRewards(poolID, pool)
+	if err != nil {
+		d.eei.AddReturnMessage("cannot distribute rewards: error " + err.Error())
+		return 0, nil, vmcommon.UserError
+	}
+
+	return poolID, pool, vmcommon.Ok
+}
+
+// distributeRewards moves the rewards from the pool address to the delegation contract, keeping the service fee for
+// the pool owner and accumulating the rest in the rewards per share of the active stake
+func (d *delegation) distributeRewards(poolID uint32, pool *DelegationPool) error {
+	poolAddress := d.poolAddress(poolID)
+	rewards := d.eei.GetBalance(poolAddress)
+	if rewards == nil || rewards.Cmp(zero) <= 0 {
+		return nil
+	}
+
+	err := d.eei.Transfer(d.delegationSCAddress, poolAddress, rewards, nil, 0)
+	if err != nil {
+		return err
+	}
+
+	serviceFee := big.NewInt(0).Mul(rewards, big.NewInt(0).SetUint64(pool.ServiceFee))
+	serviceFee.Div(serviceFee, big.NewInt(maxServiceFee))
+	delegatorsRewards := big.NewInt(0).Sub(rewards, serviceFee)
+	pool.OwnerRewards.Add(pool.OwnerRewards, serviceFee)
+
+	if pool.TotalActive.Cmp(zero) == 0 {
+		pool.OwnerRewards.Add(pool.OwnerRewards, delegatorsRewards)
+		return nil
+	}
+
+	rewardsPerShare := big.NewInt(0).Mul(delegatorsRewards, rewardsPrecision)
+	rewardsPerShare.Div(rewardsPerShare, pool.TotalActive)
+	pool.RewardsPerShare.Add(pool.RewardsPerShare, rewardsPerShare)
+
+	return nil
+}
+
+// computeDelegatorRewards moves the rewards earned by the active stake since the last checkpoint to the unclaimed
+// rewards and must be called before any change of the active stake
+func computeDelegatorRewards(pool *DelegationPool, delegator *Delegator) {
+	rewardsPerShare := big.NewInt(0).Sub(pool.RewardsPerShare, delegator.RewardsCheckpoint)
+	rewards := big.NewInt(0).Mul(delegator.ActiveStake, rewardsPerShare)
+	rewards.Div(rewards, rewardsPrecision)
+
+	delegator.UnclaimedRewards.Add(delegator.UnclaimedRewards, rewards)
+	delegator.RewardsCheckpoint.Set(pool.RewardsPerShare)
+}
+
+func (d *delegation) rebalanceAndSavePool(poolID uint32, pool *DelegationPool) vmcommon.ReturnCode {
+	err := d.unStakeNodesIfNeeded(poolID, pool)
+	if err != nil {
+		d.eei.AddReturnMessage("cannot unStake the nodes of the pool: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	err = d.stakeNodesIfPossible(poolID, pool)
+	if err != nil {
+		d.eei.AddReturnMessage("cannot stake the pooled funds: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	err = d.savePool(poolID, pool)
+	if err != nil {
+		d.eei.AddReturnMessage("cannot save pool: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// stakeNodesIfPossible stakes through the auction contract as many nodes as the pooled funds, which are not reserved
+// for the undelegated funds, allow
+func (d *delegation) stakeNodesIfPossible(poolID uint32, pool *DelegationPool) error {
+	freeFunds := big.NewInt(0).Sub(pool.Waiting, pool.TotalUnDelegated)
+	if freeFunds.Cmp(d.nodePrice) < 0 {
+		return nil
+	}
+
+	numNodes := big.NewInt(0).Div(freeFunds, d.nodePrice).Uint64()
+	if numNodes > uint64(len(pool.NotStakedNodes)) {
+		numNodes = uint64(len(pool.NotStakedNodes))
+	}
+	if numNodes == 0 {
+		return nil
+	}
+
+	nodes := pool.NotStakedNodes[:numNodes]
+	data := "stake@" + hex.EncodeToString(big.NewInt(0).SetUint64(numNodes).Bytes())
+	for _, node := range nodes {
+		data += "@" + hex.EncodeToString(node.BlsKey) + "@" + hex.EncodeToString(node.SignedMessage)
+	}
+
+	value := big.NewInt(0).Mul(d.nodePrice, big.NewInt(0).SetUint64(numNodes))
+	err := d.executeOnAuctionSC(d.poolAddress(poolID), value, data)
+	if err != nil {
+		return err
+	}
+
+	pool.Waiting.Sub(pool.Waiting, value)
+	pool.StakedNodes = append(pool.StakedNodes, nodes...)
+	pool.NotStakedNodes = pool.NotStakedNodes[numNodes:]
+
+	return nil
+}
+
+// unStakeNodesIfNeeded unStakes the nodes needed to cover the undelegated funds which are not covered by the waiting
+// funds and by the nodes already unStaked
+func (d *delegation) unStakeNodesIfNeeded(poolID uint32, pool *DelegationPool) error {
+	unStakingValue := big.NewInt(0).Mul(d.nodePrice, big.NewInt(int64(len(pool.UnStakedNodes))))
+	missingFunds := big.NewInt(0).Sub(pool.TotalUnDelegated, pool.Waiting)
+	missingFunds.Sub(missingFunds, unStakingValue)
+	if missingFunds.Cmp(zero) <= 0 {
+		return nil
+	}
+
+	numNodes := big.NewInt(0).Add(missingFunds, d.nodePrice)
+	numNodes.Sub(numNodes, big.NewInt(1))
+	numNodes.Div(numNodes, d.nodePrice)
+	if numNodes.Cmp(big.NewInt(int64(len(pool.StakedNodes)))) > 0 {
+		numNodes.SetInt64(int64(len(pool.StakedNodes)))
+	}
+	if numNodes.Cmp(zero) == 0 {
+		return nil
+	}
+
+	firstIndex := len(pool.StakedNodes) - int(numNodes.Int64())
+	nodes := pool.StakedNodes[firstIndex:]
+	data := "unStake"
+	for _, node := range nodes {
+		data += "@" + hex.EncodeToString(node.BlsKey)
+	}
+
+	err := d.executeOnAuctionSC(d.poolAddress(poolID), big.NewInt(0), data)
+	if err != nil {
+		return err
+	}
+
+	currentNonce := d.eei.BlockChainHook().CurrentNonce()
+	for _, node := range nodes {
+		stakedData, errGet := d.getStakedData(node.BlsKey)
+		if errGet != nil {
+			return errGet
+		}
+		if stakedData != nil && stakedData.Staked {
+			return fmt.Errorf("%w: node %s is still staked", vm.ErrOnExecutionAtStakingSC, hex.EncodeToString(node.BlsKey))
+		}
+
+		node.UnStakedNonce = currentNonce
+	}
+
+	pool.UnStakedNodes = append(pool.UnStakedNodes, nodes...)
+	pool.StakedNodes = pool.StakedNodes[:firstIndex]
+
+	return nil
+}
+
+// unBondNodes unBonds the nodes for which the unbond period passed and adds the unBonded funds to the waiting funds.
+// The unBonded nodes can be staked again
+func (d *delegation) unBondNodes(poolID uint32, pool *DelegationPool) error {
+	currentNonce := d.eei.BlockChainHook().CurrentNonce()
+	data := "unBond"
+	for _, node := range pool.UnStakedNodes {
+		if currentNonce-node.UnStakedNonce >= d.unBondPeriod {
+			data += "@" + hex.EncodeToString(node.BlsKey)
+		}
+	}
+	if data == "unBond" {
+		return nil
+	}
+
+	poolAddress := d.poolAddress(poolID)
+	balanceBefore := d.eei.GetBalance(poolAddress)
+	err := d.executeOnAuctionSC(poolAddress, big.NewInt(0), data)
+	if err != nil {
+		return err
+	}
+
+	unBondedFunds := big.NewInt(0).Sub(d.eei.GetBalance(poolAddress), balanceBefore)
+	err = d.eei.Transfer(d.delegationSCAddress, poolAddress, unBondedFunds, nil, 0)
+	if err != nil {
+		return err
+	}
+	pool.Waiting.Add(pool.Waiting, unBondedFunds)
+
+	stillUnStaked := make([]*DelegationNode, 0, len(pool.UnStakedNodes))
+	for _, node := range pool.UnStakedNodes {
+		stakedData, errGet := d.getStakedData(node.BlsKey)
+		if errGet != nil {
+			return errGet
+		}
+		if stakedData != nil {
+			stillUnStaked = append(stillUnStaked, node)
+			continue
+		}
+
+		node.UnStakedNonce = 0
+		pool.NotStakedNodes = append(pool.NotStakedNodes, node)
+	}
+	pool.UnStakedNodes = stillUnStaked
+
+	return nil
+}
+
+// executeOnAuctionSC calls the auction contract from the pool address, moving the value from the delegation contract
+func (d *delegation) executeOnAuctionSC(poolAddress []byte, value *big.Int, data string) error {
+	err := d.eei.Transfer(poolAddress, d.delegationSCAddress, value, nil, 0)
+	if err != nil {
+		return err
+	}
+
+	vmOutput, err := d.eei.ExecuteOnDestContext(d.auctionSCAddress, poolAddress, value, []byte(data))
+	if err != nil {
+		return err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return fmt.Errorf("%w: %s", vm.ErrOnExecutionAtAuctionSC, vmOutput.ReturnMessage)
+	}
+
+	return nil
+}
+
+// getStakedData returns the data of the node from the staking contract or nil if the node is not registered
+func (d *delegation) getStakedData(blsKey []byte) (*StakedData, error) {
+	vmOutput, err := d.eei.ExecuteOnDestContext(d.stakingSCAddress, d.delegationSCAddress, big.NewInt(0), []byte("get@"+hex.EncodeToString(blsKey)))
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return nil, vm.ErrOnExecutionAtStakingSC
+	}
+	if len(vmOutput.ReturnData) == 0 || len(vmOutput.ReturnData[0]) == 0 {
+		return nil, nil
+	}
+
+	stakedData := &StakedData{}
+	err = json.Unmarshal(vmOutput.ReturnData[0], stakedData)
+	if err != nil {
+		return nil, err
+	}
+
+	return stakedData, nil
+}
+
+// poolAddress returns the address the pool uses to stake its nodes and to receive their rewards
+func (d *delegation) poolAddress(poolID uint32) []byte {
+	address := make([]byte, len(d.delegationSCAddress))
+	copy(address, d.delegationSCAddress)
+	binary.BigEndian.PutUint32(address[len(address)-poolIDOffsetFromEnd:], poolID)
+
+	return address
+}
+
+func (d *delegation) loadPool(poolID uint32) (*DelegationPool, error) {
+	data := d.eei.GetStorage(createPoolKey(poolID))
+	if len(data) == 0 {
+		return nil, vm.ErrDelegationPoolNotFound
+	}
+
+	pool := &DelegationPool{}
+	err := json.Unmarshal(data, pool)
+	if err != nil {
+		return nil, err
+	}
+
+	return pool, nil
+}
+
+func (d *delegation) savePool(poolID uint32, pool *DelegationPool) error {
+	data, err := json.Marshal(pool)
+	if err != nil {
+		return err
+	}
+
+	d.eei.SetStorage(createPoolKey(poolID), data)
+	return nil
+}
+
+func (d *delegation) getOrCreateDelegator(poolID uint32, address []byte) (*Delegator, error) {
+	delegator := &Delegator{
+		ActiveStake:       big.NewInt(0),
+		RewardsCheckpoint: big.NewInt(0),
+		UnclaimedRewards:  big.NewInt(0),
+		UnDelegated:       make([]*UnDelegatedFunds, 0),
+	}
+
+	data := d.eei.GetStorage(createDelegatorKey(poolID, address))
+	if len(data) == 0 {
+		return delegator, nil
+	}
+
+	err := json.Unmarshal(data, delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	return delegator, nil
+}
+
+func (d *delegation) saveDelegator(poolID uint32, address []byte, delegator *Delegator) error {
+	key := createDelegatorKey(poolID, address)
+	isEmpty := delegator.ActiveStake.Cmp(zero) == 0 &&
+		delegator.UnclaimedRewards.Cmp(zero) == 0 &&
+		len(delegator.UnDelegated) == 0
+	if isEmpty {
+		d.eei.SetStorage(key, nil)
+		return nil
+	}
+
+	data, err := json.Marshal(delegator)
+	if err != nil {
+		return err
+	}
+
+	d.eei.SetStorage(key, data)
+	return nil
+}
+
+func createPoolKey(poolID uint32) []byte {
+	key := []byte(delegationPoolKeyPrefix)
+	key = append(key, poolIDToBytes(poolID)...)
+
+	return key
+}
+
+func createDelegatorKey(poolID uint32, address []byte) []byte {
+	key := []byte(delegatorKeyPrefix)
+	key = append(key, poolIDToBytes(poolID)...)
+	key = append(key, address...)
+
+	return key
+}
+
+func poolIDToBytes(poolID uint32) []byte {
+	poolIDBytes := make([]byte, poolIDLength)
+	binary.BigEndian.PutUint32(poolIDBytes, poolID)
+
+	return poolIDBytes
+}
+
+func isNodeInPool(pool *DelegationPool, blsKey []byte) bool {
+	for _, nodes := range [][]*DelegationNode{pool.NotStakedNodes, pool.StakedNodes, pool.UnStakedNodes} {
+		for _, node := range nodes {
+			if bytes.Equal(node.BlsKey, blsKey) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func removeNode(nodes []*DelegationNode, blsKey []byte) ([]*DelegationNode, bool) {
+	for i, node := range nodes {
+		if bytes.Equal(node.BlsKey, blsKey) {
+			return append(nodes[:i], nodes[i+1:]...), true
+		}
+	}
+
+	return nodes, false
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (d *delegation) IsInterfaceNil() bool {
+	return d == nil
+}
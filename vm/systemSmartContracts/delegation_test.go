@@ -0,0 +1,349 @@
+package systemSmartContracts
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/mock"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const delegationUnBondPeriod = 10
+
+var delegationNodePrice = big.NewInt(1000)
+
+func createMockArgumentsForDelegation() ArgsNewDelegation {
+	return ArgsNewDelegation{
+		Eei:                 &mock.SystemEIStub{},
+		SigVerifier:         &mock.MessageSignVerifierMock{},
+		ValidatorSettings:   &mock.ValidatorSettingsStub{},
+		DelegationSCAddress: []byte("delegationSC"),
+		StakingSCAddress:    []byte("staking"),
+		AuctionSCAddress:    []byte("auction"),
+	}
+}
+
+type delegationTestContext struct {
+	eei        *vmContext
+	delegation *delegation
+	nonce      uint64
+}
+
+func createDelegationTestContext(t *testing.T) *delegationTestContext {
+	tc := &delegationTestContext{}
+	blockChainHook := &mock.BlockChainHookStub{
+		CurrentNonceCalled: func() uint64 {
+			return tc.nonce
+		},
+	}
+	validatorSettings := &mock.ValidatorSettingsStub{
+		StakeValueCalled: func() *big.Int {
+			return delegationNodePrice
+		},
+		UnBondPeriodCalled: func() uint64 {
+			return delegationUnBondPeriod
+		},
+		StakeEnableNonceCalled: func() uint64 {
+			return 0
+		},
+	}
+
+	eei, _ := NewVMContext(blockChainHook, hooks.NewVMCryptoHook(), vmcommon.NewAtArgumentParser(), &mock.AccountsStub{})
+
+	argsStaking := createMockStakingScArguments()
+	argsStaking.MinStakeValue = delegationNodePrice
+	argsStaking.UnBondPeriod = delegationUnBondPeriod
+	argsStaking.Eei = eei
+	stakingSC, _ := NewStakingSmartContract(argsStaking)
+
+	argsAuction := createMockArgumentsForAuction()
+	argsAuction.ValidatorSettings = validatorSettings
+	argsAuction.Eei = eei
+	auctionSC, _ := NewStakingAuctionSmartContract(argsAuction)
+
+	argsDelegation := createMockArgumentsForDelegation()
+	argsDelegation.ValidatorSettings = validatorSettings
+	argsDelegation.Eei = eei
+	delegationSC, err := NewDelegationSmartContract(argsDelegation)
+	require.Nil(t, err)
+
+	_ = eei.SetSystemSCContainer(&mock.SystemSCContainerStub{GetCalled: func(key []byte) (vm.SystemSmartContract, error) {
+		switch string(key) {
+		case string(argsDelegation.StakingSCAddress):
+			return stakingSC, nil
+		case string(argsDelegation.AuctionSCAddress):
+			return auctionSC, nil
+		case string(argsDelegation.DelegationSCAddress):
+			return delegationSC, nil
+		}
+		return nil, vm.ErrUnknownSystemSmartContract
+	}})
+
+	tc.eei = eei
+	tc.delegation = delegationSC
+
+	return tc
+}
+
+func (tc *delegationTestContext) execute(caller []byte, function string, value *big.Int, arguments ...[]byte) vmcommon.ReturnCode {
+	tc.eei.SetSCAddress(tc.delegation.delegationSCAddress)
+	tc.eei.AddTxValueToSmartContract(value, tc.delegation.delegationSCAddress)
+	tc.eei.SetGasProvided(1000000)
+
+	input := CreateVmContractCallInput()
+	input.CallerAddr = caller
+	input.RecipientAddr = tc.delegation.delegationSCAddress
+	input.Function = function
+	input.CallValue = value
+	input.Arguments = arguments
+
+	return tc.delegation.Execute(input)
+}
+
+func (tc *delegationTestContext) getPool(t *testing.T, poolID uint32) *DelegationPool {
+	tc.eei.SetSCAddress(tc.delegation.delegationSCAddress)
+	pool, err := tc.delegation.loadPool(poolID)
+	require.Nil(t, err)
+
+	return pool
+}
+
+func (tc *delegationTestContext) getStakedData(t *testing.T, blsKey []byte) *StakedData {
+	tc.eei.SetSCAddress([]byte("staking"))
+	data := tc.eei.GetStorage(blsKey)
+	if len(data) == 0 {
+		return nil
+	}
+
+	stakedData := &StakedData{}
+	err := json.Unmarshal(data, stakedData)
+	require.Nil(t, err)
+
+	return stakedData
+}
+
+func (tc *delegationTestContext) createPoolWithNode(t *testing.T, owner []byte, serviceFee uint64, blsKey []byte) []byte {
+	poolID := []byte{1}
+	retCode := tc.execute(owner, "createPool", big.NewInt(0), big.NewInt(0).SetUint64(serviceFee).Bytes(), big.NewInt(0).Bytes())
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	retCode = tc.execute(owner, "addNodes", big.NewInt(0), poolID, blsKey, []byte("signed"))
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	return poolID
+}
+
+func TestNewDelegationSmartContract_NilEeiShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForDelegation()
+	args.Eei = nil
+
+	d, err := NewDelegationSmartContract(args)
+	assert.Nil(t, d)
+	assert.Equal(t, vm.ErrNilSystemEnvironmentInterface, err)
+}
+
+func TestNewDelegationSmartContract_NilSigVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForDelegation()
+	args.SigVerifier = nil
+
+	d, err := NewDelegationSmartContract(args)
+	assert.Nil(t, d)
+	assert.Equal(t, vm.ErrNilMessageSignVerifier, err)
+}
+
+func TestNewDelegationSmartContract_InvalidDelegationAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForDelegation()
+	args.DelegationSCAddress = []byte("short")
+
+	d, err := NewDelegationSmartContract(args)
+	assert.Nil(t, d)
+	assert.Equal(t, vm.ErrNilDelegationSmartContractAddress, err)
+}
+
+func TestNewDelegationSmartContract_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	d, err := NewDelegationSmartContract(createMockArgumentsForDelegation())
+	assert.Nil(t, err)
+	assert.False(t, d.IsInterfaceNil())
+}
+
+func TestDelegation_PoolAddressShouldBeOnMetachain(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForDelegation()
+	args.DelegationSCAddress = []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 255, 255}
+	d, _ := NewDelegationSmartContract(args)
+
+	poolAddress := d.poolAddress(0x01020304)
+	assert.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 2, 3, 4, 3, 255, 255}, poolAddress)
+	assert.True(t, core.IsSmartContractOnMetachain([]byte{255}, poolAddress))
+}
+
+func TestDelegation_CreatePoolInvalidServiceFeeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tc := createDelegationTestContext(t)
+	retCode := tc.execute([]byte("owner"), "createPool", big.NewInt(0), big.NewInt(maxServiceFee+1).Bytes(), []byte{})
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestDelegation_AddNodesShouldWorkOnlyForOwnerAndValidSignatures(t *testing.T) {
+	t.Parallel()
+
+	tc := createDelegationTestContext(t)
+	owner := []byte("owner")
+	retCode := tc.execute(owner, "createPool", big.NewInt(0), big.NewInt(1000).Bytes(), []byte{})
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	retCode = tc.execute([]byte("other"), "addNodes", big.NewInt(0), []byte{1}, []byte("bls1"), []byte("signed"))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	tc.delegation.sigVerifier = &mock.MessageSignVerifierMock{
+		VerifyCalled: func(message []byte, signedMessage []byte, pubKey []byte) error {
+			if !bytes.Equal(message, tc.delegation.poolAddress(1)) {
+				return errors.New("invalid signature")
+			}
+			return nil
+		},
+	}
+	retCode = tc.execute(owner, "addNodes", big.NewInt(0), []byte{1}, []byte("bls1"), []byte("signed"))
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	retCode = tc.execute(owner, "addNodes", big.NewInt(0), []byte{1}, []byte("bls1"), []byte("signed"))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	pool := tc.getPool(t, 1)
+	assert.Equal(t, 1, len(pool.NotStakedNodes))
+}
+
+func TestDelegation_DelegateShouldStakeThePooledFunds(t *testing.T) {
+	t.Parallel()
+
+	tc := createDelegationTestContext(t)
+	blsKey := []byte("bls1")
+	poolID := tc.createPoolWithNode(t, []byte("owner"), 1000, blsKey)
+
+	half := big.NewInt(0).Div(delegationNodePrice, big.NewInt(2))
+	retCode := tc.execute([]byte("delegator1"), "delegate", half, poolID)
+	require.Equal(t, vmcommon.Ok, retCode)
+	assert.Nil(t, tc.getStakedData(t, blsKey))
+
+	retCode = tc.execute([]byte("delegator2"), "delegate", half, poolID)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	stakedData := tc.getStakedData(t, blsKey)
+	require.NotNil(t, stakedData)
+	assert.True(t, stakedData.Staked)
+	assert.Equal(t, tc.delegation.poolAddress(1), stakedData.RewardAddress)
+
+	pool := tc.getPool(t, 1)
+	assert.Equal(t, delegationNodePrice, pool.TotalActive)
+	assert.Equal(t, big.NewInt(0), pool.Waiting)
+	assert.Equal(t, 1, len(pool.StakedNodes))
+	assert.Equal(t, 0, len(pool.NotStakedNodes))
+}
+
+func TestDelegation_DelegateOverTheCapShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tc := createDelegationTestContext(t)
+	retCode := tc.execute([]byte("owner"), "createPool", big.NewInt(0), big.NewInt(0).Bytes(), big.NewInt(100).Bytes())
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	retCode = tc.execute([]byte("delegator"), "delegate", big.NewInt(60), []byte{1})
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	retCode = tc.execute([]byte("delegator"), "delegate", big.NewInt(60), []byte{1})
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestDelegation_DelegateToMissingPoolShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tc := createDelegationTestContext(t)
+	retCode := tc.execute([]byte("delegator"), "delegate", big.NewInt(60), []byte{1})
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestDelegation_ClaimRewardsShouldSplitTheRewardsAndTheServiceFee(t *testing.T) {
+	t.Parallel()
+
+	tc := createDelegationTestContext(t)
+	owner := []byte("owner")
+	delegator1 := []byte("delegator1")
+	delegator2 := []byte("delegator2")
+	poolID := tc.createPoolWithNode(t, owner, 1000, []byte("bls1"))
+
+	quarter := big.NewInt(0).Div(delegationNodePrice, big.NewInt(4))
+	require.Equal(t, vmcommon.Ok, tc.execute(delegator1, "delegate", quarter, poolID))
+	require.Equal(t, vmcommon.Ok, tc.execute(delegator2, "delegate", big.NewInt(0).Mul(quarter, big.NewInt(3)), poolID))
+
+	// the epoch start rewards of the pool's node are credited to the pool address
+	_ = tc.eei.Transfer(tc.delegation.poolAddress(1), []byte("rewards"), big.NewInt(1000), nil, 0)
+
+	require.Equal(t, vmcommon.Ok, tc.execute(delegator1, "claimRewards", big.NewInt(0), poolID))
+	require.Equal(t, vmcommon.Ok, tc.execute(delegator2, "claimRewards", big.NewInt(0), poolID))
+	require.Equal(t, vmcommon.Ok, tc.execute(owner, "claimRewards", big.NewInt(0), poolID))
+
+	assert.Equal(t, big.NewInt(225), tc.eei.GetBalance(delegator1))
+	assert.Equal(t, big.NewInt(675), tc.eei.GetBalance(delegator2))
+	assert.Equal(t, big.NewInt(100), tc.eei.GetBalance(owner))
+	assert.Equal(t, big.NewInt(0), tc.eei.GetBalance(tc.delegation.poolAddress(1)))
+
+	require.Equal(t, vmcommon.Ok, tc.execute(delegator1, "claimRewards", big.NewInt(0), poolID))
+	assert.Equal(t, big.NewInt(225), tc.eei.GetBalance(delegator1))
+}
+
+func TestDelegation_UnDelegateAndWithdrawShouldWaitTheUnBondPeriod(t *testing.T) {
+	t.Parallel()
+
+	tc := createDelegationTestContext(t)
+	blsKey := []byte("bls1")
+	delegator := []byte("delegator")
+	poolID := tc.createPoolWithNode(t, []byte("owner"), 0, blsKey)
+	require.Equal(t, vmcommon.Ok, tc.execute(delegator, "delegate", delegationNodePrice, poolID))
+
+	tc.nonce = 5
+	retCode := tc.execute(delegator, "unDelegate", big.NewInt(0), poolID, big.NewInt(0).Add(delegationNodePrice, big.NewInt(1)).Bytes())
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = tc.execute(delegator, "unDelegate", big.NewInt(0), poolID, delegationNodePrice.Bytes())
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	stakedData := tc.getStakedData(t, blsKey)
+	require.NotNil(t, stakedData)
+	assert.False(t, stakedData.Staked)
+
+	tc.nonce = 5 + delegationUnBondPeriod - 1
+	retCode = tc.execute(delegator, "withdraw", big.NewInt(0), poolID)
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	tc.nonce = 5 + delegationUnBondPeriod
+	retCode = tc.execute(delegator, "withdraw", big.NewInt(0), poolID)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	assert.Equal(t, delegationNodePrice, tc.eei.GetBalance(delegator))
+	assert.Nil(t, tc.getStakedData(t, blsKey))
+
+	pool := tc.getPool(t, 1)
+	assert.Equal(t, big.NewInt(0), pool.TotalActive)
+	assert.Equal(t, big.NewInt(0), pool.TotalUnDelegated)
+	assert.Equal(t, big.NewInt(0), pool.Waiting)
+	assert.Equal(t, 1, len(pool.NotStakedNodes))
+	assert.Equal(t, 0, len(pool.UnStakedNodes))
+}
@@ -149,6 +149,19 @@ func (host *vmContext) Transfer(destination []byte, sender []byte, value *big.In
 	return nil
 }
 
+// AsyncCall sends the input to the destination as an asynchronous call. The outcome of the call is sent back to the
+// sender, which has to implement the callBack function
+func (host *vmContext) AsyncCall(destination []byte, sender []byte, input []byte) error {
+	err := host.Transfer(destination, sender, big.NewInt(0), input, 0)
+	if err != nil {
+		return err
+	}
+
+	host.outputAccounts[string(destination)].CallType = vmcommon.AsynchronousCall
+
+	return nil
+}
+
 func (host *vmContext) copyToNewContext() *vmContext {
 	newContext := vmContext{
 		storageUpdate:  host.storageUpdate,
@@ -160,7 +173,9 @@ func (host *vmContext) copyToNewContext() *vmContext {
 	return &newContext
 }
 
-func (host *vmContext) copyFromContext(currContext *vmContext) {
+// copyFromContext restores the context saved before calling another smart contract. The output accounts of the called
+// smart contract are merged into the saved ones only if the call succeeded, so the transfers of a failed call are dropped
+func (host *vmContext) copyFromContext(currContext *vmContext, callSucceeded bool) {
 	host.output = append(host.output, currContext.output...)
 	host.AddReturnMessage(currContext.returnMessage)
 
@@ -175,10 +190,35 @@ func (host *vmContext) copyFromContext(currContext *vmContext) {
 		}
 	}
 
+	calledOutputAccounts := host.outputAccounts
 	host.outputAccounts = currContext.outputAccounts
+	if callSucceeded {
+		host.mergeOutputAccounts(calledOutputAccounts)
+	}
+
 	host.scAddress = currContext.scAddress
 }
 
+func (host *vmContext) mergeOutputAccounts(outputAccounts map[string]*vmcommon.OutputAccount) {
+	for key, outAcc := range outputAccounts {
+		existingAcc, ok := host.outputAccounts[key]
+		if !ok {
+			host.outputAccounts[key] = outAcc
+			continue
+		}
+
+		_ = existingAcc.BalanceDelta.Add(existingAcc.BalanceDelta, outAcc.BalanceDelta)
+		existingAcc.Data = append(existingAcc.Data, outAcc.Data...)
+		existingAcc.GasLimit += outAcc.GasLimit
+		if len(outAcc.Code) > 0 {
+			existingAcc.Code = outAcc.Code
+		}
+		if outAcc.CallType != vmcommon.DirectCall {
+			existingAcc.CallType = outAcc.CallType
+		}
+	}
+}
+
 func (host *vmContext) createContractCallInput(destination []byte, sender []byte, value *big.Int, data []byte) (*vmcommon.ContractCallInput, error) {
 	err := host.inputParser.ParseData(string(data))
 	if err != nil {
@@ -221,20 +261,21 @@ func (host *vmContext) ExecuteOnDestContext(destination []byte, sender []byte, v
 		return nil, err
 	}
 
-	err = host.Transfer(input.RecipientAddr, input.CallerAddr, input.CallValue, nil, 0)
-	if err != nil {
-		return nil, err
-	}
-
+	returnCode := vmcommon.UserError
 	currContext := host.copyToNewContext()
 	defer func() {
 		host.output = make([][]byte, 0)
-		host.copyFromContext(currContext)
+		host.copyFromContext(currContext, returnCode == vmcommon.Ok)
 	}()
 
 	host.softCleanCache()
 	host.SetSCAddress(input.RecipientAddr)
 
+	err = host.Transfer(input.RecipientAddr, input.CallerAddr, input.CallValue, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
 	contract, err := host.systemContracts.Get(input.RecipientAddr)
 	if err != nil {
 		return nil, err
@@ -247,7 +288,7 @@ func (host *vmContext) ExecuteOnDestContext(destination []byte, sender []byte, v
 		}, nil
 	}
 
-	returnCode := contract.Execute(input)
+	returnCode = contract.Execute(input)
 
 	vmOutput := &vmcommon.VMOutput{}
 	if returnCode == vmcommon.Ok {
@@ -360,6 +401,7 @@ func (host *vmContext) CreateVMOutput() *vmcommon.VMOutput {
 		}
 
 		outAccs[addr].GasLimit = outAcc.GasLimit
+		outAccs[addr].CallType = outAcc.CallType
 	}
 
 	vmOutput.OutputAccounts = outAccs
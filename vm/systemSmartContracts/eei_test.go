@@ -139,3 +139,77 @@ func TestVmContext_Transfer(t *testing.T) {
 	vmOutput := vmContext.CreateVMOutput()
 	assert.Equal(t, 2, len(vmOutput.OutputAccounts))
 }
+
+func TestVmContext_AsyncCall(t *testing.T) {
+	t.Parallel()
+
+	vmContext, _ := NewVMContext(&mock.BlockChainHookStub{}, hooks.NewVMCryptoHook(), &mock.ArgumentParserMock{}, &mock.AccountsStub{})
+
+	destination := []byte("dest")
+	sender := []byte("sender")
+	input := []byte("input")
+
+	err := vmContext.AsyncCall(destination, sender, input)
+	assert.Nil(t, err)
+
+	vmOutput := vmContext.CreateVMOutput()
+	assert.Equal(t, 2, len(vmOutput.OutputAccounts))
+	assert.Equal(t, vmcommon.AsynchronousCall, vmOutput.OutputAccounts[string(destination)].CallType)
+	assert.Equal(t, input, vmOutput.OutputAccounts[string(destination)].Data)
+	assert.Equal(t, vmcommon.DirectCall, vmOutput.OutputAccounts[string(sender)].CallType)
+}
+
+func TestVmContext_ExecuteOnDestContextShouldKeepTheTransfersOfTheCalledContract(t *testing.T) {
+	t.Parallel()
+
+	vmContext, _ := NewVMContext(&mock.BlockChainHookStub{}, hooks.NewVMCryptoHook(), vmcommon.NewAtArgumentParser(), &mock.AccountsStub{})
+
+	caller := []byte("caller")
+	destination := []byte("destination")
+	receiver := []byte("receiver")
+	_ = vmContext.SetSystemSCContainer(&mock.SystemSCContainerStub{GetCalled: func(key []byte) (vm.SystemSmartContract, error) {
+		return &mock.SystemSCStub{ExecuteCalled: func(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+			_ = vmContext.Transfer(receiver, destination, big.NewInt(40), nil, 0)
+			return vmcommon.Ok
+		}}, nil
+	}})
+
+	vmContext.SetSCAddress(caller)
+	vmOutput, err := vmContext.ExecuteOnDestContext(destination, caller, big.NewInt(100), []byte("function"))
+	assert.Nil(t, err)
+	assert.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+
+	assert.Equal(t, big.NewInt(-100), vmContext.GetBalance(caller))
+	assert.Equal(t, big.NewInt(60), vmContext.GetBalance(destination))
+	assert.Equal(t, big.NewInt(40), vmContext.GetBalance(receiver))
+}
+
+func TestVmContext_ExecuteOnDestContextFailedCallShouldDropTheTransfersOfTheCalledContract(t *testing.T) {
+	t.Parallel()
+
+	vmContext, _ := NewVMContext(&mock.BlockChainHookStub{}, hooks.NewVMCryptoHook(), vmcommon.NewAtArgumentParser(), &mock.AccountsStub{})
+
+	caller := []byte("caller")
+	destination := []byte("destination")
+	receiver := []byte("receiver")
+	_ = vmContext.SetSystemSCContainer(&mock.SystemSCContainerStub{GetCalled: func(key []byte) (vm.SystemSmartContract, error) {
+		return &mock.SystemSCStub{ExecuteCalled: func(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+			_ = vmContext.Transfer(receiver, destination, big.NewInt(40), nil, 0)
+			return vmcommon.UserError
+		}}, nil
+	}})
+
+	vmContext.SetSCAddress(caller)
+	_ = vmContext.Transfer(destination, caller, big.NewInt(10), nil, 0)
+	vmOutput, err := vmContext.ExecuteOnDestContext(destination, caller, big.NewInt(100), []byte("function"))
+	assert.Nil(t, err)
+	assert.Equal(t, vmcommon.UserError, vmOutput.ReturnCode)
+
+	outputAccounts := vmContext.CreateVMOutput().OutputAccounts
+	assert.Equal(t, 2, len(outputAccounts))
+	assert.Equal(t, big.NewInt(-10), outputAccounts[string(caller)].BalanceDelta)
+	assert.Equal(t, big.NewInt(10), outputAccounts[string(destination)].BalanceDelta)
+	_, ok := outputAccounts[string(receiver)]
+	assert.False(t, ok)
+	assert.Equal(t, caller, vmContext.scAddress)
+}
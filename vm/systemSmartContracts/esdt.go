@@ -4,7 +4,10 @@ package systemSmartContracts
 import (
 	"bytes"
 	"encoding/hex"
+	"fmt"
+	"math"
 	"math/big"
+	"strconv"
 
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -34,6 +37,7 @@ type esdt struct {
 	eSDTSCAddress   []byte
 	marshalizer     marshal.Marshalizer
 	hasher          hashing.Hasher
+	numOfShards     uint32
 }
 
 // ArgsNewESDTSmartContract defines the arguments needed for the esdt contract
@@ -44,6 +48,7 @@ type ArgsNewESDTSmartContract struct {
 	ESDTSCAddress []byte
 	Marshalizer   marshal.Marshalizer
 	Hasher        hashing.Hasher
+	NumOfShards   uint32
 }
 
 // NewESDTSmartContract creates the esdt smart contract, which controls the issuing of tokens
@@ -57,6 +62,9 @@ func NewESDTSmartContract(args ArgsNewESDTSmartContract) (*esdt, error) {
 	if check.IfNil(args.Hasher) {
 		return nil, vm.ErrNilHasher
 	}
+	if args.NumOfShards == 0 {
+		return nil, vm.ErrInvalidNumOfShards
+	}
 
 	baseIssuingCost, ok := big.NewInt(0).SetString(args.ESDTSCConfig.BaseIssuingCost, conversionBase)
 	if !ok || baseIssuingCost.Cmp(big.NewInt(0)) < 0 {
@@ -71,6 +79,7 @@ func NewESDTSmartContract(args ArgsNewESDTSmartContract) (*esdt, error) {
 		eSDTSCAddress:   args.ESDTSCAddress,
 		hasher:          args.Hasher,
 		marshalizer:     args.Marshalizer,
+		numOfShards:     args.NumOfShards,
 	}, nil
 }
 
@@ -92,22 +101,27 @@ func (e *esdt) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	case "mint":
 		return e.mint(args)
 	case "freeze":
-		return e.freeze(args)
+		return e.toggleFreeze(args, core.BuiltInFunctionESDTFreeze)
+	case "unFreeze":
+		return e.toggleFreeze(args, core.BuiltInFunctionESDTUnFreeze)
 	case "wipe":
 		return e.wipe(args)
 	case "pause":
-		return e.pause(args)
+		return e.togglePause(args, true)
 	case "unPause":
-		return e.unpause(args)
+		return e.togglePause(args, false)
 	case "claim":
 		return e.claim(args)
 	case "configChange":
 		return e.configChange(args)
 	case "esdtControlChanges":
 		return e.esdtControlChanges(args)
+	case "callBack":
+		return e.callBack(args)
 	}
 
-	return vmcommon.Ok
+	e.eei.AddReturnMessage("invalid method to call")
+	return vmcommon.UserError
 }
 
 func (e *esdt) init(_ *vmcommon.ContractCallInput) vmcommon.ReturnCode {
@@ -125,7 +139,12 @@ func (e *esdt) init(_ *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 }
 
 func (e *esdt) issueProtected(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	if !bytes.Equal(args.CallerAddr, e.ownerAddress) {
+	esdtConfig, err := e.getESDTConfig()
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if !bytes.Equal(args.CallerAddr, esdtConfig.OwnerAddress) {
 		return vmcommon.UserError
 	}
 	if len(args.Arguments) < 3 {
@@ -134,10 +153,10 @@ func (e *esdt) issueProtected(args *vmcommon.ContractCallInput) vmcommon.ReturnC
 	if len(args.Arguments[0]) < len(args.CallerAddr) {
 		return vmcommon.FunctionWrongSignature
 	}
-	if args.CallValue.Cmp(e.baseIssuingCost) != 0 {
+	if args.CallValue.Cmp(esdtConfig.BaseIssuingCost) != 0 {
 		return vmcommon.OutOfFunds
 	}
-	err := e.eei.UseGas(e.gasCost.MetaChainSystemSCsCost.ESDTIssue)
+	err = e.eei.UseGas(e.gasCost.MetaChainSystemSCsCost.ESDTIssue)
 	if err != nil {
 		return vmcommon.OutOfGas
 	}
@@ -151,16 +170,21 @@ func (e *esdt) issueProtected(args *vmcommon.ContractCallInput) vmcommon.ReturnC
 }
 
 func (e *esdt) issue(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	esdtConfig, err := e.getESDTConfig()
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
 	if len(args.Arguments) < 2 {
 		return vmcommon.FunctionWrongSignature
 	}
-	if len(args.Arguments[0]) < minLengthForTokenName || len(args.Arguments[0]) > maxLengthForTokenName {
+	if len(args.Arguments[0]) < int(esdtConfig.MinTokenNameLength) || len(args.Arguments[0]) > int(esdtConfig.MaxTokenNameLength) {
 		return vmcommon.FunctionWrongSignature
 	}
-	if args.CallValue.Cmp(e.baseIssuingCost) != 0 {
+	if args.CallValue.Cmp(esdtConfig.BaseIssuingCost) != 0 {
 		return vmcommon.OutOfFunds
 	}
-	err := e.eei.UseGas(e.gasCost.MetaChainSystemSCsCost.ESDTIssue)
+	err = e.eei.UseGas(e.gasCost.MetaChainSystemSCsCost.ESDTIssue)
 	if err != nil {
 		return vmcommon.OutOfGas
 	}
@@ -230,50 +254,470 @@ func (e *esdt) issueToken(owner []byte, arguments [][]byte) error {
 }
 
 func (e *esdt) burn(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	//TODO: implement me
+	if len(args.Arguments) != 2 {
+		e.eei.AddReturnMessage("number of arguments must be equal with 2")
+		return vmcommon.FunctionWrongSignature
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		e.eei.AddReturnMessage("callValue must be 0")
+		return vmcommon.OutOfFunds
+	}
+	burntValue := big.NewInt(0).SetBytes(args.Arguments[1])
+	if burntValue.Cmp(zero) <= 0 {
+		e.eei.AddReturnMessage("negative or zero value to burn")
+		return vmcommon.UserError
+	}
+	err := e.eei.UseGas(e.gasCost.MetaChainSystemSCsCost.ESDTOperations)
+	if err != nil {
+		e.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+
+	token, err := e.getExistingToken(args.Arguments[0])
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if !token.Burnable {
+		e.eei.AddReturnMessage("token is not burnable")
+		return vmcommon.UserError
+	}
+	if token.Paused {
+		e.eei.AddReturnMessage("token is paused")
+		return vmcommon.UserError
+	}
+
+	// the burnt value is removed from the supply in callBack, only after the holder's shard confirms the burn
+	esdtBurnData := core.BuiltInFunctionESDTBurn + "@" + hex.EncodeToString(args.Arguments[0]) + "@" + hex.EncodeToString(args.Arguments[1])
+	err = e.eei.AsyncCall(args.CallerAddr, e.eSDTSCAddress, []byte(esdtBurnData))
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
 	return vmcommon.Ok
 }
 
 func (e *esdt) mint(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	//TODO: implement me
+	if len(args.Arguments) < 2 || len(args.Arguments) > 3 {
+		e.eei.AddReturnMessage("accepted arguments number 2/3")
+		return vmcommon.FunctionWrongSignature
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		e.eei.AddReturnMessage("callValue must be 0")
+		return vmcommon.OutOfFunds
+	}
+	mintValue := big.NewInt(0).SetBytes(args.Arguments[1])
+	if mintValue.Cmp(zero) <= 0 {
+		e.eei.AddReturnMessage("negative or zero mint value")
+		return vmcommon.UserError
+	}
+	err := e.eei.UseGas(e.gasCost.MetaChainSystemSCsCost.ESDTOperations)
+	if err != nil {
+		e.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+
+	token, returnCode := e.getExistingTokenOwnedBy(args.Arguments[0], args.CallerAddr)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	if !token.Mintable {
+		e.eei.AddReturnMessage("token is not mintable")
+		return vmcommon.UserError
+	}
+	if token.Paused {
+		e.eei.AddReturnMessage("token is paused")
+		return vmcommon.UserError
+	}
+
+	destination := token.IssuerAddress
+	if len(args.Arguments) == 3 {
+		if len(args.Arguments[2]) != len(args.CallerAddr) {
+			e.eei.AddReturnMessage("destination address of invalid length")
+			return vmcommon.UserError
+		}
+		destination = args.Arguments[2]
+	}
+
+	token.MintedValue.Add(token.MintedValue, mintValue)
+	err = e.saveToken(token)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	esdtTransferData := core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString(args.Arguments[0]) + "@" + hex.EncodeToString(args.Arguments[1])
+	err = e.eei.Transfer(destination, e.eSDTSCAddress, big.NewInt(0), []byte(esdtTransferData), 0)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
 	return vmcommon.Ok
 }
 
-func (e *esdt) freeze(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	//TODO: implement me
-	return vmcommon.Ok
+func (e *esdt) toggleFreeze(args *vmcommon.ContractCallInput, builtInFunc string) vmcommon.ReturnCode {
+	token, returnCode := e.basicOwnershipChecks(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	if !token.CanFreeze {
+		e.eei.AddReturnMessage("cannot freeze")
+		return vmcommon.UserError
+	}
+
+	return e.callBuiltInOnAddress(args, builtInFunc)
 }
 
 func (e *esdt) wipe(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	//TODO: implement me
+	token, returnCode := e.basicOwnershipChecks(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	if !token.CanWipe {
+		e.eei.AddReturnMessage("cannot wipe")
+		return vmcommon.UserError
+	}
+
+	// the wiped value is removed from the supply in callBack, as it is known only on the holder's shard
+	wipeData := core.BuiltInFunctionESDTWipe + "@" + hex.EncodeToString(args.Arguments[0])
+	err := e.eei.AsyncCall(args.Arguments[1], e.eSDTSCAddress, []byte(wipeData))
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
 	return vmcommon.Ok
 }
 
-func (e *esdt) pause(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	//TODO: implement me
+// callBack receives the outcome of the burn and wipe built-in functions called on the holders' shards and removes
+// the burnt or wiped value from the token supply if the call succeeded
+func (e *esdt) callBack(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallType != vmcommon.AsynchronousCallBack {
+		e.eei.AddReturnMessage("callBack can be called only by the protocol")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) == 0 {
+		e.eei.AddReturnMessage("callBack without return code")
+		return vmcommon.FunctionWrongSignature
+	}
+	if string(args.Arguments[0]) != vmcommon.Ok.String() {
+		// nothing was burnt or wiped on the holder's shard, so the supply remains the same
+		return vmcommon.Ok
+	}
+	if len(args.Arguments) != 4 {
+		e.eei.AddReturnMessage("invalid number of arguments for callBack")
+		return vmcommon.FunctionWrongSignature
+	}
+
+	builtInFunc := string(args.Arguments[1])
+	if builtInFunc != core.BuiltInFunctionESDTBurn && builtInFunc != core.BuiltInFunctionESDTWipe {
+		e.eei.AddReturnMessage("callBack of unexpected function " + builtInFunc)
+		return vmcommon.UserError
+	}
+
+	token, err := e.getExistingToken(args.Arguments[2])
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	removedValue := big.NewInt(0).SetBytes(args.Arguments[3])
+	token.BurntValue.Add(token.BurntValue, removedValue)
+	err = e.saveToken(token)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
 	return vmcommon.Ok
 }
 
-func (e *esdt) unpause(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	//TODO: implement me
+func (e *esdt) basicOwnershipChecks(args *vmcommon.ContractCallInput) (*ESDTData, vmcommon.ReturnCode) {
+	if len(args.Arguments) != 2 {
+		e.eei.AddReturnMessage("invalid number of arguments, wanted 2")
+		return nil, vmcommon.FunctionWrongSignature
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		e.eei.AddReturnMessage("callValue must be 0")
+		return nil, vmcommon.OutOfFunds
+	}
+	if len(args.Arguments[1]) != len(args.CallerAddr) {
+		e.eei.AddReturnMessage("invalid address")
+		return nil, vmcommon.UserError
+	}
+	err := e.eei.UseGas(e.gasCost.MetaChainSystemSCsCost.ESDTOperations)
+	if err != nil {
+		e.eei.AddReturnMessage("not enough gas")
+		return nil, vmcommon.OutOfGas
+	}
+
+	return e.getExistingTokenOwnedBy(args.Arguments[0], args.CallerAddr)
+}
+
+func (e *esdt) callBuiltInOnAddress(args *vmcommon.ContractCallInput, builtInFunc string) vmcommon.ReturnCode {
+	builtInFuncData := builtInFunc + "@" + hex.EncodeToString(args.Arguments[0])
+	err := e.eei.Transfer(args.Arguments[1], e.eSDTSCAddress, big.NewInt(0), []byte(builtInFuncData), 0)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (e *esdt) togglePause(args *vmcommon.ContractCallInput, paused bool) vmcommon.ReturnCode {
+	if len(args.Arguments) != 1 {
+		e.eei.AddReturnMessage("invalid number of arguments, wanted 1")
+		return vmcommon.FunctionWrongSignature
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		e.eei.AddReturnMessage("callValue must be 0")
+		return vmcommon.OutOfFunds
+	}
+	err := e.eei.UseGas(e.gasCost.MetaChainSystemSCsCost.ESDTOperations)
+	if err != nil {
+		e.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+
+	token, returnCode := e.getExistingTokenOwnedBy(args.Arguments[0], args.CallerAddr)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	if !token.CanPause {
+		e.eei.AddReturnMessage("cannot pause/un-pause")
+		return vmcommon.UserError
+	}
+	if token.Paused == paused {
+		e.eei.AddReturnMessage("cannot change pause status of token")
+		return vmcommon.UserError
+	}
+
+	token.Paused = paused
+	err = e.saveToken(token)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	builtInFunc := core.BuiltInFunctionESDTUnPause
+	if paused {
+		builtInFunc = core.BuiltInFunctionESDTPause
+	}
+	// every shard keeps the paused state of the token, so the transfers are refused where they originate
+	pauseData := builtInFunc + "@" + hex.EncodeToString(args.Arguments[0])
+	for shardID := uint32(0); shardID < e.numOfShards; shardID++ {
+		err = e.eei.Transfer(core.ESDTGlobalSettingsAddress(shardID), e.eSDTSCAddress, big.NewInt(0), []byte(pauseData), 0)
+		if err != nil {
+			e.eei.AddReturnMessage(err.Error())
+			return vmcommon.UserError
+		}
+	}
+
 	return vmcommon.Ok
 }
 
 func (e *esdt) configChange(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	//TODO: implement me
+	esdtConfig, err := e.getESDTConfig()
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if !bytes.Equal(args.CallerAddr, esdtConfig.OwnerAddress) {
+		e.eei.AddReturnMessage("configChange can be called by whitelisted address only")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 4 {
+		e.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected 4, got %d", len(args.Arguments)))
+		return vmcommon.FunctionWrongSignature
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		e.eei.AddReturnMessage("callValue must be 0")
+		return vmcommon.OutOfFunds
+	}
+
+	newBaseIssuingCost := big.NewInt(0).SetBytes(args.Arguments[1])
+	newMinTokenNameLength := big.NewInt(0).SetBytes(args.Arguments[2])
+	newMaxTokenNameLength := big.NewInt(0).SetBytes(args.Arguments[3])
+	if !newMinTokenNameLength.IsUint64() || !newMaxTokenNameLength.IsUint64() ||
+		newMinTokenNameLength.Uint64() > newMaxTokenNameLength.Uint64() ||
+		newMaxTokenNameLength.Uint64() > math.MaxUint32 {
+		e.eei.AddReturnMessage("invalid token name length limits")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments[0]) != len(args.CallerAddr) {
+		e.eei.AddReturnMessage("invalid owner address")
+		return vmcommon.UserError
+	}
+
+	esdtConfig.OwnerAddress = args.Arguments[0]
+	esdtConfig.BaseIssuingCost = newBaseIssuingCost
+	esdtConfig.MinTokenNameLength = uint32(newMinTokenNameLength.Uint64())
+	esdtConfig.MaxTokenNameLength = uint32(newMaxTokenNameLength.Uint64())
+
+	err = e.saveESDTConfig(esdtConfig)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
 	return vmcommon.Ok
 }
 
 func (e *esdt) claim(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	//TODO: implement me
+	esdtConfig, err := e.getESDTConfig()
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if !bytes.Equal(args.CallerAddr, esdtConfig.OwnerAddress) {
+		e.eei.AddReturnMessage("claim can be called by whitelisted address only")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		e.eei.AddReturnMessage("callValue must be 0")
+		return vmcommon.OutOfFunds
+	}
+
+	scBalance := e.eei.GetBalance(e.eSDTSCAddress)
+	if scBalance == nil || scBalance.Cmp(zero) <= 0 {
+		e.eei.AddReturnMessage("nothing to claim")
+		return vmcommon.UserError
+	}
+
+	err = e.eei.Transfer(args.CallerAddr, e.eSDTSCAddress, scBalance, nil, 0)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
 	return vmcommon.Ok
 }
 
 func (e *esdt) esdtControlChanges(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	//TODO: implement me
+	if len(args.Arguments) < 3 || len(args.Arguments)%2 == 0 {
+		e.eei.AddReturnMessage("invalid number of arguments: expected token name followed by property/value pairs")
+		return vmcommon.FunctionWrongSignature
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		e.eei.AddReturnMessage("callValue must be 0")
+		return vmcommon.OutOfFunds
+	}
+	err := e.eei.UseGas(e.gasCost.MetaChainSystemSCsCost.ESDTOperations)
+	if err != nil {
+		e.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+
+	token, returnCode := e.getExistingTokenOwnedBy(args.Arguments[0], args.CallerAddr)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	for i := 1; i < len(args.Arguments); i += 2 {
+		val, errParse := strconv.ParseBool(string(args.Arguments[i+1]))
+		if errParse != nil {
+			e.eei.AddReturnMessage("invalid value for property " + string(args.Arguments[i]))
+			return vmcommon.UserError
+		}
+
+		switch string(args.Arguments[i]) {
+		case burnable:
+			token.Burnable = val
+		case mintable:
+			token.Mintable = val
+		case canPause:
+			token.CanPause = val
+		case canFreeze:
+			token.CanFreeze = val
+		case canWipe:
+			token.CanWipe = val
+		default:
+			e.eei.AddReturnMessage("invalid property " + string(args.Arguments[i]))
+			return vmcommon.UserError
+		}
+	}
+
+	err = e.saveToken(token)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
 	return vmcommon.Ok
 }
 
+func (e *esdt) getExistingToken(tokenName []byte) (*ESDTData, error) {
+	marshaledData := e.eei.GetStorage(tokenName)
+	if len(marshaledData) == 0 {
+		return nil, vm.ErrNoTokenWithGivenName
+	}
+
+	token := &ESDTData{}
+	err := e.marshalizer.Unmarshal(token, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+func (e *esdt) getExistingTokenOwnedBy(tokenName []byte, caller []byte) (*ESDTData, vmcommon.ReturnCode) {
+	token, err := e.getExistingToken(tokenName)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return nil, vmcommon.UserError
+	}
+	if !bytes.Equal(token.IssuerAddress, caller) {
+		e.eei.AddReturnMessage("can be called by owner only")
+		return nil, vmcommon.UserError
+	}
+
+	return token, vmcommon.Ok
+}
+
+func (e *esdt) saveToken(token *ESDTData) error {
+	marshaledData, err := e.marshalizer.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	e.eei.SetStorage(token.TokenName, marshaledData)
+	return nil
+}
+
+func (e *esdt) getESDTConfig() (*ESDTConfig, error) {
+	esdtConfig := &ESDTConfig{
+		OwnerAddress:       e.ownerAddress,
+		BaseIssuingCost:    e.baseIssuingCost,
+		MinTokenNameLength: minLengthForTokenName,
+		MaxTokenNameLength: maxLengthForTokenName,
+	}
+	marshaledData := e.eei.GetStorage([]byte(configKeyPrefix))
+	if len(marshaledData) == 0 {
+		return esdtConfig, nil
+	}
+
+	err := e.marshalizer.Unmarshal(esdtConfig, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return esdtConfig, nil
+}
+
+func (e *esdt) saveESDTConfig(esdtConfig *ESDTConfig) error {
+	marshaledData, err := e.marshalizer.Marshal(esdtConfig)
+	if err != nil {
+		return err
+	}
+
+	e.eei.SetStorage([]byte(configKeyPrefix), marshaledData)
+	return nil
+}
+
 // IsInterfaceNil returns true if underlying object is nil
 func (e *esdt) IsInterfaceNil() bool {
 	return e == nil
@@ -1,10 +1,14 @@
 package systemSmartContracts
 
 import (
+	"encoding/hex"
+	"math"
 	"math/big"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
 	"github.com/ElrondNetwork/elrond-go/vm"
 	"github.com/ElrondNetwork/elrond-go/vm/mock"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
@@ -21,6 +25,7 @@ func createMockArgumentsForESDT() ArgsNewESDTSmartContract {
 		ESDTSCAddress: []byte("address"),
 		Marshalizer:   &mock.MarshalizerMock{},
 		Hasher:        &mock.HasherMock{},
+		NumOfShards:   2,
 	}
 }
 
@@ -133,3 +138,333 @@ func TestEsdt_ExecuteIssueProtected(t *testing.T) {
 
 	assert.Equal(t, vmcommon.Ok, output)
 }
+
+func createESDTWithVMContext(t *testing.T) (*esdt, *vmContext, []byte) {
+	eei, _ := NewVMContext(&mock.BlockChainHookStub{}, hooks.NewVMCryptoHook(), vmcommon.NewAtArgumentParser(), &mock.AccountsStub{})
+	args := createMockArgumentsForESDT()
+	args.Eei = eei
+	e, err := NewESDTSmartContract(args)
+	assert.Nil(t, err)
+	eei.SetSCAddress(args.ESDTSCAddress)
+	eei.SetGasProvided(math.MaxUint64)
+
+	owner := []byte("tokenOwner")
+	tokenName := []byte("01234567891")
+	vmInput := createESDTCallInput(owner, "issue", tokenName, big.NewInt(100).Bytes(),
+		[]byte(burnable), []byte(mintable), []byte(canPause), []byte(canFreeze), []byte(canWipe))
+	vmInput.CallValue, _ = big.NewInt(0).SetString(args.ESDTSCConfig.BaseIssuingCost, 10)
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	return e, eei, tokenName
+}
+
+func createESDTCallInput(caller []byte, function string, arguments ...[]byte) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  caller,
+			Arguments:   arguments,
+			CallValue:   big.NewInt(0),
+			GasProvided: 1000,
+		},
+		RecipientAddr: []byte("address"),
+		Function:      function,
+	}
+}
+
+func getTokenFromStorage(t *testing.T, e *esdt, tokenName []byte) *ESDTData {
+	token, err := e.getExistingToken(tokenName)
+	assert.Nil(t, err)
+
+	return token
+}
+
+func TestEsdt_ExecuteUnknownFunctionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	e, _, _ := createESDTWithVMContext(t)
+
+	output := e.Execute(createESDTCallInput([]byte("caller"), "unknown"))
+	assert.Equal(t, vmcommon.UserError, output)
+}
+
+func TestEsdt_ExecuteMint(t *testing.T) {
+	t.Parallel()
+
+	e, eei, tokenName := createESDTWithVMContext(t)
+	owner := []byte("tokenOwner")
+
+	output := e.Execute(createESDTCallInput(owner, "mint", tokenName))
+	assert.Equal(t, vmcommon.FunctionWrongSignature, output)
+
+	output = e.Execute(createESDTCallInput([]byte("notTheOwner"), "mint", tokenName, big.NewInt(10).Bytes()))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	output = e.Execute(createESDTCallInput(owner, "mint", []byte("inexistent"), big.NewInt(10).Bytes()))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	output = e.Execute(createESDTCallInput(owner, "mint", tokenName, big.NewInt(0).Bytes()))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	destination := []byte("destinati0")
+	output = e.Execute(createESDTCallInput(owner, "mint", tokenName, big.NewInt(10).Bytes(), destination))
+	assert.Equal(t, vmcommon.Ok, output)
+
+	token := getTokenFromStorage(t, e, tokenName)
+	assert.Equal(t, big.NewInt(110), token.MintedValue)
+
+	vmOutput := eei.CreateVMOutput()
+	expectedData := core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString(tokenName) + "@" + hex.EncodeToString(big.NewInt(10).Bytes())
+	assert.Equal(t, []byte(expectedData), vmOutput.OutputAccounts[string(destination)].Data)
+}
+
+func TestEsdt_ExecuteMintNotMintableShouldErr(t *testing.T) {
+	t.Parallel()
+
+	e, _, tokenName := createESDTWithVMContext(t)
+	owner := []byte("tokenOwner")
+
+	output := e.Execute(createESDTCallInput(owner, "esdtControlChanges", tokenName, []byte(mintable), []byte("false")))
+	assert.Equal(t, vmcommon.Ok, output)
+
+	output = e.Execute(createESDTCallInput(owner, "mint", tokenName, big.NewInt(10).Bytes()))
+	assert.Equal(t, vmcommon.UserError, output)
+}
+
+func TestEsdt_ExecuteBurn(t *testing.T) {
+	t.Parallel()
+
+	e, eei, tokenName := createESDTWithVMContext(t)
+	holder := []byte("tokenHoldr")
+
+	output := e.Execute(createESDTCallInput(holder, "burn", tokenName))
+	assert.Equal(t, vmcommon.FunctionWrongSignature, output)
+
+	output = e.Execute(createESDTCallInput(holder, "burn", tokenName, big.NewInt(0).Bytes()))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	output = e.Execute(createESDTCallInput(holder, "burn", tokenName, big.NewInt(10).Bytes()))
+	assert.Equal(t, vmcommon.Ok, output)
+
+	token := getTokenFromStorage(t, e, tokenName)
+	assert.Equal(t, big.NewInt(0), token.BurntValue)
+
+	vmOutput := eei.CreateVMOutput()
+	expectedData := core.BuiltInFunctionESDTBurn + "@" + hex.EncodeToString(tokenName) + "@" + hex.EncodeToString(big.NewInt(10).Bytes())
+	assert.Equal(t, []byte(expectedData), vmOutput.OutputAccounts[string(holder)].Data)
+	assert.Equal(t, vmcommon.AsynchronousCall, vmOutput.OutputAccounts[string(holder)].CallType)
+
+	output = e.Execute(createESDTCallInput([]byte("tokenOwner"), "esdtControlChanges", tokenName, []byte(burnable), []byte("false")))
+	assert.Equal(t, vmcommon.Ok, output)
+
+	output = e.Execute(createESDTCallInput(holder, "burn", tokenName, big.NewInt(10).Bytes()))
+	assert.Equal(t, vmcommon.UserError, output)
+}
+
+func TestEsdt_ExecuteFreezeAndUnFreeze(t *testing.T) {
+	t.Parallel()
+
+	e, eei, tokenName := createESDTWithVMContext(t)
+	owner := []byte("tokenOwner")
+	holder := []byte("tokenHoldr")
+
+	output := e.Execute(createESDTCallInput(owner, "freeze", tokenName, []byte("short")))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	output = e.Execute(createESDTCallInput(holder, "freeze", tokenName, holder))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	output = e.Execute(createESDTCallInput(owner, "freeze", tokenName, holder))
+	assert.Equal(t, vmcommon.Ok, output)
+
+	vmOutput := eei.CreateVMOutput()
+	expectedData := core.BuiltInFunctionESDTFreeze + "@" + hex.EncodeToString(tokenName)
+	assert.Equal(t, []byte(expectedData), vmOutput.OutputAccounts[string(holder)].Data)
+
+	otherHolder := []byte("otherHoldr")
+	output = e.Execute(createESDTCallInput(owner, "unFreeze", tokenName, otherHolder))
+	assert.Equal(t, vmcommon.Ok, output)
+
+	vmOutput = eei.CreateVMOutput()
+	expectedData = core.BuiltInFunctionESDTUnFreeze + "@" + hex.EncodeToString(tokenName)
+	assert.Equal(t, []byte(expectedData), vmOutput.OutputAccounts[string(otherHolder)].Data)
+
+	output = e.Execute(createESDTCallInput(owner, "esdtControlChanges", tokenName, []byte(canFreeze), []byte("false")))
+	assert.Equal(t, vmcommon.Ok, output)
+
+	output = e.Execute(createESDTCallInput(owner, "freeze", tokenName, holder))
+	assert.Equal(t, vmcommon.UserError, output)
+}
+
+func TestEsdt_ExecuteWipe(t *testing.T) {
+	t.Parallel()
+
+	e, eei, tokenName := createESDTWithVMContext(t)
+	owner := []byte("tokenOwner")
+	holder := []byte("tokenHoldr")
+
+	output := e.Execute(createESDTCallInput(holder, "wipe", tokenName, holder))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	output = e.Execute(createESDTCallInput(owner, "wipe", tokenName, holder))
+	assert.Equal(t, vmcommon.Ok, output)
+
+	vmOutput := eei.CreateVMOutput()
+	expectedData := core.BuiltInFunctionESDTWipe + "@" + hex.EncodeToString(tokenName)
+	assert.Equal(t, []byte(expectedData), vmOutput.OutputAccounts[string(holder)].Data)
+	assert.Equal(t, vmcommon.AsynchronousCall, vmOutput.OutputAccounts[string(holder)].CallType)
+
+	output = e.Execute(createESDTCallInput(owner, "esdtControlChanges", tokenName, []byte(canWipe), []byte("false")))
+	assert.Equal(t, vmcommon.Ok, output)
+
+	output = e.Execute(createESDTCallInput(owner, "wipe", tokenName, holder))
+	assert.Equal(t, vmcommon.UserError, output)
+}
+
+func TestEsdt_ExecutePauseAndUnPause(t *testing.T) {
+	t.Parallel()
+
+	e, eei, tokenName := createESDTWithVMContext(t)
+	owner := []byte("tokenOwner")
+
+	output := e.Execute(createESDTCallInput(owner, "unPause", tokenName))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	output = e.Execute(createESDTCallInput([]byte("notTheOwner"), "pause", tokenName))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	output = e.Execute(createESDTCallInput(owner, "pause", tokenName))
+	assert.Equal(t, vmcommon.Ok, output)
+	assert.True(t, getTokenFromStorage(t, e, tokenName).Paused)
+
+	vmOutput := eei.CreateVMOutput()
+	expectedData := []byte(core.BuiltInFunctionESDTPause + "@" + hex.EncodeToString(tokenName))
+	assert.Equal(t, expectedData, vmOutput.OutputAccounts[string(core.ESDTGlobalSettingsAddress(0))].Data)
+	assert.Equal(t, expectedData, vmOutput.OutputAccounts[string(core.ESDTGlobalSettingsAddress(1))].Data)
+
+	output = e.Execute(createESDTCallInput(owner, "pause", tokenName))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	output = e.Execute(createESDTCallInput(owner, "mint", tokenName, big.NewInt(10).Bytes()))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	output = e.Execute(createESDTCallInput(owner, "unPause", tokenName))
+	assert.Equal(t, vmcommon.Ok, output)
+	assert.False(t, getTokenFromStorage(t, e, tokenName).Paused)
+
+	output = e.Execute(createESDTCallInput(owner, "esdtControlChanges", tokenName, []byte(canPause), []byte("false")))
+	assert.Equal(t, vmcommon.Ok, output)
+
+	output = e.Execute(createESDTCallInput(owner, "pause", tokenName))
+	assert.Equal(t, vmcommon.UserError, output)
+}
+
+func createESDTCallBackInput(caller []byte, arguments ...[]byte) *vmcommon.ContractCallInput {
+	vmInput := createESDTCallInput(caller, "callBack", arguments...)
+	vmInput.CallType = vmcommon.AsynchronousCallBack
+
+	return vmInput
+}
+
+func TestEsdt_ExecuteCallBackShouldUpdateTheSupplyAfterBurnAndWipe(t *testing.T) {
+	t.Parallel()
+
+	e, _, tokenName := createESDTWithVMContext(t)
+	holder := []byte("tokenHoldr")
+	okCode := []byte(vmcommon.Ok.String())
+
+	burnCallBack := createESDTCallBackInput(holder, okCode, []byte(core.BuiltInFunctionESDTBurn), tokenName, big.NewInt(10).Bytes())
+	burnCallBack.CallType = vmcommon.DirectCall
+	output := e.Execute(burnCallBack)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, big.NewInt(0), getTokenFromStorage(t, e, tokenName).BurntValue)
+
+	output = e.Execute(createESDTCallBackInput(holder, []byte(vmcommon.UserError.String())))
+	assert.Equal(t, vmcommon.Ok, output)
+	assert.Equal(t, big.NewInt(0), getTokenFromStorage(t, e, tokenName).BurntValue)
+
+	output = e.Execute(createESDTCallBackInput(holder, okCode, []byte(core.BuiltInFunctionESDTFreeze), tokenName, big.NewInt(10).Bytes()))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	output = e.Execute(createESDTCallBackInput(holder, okCode, []byte(core.BuiltInFunctionESDTBurn), tokenName, big.NewInt(10).Bytes()))
+	assert.Equal(t, vmcommon.Ok, output)
+	assert.Equal(t, big.NewInt(10), getTokenFromStorage(t, e, tokenName).BurntValue)
+
+	output = e.Execute(createESDTCallBackInput(holder, okCode, []byte(core.BuiltInFunctionESDTWipe), tokenName, big.NewInt(15).Bytes()))
+	assert.Equal(t, vmcommon.Ok, output)
+	assert.Equal(t, big.NewInt(25), getTokenFromStorage(t, e, tokenName).BurntValue)
+}
+
+func TestEsdt_ExecuteEsdtControlChanges(t *testing.T) {
+	t.Parallel()
+
+	e, _, tokenName := createESDTWithVMContext(t)
+	owner := []byte("tokenOwner")
+
+	output := e.Execute(createESDTCallInput(owner, "esdtControlChanges", tokenName, []byte(mintable)))
+	assert.Equal(t, vmcommon.FunctionWrongSignature, output)
+
+	output = e.Execute(createESDTCallInput(owner, "esdtControlChanges", tokenName, []byte("unknown"), []byte("true")))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	output = e.Execute(createESDTCallInput(owner, "esdtControlChanges", tokenName, []byte(mintable), []byte("maybe")))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	output = e.Execute(createESDTCallInput([]byte("notTheOwner"), "esdtControlChanges", tokenName, []byte(mintable), []byte("false")))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	output = e.Execute(createESDTCallInput(owner, "esdtControlChanges", tokenName, []byte(mintable), []byte("false"), []byte(burnable), []byte("false")))
+	assert.Equal(t, vmcommon.Ok, output)
+
+	token := getTokenFromStorage(t, e, tokenName)
+	assert.False(t, token.Mintable)
+	assert.False(t, token.Burnable)
+	assert.True(t, token.CanPause)
+}
+
+func TestEsdt_ExecuteConfigChangeAndClaim(t *testing.T) {
+	t.Parallel()
+
+	e, eei, _ := createESDTWithVMContext(t)
+	newOwner := []byte("esdtOwner1")
+
+	output := e.Execute(createESDTCallInput([]byte("notTheOwner"), "configChange"))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	output = e.Execute(createESDTCallInput(e.ownerAddress, "configChange", newOwner, big.NewInt(5).Bytes(),
+		big.NewInt(5).Bytes(), big.NewInt(4).Bytes()))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	output = e.Execute(createESDTCallInput(e.ownerAddress, "configChange", newOwner, big.NewInt(5).Bytes(),
+		big.NewInt(4).Bytes(), big.NewInt(5).Bytes()))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	e.ownerAddress = []byte("esdtOwner0")
+	output = e.Execute(createESDTCallInput(e.ownerAddress, "configChange", newOwner, big.NewInt(5).Bytes(),
+		big.NewInt(4).Bytes(), big.NewInt(5).Bytes()))
+	assert.Equal(t, vmcommon.Ok, output)
+
+	esdtConfig, _ := e.getESDTConfig()
+	assert.Equal(t, newOwner, esdtConfig.OwnerAddress)
+	assert.Equal(t, big.NewInt(5), esdtConfig.BaseIssuingCost)
+	assert.Equal(t, uint32(4), esdtConfig.MinTokenNameLength)
+	assert.Equal(t, uint32(5), esdtConfig.MaxTokenNameLength)
+
+	vmInput := createESDTCallInput([]byte("issuer"), "issue", []byte("abcd"), big.NewInt(100).Bytes())
+	vmInput.CallValue = big.NewInt(5)
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	output = e.Execute(createESDTCallInput([]byte("esdtOwner0"), "claim"))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	output = e.Execute(createESDTCallInput(newOwner, "claim"))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	_ = eei.Transfer(e.eSDTSCAddress, []byte("issuer"), big.NewInt(5), nil, 0)
+	output = e.Execute(createESDTCallInput(newOwner, "claim"))
+	assert.Equal(t, vmcommon.Ok, output)
+
+	vmOutput := eei.CreateVMOutput()
+	assert.Equal(t, big.NewInt(5), vmOutput.OutputAccounts[string(newOwner)].BalanceDelta)
+}
@@ -19,6 +19,17 @@ var log = logger.GetOrCreate("vm/systemsmartcontracts")
 
 const ownerKey = "owner"
 const nodesConfigKey = "nodesConfig"
+const equivocationKeyPrefix = "equivocation"
+const rotatedKeyPrefix = "rotatedKey_"
+
+// KeyRotationsKeyPrefix is the prefix of the storage keys holding the validator key rotations of each epoch
+const KeyRotationsKeyPrefix = "keyRotations_"
+
+// KeyRotation holds a BLS key replaced through the auction contract along with the key that replaced it
+type KeyRotation struct {
+	OldKey []byte `json:"OldKey"`
+	NewKey []byte `json:"NewKey"`
+}
 
 type stakingSC struct {
 	eei                      vm.SystemEI
@@ -26,11 +37,15 @@ type stakingSC struct {
 	unBondPeriod             uint64
 	stakeAccessAddr          []byte
 	jailAccessAddr           []byte
+	slashingAccessAddr       []byte
 	numRoundsWithoutBleed    uint64
 	bleedPercentagePerRound  float64
 	maximumPercentageToBleed float64
 	gasCost                  vm.GasCost
 	minNumNodes              int64
+
+	equivocationVerifier        vm.EquivocationProofVerifier
+	equivocationSlashPercentage float64
 }
 
 // ArgsNewStakingSmartContract holds the arguments needed to create a StakingSmartContract
@@ -41,10 +56,14 @@ type ArgsNewStakingSmartContract struct {
 	Eei                      vm.SystemEI
 	StakingAccessAddr        []byte
 	JailAccessAddr           []byte
+	SlashingAccessAddr       []byte
 	NumRoundsWithoutBleed    uint64
 	BleedPercentagePerRound  float64
 	MaximumPercentageToBleed float64
 	GasCost                  vm.GasCost
+
+	EquivocationProofVerifier   vm.EquivocationProofVerifier
+	EquivocationSlashPercentage float64
 }
 
 // NewStakingSmartContract creates a staking smart contract
@@ -66,6 +85,15 @@ func NewStakingSmartContract(
 	if len(args.JailAccessAddr) < 1 {
 		return nil, vm.ErrInvalidJailAccessAddress
 	}
+	if len(args.SlashingAccessAddr) < 1 {
+		return nil, vm.ErrInvalidSlashingAccessAddress
+	}
+	if check.IfNil(args.EquivocationProofVerifier) {
+		return nil, vm.ErrNilEquivocationProofVerifier
+	}
+	if args.EquivocationSlashPercentage < 0 || args.EquivocationSlashPercentage > 1 {
+		return nil, vm.ErrInvalidEquivocationSlashPercentage
+	}
 
 	reg := &stakingSC{
 		minStakeValue:            big.NewInt(0).Set(args.MinStakeValue),
@@ -73,11 +101,15 @@ func NewStakingSmartContract(
 		unBondPeriod:             args.UnBondPeriod,
 		stakeAccessAddr:          args.StakingAccessAddr,
 		jailAccessAddr:           args.JailAccessAddr,
+		slashingAccessAddr:       args.SlashingAccessAddr,
 		numRoundsWithoutBleed:    args.NumRoundsWithoutBleed,
 		bleedPercentagePerRound:  args.BleedPercentagePerRound,
 		maximumPercentageToBleed: args.MaximumPercentageToBleed,
 		gasCost:                  args.GasCost,
 		minNumNodes:              int64(args.MinNumNodes),
+
+		equivocationVerifier:        args.EquivocationProofVerifier,
+		equivocationSlashPercentage: args.EquivocationSlashPercentage,
 	}
 	return reg, nil
 }
@@ -101,6 +133,8 @@ func (r *stakingSC) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCod
 		return r.unBond(args)
 	case "slash":
 		return r.slash(args)
+	case "slashEquivocation":
+		return r.slashEquivocation(args)
 	case "get":
 		return r.get(args)
 	case "isStaked":
@@ -115,6 +149,8 @@ func (r *stakingSC) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCod
 		return r.changeRewardAddress(args)
 	case "changeValidatorKeys":
 		return r.changeValidatorKey(args)
+	case "getKeyRotations":
+		return r.getKeyRotations(args)
 	}
 
 	return vmcommon.UserError
@@ -255,17 +291,148 @@ func (r *stakingSC) changeValidatorKey(args *vmcommon.ContractCallInput) vmcommo
 		// if not registered this is not an error
 		return vmcommon.Ok
 	}
+	if !stakedData.Staked {
+		r.eei.AddReturnMessage("cannot change the key of a node which is not staked")
+		return vmcommon.UserError
+	}
+	if stakedData.JailedRound != math.MaxUint64 {
+		r.eei.AddReturnMessage("cannot change the key of a jailed node")
+		return vmcommon.UserError
+	}
+	if len(r.eei.GetStorage(newKey)) > 0 {
+		r.eei.AddReturnMessage("new bls key is already registered")
+		return vmcommon.UserError
+	}
+	if r.isRotatedKey(newKey) {
+		r.eei.AddReturnMessage("new bls key was rotated in the current epoch")
+		return vmcommon.UserError
+	}
 
+	epoch := r.eei.BlockChainHook().CurrentEpoch()
 	r.eei.SetStorage(oldKey, nil)
+	r.markRotatedKey(oldKey, epoch)
 	err = r.saveStakingData(newKey, stakedData)
 	if err != nil {
 		r.eei.AddReturnMessage("cannot save staking data: error " + err.Error())
 		return vmcommon.UserError
 	}
 
+	keyRotations, err := r.getKeyRotationsForEpoch(epoch)
+	if err != nil {
+		r.eei.AddReturnMessage("cannot get key rotations: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	keyRotations = append(keyRotations, KeyRotation{OldKey: oldKey, NewKey: newKey})
+	err = r.saveKeyRotationsForEpoch(epoch, keyRotations)
+	if err != nil {
+		r.eei.AddReturnMessage("cannot save key rotations: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// getKeyRotations returns the old and the new key of each validator key change made in the given epoch, in the
+// order they were made. The peer state is migrated to the new keys at the start of the next epoch
+func (r *stakingSC) getKeyRotations(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if len(args.Arguments) != 1 {
+		r.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected %d, got %d", 1, len(args.Arguments)))
+		return vmcommon.UserError
+	}
+
+	epoch := big.NewInt(0).SetBytes(args.Arguments[0]).Uint64()
+	keyRotations, err := r.getKeyRotationsForEpoch(uint32(epoch))
+	if err != nil {
+		r.eei.AddReturnMessage("cannot get key rotations: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	for _, keyRotation := range keyRotations {
+		r.eei.Finish(keyRotation.OldKey)
+		r.eei.Finish(keyRotation.NewKey)
+	}
+
 	return vmcommon.Ok
 }
 
+func (r *stakingSC) isKeyChangedInCurrentEpoch(key []byte) (bool, error) {
+	keyRotations, err := r.getKeyRotationsForEpoch(r.eei.BlockChainHook().CurrentEpoch())
+	if err != nil {
+		return false, err
+	}
+
+	for _, keyRotation := range keyRotations {
+		if bytes.Equal(keyRotation.NewKey, key) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (r *stakingSC) getKeyRotationsForEpoch(epoch uint32) ([]KeyRotation, error) {
+	keyRotations := make([]KeyRotation, 0)
+
+	data := r.eei.GetStorage(CreateKeyRotationsKey(epoch))
+	if len(data) == 0 {
+		return keyRotations, nil
+	}
+
+	err := json.Unmarshal(data, &keyRotations)
+	if err != nil {
+		return nil, err
+	}
+
+	return keyRotations, nil
+}
+
+func (r *stakingSC) saveKeyRotationsForEpoch(epoch uint32, keyRotations []KeyRotation) error {
+	data, err := json.Marshal(keyRotations)
+	if err != nil {
+		return err
+	}
+
+	r.eei.SetStorage(CreateKeyRotationsKey(epoch), data)
+	return nil
+}
+
+// markRotatedKey keeps the replaced key reserved until its peer is moved to the new key at the start of the next epoch
+func (r *stakingSC) markRotatedKey(key []byte, epoch uint32) {
+	r.eei.SetStorage(createRotatedKeyKey(key), big.NewInt(0).SetUint64(uint64(epoch)).Bytes())
+}
+
+// isRotatedKey returns true if the key was replaced in the current epoch. A rotated key can not be staked again until
+// UpdateProtocolForKeyRotations moves its peer to the new key, which happens at the start of the next epoch
+func (r *stakingSC) isRotatedKey(key []byte) bool {
+	data := r.eei.GetStorage(createRotatedKeyKey(key))
+	if len(data) == 0 {
+		return false
+	}
+
+	rotationEpoch := big.NewInt(0).SetBytes(data).Uint64()
+	currentEpoch := uint64(r.eei.BlockChainHook().CurrentEpoch())
+
+	return currentEpoch <= rotationEpoch
+}
+
+// releaseRotatedKey removes the mark of a key rotated in a previous epoch
+func (r *stakingSC) releaseRotatedKey(key []byte) {
+	rotatedKeyKey := createRotatedKeyKey(key)
+	if len(r.eei.GetStorage(rotatedKeyKey)) > 0 {
+		r.eei.SetStorage(rotatedKeyKey, nil)
+	}
+}
+
+func createRotatedKeyKey(key []byte) []byte {
+	return append([]byte(rotatedKeyPrefix), key...)
+}
+
+// CreateKeyRotationsKey returns the storage key holding the validator key rotations made in the given epoch
+func CreateKeyRotationsKey(epoch uint32) []byte {
+	return []byte(fmt.Sprintf("%s%d", KeyRotationsKeyPrefix, epoch))
+}
+
 func (r *stakingSC) changeRewardAddress(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	if !bytes.Equal(args.CallerAddr, r.stakeAccessAddr) {
 		r.eei.AddReturnMessage("stake function not allowed to be called by address " + string(args.CallerAddr))
@@ -498,6 +665,12 @@ func (r *stakingSC) stake(args *vmcommon.ContractCallInput, onlyRegister bool) v
 		return vmcommon.UserError
 	}
 
+	if r.isRotatedKey(args.Arguments[0]) {
+		r.eei.AddReturnMessage("cannot stake a key rotated in the current epoch")
+		return vmcommon.UserError
+	}
+	r.releaseRotatedKey(args.Arguments[0])
+
 	stakeValue := r.getStakeValueForCurrentEpoch()
 	registrationData, err := r.getOrCreateRegisteredData(args.Arguments[0])
 	if err != nil {
@@ -566,6 +739,15 @@ func (r *stakingSC) unStake(args *vmcommon.ContractCallInput) vmcommon.ReturnCod
 		r.eei.AddReturnMessage("unStake is not possible as too many left")
 		return vmcommon.UserError
 	}
+	keyChanged, err := r.isKeyChangedInCurrentEpoch(args.Arguments[0])
+	if err != nil {
+		r.eei.AddReturnMessage("cannot get key rotations: error " + err.Error())
+		return vmcommon.UserError
+	}
+	if keyChanged {
+		r.eei.AddReturnMessage("unStake is not possible for a key changed in the current epoch")
+		return vmcommon.UserError
+	}
 
 	r.removeFromStakedNodes()
 	registrationData.Staked = false
@@ -658,25 +840,95 @@ func (r *stakingSC) slash(args *vmcommon.ContractCallInput) vmcommon.ReturnCode
 		return vmcommon.UserError
 	}
 
-	if registrationData.UnJailedNonce >= registrationData.JailedNonce {
-		r.addToJailedNodes()
+	slashValue := big.NewInt(0).SetBytes(args.Arguments[1])
+	err = r.slashAndJail(args.Arguments[0], registrationData, slashValue)
+	if err != nil {
+		r.eei.AddReturnMessage("cannot save staking data: error " + err.Error())
+		return vmcommon.UserError
 	}
 
-	stakedValue := big.NewInt(0).Set(registrationData.StakeValue)
-	slashValue := big.NewInt(0).SetBytes(args.Arguments[1])
-	registrationData.StakeValue = registrationData.StakeValue.Sub(stakedValue, slashValue)
-	registrationData.JailedRound = r.eei.BlockChainHook().CurrentRound()
-	registrationData.JailedNonce = r.eei.BlockChainHook().CurrentNonce()
+	return vmcommon.Ok
+}
 
-	err = r.saveStakingData(args.Arguments[0], registrationData)
+// slashEquivocation slashes and jails the key proven to have signed two different blocks in the same round. The proof
+// is submitted by the metachain while creating or processing a block and it is accepted only once for a key and a round
+func (r *stakingSC) slashEquivocation(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !bytes.Equal(args.CallerAddr, r.slashingAccessAddr) {
+		r.eei.AddReturnMessage("slashEquivocation function called by not the slashing access address")
+		return vmcommon.UserError
+	}
+	if r.equivocationSlashPercentage == 0 {
+		r.eei.AddReturnMessage("equivocation slashing is disabled")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 {
+		retMessage := fmt.Sprintf("slashEquivocation function called with wrong number of arguments: expected %d, got %d", 1, len(args.Arguments))
+		r.eei.AddReturnMessage(retMessage)
+		return vmcommon.UserError
+	}
+
+	blsKey, round, err := r.equivocationVerifier.VerifyProof(args.Arguments[0])
+	if err != nil {
+		r.eei.AddReturnMessage("invalid equivocation proof: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	evidenceKey := createEquivocationKey(blsKey, round)
+	if len(r.eei.GetStorage(evidenceKey)) > 0 {
+		r.eei.AddReturnMessage("equivocation already slashed")
+		return vmcommon.UserError
+	}
+
+	registrationData, err := r.getOrCreateRegisteredData(blsKey)
+	if err != nil {
+		r.eei.AddReturnMessage("cannot get or create registered data: error " + err.Error())
+		return vmcommon.UserError
+	}
+	if len(registrationData.RewardAddress) == 0 {
+		r.eei.AddReturnMessage("cannot slash a key that is not registered")
+		return vmcommon.UserError
+	}
+	if !registrationData.Staked {
+		r.eei.AddReturnMessage("cannot slash already unstaked or user not staked")
+		return vmcommon.UserError
+	}
+
+	slashValue := getPercentageOfValue(registrationData.StakeValue, r.equivocationSlashPercentage)
+	err = r.slashAndJail(blsKey, registrationData, slashValue)
 	if err != nil {
 		r.eei.AddReturnMessage("cannot save staking data: error " + err.Error())
 		return vmcommon.UserError
 	}
+	r.eei.SetStorage(evidenceKey, []byte{1})
+
+	log.Debug("staking SC slashed equivocation",
+		"bls key", blsKey,
+		"round", round,
+		"slash value", slashValue,
+	)
 
 	return vmcommon.Ok
 }
 
+func createEquivocationKey(blsKey []byte, round uint64) []byte {
+	roundBytes := big.NewInt(0).SetUint64(round).Bytes()
+
+	return []byte(equivocationKeyPrefix + string(blsKey) + string(roundBytes))
+}
+
+func (r *stakingSC) slashAndJail(blsKey []byte, registrationData *StakedData, slashValue *big.Int) error {
+	if registrationData.UnJailedNonce >= registrationData.JailedNonce {
+		r.addToJailedNodes()
+	}
+
+	stakedValue := big.NewInt(0).Set(registrationData.StakeValue)
+	registrationData.StakeValue = registrationData.StakeValue.Sub(stakedValue, slashValue)
+	registrationData.JailedRound = r.eei.BlockChainHook().CurrentRound()
+	registrationData.JailedNonce = r.eei.BlockChainHook().CurrentNonce()
+
+	return r.saveStakingData(blsKey, registrationData)
+}
+
 func (r *stakingSC) isStaked(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	if len(args.Arguments) < 1 {
 		r.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected min %d, got %d", 1, 0))
@@ -3,6 +3,7 @@ package systemSmartContracts
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"math"
 	"math/big"
 	"testing"
@@ -25,10 +26,13 @@ func createMockStakingScArguments() ArgsNewStakingSmartContract {
 		Eei:                      &mock.SystemEIStub{},
 		StakingAccessAddr:        []byte("auction"),
 		JailAccessAddr:           []byte("jail"),
+		SlashingAccessAddr:       []byte("staking"),
 		NumRoundsWithoutBleed:    0,
 		BleedPercentagePerRound:  0,
 		MaximumPercentageToBleed: 0,
 		MinNumNodes:              0,
+
+		EquivocationProofVerifier: &mock.EquivocationProofVerifierStub{},
 	}
 }
 
@@ -91,6 +95,39 @@ func TestNewStakingSmartContract_NilJailAccessAddrEIShouldErr(t *testing.T) {
 	assert.Equal(t, vm.ErrInvalidJailAccessAddress, err)
 }
 
+func TestNewStakingSmartContract_NilSlashingAccessAddrShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockStakingScArguments()
+	args.SlashingAccessAddr = nil
+	stakingSmartContract, err := NewStakingSmartContract(args)
+
+	assert.Nil(t, stakingSmartContract)
+	assert.Equal(t, vm.ErrInvalidSlashingAccessAddress, err)
+}
+
+func TestNewStakingSmartContract_NilEquivocationProofVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockStakingScArguments()
+	args.EquivocationProofVerifier = nil
+	stakingSmartContract, err := NewStakingSmartContract(args)
+
+	assert.Nil(t, stakingSmartContract)
+	assert.Equal(t, vm.ErrNilEquivocationProofVerifier, err)
+}
+
+func TestNewStakingSmartContract_InvalidEquivocationSlashPercentageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockStakingScArguments()
+	args.EquivocationSlashPercentage = 1.5
+	stakingSmartContract, err := NewStakingSmartContract(args)
+
+	assert.Nil(t, stakingSmartContract)
+	assert.Equal(t, vm.ErrInvalidEquivocationSlashPercentage, err)
+}
+
 func TestNewStakingSmartContract_NegativeStakeValueShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -790,6 +827,123 @@ func TestStakingSC_ExecuteSlashStaked(t *testing.T) {
 	assert.Equal(t, vmcommon.Ok, retCode)
 }
 
+func TestStakingSC_ExecuteSlashEquivocationDisabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	verifyCalled := false
+	args := createMockStakingScArguments()
+	args.EquivocationProofVerifier = &mock.EquivocationProofVerifierStub{
+		VerifyProofCalled: func(proof []byte) ([]byte, uint64, error) {
+			verifyCalled = true
+			return []byte("blsKey"), 5, nil
+		},
+	}
+	stakingSmartContract, _ := NewStakingSmartContract(args)
+
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "slashEquivocation"
+	arguments.Arguments = [][]byte{[]byte("proof")}
+
+	retCode := stakingSmartContract.Execute(arguments)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.False(t, verifyCalled)
+}
+
+func TestStakingSC_ExecuteSlashEquivocationNotFromSlashingAccessAddrShouldErr(t *testing.T) {
+	t.Parallel()
+
+	verifyCalled := false
+	args := createMockStakingScArguments()
+	args.EquivocationSlashPercentage = 0.1
+	args.EquivocationProofVerifier = &mock.EquivocationProofVerifierStub{
+		VerifyProofCalled: func(proof []byte) ([]byte, uint64, error) {
+			verifyCalled = true
+			return []byte("blsKey"), 5, nil
+		},
+	}
+	stakingSmartContract, _ := NewStakingSmartContract(args)
+
+	arguments := CreateVmContractCallInput()
+	arguments.CallerAddr = []byte("anyone")
+	arguments.Function = "slashEquivocation"
+	arguments.Arguments = [][]byte{[]byte("proof")}
+
+	retCode := stakingSmartContract.Execute(arguments)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.False(t, verifyCalled)
+}
+
+func TestStakingSC_ExecuteSlashEquivocationInvalidProofShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockStakingScArguments()
+	args.EquivocationSlashPercentage = 0.1
+	args.EquivocationProofVerifier = &mock.EquivocationProofVerifierStub{
+		VerifyProofCalled: func(proof []byte) ([]byte, uint64, error) {
+			return nil, 0, errors.New("invalid proof")
+		},
+	}
+	stakingSmartContract, _ := NewStakingSmartContract(args)
+
+	arguments := CreateVmContractCallInput()
+	arguments.CallerAddr = args.SlashingAccessAddr
+	arguments.Function = "slashEquivocation"
+	arguments.Arguments = [][]byte{[]byte("proof")}
+	retCode := stakingSmartContract.Execute(arguments)
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	arguments.Arguments = [][]byte{[]byte("proof"), []byte("extra")}
+	retCode = stakingSmartContract.Execute(arguments)
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestStakingSC_ExecuteSlashEquivocationShouldSlashAndJailOnce(t *testing.T) {
+	t.Parallel()
+
+	blsKey := []byte("blsKey")
+	blockChainHook := &mock.BlockChainHookStub{
+		CurrentRoundCalled: func() uint64 {
+			return 10
+		},
+	}
+	eei, _ := NewVMContext(blockChainHook, hooks.NewVMCryptoHook(), &mock.ArgumentParserMock{}, &mock.AccountsStub{})
+	eei.SetSCAddress([]byte("staking"))
+
+	args := createMockStakingScArguments()
+	args.Eei = eei
+	args.EquivocationSlashPercentage = 0.1
+	args.EquivocationProofVerifier = &mock.EquivocationProofVerifierStub{
+		VerifyProofCalled: func(proof []byte) ([]byte, uint64, error) {
+			return blsKey, 5, nil
+		},
+	}
+	stakingSmartContract, _ := NewStakingSmartContract(args)
+
+	registrationData := &StakedData{
+		Staked:        true,
+		RewardAddress: []byte("reward"),
+		StakeValue:    big.NewInt(1000),
+		JailedRound:   math.MaxUint64,
+	}
+	_ = stakingSmartContract.saveStakingData(blsKey, registrationData)
+
+	arguments := CreateVmContractCallInput()
+	arguments.CallerAddr = args.SlashingAccessAddr
+	arguments.Function = "slashEquivocation"
+	arguments.Arguments = [][]byte{[]byte("proof")}
+
+	retCode := stakingSmartContract.Execute(arguments)
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	slashedData, _ := stakingSmartContract.getOrCreateRegisteredData(blsKey)
+	assert.Equal(t, big.NewInt(900), slashedData.StakeValue)
+	assert.Equal(t, uint64(10), slashedData.JailedRound)
+
+	retCode = stakingSmartContract.Execute(arguments)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, "equivocation already slashed", eei.returnMessage)
+}
+
 func TestStakingSC_ExecuteUnStakeAndUnBoundStake(t *testing.T) {
 	t.Parallel()
 
@@ -1124,6 +1278,112 @@ func TestStakingSc_StakeJailAndUnJail(t *testing.T) {
 	require.Equal(t, big.NewInt(999), registrationData.StakeValue)
 }
 
+func TestStakingSc_ChangeValidatorKeyShouldRecordTheKeyRotation(t *testing.T) {
+	t.Parallel()
+
+	currentEpoch := uint32(3)
+	blockChainHook := &mock.BlockChainHookStub{
+		CurrentEpochCalled: func() uint32 {
+			return currentEpoch
+		},
+	}
+	eei, _ := NewVMContext(blockChainHook, hooks.NewVMCryptoHook(), &mock.ArgumentParserMock{}, &mock.AccountsStub{})
+	eei.SetSCAddress([]byte("addr"))
+
+	stakingAccessAddress := []byte("stakingAccessAddress")
+	args := createMockStakingScArguments()
+	args.StakingAccessAddr = stakingAccessAddress
+	args.Eei = eei
+	stakingSmartContract, _ := NewStakingSmartContract(args)
+
+	stakerAddress := []byte("stakerAddr")
+	oldKey := []byte("oldPublicKey")
+	newKey := []byte("newPublicKey")
+	otherKey := []byte("othPublicKey")
+	doStake(t, stakingSmartContract, stakingAccessAddress, stakerAddress, oldKey)
+	doStake(t, stakingSmartContract, stakingAccessAddress, stakerAddress, otherKey)
+
+	// only the auction contract can change keys
+	doChangeValidatorKey(t, stakingSmartContract, []byte("addr"), oldKey, newKey, vmcommon.UserError)
+	// the new key should not be registered
+	doChangeValidatorKey(t, stakingSmartContract, stakingAccessAddress, oldKey, otherKey, vmcommon.UserError)
+	// the keys should have the same length
+	doChangeValidatorKey(t, stakingSmartContract, stakingAccessAddress, oldKey, []byte("short"), vmcommon.UserError)
+	doChangeValidatorKey(t, stakingSmartContract, stakingAccessAddress, oldKey, newKey, vmcommon.Ok)
+
+	assert.Equal(t, 0, len(eei.GetStorage(oldKey)))
+	assert.True(t, len(eei.GetStorage(newKey)) > 0)
+
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "getKeyRotations"
+	arguments.Arguments = [][]byte{big.NewInt(int64(currentEpoch)).Bytes()}
+	retCode := stakingSmartContract.Execute(arguments)
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, [][]byte{oldKey, newKey}, eei.output)
+
+	// the peer state is migrated at the start of the next epoch, until then the new key cannot be unStaked
+	doUnStake(t, stakingSmartContract, stakingAccessAddress, stakerAddress, newKey, vmcommon.UserError)
+	currentEpoch++
+	doUnStake(t, stakingSmartContract, stakingAccessAddress, stakerAddress, newKey, vmcommon.Ok)
+
+	// the key of an unStaked node cannot be changed
+	doChangeValidatorKey(t, stakingSmartContract, stakingAccessAddress, newKey, []byte("anoPublicKey"), vmcommon.UserError)
+}
+
+func TestStakingSc_StakeRotatedKeyShouldWorkOnlyFromTheNextEpoch(t *testing.T) {
+	t.Parallel()
+
+	currentEpoch := uint32(3)
+	blockChainHook := &mock.BlockChainHookStub{
+		CurrentEpochCalled: func() uint32 {
+			return currentEpoch
+		},
+	}
+	eei, _ := NewVMContext(blockChainHook, hooks.NewVMCryptoHook(), &mock.ArgumentParserMock{}, &mock.AccountsStub{})
+	eei.SetSCAddress([]byte("addr"))
+
+	stakingAccessAddress := []byte("stakingAccessAddress")
+	args := createMockStakingScArguments()
+	args.StakingAccessAddr = stakingAccessAddress
+	args.Eei = eei
+	stakingSmartContract, _ := NewStakingSmartContract(args)
+
+	stakerAddress := []byte("stakerAddr")
+	oldKey := []byte("oldPublicKey")
+	newKey := []byte("newPublicKey")
+	doStake(t, stakingSmartContract, stakingAccessAddress, stakerAddress, oldKey)
+	doChangeValidatorKey(t, stakingSmartContract, stakingAccessAddress, oldKey, newKey, vmcommon.Ok)
+
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "stake"
+	arguments.CallerAddr = stakingAccessAddress
+	arguments.Arguments = [][]byte{oldKey, stakerAddress}
+
+	// the rotated key is reserved until its peer is moved to the new key at the start of the next epoch
+	retCode := stakingSmartContract.Execute(arguments)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	arguments.Function = "register"
+	retCode = stakingSmartContract.Execute(arguments)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	doChangeValidatorKey(t, stakingSmartContract, stakingAccessAddress, newKey, oldKey, vmcommon.UserError)
+	assert.Equal(t, 0, len(eei.GetStorage(oldKey)))
+
+	currentEpoch++
+	doStake(t, stakingSmartContract, stakingAccessAddress, stakerAddress, oldKey)
+	assert.True(t, len(eei.GetStorage(oldKey)) > 0)
+	assert.Equal(t, 0, len(eei.GetStorage(createRotatedKeyKey(oldKey))))
+}
+
+func doChangeValidatorKey(t *testing.T, sc *stakingSC, callerAddr, oldKey, newKey []byte, expectedCode vmcommon.ReturnCode) {
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "changeValidatorKeys"
+	arguments.CallerAddr = callerAddr
+	arguments.Arguments = [][]byte{oldKey, newKey}
+
+	retCode := sc.Execute(arguments)
+	assert.Equal(t, expectedCode, retCode)
+}
+
 func doUnJail(t *testing.T, sc *stakingSC, callerAddr, addrToUnJail []byte, expectedCode vmcommon.ReturnCode) {
 	arguments := CreateVmContractCallInput()
 	arguments.Function = "unJail"